@@ -0,0 +1,144 @@
+package bifrost
+
+import (
+	"strings"
+	"unicode"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// assistantPrefillInstruction asks a model without native prefill support to begin its response with the prefill.
+const assistantPrefillInstruction = "Begin your response with exactly the following text, then continue it:\n\n"
+
+// supportsAssistantPrefill reports whether the provider continues a trailing assistant message natively.
+func supportsAssistantPrefill(provider schemas.ModelProvider, model string) bool {
+	switch provider {
+	case schemas.Anthropic:
+		return true
+	case schemas.Bedrock, schemas.Vertex:
+		return schemas.IsAnthropicModel(model)
+	}
+	return false
+}
+
+// getAssistantPrefill returns the text of the trailing assistant message of a conversation, the prefill the model
+// is asked to continue from, or "" if the conversation does not end with an assistant text message.
+// Trailing whitespace is not part of the prefill, Anthropic rejects it.
+func getAssistantPrefill(messages []schemas.ChatMessage) string {
+	if len(messages) == 0 {
+		return ""
+	}
+	last := messages[len(messages)-1]
+	if last.Role != schemas.ChatMessageRoleAssistant || last.Content == nil {
+		return ""
+	}
+	if last.ChatAssistantMessage != nil && len(last.ChatAssistantMessage.ToolCalls) > 0 {
+		return ""
+	}
+	var prefill string
+	if last.Content.ContentStr != nil {
+		prefill = *last.Content.ContentStr
+	} else {
+		var text strings.Builder
+		for _, block := range last.Content.ContentBlocks {
+			if block.Text != nil {
+				text.WriteString(*block.Text)
+			}
+		}
+		prefill = text.String()
+	}
+	return strings.TrimRightFunc(prefill, unicode.IsSpace)
+}
+
+// prepareAssistantPrefill applies the provider's assistant prefill mode to a chat request ending with an assistant
+// message the provider cannot continue natively. In emulate mode, the assistant message is replaced by an
+// instruction to begin the response with the prefill, and the returned prefill must be removed from the response.
+// The caller's request is left untouched, a copy is returned if the messages are rewritten.
+func (bifrost *Bifrost) prepareAssistantPrefill(req *schemas.BifrostChatRequest, provider schemas.ModelProvider, mode schemas.AssistantPrefillMode) (*schemas.BifrostChatRequest, string) {
+	if mode == "" || req == nil || supportsAssistantPrefill(provider, req.Model) {
+		return req, ""
+	}
+	prefill := getAssistantPrefill(req.Input)
+	if prefill == "" {
+		return req, ""
+	}
+	if mode == schemas.AssistantPrefillModeWarn {
+		bifrost.logger.Warn("provider %s does not support assistant prefill, the trailing assistant message of the %s request is sent unchanged", req.Provider, req.Model)
+		return req, ""
+	}
+
+	emulated := *req
+	emulated.Input = make([]schemas.ChatMessage, len(req.Input))
+	copy(emulated.Input, req.Input)
+	emulated.Input[len(emulated.Input)-1] = schemas.ChatMessage{
+		Role:    schemas.ChatMessageRoleUser,
+		Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(assistantPrefillInstruction + prefill)},
+	}
+	return &emulated, prefill
+}
+
+// trimAssistantPrefill removes an emulated prefill the model repeated at the start of its response, so that the
+// response only holds the continuation, as with providers supporting prefill natively.
+func trimAssistantPrefill(resp *schemas.BifrostResponse, prefill string) {
+	if resp == nil || resp.ChatResponse == nil {
+		return
+	}
+	for _, choice := range resp.ChatResponse.Choices {
+		if choice.ChatNonStreamResponseChoice == nil || choice.Message == nil || choice.Message.Content == nil {
+			continue
+		}
+		content := choice.Message.Content
+		if content.ContentStr != nil {
+			if continuation, ok := strings.CutPrefix(*content.ContentStr, prefill); ok {
+				content.ContentStr = schemas.Ptr(continuation)
+			}
+		} else if len(content.ContentBlocks) > 0 && content.ContentBlocks[0].Text != nil {
+			if continuation, ok := strings.CutPrefix(*content.ContentBlocks[0].Text, prefill); ok {
+				content.ContentBlocks[0].Text = schemas.Ptr(continuation)
+			}
+		}
+	}
+}
+
+// assistantPrefillStreamTrimmer removes an emulated prefill the model repeated at the start of a streamed response.
+// The prefill may span several chunks: content matching it is held back, and given back if the response diverges.
+type assistantPrefillStreamTrimmer struct {
+	prefill string
+	matched map[int]int // Length of the prefill matched so far per choice index, -1 once the prefill is handled
+}
+
+func newAssistantPrefillStreamTrimmer(prefill string) *assistantPrefillStreamTrimmer {
+	return &assistantPrefillStreamTrimmer{prefill: prefill, matched: make(map[int]int)}
+}
+
+// trim removes the part of the prefill held by the chunk's content deltas.
+func (t *assistantPrefillStreamTrimmer) trim(resp *schemas.BifrostResponse) {
+	if resp == nil || resp.ChatResponse == nil {
+		return
+	}
+	for _, choice := range resp.ChatResponse.Choices {
+		if choice.ChatStreamResponseChoice == nil || choice.Delta == nil || choice.Delta.Content == nil {
+			continue
+		}
+		matched := t.matched[choice.Index]
+		if matched < 0 {
+			continue
+		}
+		content := *choice.Delta.Content
+		remaining := t.prefill[matched:]
+		switch {
+		case strings.HasPrefix(content, remaining):
+			content = content[len(remaining):]
+			matched = -1
+		case strings.HasPrefix(remaining, content):
+			matched += len(content)
+			content = ""
+		default:
+			// The response does not start with the prefill, give back the content held so far
+			content = t.prefill[:matched] + content
+			matched = -1
+		}
+		t.matched[choice.Index] = matched
+		choice.Delta.Content = schemas.Ptr(content)
+	}
+}
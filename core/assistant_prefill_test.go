@@ -0,0 +1,119 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that an assistant prefill is emulated for a provider without native support, and not duplicated in the response
+func TestAssistantPrefill_Emulate(t *testing.T) {
+	bodies := make(chan map[string]any, 1)
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies <- body
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"{\"colors\": [\"red\", \"green\"]}"},"finish_reason":"stop"}]}`))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.configs[schemas.OpenAI].AssistantPrefill = schemas.AssistantPrefillModeEmulate
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	request := newTestChatRequest(schemas.OpenAI)
+	request.Input = append(request.Input, schemas.ChatMessage{
+		Role:    schemas.ChatMessageRoleAssistant,
+		Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(`{"colors": [ `)},
+	})
+	resp, bifrostErr := client.ChatCompletionRequest(context.Background(), request)
+	if bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+
+	body := <-bodies
+	messages, _ := body["messages"].([]any)
+	if len(messages) != 2 {
+		t.Fatalf("Expected 2 messages to be sent, got %v", body["messages"])
+	}
+	last, _ := messages[1].(map[string]any)
+	if last["role"] != "user" || !strings.HasSuffix(last["content"].(string), `{"colors": [`) {
+		t.Errorf("Expected the prefill to be sent as an instruction, got %v", last)
+	}
+	if request.Input[1].Role != schemas.ChatMessageRoleAssistant {
+		t.Errorf("Expected the caller's request to be left untouched, got %v", request.Input[1].Role)
+	}
+	content := resp.Choices[0].Message.Content.ContentStr
+	if content == nil || *content != `"red", "green"]}` {
+		t.Errorf("Expected only the continuation of the prefill, got %v", content)
+	}
+}
+
+// Test that a prefill spanning several stream chunks is removed, and that held content is given back on divergence
+func TestAssistantPrefillStreamTrimmer(t *testing.T) {
+	chunk := func(content string) *schemas.BifrostResponse {
+		return &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+			Choices: []schemas.BifrostResponseChoice{{
+				ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{
+					Delta: &schemas.ChatStreamResponseChoiceDelta{Content: schemas.Ptr(content)},
+				},
+			}},
+		}}
+	}
+	stream := func(prefill string, contents ...string) string {
+		trimmer := newAssistantPrefillStreamTrimmer(prefill)
+		var out strings.Builder
+		for _, content := range contents {
+			resp := chunk(content)
+			trimmer.trim(resp)
+			out.WriteString(*resp.ChatResponse.Choices[0].Delta.Content)
+		}
+		return out.String()
+	}
+
+	if got := stream("Once upon", "Once", " up", "on a time", " there"); got != " a time there" {
+		t.Errorf("Expected the repeated prefill to be removed, got %q", got)
+	}
+	if got := stream("Once upon", "Once", " there was"); got != "Once there was" {
+		t.Errorf("Expected the held content to be given back, got %q", got)
+	}
+	if got := stream("Once upon", "Hello", " Once upon"); got != "Hello Once upon" {
+		t.Errorf("Expected a response not starting with the prefill to be unchanged, got %q", got)
+	}
+}
+
+// Test that providers with native prefill support and requests without prefill are sent unchanged
+func TestPrepareAssistantPrefill_Unchanged(t *testing.T) {
+	bifrost := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	prefilled := []schemas.ChatMessage{
+		{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("hello")}},
+		{Role: schemas.ChatMessageRoleAssistant, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Hi")}},
+	}
+	tests := []struct {
+		name     string
+		provider schemas.ModelProvider
+		model    string
+		mode     schemas.AssistantPrefillMode
+		input    []schemas.ChatMessage
+	}{
+		{"Anthropic", schemas.Anthropic, "claude-sonnet-4-5", schemas.AssistantPrefillModeEmulate, prefilled},
+		{"BedrockClaude", schemas.Bedrock, "anthropic.claude-3-5-sonnet", schemas.AssistantPrefillModeEmulate, prefilled},
+		{"NoMode", schemas.OpenAI, "gpt-4o", "", prefilled},
+		{"Warn", schemas.OpenAI, "gpt-4o", schemas.AssistantPrefillModeWarn, prefilled},
+		{"NoPrefill", schemas.OpenAI, "gpt-4o", schemas.AssistantPrefillModeEmulate, prefilled[:1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &schemas.BifrostChatRequest{Provider: tt.provider, Model: tt.model, Input: tt.input}
+			sent, prefill := bifrost.prepareAssistantPrefill(req, tt.provider, tt.mode)
+			if sent != req || prefill != "" {
+				t.Errorf("Expected the request to be sent unchanged, got prefill %q", prefill)
+			}
+		})
+	}
+}
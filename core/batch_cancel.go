@@ -0,0 +1,94 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// maxBatchCancelAllPages bounds the batch list pages walked by BatchCancelAllRequest, in case a provider
+// keeps returning a next page.
+const maxBatchCancelAllPages = 1000
+
+// BatchCancelAllRequest cancels every running batch job of a provider, as a safety valve when runaway batch
+// jobs must be stopped. The provider's batch jobs are listed across all its batch keys, and a cancellation is
+// requested for each one that is not terminal or already being cancelled.
+// A failed cancellation does not stop the others, the response reports which batch jobs were cancelled and
+// which could not be. An error is only returned if the batch jobs could not be listed.
+func (bifrost *Bifrost) BatchCancelAllRequest(ctx context.Context, req *schemas.BifrostBatchCancelAllRequest) (*schemas.BifrostBatchCancelAllResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "batch cancel all request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for batch cancel all request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	// List every batch job first, so that cancellations do not shift the pages being walked
+	var running []string
+	skipped := 0
+	var cursor *string
+	for page := 0; page < maxBatchCancelAllPages; page++ {
+		listResp, bifrostErr := bifrost.BatchListRequest(ctx, &schemas.BifrostBatchListRequest{
+			Provider: req.Provider,
+			Model:    req.Model,
+			// Providers read their cursor from different fields, the serial list helper cursor works with all of them
+			After:       cursor,
+			AfterID:     cursor,
+			PageToken:   cursor,
+			ExtraParams: req.ExtraParams,
+		})
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		for _, batch := range listResp.Data {
+			if batch.Status.IsTerminal() || batch.Status == schemas.BatchStatusCancelling {
+				skipped++
+				continue
+			}
+			running = append(running, batch.ID)
+		}
+		if !listResp.HasMore || listResp.NextCursor == nil || *listResp.NextCursor == "" || (cursor != nil && *listResp.NextCursor == *cursor) {
+			break
+		}
+		cursor = listResp.NextCursor
+	}
+
+	response := &schemas.BifrostBatchCancelAllResponse{
+		Provider:  req.Provider,
+		Cancelled: []schemas.BifrostBatchCancelResponse{},
+		Failed:    []schemas.BatchCancelFailure{},
+		Skipped:   skipped,
+	}
+	for _, batchID := range running {
+		cancelResp, bifrostErr := bifrost.BatchCancelRequest(ctx, &schemas.BifrostBatchCancelRequest{
+			Provider:    req.Provider,
+			Model:       req.Model,
+			BatchID:     batchID,
+			ExtraParams: req.ExtraParams,
+		})
+		if bifrostErr != nil {
+			bifrost.logger.Warn(fmt.Sprintf("failed to cancel batch %s (provider %s): %s", batchID, req.Provider, GetErrorMessage(bifrostErr)))
+			response.Failed = append(response.Failed, schemas.BatchCancelFailure{
+				BatchID: batchID,
+				Error:   GetErrorMessage(bifrostErr),
+			})
+			continue
+		}
+		response.Cancelled = append(response.Cancelled, *cancelResp)
+	}
+	return response, nil
+}
@@ -0,0 +1,77 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that every running batch across all list pages gets a cancel request, and that a failed
+// cancellation is reported without stopping the others
+func TestBatchCancelAll(t *testing.T) {
+	var mu sync.Mutex
+	var cancelled []string
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/batches":
+			if r.URL.Query().Get("after") == "" {
+				w.Write([]byte(`{"object":"list","data":[
+					{"id":"batch_1","object":"batch","status":"in_progress"},
+					{"id":"batch_2","object":"batch","status":"completed"}
+				],"has_more":true}`))
+				return
+			}
+			w.Write([]byte(`{"object":"list","data":[
+				{"id":"batch_3","object":"batch","status":"validating"},
+				{"id":"batch_4","object":"batch","status":"finalizing"},
+				{"id":"batch_5","object":"batch","status":"cancelling"}
+			],"has_more":false}`))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/cancel"):
+			batchID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/batches/"), "/cancel")
+			mu.Lock()
+			cancelled = append(cancelled, batchID)
+			mu.Unlock()
+			if batchID == "batch_4" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":{"message":"Cannot cancel a batch that is finalizing","type":"invalid_request_error"}}`))
+				return
+			}
+			fmt.Fprintf(w, `{"id":%q,"object":"batch","status":"cancelling"}`, batchID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.keys[schemas.OpenAI][0].UseForBatchAPI = schemas.Ptr(true)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	resp, bifrostErr := client.BatchCancelAllRequest(context.Background(), &schemas.BifrostBatchCancelAllRequest{Provider: schemas.OpenAI})
+	if bifrostErr != nil {
+		t.Fatalf("Expected batch cancel all to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+
+	mu.Lock()
+	slices.Sort(cancelled)
+	if !slices.Equal(cancelled, []string{"batch_1", "batch_3", "batch_4"}) {
+		t.Errorf("Expected cancel requests for every running batch, got %v", cancelled)
+	}
+	mu.Unlock()
+	if len(resp.Cancelled) != 2 || resp.Cancelled[0].ID != "batch_1" || resp.Cancelled[1].ID != "batch_3" {
+		t.Errorf("Expected batch_1 and batch_3 to be cancelled, got %+v", resp.Cancelled)
+	}
+	if len(resp.Failed) != 1 || resp.Failed[0].BatchID != "batch_4" || !strings.Contains(resp.Failed[0].Error, "finalizing") {
+		t.Errorf("Expected batch_4 cancellation to fail, got %+v", resp.Failed)
+	}
+	if resp.Skipped != 2 {
+		t.Errorf("Expected the completed and cancelling batches to be skipped, got %d", resp.Skipped)
+	}
+}
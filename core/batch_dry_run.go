@@ -0,0 +1,251 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+// batchInputFields are the request body fields counted towards the estimated input tokens of batch requests.
+var batchInputFields = []string{"messages", "input", "prompt", "system", "instructions", "tools", "contents"}
+
+// batchOutputLimitFields are the request body fields holding the output token limit of batch requests, in order
+// of precedence.
+var batchOutputLimitFields = []string{"max_completion_tokens", "max_tokens", "max_output_tokens"}
+
+// BatchDryRunRequest checks a batch before it is submitted: the input requests are validated and counted, their
+// token usage is estimated, and the provider-native batch create request is rendered with its credentials
+// redacted. Nothing is uploaded or submitted, so that large batches can be verified before they are paid for.
+// Validation errors are reported in the response, an error is only returned if the batch cannot be checked at
+// all. Streamed inputs are read to be checked. Inputs already uploaded (input_file_id) are not downloaded, so
+// they are neither counted nor estimated. The request is only rendered for providers implementing
+// schemas.BatchCreateRenderer, and if it passed validation.
+func (bifrost *Bifrost) BatchDryRunRequest(ctx context.Context, req *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchDryRunResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "batch dry run request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for batch dry run request",
+			},
+		}
+	}
+	if req.InputFileID == "" && len(req.Requests) == 0 && req.InputReader == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "either input_file_id, requests or an input reader is required for batch dry run request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	provider := bifrost.getProviderByKey(req.Provider)
+	if provider == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider not found for batch dry run request",
+			},
+		}
+	}
+
+	response := &schemas.BifrostBatchDryRunResponse{Provider: req.Provider}
+	renderReq := *req
+	seen := make(map[string]struct{})
+	var items []schemas.BatchRequestItem
+	switch {
+	case len(req.Requests) > 0:
+		items = req.Requests
+		if message := bifrost.checkInlineBatchRequestCount(req); message != "" {
+			response.Errors = append(response.Errors, schemas.BatchError{
+				Code:    "too_many_requests",
+				Message: message,
+			})
+		}
+		for i, item := range items {
+			if err := checkBatchItem(item, seen); err != nil {
+				response.Errors = append(response.Errors, schemas.BatchError{
+					Code:    "invalid_request",
+					Message: err.Error(),
+					Line:    schemas.Ptr(i + 1),
+				})
+			}
+		}
+	case req.InputReader != nil:
+		// Streamed inputs are uploaded as a batch file before the batch is created from it
+		result, err := providerUtils.ScanJSONL(req.InputReader, func(line []byte) error {
+			var item schemas.BatchRequestItem
+			if err := serialization.Unmarshal(line, &item); err != nil {
+				return fmt.Errorf("batch request is not a valid JSON object: %v", err)
+			}
+			items = append(items, item)
+			return checkBatchItem(item, seen)
+		})
+		if err != nil {
+			response.Errors = append(response.Errors, schemas.BatchError{
+				Code:    "invalid_input",
+				Message: fmt.Sprintf("failed to read batch requests: %v", err),
+			})
+		}
+		response.Errors = append(response.Errors, result.Errors...)
+		renderReq.InputReader = nil
+		renderReq.InputFileID = providerUtils.RenderedInputFileID
+	}
+	if req.InputReader != nil && len(items) == 0 && len(response.Errors) == 0 {
+		response.Errors = append(response.Errors, schemas.BatchError{
+			Code:    "invalid_input",
+			Message: "batch input contains no requests",
+		})
+	}
+	response.RequestCount = len(items)
+	if len(items) > 0 {
+		response.Estimate = estimateBatchRequests(items, req.Model)
+	}
+
+	if len(response.Errors) == 0 {
+		if renderer, ok := provider.(schemas.BatchCreateRenderer); ok {
+			rendered, err := bifrost.renderBatchCreate(ctx, renderer, &renderReq)
+			if err != nil {
+				response.Errors = append(response.Errors, schemas.BatchError{
+					Code:    "invalid_request",
+					Message: GetErrorMessage(err),
+				})
+			}
+			response.Rendered = rendered
+		}
+	}
+	response.Valid = len(response.Errors) == 0
+	return response, nil
+}
+
+// checkBatchItem checks that a batch request has a body and a custom ID unique within the batch.
+func checkBatchItem(item schemas.BatchRequestItem, seen map[string]struct{}) error {
+	if item.CustomID == "" {
+		return fmt.Errorf("custom_id is required")
+	}
+	if _, ok := seen[item.CustomID]; ok {
+		return fmt.Errorf("duplicate custom_id %q", item.CustomID)
+	}
+	seen[item.CustomID] = struct{}{}
+	if item.Body == nil && item.Params == nil {
+		return fmt.Errorf("request %q has no body", item.CustomID)
+	}
+	return nil
+}
+
+// estimateBatchRequests estimates the token usage of batch requests, per model. Input tokens are estimated from
+// the length of the request contents, output tokens from the output token limits of the requests setting one.
+// Requests without a model in their body are counted towards the model of the batch, if any.
+func estimateBatchRequests(items []schemas.BatchRequestItem, batchModel *string) *schemas.BatchEstimate {
+	estimate := &schemas.BatchEstimate{Models: make(map[string]*schemas.BatchModelEstimate)}
+	for _, item := range items {
+		body := item.Body
+		if body == nil {
+			body = item.Params
+		}
+		model, _ := body["model"].(string)
+		if model == "" && batchModel != nil {
+			model = *batchModel
+		}
+		modelEstimate, ok := estimate.Models[model]
+		if !ok {
+			modelEstimate = &schemas.BatchModelEstimate{}
+			estimate.Models[model] = modelEstimate
+		}
+		modelEstimate.Requests++
+
+		chars := 0
+		for _, field := range batchInputFields {
+			if value, ok := body[field]; ok {
+				if data, err := serialization.Marshal(value); err == nil {
+					chars += len(data)
+				}
+			}
+		}
+		inputTokens := (chars + estimatedCharsPerToken - 1) / estimatedCharsPerToken
+		modelEstimate.InputTokens += inputTokens
+		estimate.InputTokens += inputTokens
+
+		if outputTokens, ok := batchOutputLimit(body); ok {
+			modelEstimate.OutputTokens += outputTokens
+			estimate.OutputTokens += outputTokens
+		} else {
+			modelEstimate.RequestsWithoutOutputLimit++
+		}
+	}
+	return estimate
+}
+
+// batchOutputLimit returns the output token limit of a batch request body, if it sets one.
+func batchOutputLimit(body map[string]interface{}) (int, bool) {
+	for _, field := range batchOutputLimitFields {
+		switch limit := body[field].(type) {
+		case float64:
+			return int(limit), true
+		case int:
+			return limit, true
+		case int64:
+			return int(limit), true
+		case json.Number:
+			if n, err := limit.Int64(); err == nil {
+				return int(n), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// renderBatchCreate renders the batch create request a provider would send with the key BatchCreateRequest
+// would select, with the credentials of its headers and body redacted.
+func (bifrost *Bifrost) renderBatchCreate(ctx context.Context, renderer schemas.BatchCreateRenderer, req *schemas.BifrostBatchCreateRequest) (*schemas.BatchRenderedRequest, *schemas.BifrostError) {
+	config, err := bifrost.account.GetConfigForProvider(req.Provider)
+	if err != nil {
+		return nil, newBifrostError(err)
+	}
+	baseProvider := req.Provider
+	if config.CustomProviderConfig != nil && config.CustomProviderConfig.BaseProviderType != "" {
+		baseProvider = config.CustomProviderConfig.BaseProviderType
+	}
+	key := schemas.Key{}
+	if providerRequiresKey(baseProvider, config.CustomProviderConfig) {
+		model := ""
+		if req.Model != nil {
+			model = *req.Model
+		}
+		if key, err = bifrost.selectKeyFromProviderForModel(&ctx, schemas.BatchCreateRequest, req.Provider, model, baseProvider); err != nil {
+			return nil, newBifrostError(err)
+		}
+	}
+
+	rendered, bifrostErr := renderer.RenderBatchCreate(ctx, key, req)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	for name := range rendered.Headers {
+		if isSensitiveCapturedField(name) {
+			rendered.Headers[name] = schemas.CapturedRequestRedacted
+		}
+	}
+	if len(rendered.Body) > 0 {
+		body, err := redactCapturedValue(rendered.Body)
+		if err != nil {
+			return nil, newBifrostError(err)
+		}
+		rendered.Body = body
+	}
+	return rendered, nil
+}
@@ -0,0 +1,91 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+// Test that a dry run of a well-formed inline batch renders the batch create request and estimates its requests
+// without sending anything to the provider
+func TestBatchDryRun_RendersInlineBatch(t *testing.T) {
+	var received atomic.Int32
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.keys[schemas.OpenAI][0].UseForBatchAPI = schemas.Ptr(true)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	messages := []interface{}{map[string]interface{}{"role": "user", "content": "Summarize the plot of Hamlet in one paragraph."}}
+	req := &schemas.BifrostBatchCreateRequest{
+		Provider: schemas.OpenAI,
+		Endpoint: schemas.BatchEndpointCanonicalChat,
+		Requests: []schemas.BatchRequestItem{
+			{CustomID: "req-1", Method: "POST", URL: "/v1/chat/completions", Body: map[string]interface{}{"model": "gpt-4o-mini", "messages": messages, "max_tokens": 100}},
+			{CustomID: "req-2", Method: "POST", URL: "/v1/chat/completions", Body: map[string]interface{}{"model": "gpt-4o-mini", "messages": messages}},
+		},
+		Metadata: map[string]string{"team": "research"},
+	}
+	resp, bifrostErr := client.BatchDryRunRequest(context.Background(), req)
+	if bifrostErr != nil {
+		t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+	}
+	if received.Load() != 0 {
+		t.Errorf("Expected nothing to be sent to the provider, got %d requests", received.Load())
+	}
+	if !resp.Valid || len(resp.Errors) != 0 || resp.RequestCount != 2 {
+		t.Fatalf("Expected a valid batch of 2 requests, got valid=%v, errors=%+v, count=%d", resp.Valid, resp.Errors, resp.RequestCount)
+	}
+
+	rendered := resp.Rendered
+	if rendered == nil {
+		t.Fatal("Expected the rendered batch create request")
+	}
+	if rendered.Method != http.MethodPost || rendered.URL != server.URL+"/v1/batches" {
+		t.Errorf("Expected POST %s/v1/batches, got %s %s", server.URL, rendered.Method, rendered.URL)
+	}
+	if rendered.Headers["Authorization"] != schemas.CapturedRequestRedacted {
+		t.Errorf("Expected the authorization header to be redacted, got %q", rendered.Headers["Authorization"])
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(rendered.Body, &body); err != nil {
+		t.Fatalf("Expected a JSON body, got %s: %v", rendered.Body, err)
+	}
+	if body["input_file_id"] != providerUtils.RenderedInputFileID || body["endpoint"] != "/v1/chat/completions" || body["completion_window"] != "24h" {
+		t.Errorf("Expected the OpenAI batch create body, got %s", rendered.Body)
+	}
+	if metadata, _ := body["metadata"].(map[string]interface{}); metadata["team"] != "research" {
+		t.Errorf("Expected the batch metadata in the body, got %s", rendered.Body)
+	}
+
+	data, _ := serialization.Marshal(messages)
+	inputTokens := (len(data) + estimatedCharsPerToken - 1) / estimatedCharsPerToken
+	estimate := resp.Estimate
+	if estimate == nil || estimate.InputTokens != 2*inputTokens || estimate.OutputTokens != 100 {
+		t.Fatalf("Expected %d input and 100 output tokens, got %+v", 2*inputTokens, estimate)
+	}
+	model := estimate.Models["gpt-4o-mini"]
+	if model == nil || model.Requests != 2 || model.InputTokens != 2*inputTokens || model.OutputTokens != 100 || model.RequestsWithoutOutputLimit != 1 {
+		t.Errorf("Expected the estimate of gpt-4o-mini, got %+v", model)
+	}
+
+	// Invalid requests are reported with their line and the batch is not rendered
+	req.Requests = append(req.Requests, schemas.BatchRequestItem{CustomID: "req-1", Body: map[string]interface{}{"model": "gpt-4o-mini"}})
+	resp, bifrostErr = client.BatchDryRunRequest(context.Background(), req)
+	if bifrostErr != nil {
+		t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+	}
+	if resp.Valid || len(resp.Errors) != 1 || resp.Errors[0].Line == nil || *resp.Errors[0].Line != 3 || resp.Rendered != nil {
+		t.Errorf("Expected the duplicate custom_id on line 3 to be reported, got %+v", resp)
+	}
+}
@@ -0,0 +1,73 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that inline batches over the provider's maximum are rejected before submission with an actionable error,
+// and batches within it are submitted
+func TestBatchCreateRequest_MaxInlineBatchRequests(t *testing.T) {
+	var received atomic.Int32
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/files":
+			w.Write([]byte(`{"id":"file-inline","object":"file","bytes":1,"filename":"batch.jsonl","purpose":"batch"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/batches":
+			w.Write([]byte(`{"id":"batch_inline","object":"batch","endpoint":"/v1/chat/completions","input_file_id":"file-inline","status":"validating"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.keys[schemas.OpenAI][0].UseForBatchAPI = schemas.Ptr(true)
+	account.configs[schemas.OpenAI].MaxInlineBatchRequests = 2
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	newBatch := func(count int) *schemas.BifrostBatchCreateRequest {
+		req := &schemas.BifrostBatchCreateRequest{Provider: schemas.OpenAI, Endpoint: schemas.BatchEndpointCanonicalChat}
+		for i := range count {
+			req.Requests = append(req.Requests, schemas.BatchRequestItem{
+				CustomID: fmt.Sprintf("req-%d", i),
+				Method:   "POST",
+				URL:      "/v1/chat/completions",
+				Body:     map[string]interface{}{"model": "gpt-4o-mini", "messages": []interface{}{map[string]interface{}{"role": "user", "content": "hello"}}},
+			})
+		}
+		return req
+	}
+
+	_, bifrostErr := client.BatchCreateRequest(context.Background(), newBatch(3))
+	if bifrostErr == nil {
+		t.Fatal("Expected a batch over the limit to be rejected")
+	}
+	message := GetErrorMessage(bifrostErr)
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest || !strings.Contains(message, "at most 2") || !strings.Contains(message, "input_file_id") {
+		t.Errorf("Expected a bad request error with the limit and the file-based alternative, got %v: %s", bifrostErr.StatusCode, message)
+	}
+	if received.Load() != 0 {
+		t.Errorf("Expected nothing to be sent to the provider, got %d requests", received.Load())
+	}
+
+	dryRun, bifrostErr := client.BatchDryRunRequest(context.Background(), newBatch(3))
+	if bifrostErr != nil || dryRun.Valid || len(dryRun.Errors) != 1 || dryRun.Errors[0].Code != "too_many_requests" {
+		t.Errorf("Expected the dry run to report the batch over the limit, got %+v", dryRun)
+	}
+
+	if _, bifrostErr := client.BatchCreateRequest(context.Background(), newBatch(2)); bifrostErr != nil {
+		t.Fatalf("Expected a batch within the limit to be submitted, got: %v", GetErrorMessage(bifrostErr))
+	}
+	if received.Load() == 0 {
+		t.Error("Expected the batch within the limit to be sent to the provider")
+	}
+}
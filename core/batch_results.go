@@ -0,0 +1,44 @@
+package bifrost
+
+import (
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// batchResultMissingCode is the error code of the placeholder returned for a request without a result.
+const batchResultMissingCode = "result_missing"
+
+// orderBatchResults returns the batch results in submission order, given as the custom IDs of the submitted
+// requests. Requests without a result get an error placeholder, so that the results line up with the
+// submitted requests. Results whose custom ID is not in the order are kept, in file order, after the rest.
+// Custom IDs submitted more than once are matched to their results in file order.
+func orderBatchResults(results []schemas.BatchResultItem, order []string) []schemas.BatchResultItem {
+	indexesByID := make(map[string][]int, len(results))
+	for i := range results {
+		indexesByID[results[i].CustomID] = append(indexesByID[results[i].CustomID], i)
+	}
+
+	ordered := make([]schemas.BatchResultItem, 0, max(len(order), len(results)))
+	used := make([]bool, len(results))
+	for _, customID := range order {
+		indexes := indexesByID[customID]
+		if len(indexes) == 0 {
+			ordered = append(ordered, schemas.BatchResultItem{
+				CustomID: customID,
+				Error: &schemas.BatchResultError{
+					Code:    batchResultMissingCode,
+					Message: "no result was returned for this request",
+				},
+			})
+			continue
+		}
+		ordered = append(ordered, results[indexes[0]])
+		used[indexes[0]] = true
+		indexesByID[customID] = indexes[1:]
+	}
+	for i := range results {
+		if !used[i] {
+			ordered = append(ordered, results[i])
+		}
+	}
+	return ordered
+}
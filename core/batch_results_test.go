@@ -0,0 +1,89 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that batch results are returned in submission order regardless of the provider's file order
+func TestBatchResults_CustomIDOrder(t *testing.T) {
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/batches/batch_abc":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"batch_abc","object":"batch","status":"completed","output_file_id":"file-out"}`))
+		case "/v1/files/file-out/content":
+			w.Header().Set("Content-Type", "application/jsonl")
+			w.Write([]byte(`{"custom_id":"request-3","response":{"status_code":200,"body":{"id":"chatcmpl-3"}}}
+{"custom_id":"request-1","response":{"status_code":200,"body":{"id":"chatcmpl-1"}}}
+{"custom_id":"request-extra","response":{"status_code":200,"body":{"id":"chatcmpl-extra"}}}
+{"custom_id":"request-0","response":{"status_code":200,"body":{"id":"chatcmpl-0"}}}
+`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.keys[schemas.OpenAI][0].UseForBatchAPI = schemas.Ptr(true)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	resp, bifrostErr := client.BatchResultsRequest(context.Background(), &schemas.BifrostBatchResultsRequest{
+		Provider:      schemas.OpenAI,
+		BatchID:       "batch_abc",
+		CustomIDOrder: []string{"request-0", "request-1", "request-2", "request-3"},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("Expected batch results to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+
+	expected := []string{"request-0", "request-1", "request-2", "request-3", "request-extra"}
+	if len(resp.Results) != len(expected) {
+		t.Fatalf("Expected %d results, got %d", len(expected), len(resp.Results))
+	}
+	for i, customID := range expected {
+		if resp.Results[i].CustomID != customID {
+			t.Errorf("Expected result %d to be %s, got %s", i, customID, resp.Results[i].CustomID)
+		}
+	}
+	if missing := resp.Results[2]; missing.Response != nil || missing.Error == nil || missing.Error.Code != batchResultMissingCode {
+		t.Errorf("Expected an error placeholder for the missing result, got %+v", missing)
+	}
+	if resp.Results[0].Error != nil || resp.Results[0].Response == nil {
+		t.Errorf("Expected a successful result for request-0, got %+v", resp.Results[0])
+	}
+
+	// Ordering needs every result, so it cannot be combined with pagination
+	_, bifrostErr = client.BatchResultsRequest(context.Background(), &schemas.BifrostBatchResultsRequest{
+		Provider:      schemas.OpenAI,
+		BatchID:       "batch_abc",
+		Limit:         2,
+		CustomIDOrder: []string{"request-0"},
+	})
+	if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected a 400 error when combining custom_id_order with pagination, got %v", bifrostErr)
+	}
+}
+
+// Test that custom IDs submitted more than once are matched to their results in file order
+func TestOrderBatchResults_DuplicateCustomIDs(t *testing.T) {
+	results := []schemas.BatchResultItem{
+		{CustomID: "b", Result: &schemas.BatchResultData{Type: "succeeded"}},
+		{CustomID: "a", Result: &schemas.BatchResultData{Type: "errored"}},
+		{CustomID: "a", Result: &schemas.BatchResultData{Type: "succeeded"}},
+	}
+	ordered := orderBatchResults(results, []string{"a", "a", "a", "b"})
+	if len(ordered) != 4 {
+		t.Fatalf("Expected 4 results, got %d", len(ordered))
+	}
+	if ordered[0].Result.Type != "errored" || ordered[1].Result.Type != "succeeded" {
+		t.Errorf("Expected duplicate custom IDs in file order, got %v and %v", ordered[0].Result, ordered[1].Result)
+	}
+	if ordered[2].Error == nil || ordered[3].CustomID != "b" {
+		t.Errorf("Expected a placeholder for the third a and b last, got %+v", ordered[2:])
+	}
+}
@@ -0,0 +1,148 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// generateBatchInput writes batch request lines to a pipe as they are generated, like a pipeline producing its input on the fly
+func generateBatchInput(lines int) (io.Reader, string) {
+	var expected strings.Builder
+	for i := 0; i < lines; i++ {
+		fmt.Fprintf(&expected, `{"custom_id":"req-%d","method":"POST","url":"/v1/chat/completions","body":{"model":"gpt-4o-mini","messages":[{"role":"user","content":"hello %d"}]}}`+"\n", i, i)
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		for _, line := range strings.SplitAfter(expected.String(), "\n") {
+			if _, err := writer.Write([]byte(line)); err != nil {
+				return
+			}
+		}
+		writer.Close()
+	}()
+	return reader, expected.String()
+}
+
+// Test that a batch is created from a streamed input, uploaded as a batch file with chunked transfer encoding
+func TestBatchCreateRequest_StreamedInput(t *testing.T) {
+	var mu sync.Mutex
+	var uploaded, purpose, filename, batchInputFileID string
+	var transferEncoding []string
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/files":
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content, _ := io.ReadAll(file)
+			mu.Lock()
+			uploaded, purpose, filename, transferEncoding = string(content), r.FormValue("purpose"), header.Filename, r.TransferEncoding
+			mu.Unlock()
+			fmt.Fprintf(w, `{"id":"file-streamed","object":"file","bytes":%d,"filename":%q,"purpose":"batch"}`, len(content), header.Filename)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/batches":
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			if strings.Contains(string(body), `"input_file_id":"file-streamed"`) {
+				batchInputFileID = "file-streamed"
+			}
+			mu.Unlock()
+			w.Write([]byte(`{"id":"batch_streamed","object":"batch","endpoint":"/v1/chat/completions","input_file_id":"file-streamed","status":"validating"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.keys[schemas.OpenAI][0].UseForBatchAPI = schemas.Ptr(true)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	input, expected := generateBatchInput(1000)
+	resp, bifrostErr := client.BatchCreateRequest(context.Background(), &schemas.BifrostBatchCreateRequest{
+		Provider:         schemas.OpenAI,
+		InputReader:      input,
+		InputFilename:    "generated.jsonl",
+		Endpoint:         schemas.BatchEndpointChatCompletions,
+		CompletionWindow: "24h",
+	})
+	if bifrostErr != nil {
+		t.Fatalf("Expected batch create to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if resp.ID != "batch_streamed" {
+		t.Errorf("Expected batch_streamed, got %q", resp.ID)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if uploaded != expected {
+		t.Errorf("Expected the uploaded file to be the generated input (%d bytes), got %d bytes", len(expected), len(uploaded))
+	}
+	if purpose != "batch" || filename != "generated.jsonl" {
+		t.Errorf("Expected a batch file named generated.jsonl, got purpose %q and filename %q", purpose, filename)
+	}
+	if !slices.Contains(transferEncoding, "chunked") {
+		t.Errorf("Expected the file to be streamed with chunked transfer encoding, got %v", transferEncoding)
+	}
+	if batchInputFileID != "file-streamed" {
+		t.Error("Expected the batch to be created from the uploaded file")
+	}
+}
+
+// Test that streamed file uploads are not retried, as their content cannot be read again
+func TestFileUploadRequest_StreamedNotRetried(t *testing.T) {
+	var uploads atomic.Int32
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		uploads.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":{"message":"service unavailable","type":"server_error"}}`))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.keys[schemas.OpenAI][0].UseForBatchAPI = schemas.Ptr(true)
+	account.configs[schemas.OpenAI].NetworkConfig.MaxRetries = 2
+	account.configs[schemas.OpenAI].NetworkConfig.RetryBackoffInitial = 1
+	account.configs[schemas.OpenAI].NetworkConfig.RetryBackoffMax = 1
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	input, _ := generateBatchInput(10)
+	_, bifrostErr := client.FileUploadRequest(context.Background(), &schemas.BifrostFileUploadRequest{
+		Provider:   schemas.OpenAI,
+		FileReader: input,
+		Purpose:    schemas.FilePurposeBatch,
+	})
+	if bifrostErr == nil {
+		t.Fatal("Expected the upload to fail")
+	}
+	if got := uploads.Load(); got != 1 {
+		t.Errorf("Expected a single upload attempt, got %d", got)
+	}
+
+	// Uploads from memory are still retried
+	uploads.Store(0)
+	_, bifrostErr = client.FileUploadRequest(context.Background(), &schemas.BifrostFileUploadRequest{
+		Provider: schemas.OpenAI,
+		File:     []byte(`{"custom_id":"req-0"}`),
+		Purpose:  schemas.FilePurposeBatch,
+	})
+	if bifrostErr == nil {
+		t.Fatal("Expected the upload to fail")
+	}
+	if got := uploads.Load(); got != 3 {
+		t.Errorf("Expected 3 upload attempts, got %d", got)
+	}
+}
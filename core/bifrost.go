@@ -4,7 +4,9 @@
 package bifrost
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"slices"
@@ -46,29 +48,45 @@ type ChannelMessage struct {
 	Response       chan *schemas.BifrostResponse
 	ResponseStream chan chan *schemas.BifrostStream
 	Err            chan schemas.BifrostError
+	enqueuedAt     time.Time // when tryRequest queued the message, to time the wait for a worker
 }
 
 // Bifrost manages providers and maintains specified open channels for concurrent processing.
 // It handles request routing, provider management, and response processing.
 type Bifrost struct {
-	ctx                 context.Context
-	cancel              context.CancelFunc
-	account             schemas.Account                    // account interface
-	plugins             atomic.Pointer[[]schemas.Plugin]   // list of plugins
-	providers           atomic.Pointer[[]schemas.Provider] // list of providers
-	requestQueues       sync.Map                           // provider request queues (thread-safe)
-	waitGroups          sync.Map                           // wait groups for each provider (thread-safe)
-	providerMutexes     sync.Map                           // mutexes for each provider to prevent concurrent updates (thread-safe)
-	channelMessagePool  sync.Pool                          // Pool for ChannelMessage objects, initial pool size is set in Init
-	responseChannelPool sync.Pool                          // Pool for response channels, initial pool size is set in Init
-	errorChannelPool    sync.Pool                          // Pool for error channels, initial pool size is set in Init
-	responseStreamPool  sync.Pool                          // Pool for response stream channels, initial pool size is set in Init
-	pluginPipelinePool  sync.Pool                          // Pool for PluginPipeline objects
-	bifrostRequestPool  sync.Pool                          // Pool for BifrostRequest objects
-	logger              schemas.Logger                     // logger instance, default logger is used if not provided
-	mcpManager          *MCPManager                        // MCP integration manager (nil if MCP not configured)
-	dropExcessRequests  atomic.Bool                        // If true, in cases where the queue is full, requests will not wait for the queue to be empty and will be dropped instead.
-	keySelector         schemas.KeySelector                // Custom key selector function
+	ctx                  context.Context
+	cancel               context.CancelFunc
+	account              schemas.Account                    // account interface
+	plugins              atomic.Pointer[[]schemas.Plugin]   // list of plugins
+	providers            atomic.Pointer[[]schemas.Provider] // list of providers
+	requestQueues        sync.Map                           // provider request queues (thread-safe)
+	waitGroups           sync.Map                           // wait groups for each provider (thread-safe)
+	providerMutexes      sync.Map                           // mutexes for each provider to prevent concurrent updates (thread-safe)
+	bulkheads            sync.Map                           // per-provider bulkheads bounding in-flight requests (thread-safe)
+	outboundRateLimiters sync.Map                           // per-provider token buckets pacing requests sent to providers (thread-safe)
+	parameterRules       sync.Map                           // per-provider rules of the parameters each model accepts (thread-safe)
+	streamLimiters       sync.Map                           // per-provider counts and caps of the streams open at once (thread-safe)
+	streams              streamLimiter                      // count and cap of the streams open at once across all providers (see stream_capacity.go)
+	channelMessagePool   sync.Pool                          // Pool for ChannelMessage objects, initial pool size is set in Init
+	responseChannelPool  sync.Pool                          // Pool for response channels, initial pool size is set in Init
+	errorChannelPool     sync.Pool                          // Pool for error channels, initial pool size is set in Init
+	responseStreamPool   sync.Pool                          // Pool for response stream channels, initial pool size is set in Init
+	pluginPipelinePool   sync.Pool                          // Pool for PluginPipeline objects
+	bifrostRequestPool   sync.Pool                          // Pool for BifrostRequest objects
+	logger               schemas.Logger                     // logger instance, default logger is used if not provided
+	mcpManager           *MCPManager                        // MCP integration manager (nil if MCP not configured)
+	dropExcessRequests   atomic.Bool                        // If true, in cases where the queue is full, requests will not wait for the queue to be empty and will be dropped instead.
+	emptyContent         atomic.Value                       // schemas.EmptyContentHandling, how chat messages with empty content are handled before dispatch
+	embeddedErrors       atomic.Value                       // schemas.EmbeddedErrorHandling, how 200 provider responses with an error body are treated
+	modelNames           atomic.Value                       // *schemas.ModelNameNormalization, normalization applied to model strings by ParseModelString (nil for the default only)
+	maxTokensDerivation  atomic.Value                       // *schemas.MaxTokensDerivationConfig, derivation of max tokens for chat requests omitting it (nil if disabled)
+	lengthContinuation   atomic.Value                       // *schemas.LengthContinuationConfig, continuation of chat responses truncated by the output token limit (nil if disabled)
+	hedging              atomic.Value                       // *schemas.HedgingConfig, hedging of slow requests to their first fallback (nil if disabled)
+	keySelector          schemas.KeySelector                // Custom key selector function
+	requestHooks         *requestHooks                      // telemetry callbacks registered by library embedders (see request_hooks.go)
+	failedRequests       *failedRequestCaptures             // redacted captures of failed requests for replay (see request_capture.go)
+	pluginFlushTimeout   atomic.Int64                       // time.Duration, how long plugins are given to flush their pending work (see plugin_flush.go)
+	pluginOrder          atomic.Pointer[[]string]           // names of the plugins run first, in that order (see plugin_order.go)
 }
 
 // PluginPipeline encapsulates the execution of plugin PreHooks and PostHooks, tracks how many plugins ran, and manages short-circuiting and error aggregation.
@@ -104,21 +122,34 @@ func Init(ctx context.Context, config schemas.BifrostConfig) (*Bifrost, error) {
 	providerUtils.SetLogger(config.Logger)
 	bifrostCtx, cancel := context.WithCancel(ctx)
 	bifrost := &Bifrost{
-		ctx:           bifrostCtx,
-		cancel:        cancel,
-		account:       config.Account,
-		plugins:       atomic.Pointer[[]schemas.Plugin]{},
-		requestQueues: sync.Map{},
-		waitGroups:    sync.Map{},
-		keySelector:   config.KeySelector,
-		logger:        config.Logger,
-	}
-	bifrost.plugins.Store(&config.Plugins)
+		ctx:            bifrostCtx,
+		cancel:         cancel,
+		account:        config.Account,
+		plugins:        atomic.Pointer[[]schemas.Plugin]{},
+		requestQueues:  sync.Map{},
+		waitGroups:     sync.Map{},
+		keySelector:    config.KeySelector,
+		logger:         config.Logger,
+		requestHooks:   newRequestHooks(config.Logger),
+		failedRequests: &failedRequestCaptures{},
+	}
+	bifrost.pluginOrder.Store(&config.PluginOrder)
+	plugins := orderPlugins(config.Plugins, config.PluginOrder)
+	bifrost.plugins.Store(&plugins)
 
 	// Initialize providers slice
 	bifrost.providers.Store(&[]schemas.Provider{})
 
 	bifrost.dropExcessRequests.Store(config.DropExcessRequests)
+	bifrost.emptyContent.Store(config.EmptyContentHandling)
+	bifrost.embeddedErrors.Store(config.EmbeddedErrorHandling)
+	bifrost.modelNames.Store(config.ModelNameNormalization)
+	bifrost.maxTokensDerivation.Store(config.MaxTokensDerivation)
+	bifrost.lengthContinuation.Store(config.LengthContinuation)
+	bifrost.hedging.Store(config.Hedging)
+	bifrost.failedRequests.configure(config.FailedRequestCapture)
+	bifrost.setPluginFlushTimeout(config.PluginFlushTimeout)
+	bifrost.streams.maxConcurrent.Store(int64(max(config.MaxConcurrentStreams, 0)))
 
 	if bifrost.keySelector == nil {
 		bifrost.keySelector = WeightedRandomKeySelector
@@ -223,13 +254,31 @@ func Init(ctx context.Context, config schemas.BifrostConfig) (*Bifrost, error) {
 }
 
 // ReloadConfig reloads the config from DB
-// Currently we only update account and drop excess requests
+// Currently we only update account, drop excess requests, empty content handling, max tokens derivation,
+// length continuation, hedging, embedded error handling, failed request capture, model name normalization, plugin order and max concurrent streams
 // We will keep on adding other aspects as required
 func (bifrost *Bifrost) ReloadConfig(config schemas.BifrostConfig) error {
 	bifrost.dropExcessRequests.Store(config.DropExcessRequests)
+	bifrost.emptyContent.Store(config.EmptyContentHandling)
+	bifrost.embeddedErrors.Store(config.EmbeddedErrorHandling)
+	bifrost.modelNames.Store(config.ModelNameNormalization)
+	bifrost.maxTokensDerivation.Store(config.MaxTokensDerivation)
+	bifrost.lengthContinuation.Store(config.LengthContinuation)
+	bifrost.hedging.Store(config.Hedging)
+	bifrost.failedRequests.configure(config.FailedRequestCapture)
+	bifrost.setPluginFlushTimeout(config.PluginFlushTimeout)
+	bifrost.setPluginOrder(config.PluginOrder)
+	bifrost.streams.maxConcurrent.Store(int64(max(config.MaxConcurrentStreams, 0)))
 	return nil
 }
 
+// ParseModelString extracts provider and model from a model string such as "OpenAI/GPT-4o", applying the
+// aliases and model name case-folding of this instance's ModelNameNormalization.
+func (bifrost *Bifrost) ParseModelString(model string, defaultProvider schemas.ModelProvider) (schemas.ModelProvider, string) {
+	normalization, _ := bifrost.modelNames.Load().(*schemas.ModelNameNormalization)
+	return normalization.ParseModelString(model, defaultProvider)
+}
+
 // PUBLIC API METHODS
 
 // ListModelsRequest sends a list models request to the specified provider.
@@ -566,6 +615,14 @@ func (bifrost *Bifrost) ChatCompletionRequest(ctx context.Context, req *schemas.
 		}
 	}
 
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+	req, derivedMaxTokens := bifrost.deriveMaxTokens(req)
+	if derivedMaxTokens != nil {
+		ctx = context.WithValue(ctx, schemas.BifrostContextKeyDerivedMaxTokens, *derivedMaxTokens)
+	}
+
 	bifrostReq := bifrost.getBifrostRequest()
 	bifrostReq.RequestType = schemas.ChatCompletionRequest
 	bifrostReq.ChatRequest = req
@@ -574,6 +631,12 @@ func (bifrost *Bifrost) ChatCompletionRequest(ctx context.Context, req *schemas.
 	if err != nil {
 		return nil, err
 	}
+	if response.ChatResponse != nil {
+		response.ChatResponse = bifrost.continueTruncatedChat(ctx, req, response.ChatResponse)
+	}
+	if derivedMaxTokens != nil && response.ChatResponse != nil {
+		response.ChatResponse.ExtraFields.DerivedMaxTokens = derivedMaxTokens
+	}
 	//TODO: Release the response
 	return response.ChatResponse, nil
 }
@@ -605,6 +668,14 @@ func (bifrost *Bifrost) ChatCompletionStreamRequest(ctx context.Context, req *sc
 		}
 	}
 
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+	req, derivedMaxTokens := bifrost.deriveMaxTokens(req)
+	if derivedMaxTokens != nil {
+		ctx = context.WithValue(ctx, schemas.BifrostContextKeyDerivedMaxTokens, *derivedMaxTokens)
+	}
+
 	bifrostReq := bifrost.getBifrostRequest()
 	bifrostReq.RequestType = schemas.ChatCompletionStreamRequest
 	bifrostReq.ChatRequest = req
@@ -888,11 +959,11 @@ func (bifrost *Bifrost) BatchCreateRequest(ctx context.Context, req *schemas.Bif
 			},
 		}
 	}
-	if req.InputFileID == "" && len(req.Requests) == 0 {
+	if req.InputFileID == "" && len(req.Requests) == 0 && req.InputReader == nil {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "either input_file_id or requests is required for batch create request",
+				Message: "either input_file_id, requests or an input reader is required for batch create request",
 			},
 		}
 	}
@@ -909,6 +980,38 @@ func (bifrost *Bifrost) BatchCreateRequest(ctx context.Context, req *schemas.Bif
 			},
 		}
 	}
+	if message := bifrost.checkInlineBatchRequestCount(req); message != "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+			Error: &schemas.ErrorField{
+				Message: message,
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.BatchCreateRequest,
+				Provider:    req.Provider,
+			},
+		}
+	}
+
+	// Streamed input is uploaded as a batch file first, and the batch is created from the uploaded file
+	if req.InputReader != nil {
+		uploadResponse, err := bifrost.FileUploadRequest(ctx, &schemas.BifrostFileUploadRequest{
+			Provider:    req.Provider,
+			Model:       req.Model,
+			FileReader:  req.InputReader,
+			Filename:    req.InputFilename,
+			Purpose:     schemas.FilePurposeBatch,
+			ExtraParams: req.ExtraParams,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fileReq := *req
+		fileReq.InputFileID = uploadResponse.ID
+		fileReq.InputReader = nil
+		req = &fileReq
+	}
 
 	bifrostReq := bifrost.getBifrostRequest()
 	bifrostReq.RequestType = schemas.BatchCreateRequest
@@ -921,6 +1024,21 @@ func (bifrost *Bifrost) BatchCreateRequest(ctx context.Context, req *schemas.Bif
 	return response.BatchCreateResponse, nil
 }
 
+// checkInlineBatchRequestCount checks the number of inline requests of a batch against the provider's
+// MaxInlineBatchRequests, so that oversized batches are rejected before submission with an actionable error
+// rather than by the provider. It returns the error message, or "" if the batch is within the limit.
+func (bifrost *Bifrost) checkInlineBatchRequestCount(req *schemas.BifrostBatchCreateRequest) string {
+	if len(req.Requests) == 0 {
+		return ""
+	}
+	config, err := bifrost.account.GetConfigForProvider(req.Provider)
+	if err != nil || config == nil || config.MaxInlineBatchRequests <= 0 || len(req.Requests) <= config.MaxInlineBatchRequests {
+		return ""
+	}
+	return fmt.Sprintf("batch has %d inline requests but provider %s accepts at most %d: upload the requests as a JSONL file and create the batch from its input_file_id, or split them into batches of at most %d requests",
+		len(req.Requests), req.Provider, config.MaxInlineBatchRequests, config.MaxInlineBatchRequests)
+}
+
 // BatchListRequest lists batch jobs for the specified provider.
 func (bifrost *Bifrost) BatchListRequest(ctx context.Context, req *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
 	if req == nil {
@@ -1072,6 +1190,19 @@ func (bifrost *Bifrost) BatchResultsRequest(ctx context.Context, req *schemas.Bi
 			},
 		}
 	}
+	if len(req.CustomIDOrder) > 0 && (req.Limit > 0 || req.After != nil) {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+			Error: &schemas.ErrorField{
+				Message: "custom_id_order cannot be combined with limit or after for batch results request",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.BatchResultsRequest,
+				Provider:    req.Provider,
+			},
+		}
+	}
 	if ctx == nil {
 		ctx = bifrost.ctx
 	}
@@ -1084,6 +1215,9 @@ func (bifrost *Bifrost) BatchResultsRequest(ctx context.Context, req *schemas.Bi
 	if err != nil {
 		return nil, err
 	}
+	if len(req.CustomIDOrder) > 0 && response.BatchResultsResponse != nil {
+		response.BatchResultsResponse.Results = orderBatchResults(response.BatchResultsResponse.Results, req.CustomIDOrder)
+	}
 	return response.BatchResultsResponse, nil
 }
 
@@ -1111,7 +1245,7 @@ func (bifrost *Bifrost) FileUploadRequest(ctx context.Context, req *schemas.Bifr
 			},
 		}
 	}
-	if len(req.File) == 0 {
+	if len(req.File) == 0 && req.FileReader == nil {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
@@ -1123,6 +1257,27 @@ func (bifrost *Bifrost) FileUploadRequest(ctx context.Context, req *schemas.Bifr
 			},
 		}
 	}
+	// Batch input files held in memory are checked to contain requests before they are uploaded
+	if req.Purpose == schemas.FilePurposeBatch && len(req.File) > 0 {
+		count, err := providerUtils.CountBatchRequests(bytes.NewReader(req.File))
+		if err == nil && count == 0 {
+			err = errors.New("batch input file contains no requests")
+		}
+		if err != nil {
+			return nil, &schemas.BifrostError{
+				IsBifrostError: false,
+				StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+				Error: &schemas.ErrorField{
+					Message: err.Error(),
+					Error:   err,
+				},
+				ExtraFields: schemas.BifrostErrorExtraFields{
+					RequestType: schemas.FileUploadRequest,
+					Provider:    req.Provider,
+				},
+			}
+		}
+	}
 	if ctx == nil {
 		ctx = bifrost.ctx
 	}
@@ -1328,11 +1483,8 @@ func (bifrost *Bifrost) RemovePlugin(name string) error {
 		if pluginToCleanup != nil {
 			// Atomic compare-and-swap
 			if bifrost.plugins.CompareAndSwap(oldPlugins, &newPlugins) {
-				// Cleanup the old plugin
-				err := pluginToCleanup.Cleanup()
-				if err != nil {
-					bifrost.logger.Warn("failed to cleanup old plugin %s: %v", pluginToCleanup.GetName(), err)
-				}
+				// Flush and cleanup the old plugin
+				bifrost.cleanupPlugins([]schemas.Plugin{pluginToCleanup})
 				return nil
 			}
 		}
@@ -1366,16 +1518,13 @@ func (bifrost *Bifrost) ReloadPlugin(plugin schemas.Plugin) error {
 		if !found {
 			// This means that user is adding a new plugin
 			bifrost.logger.Debug("adding new plugin %s", plugin.GetName())
-			newPlugins = append(newPlugins, plugin)
+			newPlugins = orderPlugins(append(newPlugins, plugin), bifrost.getPluginOrder())
 		}
 		// Atomic compare-and-swap
 		if bifrost.plugins.CompareAndSwap(oldPlugins, &newPlugins) {
 			// Cleanup the old plugin
 			if found && pluginToCleanup != nil {
-				err := pluginToCleanup.Cleanup()
-				if err != nil {
-					bifrost.logger.Warn("failed to cleanup old plugin %s: %v", pluginToCleanup.GetName(), err)
-				}
+				bifrost.cleanupPlugins([]schemas.Plugin{pluginToCleanup})
 			}
 			return nil
 		}
@@ -1526,6 +1675,12 @@ transferComplete:
 	// Step 6: Create new wait group for the updated workers
 	bifrost.waitGroups.Store(providerKey, &sync.WaitGroup{})
 
+	// Step 6.5: Replace the bulkhead, outbound rate limiter, parameter rules and stream cap, requests holding a slot in the old bulkhead release it there
+	bifrost.storeBulkhead(providerKey, providerConfig.Bulkhead)
+	bifrost.storeOutboundRateLimiter(providerKey, providerConfig.OutboundRateLimit)
+	bifrost.storeParameterRules(providerKey, providerConfig.ParameterRules)
+	bifrost.storeStreamLimit(providerKey, providerConfig.MaxConcurrentStreams)
+
 	// Step 7: Create provider instance
 	provider, err := bifrost.createBaseProvider(providerKey, providerConfig)
 	if err != nil {
@@ -1596,6 +1751,19 @@ transferComplete:
 	return nil
 }
 
+// GetResponsePoolStats returns the usage of the pools of provider response objects, keyed by pool name.
+// Pools are shared by all Bifrost instances of the process. It is intended to be polled by metrics exporters.
+func (bifrost *Bifrost) GetResponsePoolStats() map[string]schemas.ResponsePoolStats {
+	return providerUtils.GetResponsePoolStats()
+}
+
+// GetS3OperationStats returns the S3 operations sent by the Bedrock providers for batches and files, keyed by
+// provider and S3 operation name (e.g. PutObject). Like the response pools, they are counted across all Bifrost
+// instances of the process. It is intended to be polled by metrics exporters.
+func (bifrost *Bifrost) GetS3OperationStats() map[schemas.ModelProvider]map[string]schemas.S3OperationStats {
+	return bedrock.GetS3OperationStats()
+}
+
 // GetDropExcessRequests returns the current value of DropExcessRequests
 func (bifrost *Bifrost) GetDropExcessRequests() bool {
 	return bifrost.dropExcessRequests.Load()
@@ -1851,6 +2019,11 @@ func (bifrost *Bifrost) createBaseProvider(providerKey schemas.ModelProvider, co
 		targetProviderKey = config.CustomProviderConfig.BaseProviderType
 	}
 
+	// An invalid TLS config is rejected rather than replaced by the defaults
+	if _, err := providerUtils.BuildTLSConfig(nil, config.NetworkConfig.TLS); err != nil {
+		return nil, fmt.Errorf("invalid TLS configuration: %w", err)
+	}
+
 	switch targetProviderKey {
 	case schemas.OpenAI:
 		return openai.NewOpenAIProvider(config, bifrost.logger), nil
@@ -1908,6 +2081,10 @@ func (bifrost *Bifrost) prepareProvider(providerKey schemas.ModelProvider, confi
 	queue := make(chan *ChannelMessage, providerConfig.ConcurrencyAndBufferSize.BufferSize) // Buffered channel per provider
 
 	bifrost.requestQueues.Store(providerKey, queue)
+	bifrost.storeBulkhead(providerKey, providerConfig.Bulkhead)
+	bifrost.storeOutboundRateLimiter(providerKey, providerConfig.OutboundRateLimit)
+	bifrost.storeParameterRules(providerKey, providerConfig.ParameterRules)
+	bifrost.storeStreamLimit(providerKey, providerConfig.MaxConcurrentStreams)
 
 	// Start specified number of workers
 	bifrost.waitGroups.Store(providerKey, &sync.WaitGroup{})
@@ -2147,8 +2324,12 @@ func (bifrost *Bifrost) shouldContinueWithFallbacks(fallback schemas.Fallback, f
 // It handles plugin hooks, request validation, response processing, and fallback providers.
 // If the primary provider fails, it will try each fallback provider in order until one succeeds.
 // It is the wrapper for all non-streaming public API methods.
-func (bifrost *Bifrost) handleRequest(ctx context.Context, req *schemas.BifrostRequest) (*schemas.BifrostResponse, *schemas.BifrostError) {
+func (bifrost *Bifrost) handleRequest(ctx context.Context, req *schemas.BifrostRequest) (response *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) {
 	defer bifrost.releaseBifrostRequest(req)
+	defer func() {
+		bifrost.captureFailedRequest(ctx, req, bifrostErr)
+		restoreModelRequested(ctx, response, bifrostErr)
+	}()
 	provider, model, fallbacks := req.GetRequestFields()
 	if err := validateRequest(req); err != nil {
 		err.ExtraFields = schemas.BifrostErrorExtraFields{
@@ -2158,17 +2339,42 @@ func (bifrost *Bifrost) handleRequest(ctx context.Context, req *schemas.BifrostR
 		}
 		return nil, err
 	}
+	if err := bifrost.normalizeEmptyContent(req); err != nil {
+		err.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		return nil, err
+	}
 
 	// Handle nil context early to prevent blocking
 	if ctx == nil {
 		ctx = bifrost.ctx
 	}
 
+	// Pinned requests go to the pinned provider only, without fallbacks
+	if pinned := getPinnedProvider(ctx); pinned != nil {
+		pinnedReq, err := bifrost.pinRequest(req, pinned)
+		if err != nil {
+			return nil, err
+		}
+		req = pinnedReq
+		provider, model, fallbacks = req.GetRequestFields()
+	}
+
 	bifrost.logger.Debug(fmt.Sprintf("primary provider %s with model %s and %d fallbacks", provider, model, len(fallbacks)))
 
-	// Try the primary provider first
+	// Try the primary provider first, hedged to the first fallback if it is slow to respond
 	ctx = context.WithValue(ctx, schemas.BifrostContextKeyFallbackIndex, 0)
-	primaryResult, primaryErr := bifrost.tryRequest(ctx, req)
+	var primaryResult *schemas.BifrostResponse
+	var primaryErr *schemas.BifrostError
+	hedged := false
+	if delay := bifrost.hedgeDelay(ctx, req); delay > 0 {
+		primaryResult, primaryErr, hedged = bifrost.tryHedgedRequest(ctx, req, fallbacks[0], delay)
+	} else {
+		primaryResult, primaryErr = bifrost.tryRequest(ctx, req)
+	}
 	if primaryErr != nil {
 		if primaryErr.Error != nil {
 			bifrost.logger.Debug(fmt.Sprintf("primary provider %s with model %s returned error: %s", provider, model, primaryErr.Error.Message))
@@ -2195,6 +2401,10 @@ func (bifrost *Bifrost) handleRequest(ctx context.Context, req *schemas.BifrostR
 
 	// Try fallbacks in order
 	for i, fallback := range fallbacks {
+		// The first fallback already failed as the hedge
+		if hedged && i == 0 {
+			continue
+		}
 		ctx = context.WithValue(ctx, schemas.BifrostContextKeyFallbackIndex, i+1)
 		bifrost.logger.Debug(fmt.Sprintf("trying fallback provider %s with model %s", fallback.Provider, fallback.Model))
 		ctx = context.WithValue(ctx, schemas.BifrostContextKeyFallbackRequestID, uuid.New().String())
@@ -2239,8 +2449,12 @@ func (bifrost *Bifrost) handleRequest(ctx context.Context, req *schemas.BifrostR
 // It handles plugin hooks, request validation, response processing, and fallback providers.
 // If the primary provider fails, it will try each fallback provider in order until one succeeds.
 // It is the wrapper for all streaming public API methods.
-func (bifrost *Bifrost) handleStreamRequest(ctx context.Context, req *schemas.BifrostRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
+func (bifrost *Bifrost) handleStreamRequest(ctx context.Context, req *schemas.BifrostRequest) (_ chan *schemas.BifrostStream, bifrostErr *schemas.BifrostError) {
 	defer bifrost.releaseBifrostRequest(req)
+	defer func() {
+		bifrost.captureFailedRequest(ctx, req, bifrostErr)
+		restoreModelRequested(ctx, nil, bifrostErr)
+	}()
 
 	provider, model, fallbacks := req.GetRequestFields()
 
@@ -2253,12 +2467,30 @@ func (bifrost *Bifrost) handleStreamRequest(ctx context.Context, req *schemas.Bi
 		err.StatusCode = schemas.Ptr(fasthttp.StatusBadRequest)
 		return nil, err
 	}
+	if err := bifrost.normalizeEmptyContent(req); err != nil {
+		err.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		return nil, err
+	}
 
 	// Handle nil context early to prevent blocking
 	if ctx == nil {
 		ctx = bifrost.ctx
 	}
 
+	// Pinned requests go to the pinned provider only, without fallbacks
+	if pinned := getPinnedProvider(ctx); pinned != nil {
+		pinnedReq, err := bifrost.pinRequest(req, pinned)
+		if err != nil {
+			return nil, err
+		}
+		req = pinnedReq
+		provider, model, fallbacks = req.GetRequestFields()
+	}
+
 	// Try the primary provider first
 	ctx = context.WithValue(ctx, schemas.BifrostContextKeyFallbackIndex, 0)
 	primaryResult, primaryErr := bifrost.tryStreamRequest(ctx, req)
@@ -2331,6 +2563,25 @@ func (bifrost *Bifrost) tryRequest(ctx context.Context, req *schemas.BifrostRequ
 		return nil, bifrostErr
 	}
 
+	// Reserve a slot in the provider's bulkhead so a slow provider cannot hold callers of other providers
+	providerBulkhead := bifrost.getBulkhead(provider)
+	if err := providerBulkhead.acquire(ctx); err != nil {
+		bifrostErr := newBulkheadError(err)
+		bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		return nil, bifrostErr
+	}
+	defer providerBulkhead.release()
+
+	// Strip the parameters the model does not support before they reach the plugins and the provider
+	req, bifrostErr := bifrost.filterModelParameters(req)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
 	// Add MCP tools to request if MCP is configured and requested
 	if req.RequestType != schemas.EmbeddingRequest &&
 		req.RequestType != schemas.SpeechRequest &&
@@ -2342,22 +2593,34 @@ func (bifrost *Bifrost) tryRequest(ctx context.Context, req *schemas.BifrostRequ
 	pipeline := bifrost.getPluginPipeline()
 	defer bifrost.releasePluginPipeline(pipeline)
 
+	// Time the stages of the request for the latency breakdown of the response
+	startTime := time.Now()
+	timings := &schemas.LatencyTimings{}
+	ctx = context.WithValue(ctx, schemas.BifrostContextKeyLatencyTimings, timings)
+
 	preReq, shortCircuit, preCount := pipeline.RunPreHooks(&ctx, req)
+	timings.Add(schemas.LatencyStagePreHooks, time.Since(startTime))
 	if shortCircuit != nil {
 		// Handle short-circuit with response (success case)
 		if shortCircuit.Response != nil {
+			postHooksStart := time.Now()
 			resp, bifrostErr := pipeline.RunPostHooks(&ctx, shortCircuit.Response, nil, preCount)
+			timings.Add(schemas.LatencyStagePostHooks, time.Since(postHooksStart))
 			if bifrostErr != nil {
 				return nil, bifrostErr
 			}
+			setLatencyBreakdown(resp, timings, startTime)
 			return resp, nil
 		}
 		// Handle short-circuit with error
 		if shortCircuit.Error != nil {
+			postHooksStart := time.Now()
 			resp, bifrostErr := pipeline.RunPostHooks(&ctx, nil, shortCircuit.Error, preCount)
+			timings.Add(schemas.LatencyStagePostHooks, time.Since(postHooksStart))
 			if bifrostErr != nil {
 				return nil, bifrostErr
 			}
+			setLatencyBreakdown(resp, timings, startTime)
 			return resp, nil
 		}
 	}
@@ -2373,6 +2636,7 @@ func (bifrost *Bifrost) tryRequest(ctx context.Context, req *schemas.BifrostRequ
 
 	msg := bifrost.getChannelMessage(*preReq)
 	msg.Context = ctx
+	msg.enqueuedAt = time.Now()
 	select {
 	case queue <- msg:
 		// Message was sent successfully
@@ -2417,20 +2681,50 @@ func (bifrost *Bifrost) tryRequest(ctx context.Context, req *schemas.BifrostRequ
 	pluginCount := len(*bifrost.plugins.Load())
 	select {
 	case result = <-msg.Response:
+		postHooksStart := time.Now()
 		resp, bifrostErr := pipeline.RunPostHooks(&msg.Context, result, nil, pluginCount)
+		timings.Add(schemas.LatencyStagePostHooks, time.Since(postHooksStart))
 		if bifrostErr != nil {
 			bifrost.releaseChannelMessage(msg)
 			return nil, bifrostErr
 		}
 		bifrost.releaseChannelMessage(msg)
+		setLatencyBreakdown(resp, timings, startTime)
 		return resp, nil
 	case bifrostErrVal := <-msg.Err:
 		bifrostErrPtr := &bifrostErrVal
+		postHooksStart := time.Now()
 		resp, bifrostErrPtr = pipeline.RunPostHooks(&msg.Context, nil, bifrostErrPtr, pluginCount)
+		timings.Add(schemas.LatencyStagePostHooks, time.Since(postHooksStart))
 		bifrost.releaseChannelMessage(msg)
 		if bifrostErrPtr != nil {
 			return nil, bifrostErrPtr
 		}
+		setLatencyBreakdown(resp, timings, startTime)
+		return resp, nil
+	case <-ctx.Done():
+		// The worker may drop the response of a cancelled request. The message is not reused, as the worker may
+		// still be answering it
+		bifrostErrPtr := &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Type:    schemas.Ptr(schemas.RequestCancelled),
+				Message: schemas.ErrRequestCancelled,
+				Error:   ctx.Err(),
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    req.RequestType,
+				Provider:       provider,
+				ModelRequested: model,
+			},
+		}
+		postHooksStart := time.Now()
+		resp, bifrostErrPtr = pipeline.RunPostHooks(&ctx, nil, bifrostErrPtr, pluginCount)
+		timings.Add(schemas.LatencyStagePostHooks, time.Since(postHooksStart))
+		if bifrostErrPtr != nil {
+			return nil, bifrostErrPtr
+		}
+		setLatencyBreakdown(resp, timings, startTime)
 		return resp, nil
 	}
 }
@@ -2450,6 +2744,57 @@ func (bifrost *Bifrost) tryStreamRequest(ctx context.Context, req *schemas.Bifro
 		return nil, bifrostErr
 	}
 
+	// Without a stream cap or a bulkhead there is no slot to hold for the lifetime of the stream
+	providerBulkhead := bifrost.getBulkhead(provider)
+	if providerBulkhead == nil && !bifrost.streamsLimited(provider) {
+		return bifrost.tryStreamRequestWithSlot(ctx, req, queue)
+	}
+
+	// Count the stream against the stream caps until the returned stream is drained
+	releaseStream, err := bifrost.acquireStream(provider)
+	if err != nil {
+		bifrostErr := newStreamCapacityError(err)
+		bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		return nil, bifrostErr
+	}
+
+	// Reserve a slot in the provider's bulkhead, it is held until the returned stream is drained
+	if err := providerBulkhead.acquire(ctx); err != nil {
+		bifrostErr := newBulkheadError(err)
+		bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		releaseStream()
+		return nil, bifrostErr
+	}
+	stream, bifrostErr := bifrost.tryStreamRequestWithSlot(ctx, req, queue)
+	if bifrostErr != nil {
+		providerBulkhead.release()
+		releaseStream()
+		return nil, bifrostErr
+	}
+	return releaseStreamOnClose(ctx, stream, func() {
+		providerBulkhead.release()
+		releaseStream()
+	}), nil
+}
+
+// tryStreamRequestWithSlot runs the stream request once a bulkhead slot (if any) has been reserved.
+func (bifrost *Bifrost) tryStreamRequestWithSlot(ctx context.Context, req *schemas.BifrostRequest, queue chan *ChannelMessage) (chan *schemas.BifrostStream, *schemas.BifrostError) {
+	provider, model, _ := req.GetRequestFields()
+
+	// Strip the parameters the model does not support before they reach the plugins and the provider
+	req, bifrostErr := bifrost.filterModelParameters(req)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
 	// Add MCP tools to request if MCP is configured and requested
 	if req.RequestType != schemas.SpeechStreamRequest && req.RequestType != schemas.TranscriptionStreamRequest && bifrost.mcpManager != nil {
 		req = bifrost.mcpManager.addMCPToolsToBifrostRequest(ctx, req)
@@ -2626,6 +2971,7 @@ func executeRequestWithRetries[T any](
 	var result T
 	var bifrostError *schemas.BifrostError
 	var attempts int
+	var malformedRetried bool
 
 	for attempts = 0; attempts <= config.NetworkConfig.MaxRetries; attempts++ {
 		*ctx = context.WithValue(*ctx, schemas.BifrostContextKeyNumberOfRetries, attempts)
@@ -2655,6 +3001,13 @@ func executeRequestWithRetries[T any](
 
 		logger.Debug("request %s for provider %s completed", requestType, providerKey)
 
+		// A response that cannot be decoded is often truncated or replaced by a proxy, it is requested again once
+		if !malformedRetried && config.NetworkConfig.RetryOnMalformedResponse && isMalformedResponseError(bifrostError) && isIdempotentRequestType(requestType) {
+			malformedRetried = true
+			logger.Debug("malformed response for %s request from provider %s, requesting it again: %s", requestType, providerKey, bifrostError.Error.Message)
+			result, bifrostError = requestHandler()
+		}
+
 		// Check if successful or if we should retry
 		if bifrostError == nil ||
 			bifrostError.IsBifrostError ||
@@ -2662,6 +3015,12 @@ func executeRequestWithRetries[T any](
 			break
 		}
 
+		// A used up quota is not replenished by retrying the key, fail over to the fallbacks instead
+		if bifrostError.RateLimitKind == schemas.RateLimitKindQuotaExhausted {
+			logger.Debug("detected quota exhausted error, will not retry: %s", GetErrorMessage(bifrostError))
+			break
+		}
+
 		// Check if we should retry based on status code or error message
 		shouldRetry := false
 
@@ -2692,6 +3051,41 @@ func executeRequestWithRetries[T any](
 	return result, bifrostError
 }
 
+// isMalformedResponseError reports whether an error is a successful response whose body could not be decoded.
+func isMalformedResponseError(bifrostError *schemas.BifrostError) bool {
+	if bifrostError == nil || bifrostError.Error == nil {
+		return false
+	}
+	switch bifrostError.Error.Message {
+	case schemas.ErrProviderResponseEmpty, schemas.ErrProviderResponseHTML, schemas.ErrProviderResponseUnmarshal, schemas.ErrProviderResponseDecode:
+		return true
+	}
+	return false
+}
+
+// isIdempotentRequestType reports whether requests of a type can be sent again without side effects on the provider.
+// Only the read-only list, retrieve and content requests are: generations and embeddings are billed, and the
+// provider charges a request answered with a malformed body all the same.
+func isIdempotentRequestType(requestType schemas.RequestType) bool {
+	switch requestType {
+	case schemas.ListModelsRequest, schemas.BatchListRequest, schemas.BatchRetrieveRequest, schemas.BatchResultsRequest,
+		schemas.FileListRequest, schemas.FileRetrieveRequest, schemas.FileContentRequest, schemas.FileDownloadURLRequest:
+		return true
+	}
+	return false
+}
+
+// retryConfig returns the provider config used to retry the request.
+// Streamed file uploads are read once and cannot be sent again, so they are not retried.
+func retryConfig(config *schemas.ProviderConfig, req *ChannelMessage) *schemas.ProviderConfig {
+	if req.FileUploadRequest == nil || req.FileUploadRequest.FileReader == nil || config.NetworkConfig.MaxRetries == 0 {
+		return config
+	}
+	noRetryConfig := *config
+	noRetryConfig.NetworkConfig.MaxRetries = 0
+	return &noRetryConfig
+}
+
 // requestWorker handles incoming requests from the queue for a specific provider.
 // It manages retries, error handling, and response processing.
 func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas.ProviderConfig, queue chan *ChannelMessage) {
@@ -2705,6 +3099,13 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 	for req := range queue {
 		_, model, _ := req.BifrostRequest.GetRequestFields()
 
+		// Non-streaming requests are timed for their latency breakdown
+		dequeuedAt := time.Now()
+		timings, _ := req.Context.Value(schemas.BifrostContextKeyLatencyTimings).(*schemas.LatencyTimings)
+		if timings != nil && !req.enqueuedAt.IsZero() {
+			timings.Add(schemas.LatencyStageQueue, dequeuedAt.Sub(req.enqueuedAt))
+		}
+
 		var result *schemas.BifrostResponse
 		var stream chan *schemas.BifrostStream
 		var bifrostError *schemas.BifrostError
@@ -2720,9 +3121,9 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 		var keys []schemas.Key
 		if providerRequiresKey(baseProvider, config.CustomProviderConfig) {
 			// Determine if this is a multi-key batch/file operation
-			// BatchCreate, FileUpload use single key; other batch/file ops use multiple keys
+			// BatchCreate, FileUpload and FileDownloadURL use single key; other batch/file ops use multiple keys
 			isMultiKeyBatchOp := isBatchRequestType(req.RequestType) && req.RequestType != schemas.BatchCreateRequest
-			isMultiKeyFileOp := isFileRequestType(req.RequestType) && req.RequestType != schemas.FileUploadRequest
+			isMultiKeyFileOp := isFileRequestType(req.RequestType) && req.RequestType != schemas.FileUploadRequest && req.RequestType != schemas.FileDownloadURLRequest
 
 			if isMultiKeyBatchOp || isMultiKeyFileOp {
 				var modelPtr *string
@@ -2769,13 +3170,98 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 				req.Context = context.WithValue(req.Context, schemas.BifrostContextKeySelectedKeyName, key.Name)
 			}
 		}
+		// Providers parsing a 200 response read how an error body is treated from the context
+		if handling, ok := bifrost.embeddedErrors.Load().(schemas.EmbeddedErrorHandling); ok {
+			req.Context = context.WithValue(req.Context, schemas.BifrostContextKeyEmbeddedErrorHandling, handling)
+		}
+		// Custom providers may reshape the request body of the request type
+		if template := config.CustomProviderConfig.GetRequestBodyTemplate(req.RequestType); template != nil {
+			req.Context = context.WithValue(req.Context, schemas.BifrostContextKeyRequestBodyTemplate, template)
+		}
+		// Speech voices are mapped to the provider's voices, and output formats it cannot return are rejected
+		if req.SpeechRequest != nil {
+			speechRequest, err := providerUtils.NormalizeSpeechRequest(provider.GetProviderKey(), req.SpeechRequest)
+			if err != nil {
+				req.Err <- schemas.BifrostError{
+					IsBifrostError: false,
+					StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+					Error: &schemas.ErrorField{
+						Message: err.Error(),
+						Error:   err,
+					},
+					ExtraFields: schemas.BifrostErrorExtraFields{
+						Provider:       provider.GetProviderKey(),
+						ModelRequested: model,
+						RequestType:    req.RequestType,
+					},
+				}
+				continue
+			}
+			req.SpeechRequest = speechRequest
+		}
+		// Attachments are rejected by providers that would answer without them
+		if err := providerUtils.CheckChatAttachments(provider.GetProviderKey(), baseProvider, req.ChatRequest); err != nil {
+			req.Err <- schemas.BifrostError{
+				IsBifrostError: false,
+				StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+				Error: &schemas.ErrorField{
+					Message: err.Error(),
+					Error:   err,
+				},
+				ExtraFields: schemas.BifrostErrorExtraFields{
+					Provider:       provider.GetProviderKey(),
+					ModelRequested: model,
+					RequestType:    req.RequestType,
+				},
+			}
+			continue
+		}
+		// Request metadata is mirrored in the provider's native metadata fields, custom providers are left as is
+		if metadata, ok := req.Context.Value(schemas.BifrostContextKeyRequestMetadata).(map[string]string); ok && len(metadata) > 0 {
+			var metadataErr error
+			if req.ChatRequest != nil {
+				req.ChatRequest, metadataErr = providerUtils.ApplyChatRequestMetadata(provider.GetProviderKey(), req.ChatRequest, metadata)
+			} else if req.ResponsesRequest != nil {
+				req.ResponsesRequest, metadataErr = providerUtils.ApplyResponsesRequestMetadata(provider.GetProviderKey(), req.ResponsesRequest, metadata)
+			}
+			if metadataErr != nil {
+				req.Err <- schemas.BifrostError{
+					IsBifrostError: false,
+					StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+					Error: &schemas.ErrorField{
+						Message: metadataErr.Error(),
+						Error:   metadataErr,
+					},
+					ExtraFields: schemas.BifrostErrorExtraFields{
+						Provider:       provider.GetProviderKey(),
+						ModelRequested: model,
+						RequestType:    req.RequestType,
+					},
+				}
+				continue
+			}
+		}
+		// Providers without native assistant prefill get the trailing assistant message emulated, if configured
+		var assistantPrefill string
+		var prefillStreamTrimmer *assistantPrefillStreamTrimmer
+		if req.ChatRequest != nil {
+			req.ChatRequest, assistantPrefill = bifrost.prepareAssistantPrefill(req.ChatRequest, baseProvider, config.AssistantPrefill)
+			if assistantPrefill != "" && IsStreamRequestType(req.RequestType) {
+				prefillStreamTrimmer = newAssistantPrefillStreamTrimmer(assistantPrefill)
+			}
+		}
 		// Create plugin pipeline for streaming requests outside retry loop to prevent leaks
 		var postHookRunner schemas.PostHookRunner
 		var pipeline *PluginPipeline
 		if IsStreamRequestType(req.RequestType) {
 			pipeline = bifrost.getPluginPipeline()
 			postHookRunner = func(ctx *context.Context, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError) {
+				if prefillStreamTrimmer != nil {
+					prefillStreamTrimmer.trim(result)
+				}
+				stripRawResponseFields(result, config.RawResponseDenylist)
 				resp, bifrostErr := pipeline.RunPostHooks(ctx, result, err, len(*bifrost.plugins.Load()))
+				restoreModelRequested(*ctx, resp, bifrostErr)
 				if bifrostErr != nil {
 					return nil, bifrostErr
 				}
@@ -2783,14 +3269,39 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 			}
 		}
 
-		// Execute request with retries
+		// Execute request with retries, pacing every attempt to the provider's outbound rate limit
+		// and feeding its outcome to the provider's adaptive bulkhead limit
+		outboundRateLimiter := bifrost.getOutboundRateLimiter(provider.GetProviderKey())
+		providerBulkhead := bifrost.getBulkhead(provider.GetProviderKey())
+		timings.Add(schemas.LatencyStageKeySelection, time.Since(dequeuedAt))
 		if IsStreamRequestType(req.RequestType) {
 			stream, bifrostError = executeRequestWithRetries(&req.Context, config, func() (chan *schemas.BifrostStream, *schemas.BifrostError) {
-				return bifrost.handleProviderStreamRequest(provider, req, key, postHookRunner)
+				if err := outboundRateLimiter.wait(req.Context, key.ID); err != nil {
+					return nil, newOutboundRateLimitError(err)
+				}
+				hookEvent := bifrost.requestHooks.requestStarted(req.Context, provider.GetProviderKey(), model, req.RequestType, key.ID)
+				providerStart := time.Now()
+				stream, bifrostError := bifrost.handleProviderStreamRequest(provider, req, key, postHookRunner)
+				providerBulkhead.observe(time.Since(providerStart), bifrostError)
+				return bifrost.requestHooks.observeStream(hookEvent, stream, bifrostError)
 			}, req.RequestType, provider.GetProviderKey(), model)
 		} else {
-			result, bifrostError = executeRequestWithRetries(&req.Context, config, func() (*schemas.BifrostResponse, *schemas.BifrostError) {
-				return bifrost.handleProviderRequest(provider, req, key, keys)
+			result, bifrostError = executeRequestWithRetries(&req.Context, retryConfig(config, req), func() (*schemas.BifrostResponse, *schemas.BifrostError) {
+				if err := outboundRateLimiter.wait(req.Context, key.ID); err != nil {
+					return nil, newOutboundRateLimitError(err)
+				}
+				hookEvent := bifrost.requestHooks.requestStarted(req.Context, provider.GetProviderKey(), model, req.RequestType, key.ID)
+				providerStart := time.Now()
+				result, bifrostError := bifrost.handleProviderRequest(provider, req, key, keys)
+				timings.Add(schemas.LatencyStageProvider, time.Since(providerStart))
+				providerBulkhead.observe(time.Since(providerStart), bifrostError)
+				bifrost.requestHooks.requestEnded(hookEvent, 0, bifrostError)
+				if assistantPrefill != "" {
+					trimAssistantPrefill(result, assistantPrefill)
+				}
+				stripRawResponseFields(result, config.RawResponseDenylist)
+				setPinnedKey(req.Context, result, key)
+				return result, bifrostError
 			}, req.RequestType, provider.GetProviderKey(), model)
 		}
 
@@ -2848,6 +3359,14 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 	// bifrost.logger.Debug("worker for provider %s exiting...", provider.GetProviderKey())
 }
 
+// setLatencyBreakdown reports the stage timings of a request in the extra fields of its response.
+func setLatencyBreakdown(resp *schemas.BifrostResponse, timings *schemas.LatencyTimings, startTime time.Time) {
+	if resp == nil {
+		return
+	}
+	resp.GetExtraFields().LatencyBreakdown = timings.Breakdown(time.Since(startTime))
+}
+
 // handleProviderRequest handles the request to the provider based on the request type
 // key is used for single-key operations, keys is used for batch/file operations that need multiple keys
 func (bifrost *Bifrost) handleProviderRequest(provider schemas.Provider, req *ChannelMessage, key schemas.Key, keys []schemas.Key) (*schemas.BifrostResponse, *schemas.BifrostError) {
@@ -2888,6 +3407,10 @@ func (bifrost *Bifrost) handleProviderRequest(provider schemas.Provider, req *Ch
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		// Render the transcription in the requested text, srt or vtt format if the provider returned JSON
+		if params := req.BifrostRequest.TranscriptionRequest.Params; params != nil {
+			providerUtils.ApplyTranscriptionResponseFormat(transcriptionResponse, params.ResponseFormat)
+		}
 		response.TranscriptionResponse = transcriptionResponse
 	case schemas.FileUploadRequest:
 		fileUploadResponse, bifrostError := provider.FileUpload(req.Context, key, req.BifrostRequest.FileUploadRequest)
@@ -2919,6 +3442,12 @@ func (bifrost *Bifrost) handleProviderRequest(provider schemas.Provider, req *Ch
 			return nil, bifrostError
 		}
 		response.FileContentResponse = fileContentResponse
+	case schemas.FileDownloadURLRequest:
+		fileDownloadURLResponse, bifrostError := fileDownloadURL(req.Context, provider, key, req.BifrostRequest.FileDownloadURLRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.FileDownloadURLResponse = fileDownloadURLResponse
 	case schemas.BatchCreateRequest:
 		batchCreateResponse, bifrostError := provider.BatchCreate(req.Context, key, req.BifrostRequest.BatchCreateRequest)
 		if bifrostError != nil {
@@ -2974,7 +3503,12 @@ func (bifrost *Bifrost) handleProviderStreamRequest(provider schemas.Provider, r
 	case schemas.ChatCompletionStreamRequest:
 		return provider.ChatCompletionStream(req.Context, postHookRunner, key, req.BifrostRequest.ChatRequest)
 	case schemas.ResponsesStreamRequest:
-		return provider.ResponsesStream(req.Context, postHookRunner, key, req.BifrostRequest.ResponsesRequest)
+		stream, bifrostError := provider.ResponsesStream(req.Context, postHookRunner, key, req.BifrostRequest.ResponsesRequest)
+		if isUnsupportedOperation(bifrostError) && hasImageGenerationTool(req.BifrostRequest.ResponsesRequest) {
+			// Image generation falls back to a single-shot request on providers that do not stream responses
+			return singleShotImageStream(provider, req, key, postHookRunner)
+		}
+		return stream, bifrostError
 	case schemas.SpeechStreamRequest:
 		return provider.SpeechStream(req.Context, postHookRunner, key, req.BifrostRequest.SpeechRequest)
 	case schemas.TranscriptionStreamRequest:
@@ -3161,6 +3695,7 @@ func resetBifrostRequest(req *schemas.BifrostRequest) {
 	req.FileRetrieveRequest = nil
 	req.FileDeleteRequest = nil
 	req.FileContentRequest = nil
+	req.FileDownloadURLRequest = nil
 	req.BatchCreateRequest = nil
 	req.BatchListRequest = nil
 	req.BatchRetrieveRequest = nil
@@ -3384,6 +3919,13 @@ func (bifrost *Bifrost) selectKeyFromProviderForModel(ctx *context.Context, requ
 		return schemas.Key{}, fmt.Errorf("no keys found that support model: %s", model)
 	}
 
+	// Pinned requests always use the pinned key, or the same key if none was pinned
+	if ctx != nil {
+		if pinned := getPinnedProvider(*ctx); pinned != nil && pinned.Provider == providerKey {
+			return selectPinnedKey(pinned, supportedKeys, providerKey, model)
+		}
+	}
+
 	var requestedKeyName string
 	if ctx != nil {
 		if keyName, ok := (*ctx).Value(schemas.BifrostContextKeyAPIKeyName).(string); ok {
@@ -3469,12 +4011,7 @@ func (bifrost *Bifrost) Shutdown() {
 		}
 	}
 
-	// Cleanup plugins
-	for _, plugin := range *bifrost.plugins.Load() {
-		err := plugin.Cleanup()
-		if err != nil {
-			bifrost.logger.Warn(fmt.Sprintf("Error cleaning up plugin: %s", err.Error()))
-		}
-	}
+	// Flush the pending asynchronous work of plugins (logs, metrics) and cleanup plugins
+	bifrost.cleanupPlugins(*bifrost.plugins.Load())
 	bifrost.logger.Info("all request channels closed")
 }
@@ -572,73 +572,6 @@ func BenchmarkIsRateLimitError(b *testing.B) {
 	}
 }
 
-// Mock Account implementation for testing UpdateProvider
-type MockAccount struct {
-	configs map[schemas.ModelProvider]*schemas.ProviderConfig
-	keys    map[schemas.ModelProvider][]schemas.Key
-}
-
-func NewMockAccount() *MockAccount {
-	return &MockAccount{
-		configs: make(map[schemas.ModelProvider]*schemas.ProviderConfig),
-		keys:    make(map[schemas.ModelProvider][]schemas.Key),
-	}
-}
-
-func (ma *MockAccount) AddProvider(provider schemas.ModelProvider, concurrency int, bufferSize int) {
-	ma.configs[provider] = &schemas.ProviderConfig{
-		NetworkConfig: schemas.NetworkConfig{
-			DefaultRequestTimeoutInSeconds: 30,
-			MaxRetries:                     3,
-			RetryBackoffInitial:            500 * time.Millisecond,
-			RetryBackoffMax:                5 * time.Second,
-		},
-		ConcurrencyAndBufferSize: schemas.ConcurrencyAndBufferSize{
-			Concurrency: concurrency,
-			BufferSize:  bufferSize,
-		},
-	}
-
-	ma.keys[provider] = []schemas.Key{
-		{
-			ID:     fmt.Sprintf("test-key-%s", provider),
-			Value:  fmt.Sprintf("sk-test-%s", provider),
-			Weight: 100,
-		},
-	}
-}
-
-func (ma *MockAccount) UpdateProviderConfig(provider schemas.ModelProvider, concurrency int, bufferSize int) {
-	if config, exists := ma.configs[provider]; exists {
-		config.ConcurrencyAndBufferSize.Concurrency = concurrency
-		config.ConcurrencyAndBufferSize.BufferSize = bufferSize
-	}
-}
-
-func (ma *MockAccount) GetConfiguredProviders() ([]schemas.ModelProvider, error) {
-	providers := make([]schemas.ModelProvider, 0, len(ma.configs))
-	for provider := range ma.configs {
-		providers = append(providers, provider)
-	}
-	return providers, nil
-}
-
-func (ma *MockAccount) GetConfigForProvider(provider schemas.ModelProvider) (*schemas.ProviderConfig, error) {
-	if config, exists := ma.configs[provider]; exists {
-		// Return a copy to simulate real behavior
-		configCopy := *config
-		return &configCopy, nil
-	}
-	return nil, fmt.Errorf("provider %s not configured", provider)
-}
-
-func (ma *MockAccount) GetKeysForProvider(ctx *context.Context, provider schemas.ModelProvider) ([]schemas.Key, error) {
-	if keys, exists := ma.keys[provider]; exists {
-		return keys, nil
-	}
-	return nil, fmt.Errorf("no keys for provider %s", provider)
-}
-
 // Test UpdateProvider functionality
 func TestUpdateProvider(t *testing.T) {
 	t.Run("SuccessfulUpdate", func(t *testing.T) {
@@ -960,3 +893,68 @@ func TestUpdateProvider_ProviderSliceIntegrity(t *testing.T) {
 		}
 	})
 }
+
+// requestURLBuilder is implemented by providers through their embedded base provider
+type requestURLBuilder interface {
+	BuildRequestURL(ctx context.Context, defaultPath string, requestType schemas.RequestType) string
+}
+
+// Test that every provider reports its provider key and builds request URLs from its base URL
+func TestCreateBaseProvider_ProviderKeyAndRequestURL(t *testing.T) {
+	bifrost := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	providers := []schemas.ModelProvider{
+		schemas.OpenAI, schemas.Anthropic, schemas.Bedrock, schemas.Cohere, schemas.Azure, schemas.Vertex,
+		schemas.Mistral, schemas.Ollama, schemas.Groq, schemas.SGL, schemas.Parasail, schemas.Perplexity,
+		schemas.Cerebras, schemas.Gemini, schemas.OpenRouter, schemas.Elevenlabs, schemas.Nebius, schemas.HuggingFace,
+	}
+	for _, providerKey := range providers {
+		t.Run(string(providerKey), func(t *testing.T) {
+			provider, err := bifrost.createBaseProvider(providerKey, &schemas.ProviderConfig{
+				NetworkConfig: schemas.NetworkConfig{BaseURL: "https://llm.example.com/"},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create provider: %v", err)
+			}
+			if got := provider.GetProviderKey(); got != providerKey {
+				t.Errorf("Expected provider key %s, got %s", providerKey, got)
+			}
+			builder, ok := provider.(requestURLBuilder)
+			if !ok {
+				t.Fatalf("Expected %T to build request URLs", provider)
+			}
+			if got := builder.BuildRequestURL(context.Background(), "/v1/models", schemas.ListModelsRequest); !strings.HasPrefix(got, "https://llm.example.com") || !strings.HasSuffix(got, "/v1/models") {
+				t.Errorf("Expected the request URL to join the base URL and the path, got %s", got)
+			}
+		})
+	}
+}
+
+// Test that custom providers report their own key and apply their request path overrides
+func TestCreateBaseProvider_CustomProviderKeyAndRequestURL(t *testing.T) {
+	bifrost := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	for _, baseProvider := range []schemas.ModelProvider{schemas.OpenAI, schemas.Anthropic, schemas.Cohere, schemas.Gemini, schemas.HuggingFace} {
+		t.Run(string(baseProvider), func(t *testing.T) {
+			provider, err := bifrost.createBaseProvider("my-provider", &schemas.ProviderConfig{
+				NetworkConfig: schemas.NetworkConfig{BaseURL: "https://llm.example.com"},
+				CustomProviderConfig: &schemas.CustomProviderConfig{
+					BaseProviderType:     baseProvider,
+					RequestPathOverrides: map[schemas.RequestType]string{schemas.ChatCompletionRequest: "custom/chat"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to create provider: %v", err)
+			}
+			if got := provider.GetProviderKey(); got != "my-provider" {
+				t.Errorf("Expected provider key my-provider, got %s", got)
+			}
+			builder := provider.(requestURLBuilder)
+			if got := builder.BuildRequestURL(context.Background(), "/v1/chat/completions", schemas.ChatCompletionRequest); got != "https://llm.example.com/custom/chat" {
+				t.Errorf("Expected the overridden request path, got %s", got)
+			}
+			ctx := context.WithValue(context.Background(), schemas.BifrostContextKeyURLPath, "/from/context")
+			if got := builder.BuildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionRequest); got != "https://llm.example.com/from/context" {
+				t.Errorf("Expected the request path from the context, got %s", got)
+			}
+		})
+	}
+}
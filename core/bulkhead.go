@@ -0,0 +1,246 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// adaptiveLimitBackoffRatio is the factor an adaptive bulkhead limit is multiplied by on overload.
+const adaptiveLimitBackoffRatio = 0.9
+
+// bulkhead bounds the number of requests admitted for a single provider.
+// Every provider gets its own bulkhead so that a slow provider only exhausts its own slots,
+// and callers targeting other providers are never blocked behind it.
+type bulkhead struct {
+	mu          sync.Mutex
+	freed       chan struct{} // Closed and replaced when a slot frees up or the limit grows, wakes up waiting callers
+	maxInFlight int
+	maxWait     time.Duration
+	limiter     *adaptiveLimiter // Adjusts the limit from the outcome of provider calls, nil for a fixed limit
+	inFlight    atomic.Int64
+	waiting     atomic.Int64
+	rejected    atomic.Uint64
+}
+
+// newBulkhead creates a bulkhead from the provider config.
+// Returns nil if the bulkhead is not configured, callers must treat a nil bulkhead as unbounded.
+func newBulkhead(config *schemas.BulkheadConfig) *bulkhead {
+	if config == nil || config.MaxInFlight <= 0 {
+		return nil
+	}
+	maxWait := time.Duration(config.MaxWaitInMs) * time.Millisecond
+	if maxWait < 0 {
+		maxWait = 0
+	}
+	return &bulkhead{
+		freed:       make(chan struct{}),
+		maxInFlight: config.MaxInFlight,
+		maxWait:     maxWait,
+		limiter:     newAdaptiveLimiter(config.Adaptive, config.MaxInFlight),
+	}
+}
+
+// limit returns the number of requests currently admitted.
+func (b *bulkhead) limit() int {
+	if b.limiter == nil {
+		return b.maxInFlight
+	}
+	return b.limiter.current()
+}
+
+// tryAcquire reserves a slot if one is free, otherwise it returns the channel closed when one may have freed up.
+func (b *bulkhead) tryAcquire() (bool, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.inFlight.Load() < int64(b.limit()) {
+		b.inFlight.Add(1)
+		return true, nil
+	}
+	return false, b.freed
+}
+
+// wakeWaiters wakes up the callers waiting for a slot, so that they check the bulkhead again.
+func (b *bulkhead) wakeWaiters() {
+	b.mu.Lock()
+	close(b.freed)
+	b.freed = make(chan struct{})
+	b.mu.Unlock()
+}
+
+// acquire reserves a slot in the bulkhead, waiting at most maxWait for one to free up.
+// Returns an error if no slot became available in time or the context was cancelled.
+func (b *bulkhead) acquire(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	// Fast path: a slot is free
+	acquired, freed := b.tryAcquire()
+	if acquired {
+		return nil
+	}
+	if b.maxWait == 0 {
+		b.rejected.Add(1)
+		return fmt.Errorf("provider bulkhead is full (%d in flight)", b.limit())
+	}
+
+	b.waiting.Add(1)
+	defer b.waiting.Add(-1)
+
+	timer := time.NewTimer(b.maxWait)
+	defer timer.Stop()
+	for {
+		select {
+		case <-freed:
+			if acquired, freed = b.tryAcquire(); acquired {
+				return nil
+			}
+		case <-timer.C:
+			b.rejected.Add(1)
+			return fmt.Errorf("provider bulkhead is full (%d in flight), no slot freed within %s", b.limit(), b.maxWait)
+		case <-ctx.Done():
+			return fmt.Errorf("request cancelled while waiting for provider bulkhead slot")
+		}
+	}
+}
+
+// release frees a slot previously reserved with acquire.
+func (b *bulkhead) release() {
+	if b == nil {
+		return
+	}
+	b.inFlight.Add(-1)
+	b.wakeWaiters()
+}
+
+// observe feeds the outcome of a provider call to the adaptive limiter, if the bulkhead has one.
+func (b *bulkhead) observe(latency time.Duration, bifrostErr *schemas.BifrostError) {
+	if b == nil || b.limiter == nil {
+		return
+	}
+	if b.limiter.observe(latency, bifrostErr) {
+		b.wakeWaiters()
+	}
+}
+
+// stats returns a snapshot of the bulkhead usage.
+func (b *bulkhead) stats() schemas.BulkheadStats {
+	return schemas.BulkheadStats{
+		MaxInFlight: b.maxInFlight,
+		Limit:       b.limit(),
+		InFlight:    b.inFlight.Load(),
+		Waiting:     b.waiting.Load(),
+		Rejected:    b.rejected.Load(),
+	}
+}
+
+// adaptiveLimiter adjusts the limit of a bulkhead from the outcome of the provider calls: the limit is multiplied
+// by adaptiveLimitBackoffRatio when a call shows the provider is overloaded and grows by one slot per successful call,
+// between the configured minimum and the bulkhead's MaxInFlight.
+type adaptiveLimiter struct {
+	mu            sync.Mutex
+	limit         float64
+	minLimit      float64
+	maxLimit      float64
+	latencyTarget time.Duration
+}
+
+// newAdaptiveLimiter creates the adaptive limiter of a bulkhead, starting at the maximum.
+// Returns nil if the limit is not adaptive.
+func newAdaptiveLimiter(config *schemas.AdaptiveConcurrencyConfig, maxInFlight int) *adaptiveLimiter {
+	if config == nil {
+		return nil
+	}
+	minLimit := max(config.MinInFlight, 1)
+	return &adaptiveLimiter{
+		limit:         float64(maxInFlight),
+		minLimit:      float64(min(minLimit, maxInFlight)),
+		maxLimit:      float64(maxInFlight),
+		latencyTarget: time.Duration(config.LatencyTargetInMs) * time.Millisecond,
+	}
+}
+
+// current returns the current limit, in whole slots.
+func (l *adaptiveLimiter) current() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// observe adjusts the limit from the outcome of a provider call and reports whether it grew.
+func (l *adaptiveLimiter) observe(latency time.Duration, bifrostErr *schemas.BifrostError) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if isOverloadError(bifrostErr) || (l.latencyTarget > 0 && latency > l.latencyTarget) {
+		l.limit = max(l.limit*adaptiveLimitBackoffRatio, l.minLimit)
+		return false
+	}
+	if bifrostErr != nil || l.limit >= l.maxLimit {
+		return false
+	}
+	previous := int(l.limit)
+	l.limit = min(l.limit+1, l.maxLimit)
+	return int(l.limit) > previous
+}
+
+// isOverloadError reports whether a provider error means the provider is overloaded: a temporary rate limit,
+// a 502, 503 or 504, or a timeout. Used up quotas and client errors say nothing about the provider's load.
+func isOverloadError(bifrostErr *schemas.BifrostError) bool {
+	if bifrostErr == nil {
+		return false
+	}
+	if bifrostErr.Error != nil && bifrostErr.Error.Message == schemas.ErrProviderRequestTimedOut {
+		return true
+	}
+	if bifrostErr.StatusCode == nil {
+		return false
+	}
+	switch *bifrostErr.StatusCode {
+	case fasthttp.StatusTooManyRequests:
+		return bifrostErr.RateLimitKind != schemas.RateLimitKindQuotaExhausted
+	case fasthttp.StatusBadGateway, fasthttp.StatusServiceUnavailable, fasthttp.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// getBulkhead returns the bulkhead of a provider, or nil if none is configured.
+func (bifrost *Bifrost) getBulkhead(providerKey schemas.ModelProvider) *bulkhead {
+	if value, ok := bifrost.bulkheads.Load(providerKey); ok {
+		return value.(*bulkhead)
+	}
+	return nil
+}
+
+// GetBulkheadStats returns the current bulkhead usage of every provider that has a bulkhead configured.
+// It is intended to be polled by metrics exporters.
+func (bifrost *Bifrost) GetBulkheadStats() map[schemas.ModelProvider]schemas.BulkheadStats {
+	stats := make(map[schemas.ModelProvider]schemas.BulkheadStats)
+	bifrost.bulkheads.Range(func(key, value interface{}) bool {
+		stats[key.(schemas.ModelProvider)] = value.(*bulkhead).stats()
+		return true
+	})
+	return stats
+}
+
+// storeBulkhead creates (or removes) the bulkhead of a provider according to its config.
+func (bifrost *Bifrost) storeBulkhead(providerKey schemas.ModelProvider, config *schemas.BulkheadConfig) {
+	if providerBulkhead := newBulkhead(config); providerBulkhead != nil {
+		bifrost.bulkheads.Store(providerKey, providerBulkhead)
+		return
+	}
+	bifrost.bulkheads.Delete(providerKey)
+}
+
+// newBulkheadError creates the error returned when a request is rejected by a provider bulkhead.
+// It is a 503 so that callers (and fallbacks) treat it as a transient provider-side capacity issue.
+func newBulkheadError(err error) *schemas.BifrostError {
+	bifrostErr := newBifrostError(err)
+	bifrostErr.StatusCode = schemas.Ptr(fasthttp.StatusServiceUnavailable)
+	return bifrostErr
+}
@@ -0,0 +1,174 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that saturating one provider's bulkhead neither blocks nor slows down another provider
+func TestBulkhead_SaturatedProviderDoesNotBlockOthers(t *testing.T) {
+	release := make(chan struct{})
+	slowServer := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+	defer close(release)
+
+	fastServer := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	const fastProvider = schemas.ModelProvider("fast-openai")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, slowServer.URL, &schemas.BulkheadConfig{MaxInFlight: 2, MaxWaitInMs: 50})
+	account.addOpenAICompatibleProvider(fastProvider, fastServer.URL, &schemas.BulkheadConfig{MaxInFlight: 2})
+
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	// Saturate the slow provider's bulkhead
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+		}()
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for client.GetBulkheadStats()[schemas.OpenAI].InFlight < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("slow provider bulkhead never saturated: %+v", client.GetBulkheadStats()[schemas.OpenAI])
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// A further request to the saturated provider is rejected after the max wait
+	_, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+	if bifrostErr == nil {
+		t.Fatalf("Expected saturated provider to reject the request")
+	}
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status 503 for bulkhead rejection, got %v", bifrostErr.StatusCode)
+	}
+
+	// The other provider keeps serving while the slow one is saturated
+	start := time.Now()
+	resp, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(fastProvider))
+	if bifrostErr != nil {
+		t.Fatalf("Expected fast provider to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if resp == nil || len(resp.Choices) == 0 {
+		t.Fatalf("Expected a chat response from fast provider")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Fast provider request took %s while slow provider was saturated", elapsed)
+	}
+
+	stats := client.GetBulkheadStats()
+	if stats[schemas.OpenAI].Rejected != 1 {
+		t.Errorf("Expected 1 rejected request on slow provider, got %d", stats[schemas.OpenAI].Rejected)
+	}
+	if stats[fastProvider].InFlight != 0 {
+		t.Errorf("Expected fast provider slots to be released, got %d in flight", stats[fastProvider].InFlight)
+	}
+
+	// Unblock the slow provider and make sure its slots are released
+	release <- struct{}{}
+	release <- struct{}{}
+	wg.Wait()
+	if inFlight := client.GetBulkheadStats()[schemas.OpenAI].InFlight; inFlight != 0 {
+		t.Errorf("Expected slow provider slots to be released, got %d in flight", inFlight)
+	}
+}
+
+// Test that a bulkhead without max wait rejects immediately and releases slots
+func TestBulkhead_AcquireRelease(t *testing.T) {
+	b := newBulkhead(&schemas.BulkheadConfig{MaxInFlight: 1})
+	if err := b.acquire(context.Background()); err != nil {
+		t.Fatalf("Expected first acquire to succeed, got %v", err)
+	}
+	if err := b.acquire(context.Background()); err == nil {
+		t.Fatalf("Expected second acquire to be rejected")
+	}
+	b.release()
+	if err := b.acquire(context.Background()); err != nil {
+		t.Fatalf("Expected acquire after release to succeed, got %v", err)
+	}
+
+	if newBulkhead(nil) != nil || newBulkhead(&schemas.BulkheadConfig{}) != nil {
+		t.Errorf("Expected unconfigured bulkhead to be nil")
+	}
+	// A nil bulkhead never blocks
+	var unbounded *bulkhead
+	if err := unbounded.acquire(context.Background()); err != nil {
+		t.Errorf("Expected nil bulkhead to admit requests, got %v", err)
+	}
+	unbounded.release()
+}
+
+// Test that an adaptive bulkhead limit backs off on overload, not on other errors, and grows back on success
+func TestBulkhead_AdaptiveLimit(t *testing.T) {
+	b := newBulkhead(&schemas.BulkheadConfig{
+		MaxInFlight: 10,
+		Adaptive:    &schemas.AdaptiveConcurrencyConfig{MinInFlight: 8, LatencyTargetInMs: 1000},
+	})
+	overloaded := &schemas.BifrostError{StatusCode: schemas.Ptr(http.StatusServiceUnavailable)}
+	quotaExhausted := &schemas.BifrostError{StatusCode: schemas.Ptr(http.StatusTooManyRequests), RateLimitKind: schemas.RateLimitKindQuotaExhausted}
+	badRequest := &schemas.BifrostError{StatusCode: schemas.Ptr(http.StatusBadRequest)}
+
+	tests := []struct {
+		name     string
+		latency  time.Duration
+		err      *schemas.BifrostError
+		expected int
+	}{
+		{"overload backs off", time.Millisecond, overloaded, 9},
+		{"slow call backs off", 2 * time.Second, nil, 8},
+		{"backoff stops at the minimum", time.Millisecond, overloaded, 8},
+		{"used up quota is not overload", time.Millisecond, quotaExhausted, 8},
+		{"client error is not overload", time.Millisecond, badRequest, 8},
+		{"success grows the limit", time.Millisecond, nil, 9},
+		{"success grows the limit up to the maximum", time.Millisecond, nil, 10},
+		{"limit stays at the maximum", time.Millisecond, nil, 10},
+	}
+	for _, tt := range tests {
+		b.observe(tt.latency, tt.err)
+		if got := b.stats().Limit; got != tt.expected {
+			t.Fatalf("%s: expected limit %d, got %d", tt.name, tt.expected, got)
+		}
+	}
+}
+
+// Test that a provider answering 503s gets its adaptive bulkhead limit lowered, so fewer requests are admitted
+func TestBulkhead_AdaptiveLimitFromProviderErrors(t *testing.T) {
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":{"message":"overloaded","type":"server_error"}}`))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, &schemas.BulkheadConfig{
+		MaxInFlight: 4,
+		Adaptive:    &schemas.AdaptiveConcurrencyConfig{MinInFlight: 2},
+	})
+
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	for range 10 {
+		if _, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI)); bifrostErr == nil {
+			t.Fatal("Expected the overloaded provider to fail")
+		}
+	}
+	stats := client.GetBulkheadStats()[schemas.OpenAI]
+	if stats.MaxInFlight != 4 || stats.Limit != 2 {
+		t.Errorf("Expected the limit to back off from 4 to the minimum of 2, got %+v", stats)
+	}
+}
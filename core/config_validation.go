@@ -0,0 +1,321 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// ConfigValidationError describes a single invalid configuration field.
+// Field is a dotted path to the offending value (e.g. "providers.bedrock.keys[0].bedrock_key_config").
+type ConfigValidationError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e ConfigValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ConfigValidationErrors is a consolidated list of configuration problems.
+type ConfigValidationErrors []ConfigValidationError
+
+// Error implements the error interface, listing every problem on its own line.
+func (errs ConfigValidationErrors) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("invalid configuration (%d %s):", len(errs), map[bool]string{true: "problems", false: "problem"}[len(errs) > 1]))
+	for _, err := range errs {
+		sb.WriteString("\n  - ")
+		sb.WriteString(err.Error())
+	}
+	return sb.String()
+}
+
+// Add appends a problem for the given field.
+func (errs *ConfigValidationErrors) Add(field string, format string, args ...any) {
+	*errs = append(*errs, ConfigValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// ErrOrNil returns the list as an error, or nil if there are no problems.
+func (errs ConfigValidationErrors) ErrOrNil() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// ValidateProviderConfig checks a provider config for problems that would otherwise only surface at the first request.
+// Field paths are prefixed with "providers.<provider>".
+func ValidateProviderConfig(providerKey schemas.ModelProvider, config *schemas.ProviderConfig) ConfigValidationErrors {
+	var errs ConfigValidationErrors
+	prefix := fmt.Sprintf("providers.%s", providerKey)
+	if config == nil {
+		errs.Add(prefix, "config is nil")
+		return errs
+	}
+
+	baseProviderType := providerKey
+	if cpc := config.CustomProviderConfig; cpc != nil {
+		switch {
+		case cpc.BaseProviderType == "":
+			errs.Add(prefix+".custom_provider_config.base_provider_type", "is required")
+		case !IsSupportedBaseProvider(cpc.BaseProviderType):
+			errs.Add(prefix+".custom_provider_config.base_provider_type", "unsupported base provider type %q", cpc.BaseProviderType)
+		default:
+			baseProviderType = cpc.BaseProviderType
+		}
+		if IsStandardProvider(providerKey) {
+			errs.Add(prefix+".custom_provider_config", "cannot be set on standard provider %s", providerKey)
+		}
+		if cpc.BaseProviderType == schemas.Bedrock && cpc.IsKeyLess {
+			errs.Add(prefix+".custom_provider_config.is_key_less", "bedrock based providers cannot be keyless")
+		}
+		for requestType, template := range cpc.RequestBodyTemplates {
+			templatePrefix := fmt.Sprintf("%s.custom_provider_config.request_body_templates.%s", prefix, requestType)
+			if template == nil {
+				errs.Add(templatePrefix, "is nil")
+				continue
+			}
+			for targetPath, sourcePath := range template.Fields {
+				if !isValidJSONPath(targetPath) || !isValidJSONPath(sourcePath) {
+					errs.Add(templatePrefix+".fields", "invalid mapping %q -> %q, paths must be dot-separated field names", targetPath, sourcePath)
+				}
+			}
+			for targetPath := range template.Defaults {
+				if !isValidJSONPath(targetPath) {
+					errs.Add(templatePrefix+".defaults", "invalid path %q, paths must be dot-separated field names", targetPath)
+				}
+			}
+		}
+	} else if !IsStandardProvider(providerKey) {
+		errs.Add(prefix, "unknown provider, set custom_provider_config to configure a custom provider")
+	}
+
+	network := config.NetworkConfig
+	baseURL := providerUtils.NormalizeBaseURL(network.BaseURL)
+	if baseURL == "" {
+		if network.BaseURL != "" {
+			errs.Add(prefix+".network_config.base_url", "is empty after trimming whitespace and trailing slashes")
+		} else if baseProviderType == schemas.Ollama || baseProviderType == schemas.SGL {
+			errs.Add(prefix+".network_config.base_url", "is required for %s", baseProviderType)
+		}
+	} else if parsed, err := url.Parse(baseURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errs.Add(prefix+".network_config.base_url", "must be an absolute http(s) URL, got %q", network.BaseURL)
+	}
+	if network.DefaultRequestTimeoutInSeconds < 0 {
+		errs.Add(prefix+".network_config.default_request_timeout_in_seconds", "must not be negative")
+	}
+	if network.MaxRetries < 0 {
+		errs.Add(prefix+".network_config.max_retries", "must not be negative")
+	}
+	if network.RetryBackoffInitial < 0 {
+		errs.Add(prefix+".network_config.retry_backoff_initial", "must not be negative")
+	}
+	if network.RetryBackoffMax < 0 {
+		errs.Add(prefix+".network_config.retry_backoff_max", "must not be negative")
+	}
+	if network.RetryBackoffInitial > 0 && network.RetryBackoffMax > 0 && network.RetryBackoffInitial > network.RetryBackoffMax {
+		errs.Add(prefix+".network_config.retry_backoff_initial", "must be less than or equal to retry_backoff_max")
+	}
+	if _, err := providerUtils.BuildTLSConfig(nil, network.TLS); err != nil {
+		errs.Add(prefix+".network_config.tls", "%v", err)
+	}
+
+	concurrency := config.ConcurrencyAndBufferSize
+	if concurrency.Concurrency < 0 {
+		errs.Add(prefix+".concurrency_and_buffer_size.concurrency", "must not be negative")
+	}
+	if concurrency.BufferSize < 0 {
+		errs.Add(prefix+".concurrency_and_buffer_size.buffer_size", "must not be negative")
+	}
+
+	if proxy := config.ProxyConfig; proxy != nil {
+		switch proxy.Type {
+		case schemas.NoProxy, schemas.EnvProxy, "":
+		case schemas.HTTPProxy, schemas.Socks5Proxy:
+			if strings.TrimSpace(proxy.URL) == "" {
+				errs.Add(prefix+".proxy_config.url", "is required for %s proxy", proxy.Type)
+			}
+		default:
+			errs.Add(prefix+".proxy_config.type", "unsupported proxy type %q", proxy.Type)
+		}
+	}
+
+	if bulkhead := config.Bulkhead; bulkhead != nil {
+		if bulkhead.MaxInFlight < 0 {
+			errs.Add(prefix+".bulkhead.max_in_flight", "must not be negative")
+		}
+		if bulkhead.MaxWaitInMs < 0 {
+			errs.Add(prefix+".bulkhead.max_wait_ms", "must not be negative")
+		}
+		if adaptive := bulkhead.Adaptive; adaptive != nil {
+			if adaptive.MinInFlight < 0 || adaptive.MinInFlight > bulkhead.MaxInFlight {
+				errs.Add(prefix+".bulkhead.adaptive.min_in_flight", "must be between 0 and max_in_flight")
+			}
+			if adaptive.LatencyTargetInMs < 0 {
+				errs.Add(prefix+".bulkhead.adaptive.latency_target_ms", "must not be negative")
+			}
+		}
+	}
+
+	if config.MaxConcurrentStreams < 0 {
+		errs.Add(prefix+".max_concurrent_streams", "must not be negative")
+	}
+
+	if config.MaxInlineBatchRequests < 0 {
+		errs.Add(prefix+".max_inline_batch_requests", "must not be negative")
+	}
+
+	if rateLimit := config.OutboundRateLimit; rateLimit != nil {
+		if rateLimit.RequestsPerSecond < 0 {
+			errs.Add(prefix+".outbound_rate_limit.requests_per_second", "must not be negative")
+		}
+		if rateLimit.Burst < 0 {
+			errs.Add(prefix+".outbound_rate_limit.burst", "must not be negative")
+		}
+		if rateLimit.MaxWaitInMs < 0 {
+			errs.Add(prefix+".outbound_rate_limit.max_wait_ms", "must not be negative")
+		}
+	}
+
+	switch config.AssistantPrefill {
+	case schemas.AssistantPrefillModeEmulate, schemas.AssistantPrefillModeWarn, "":
+	default:
+		errs.Add(prefix+".assistant_prefill", "unsupported assistant prefill mode %q", config.AssistantPrefill)
+	}
+
+	if err := providerUtils.ValidateBatchNameTemplate(config.BatchNameTemplate); err != nil {
+		errs.Add(prefix+".batch_name_template", "%v", err)
+	}
+
+	for i, rule := range config.ParameterRules {
+		rulePrefix := fmt.Sprintf("%s.parameter_rules[%d]", prefix, i)
+		if len(rule.Models) == 0 {
+			errs.Add(rulePrefix+".models", "is required")
+		}
+		if len(rule.AllowedParams) == 0 && len(rule.DeniedParams) == 0 {
+			errs.Add(rulePrefix, "one of allowed_params or denied_params is required")
+		}
+		switch rule.Action {
+		case schemas.ModelParameterActionStrip, schemas.ModelParameterActionReject, "":
+		default:
+			errs.Add(rulePrefix+".action", "unsupported action %q", rule.Action)
+		}
+	}
+
+	return errs
+}
+
+// ValidateProviderKeys checks the keys of a provider, including the provider-specific key configs
+// (Azure, Vertex, Bedrock) that are otherwise only checked when a request is made.
+// Field paths are prefixed with "providers.<provider>.keys[<index>]".
+func ValidateProviderKeys(providerKey schemas.ModelProvider, customProviderConfig *schemas.CustomProviderConfig, keys []schemas.Key) ConfigValidationErrors {
+	var errs ConfigValidationErrors
+	baseProviderType := providerKey
+	if customProviderConfig != nil && customProviderConfig.BaseProviderType != "" {
+		baseProviderType = customProviderConfig.BaseProviderType
+	}
+	if !providerRequiresKey(baseProviderType, customProviderConfig) {
+		return errs
+	}
+	if len(keys) == 0 {
+		errs.Add(fmt.Sprintf("providers.%s.keys", providerKey), "at least one key is required")
+		return errs
+	}
+
+	seenIDs := make(map[string]int, len(keys))
+	for i, key := range keys {
+		prefix := fmt.Sprintf("providers.%s.keys[%d]", providerKey, i)
+		if key.ID != "" {
+			if first, ok := seenIDs[key.ID]; ok {
+				errs.Add(prefix+".id", "duplicate key id %q (also used by keys[%d])", key.ID, first)
+			} else {
+				seenIDs[key.ID] = i
+			}
+		}
+		if key.Weight < 0 {
+			errs.Add(prefix+".weight", "must not be negative")
+		}
+		if strings.TrimSpace(key.Value) == "" && !canProviderKeyValueBeEmpty(baseProviderType) {
+			errs.Add(prefix+".value", "is required")
+		}
+
+		switch baseProviderType {
+		case schemas.Azure:
+			if key.AzureKeyConfig == nil {
+				errs.Add(prefix+".azure_key_config", "is required for azure keys")
+			} else if strings.TrimSpace(key.AzureKeyConfig.Endpoint) == "" {
+				errs.Add(prefix+".azure_key_config.endpoint", "is required")
+			}
+		case schemas.Vertex:
+			if key.VertexKeyConfig == nil {
+				errs.Add(prefix+".vertex_key_config", "is required for vertex keys")
+			} else {
+				if strings.TrimSpace(key.VertexKeyConfig.ProjectID) == "" {
+					errs.Add(prefix+".vertex_key_config.project_id", "is required")
+				}
+				if strings.TrimSpace(key.VertexKeyConfig.Region) == "" {
+					errs.Add(prefix+".vertex_key_config.region", "is required")
+				}
+			}
+		case schemas.Bedrock:
+			if key.BedrockKeyConfig == nil {
+				errs.Add(prefix+".bedrock_key_config", "is required for bedrock keys")
+			} else if (key.BedrockKeyConfig.AccessKey == "") != (key.BedrockKeyConfig.SecretKey == "") {
+				errs.Add(prefix+".bedrock_key_config", "access_key and secret_key must be set together")
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateAccount validates the config and keys of every provider configured in the account.
+// It is meant to be called at startup, before serving traffic, and returns a ConfigValidationErrors
+// listing every problem found (or nil if the account is valid).
+func ValidateAccount(ctx context.Context, account schemas.Account) error {
+	var errs ConfigValidationErrors
+	if account == nil {
+		errs.Add("account", "is required")
+		return errs
+	}
+	providers, err := account.GetConfiguredProviders()
+	if err != nil {
+		errs.Add("providers", "failed to get configured providers: %v", err)
+		return errs
+	}
+	for _, providerKey := range providers {
+		config, err := account.GetConfigForProvider(providerKey)
+		if err != nil {
+			errs.Add(fmt.Sprintf("providers.%s", providerKey), "failed to get config: %v", err)
+			continue
+		}
+		errs = append(errs, ValidateProviderConfig(providerKey, config)...)
+		if config == nil {
+			continue
+		}
+		baseProviderType := providerKey
+		if config.CustomProviderConfig != nil && config.CustomProviderConfig.BaseProviderType != "" {
+			baseProviderType = config.CustomProviderConfig.BaseProviderType
+		}
+		keys, err := account.GetKeysForProvider(&ctx, providerKey)
+		if err != nil {
+			if providerRequiresKey(baseProviderType, config.CustomProviderConfig) {
+				errs.Add(fmt.Sprintf("providers.%s.keys", providerKey), "failed to get keys: %v", err)
+			}
+			continue
+		}
+		errs = append(errs, ValidateProviderKeys(providerKey, config.CustomProviderConfig, keys)...)
+	}
+	return errs.ErrOrNil()
+}
+
+// isValidJSONPath reports whether a path is made of non-empty dot-separated field names.
+func isValidJSONPath(path string) bool {
+	return path != "" && !slices.Contains(strings.Split(path, "."), "")
+}
@@ -0,0 +1,272 @@
+package bifrost
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// hasFieldError reports whether the list contains a problem for the exact field
+func hasFieldError(errs ConfigValidationErrors, field string) bool {
+	for _, err := range errs {
+		if err.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidateProviderConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider schemas.ModelProvider
+		config   *schemas.ProviderConfig
+		fields   []string
+	}{
+		{
+			name:     "ValidConfig",
+			provider: schemas.OpenAI,
+			config:   &schemas.ProviderConfig{NetworkConfig: schemas.NetworkConfig{BaseURL: "https://api.openai.com/"}},
+		},
+		{
+			name:     "BaseURLEmptyAfterTrimming",
+			provider: schemas.OpenAI,
+			config:   &schemas.ProviderConfig{NetworkConfig: schemas.NetworkConfig{BaseURL: "  / "}},
+			fields:   []string{"providers.openai.network_config.base_url"},
+		},
+		{
+			name:     "BaseURLWithoutScheme",
+			provider: schemas.Anthropic,
+			config:   &schemas.ProviderConfig{NetworkConfig: schemas.NetworkConfig{BaseURL: "api.anthropic.com"}},
+			fields:   []string{"providers.anthropic.network_config.base_url"},
+		},
+		{
+			name:     "OllamaMissingBaseURL",
+			provider: schemas.Ollama,
+			config:   &schemas.ProviderConfig{},
+			fields:   []string{"providers.ollama.network_config.base_url"},
+		},
+		{
+			name:     "NegativeValuesAndInvertedBackoff",
+			provider: schemas.OpenAI,
+			config: &schemas.ProviderConfig{
+				NetworkConfig: schemas.NetworkConfig{
+					MaxRetries:          -1,
+					RetryBackoffInitial: 10 * 1e9,
+					RetryBackoffMax:     1e9,
+				},
+				ConcurrencyAndBufferSize: schemas.ConcurrencyAndBufferSize{Concurrency: -5},
+			},
+			fields: []string{
+				"providers.openai.network_config.max_retries",
+				"providers.openai.network_config.retry_backoff_initial",
+				"providers.openai.concurrency_and_buffer_size.concurrency",
+			},
+		},
+		{
+			name:     "CustomProviderWithUnsupportedBase",
+			provider: "my-provider",
+			config: &schemas.ProviderConfig{
+				CustomProviderConfig: &schemas.CustomProviderConfig{BaseProviderType: "not-a-provider"},
+			},
+			fields: []string{"providers.my-provider.custom_provider_config.base_provider_type"},
+		},
+		{
+			name:     "CustomProviderWithInvalidRequestBodyTemplatePaths",
+			provider: "my-provider",
+			config: &schemas.ProviderConfig{
+				CustomProviderConfig: &schemas.CustomProviderConfig{
+					BaseProviderType: schemas.OpenAI,
+					RequestBodyTemplates: map[schemas.RequestType]*schemas.RequestBodyTemplate{
+						schemas.ChatCompletionRequest: {
+							Fields:   map[string]string{"prompt": "messages", "parameters..temperature": "temperature"},
+							Defaults: map[string]any{"": true},
+						},
+					},
+				},
+			},
+			fields: []string{
+				"providers.my-provider.custom_provider_config.request_body_templates.chat_completion.fields",
+				"providers.my-provider.custom_provider_config.request_body_templates.chat_completion.defaults",
+			},
+		},
+		{
+			name:     "UnknownProviderWithoutCustomConfig",
+			provider: "my-provider",
+			config:   &schemas.ProviderConfig{},
+			fields:   []string{"providers.my-provider"},
+		},
+		{
+			name:     "HTTPProxyWithoutURL",
+			provider: schemas.OpenAI,
+			config:   &schemas.ProviderConfig{ProxyConfig: &schemas.ProxyConfig{Type: schemas.HTTPProxy}},
+			fields:   []string{"providers.openai.proxy_config.url"},
+		},
+		{
+			name:     "UnsupportedAssistantPrefillMode",
+			provider: schemas.OpenAI,
+			config:   &schemas.ProviderConfig{AssistantPrefill: "prepend"},
+			fields:   []string{"providers.openai.assistant_prefill"},
+		},
+		{
+			name:     "UnknownBatchNameTemplateVariable",
+			provider: schemas.Bedrock,
+			config:   &schemas.ProviderConfig{BatchNameTemplate: "{team}-{timestamp}"},
+			fields:   []string{"providers.bedrock.batch_name_template"},
+		},
+		{
+			name:     "NegativeMaxConcurrentStreams",
+			provider: schemas.OpenAI,
+			config:   &schemas.ProviderConfig{MaxConcurrentStreams: -1},
+			fields:   []string{"providers.openai.max_concurrent_streams"},
+		},
+		{
+			name:     "NegativeMaxInlineBatchRequests",
+			provider: schemas.Anthropic,
+			config:   &schemas.ProviderConfig{MaxInlineBatchRequests: -1},
+			fields:   []string{"providers.anthropic.max_inline_batch_requests"},
+		},
+		{
+			name:     "ValidTLSConfig",
+			provider: schemas.OpenAI,
+			config: &schemas.ProviderConfig{NetworkConfig: schemas.NetworkConfig{TLS: &schemas.TLSConfig{
+				MinVersion:   "1.2",
+				CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			}}},
+		},
+		{
+			name:     "TLSMinVersionTooOld",
+			provider: schemas.OpenAI,
+			config:   &schemas.ProviderConfig{NetworkConfig: schemas.NetworkConfig{TLS: &schemas.TLSConfig{MinVersion: "1.0"}}},
+			fields:   []string{"providers.openai.network_config.tls"},
+		},
+		{
+			name:     "TLSInsecureCipherSuite",
+			provider: schemas.Bedrock,
+			config: &schemas.ProviderConfig{NetworkConfig: schemas.NetworkConfig{TLS: &schemas.TLSConfig{
+				CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"},
+			}}},
+			fields: []string{"providers.bedrock.network_config.tls"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateProviderConfig(tt.provider, tt.config)
+			if len(errs) != len(tt.fields) {
+				t.Fatalf("Expected %d problems, got %d: %v", len(tt.fields), len(errs), errs)
+			}
+			for _, field := range tt.fields {
+				if !hasFieldError(errs, field) {
+					t.Errorf("Expected a problem for field %s, got: %v", field, errs)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateProviderKeys(t *testing.T) {
+	t.Run("BedrockMissingKeyConfig", func(t *testing.T) {
+		errs := ValidateProviderKeys(schemas.Bedrock, nil, []schemas.Key{{ID: "k1", Weight: 1}})
+		if len(errs) != 1 || !hasFieldError(errs, "providers.bedrock.keys[0].bedrock_key_config") {
+			t.Errorf("Expected only a bedrock_key_config problem, got: %v", errs)
+		}
+	})
+
+	t.Run("BedrockPartialCredentials", func(t *testing.T) {
+		errs := ValidateProviderKeys(schemas.Bedrock, nil, []schemas.Key{
+			{ID: "k1", BedrockKeyConfig: &schemas.BedrockKeyConfig{AccessKey: "AKIA"}},
+		})
+		if !hasFieldError(errs, "providers.bedrock.keys[0].bedrock_key_config") {
+			t.Errorf("Expected a bedrock_key_config problem, got: %v", errs)
+		}
+	})
+
+	t.Run("AzureMissingEndpointAndValue", func(t *testing.T) {
+		errs := ValidateProviderKeys(schemas.Azure, nil, []schemas.Key{
+			{ID: "k1", AzureKeyConfig: &schemas.AzureKeyConfig{}},
+		})
+		if !hasFieldError(errs, "providers.azure.keys[0].azure_key_config.endpoint") || !hasFieldError(errs, "providers.azure.keys[0].value") {
+			t.Errorf("Expected endpoint and value problems, got: %v", errs)
+		}
+	})
+
+	t.Run("VertexMissingProjectAndRegion", func(t *testing.T) {
+		errs := ValidateProviderKeys(schemas.Vertex, nil, []schemas.Key{
+			{ID: "k1", VertexKeyConfig: &schemas.VertexKeyConfig{}},
+		})
+		if !hasFieldError(errs, "providers.vertex.keys[0].vertex_key_config.project_id") || !hasFieldError(errs, "providers.vertex.keys[0].vertex_key_config.region") {
+			t.Errorf("Expected project_id and region problems, got: %v", errs)
+		}
+	})
+
+	t.Run("DuplicateIDsAndNegativeWeight", func(t *testing.T) {
+		errs := ValidateProviderKeys(schemas.OpenAI, nil, []schemas.Key{
+			{ID: "k1", Value: "sk-1", Weight: 1},
+			{ID: "k1", Value: "sk-2", Weight: -1},
+		})
+		if !hasFieldError(errs, "providers.openai.keys[1].id") || !hasFieldError(errs, "providers.openai.keys[1].weight") {
+			t.Errorf("Expected id and weight problems, got: %v", errs)
+		}
+	})
+
+	t.Run("NoKeys", func(t *testing.T) {
+		errs := ValidateProviderKeys(schemas.OpenAI, nil, nil)
+		if !hasFieldError(errs, "providers.openai.keys") {
+			t.Errorf("Expected a keys problem, got: %v", errs)
+		}
+	})
+
+	t.Run("KeylessProvider", func(t *testing.T) {
+		if errs := ValidateProviderKeys(schemas.Ollama, nil, nil); len(errs) != 0 {
+			t.Errorf("Expected no problems for keyless provider, got: %v", errs)
+		}
+	})
+}
+
+func TestValidateAccount_ConsolidatesProblems(t *testing.T) {
+	account := NewMockAccount()
+	account.AddProvider(schemas.OpenAI, 5, 100)
+	account.configs[schemas.OpenAI].NetworkConfig.BaseURL = " "
+	account.AddProvider(schemas.Bedrock, 5, 100)
+	account.keys[schemas.Bedrock][0].Value = ""
+
+	err := ValidateAccount(context.Background(), account)
+	if err == nil {
+		t.Fatalf("Expected validation to fail")
+	}
+	var errs ConfigValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ConfigValidationErrors, got %T", err)
+	}
+	if !hasFieldError(errs, "providers.openai.network_config.base_url") || !hasFieldError(errs, "providers.bedrock.keys[0].bedrock_key_config") {
+		t.Errorf("Expected problems from both providers, got: %v", errs)
+	}
+	if !strings.Contains(err.Error(), "invalid configuration (2 problems)") {
+		t.Errorf("Expected consolidated message, got: %s", err.Error())
+	}
+
+	valid := NewMockAccount()
+	valid.AddProvider(schemas.OpenAI, 5, 100)
+	if err := ValidateAccount(context.Background(), valid); err != nil {
+		t.Errorf("Expected valid account to pass, got: %v", err)
+	}
+}
+
+// Test that an invalid TLS config rejected by validation also fails provider creation, for every provider
+func TestCreateBaseProvider_RejectsInvalidTLS(t *testing.T) {
+	client := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	for _, provider := range []schemas.ModelProvider{schemas.OpenAI, schemas.Anthropic, schemas.Bedrock} {
+		config := &schemas.ProviderConfig{NetworkConfig: schemas.NetworkConfig{TLS: &schemas.TLSConfig{MinVersion: "1.0"}}}
+		config.CheckAndSetDefaults()
+		if errs := ValidateProviderConfig(provider, config); !hasFieldError(errs, "providers."+string(provider)+".network_config.tls") {
+			t.Errorf("Expected validation to reject the TLS config of %s, got: %v", provider, errs)
+		}
+		if _, err := client.createBaseProvider(provider, config); err == nil || !strings.Contains(err.Error(), "invalid TLS configuration") {
+			t.Errorf("Expected the creation of %s to fail with the TLS configuration error, got %v", provider, err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the embedded error handling of one Bifrost instance does not leak into another instance
+func TestEmbeddedErrorHandling_PerInstance(t *testing.T) {
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":{"message":"The model does not exist","type":"invalid_request_error","code":"model_not_found"}}`))
+	})
+
+	newClient := func(handling schemas.EmbeddedErrorHandling) *Bifrost {
+		account := NewMockAccount()
+		account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+		return initTestClient(t, account, schemas.BifrostConfig{EmbeddedErrorHandling: handling})
+	}
+	converting := newClient(schemas.EmbeddedErrorHandlingConvert)
+	passthrough := newClient(schemas.EmbeddedErrorHandlingPassthrough)
+
+	_, bifrostErr := converting.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+	if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Expected the error body to be converted into a 400 error, got %v", GetErrorMessage(bifrostErr))
+	}
+	if _, bifrostErr := passthrough.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI)); bifrostErr != nil {
+		t.Fatalf("Expected the error body to be parsed as a response in passthrough mode, got %v", GetErrorMessage(bifrostErr))
+	}
+}
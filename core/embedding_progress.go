@@ -0,0 +1,138 @@
+package bifrost
+
+import (
+	"context"
+	"sort"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// DefaultEmbeddingProgressBatchSize is the number of inputs embedded per sub-batch
+// by EmbeddingRequestWithProgress when no batch size is given.
+const DefaultEmbeddingProgressBatchSize = 100
+
+// EmbeddingRequestWithProgress embeds a (potentially very large) list of inputs in sub-batches of batchSize,
+// sending a progress event on the returned channel every time a sub-batch completes.
+// The last event carries the final response, with the embeddings of all sub-batches in input order
+// and their usage summed up. If a sub-batch fails, an event carrying the error is sent instead and no
+// further sub-batches are requested. The channel is closed after the last event.
+func (bifrost *Bifrost) EmbeddingRequestWithProgress(ctx context.Context, req *schemas.BifrostEmbeddingRequest, batchSize int) (chan *schemas.BifrostEmbeddingProgress, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "embedding request is nil",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.EmbeddingRequest,
+			},
+		}
+	}
+	if req.Input == nil || (req.Input.Text == nil && req.Input.Texts == nil && req.Input.Embedding == nil && req.Input.Embeddings == nil) {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "embedding input not provided for embedding request",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.EmbeddingRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultEmbeddingProgressBatchSize
+	}
+
+	subRequests, total := splitEmbeddingRequest(req, batchSize)
+	progress := make(chan *schemas.BifrostEmbeddingProgress, len(subRequests)+1)
+	go func() {
+		defer close(progress)
+		final := &schemas.BifrostEmbeddingResponse{
+			Data:   make([]schemas.EmbeddingData, 0, total),
+			Object: "list",
+			Usage:  &schemas.BifrostLLMUsage{},
+		}
+		completed := 0
+		for _, subRequest := range subRequests {
+			if ctx.Err() != nil {
+				progress <- &schemas.BifrostEmbeddingProgress{Completed: completed, Total: total, Error: newBifrostError(ctx.Err())}
+				return
+			}
+			response, err := bifrost.EmbeddingRequest(ctx, subRequest)
+			if err != nil {
+				progress <- &schemas.BifrostEmbeddingProgress{Completed: completed, Total: total, Error: err}
+				return
+			}
+			mergeEmbeddingResponse(final, response, completed)
+			completed += embeddingInputCount(subRequest.Input)
+			if completed < total {
+				progress <- &schemas.BifrostEmbeddingProgress{Completed: completed, Total: total}
+			}
+		}
+		// Providers are not required to return embeddings in input order
+		sort.SliceStable(final.Data, func(i, j int) bool { return final.Data[i].Index < final.Data[j].Index })
+		progress <- &schemas.BifrostEmbeddingProgress{Completed: completed, Total: total, Response: final}
+	}()
+	return progress, nil
+}
+
+// splitEmbeddingRequest splits the inputs of an embedding request into sub-requests of at most batchSize inputs.
+// Single inputs (text or token embedding) are never split. Returns the sub-requests and the total number of inputs.
+func splitEmbeddingRequest(req *schemas.BifrostEmbeddingRequest, batchSize int) ([]*schemas.BifrostEmbeddingRequest, int) {
+	total := embeddingInputCount(req.Input)
+	if total <= batchSize {
+		return []*schemas.BifrostEmbeddingRequest{req}, total
+	}
+	subRequests := make([]*schemas.BifrostEmbeddingRequest, 0, (total+batchSize-1)/batchSize)
+	for start := 0; start < total; start += batchSize {
+		end := min(start+batchSize, total)
+		subRequest := *req
+		subRequest.RawRequestBody = nil
+		if req.Input.Texts != nil {
+			subRequest.Input = &schemas.EmbeddingInput{Texts: req.Input.Texts[start:end]}
+		} else {
+			subRequest.Input = &schemas.EmbeddingInput{Embeddings: req.Input.Embeddings[start:end]}
+		}
+		subRequests = append(subRequests, &subRequest)
+	}
+	return subRequests, total
+}
+
+// embeddingInputCount returns the number of inputs to embed.
+func embeddingInputCount(input *schemas.EmbeddingInput) int {
+	switch {
+	case input.Texts != nil:
+		return len(input.Texts)
+	case input.Embeddings != nil:
+		return len(input.Embeddings)
+	default:
+		return 1
+	}
+}
+
+// mergeEmbeddingResponse appends the embeddings of a sub-batch response to the final response,
+// offsetting their indexes by the position of the sub-batch in the original input.
+func mergeEmbeddingResponse(final *schemas.BifrostEmbeddingResponse, response *schemas.BifrostEmbeddingResponse, offset int) {
+	if response == nil {
+		return
+	}
+	for _, data := range response.Data {
+		data.Index += offset
+		final.Data = append(final.Data, data)
+	}
+	if final.Model == "" {
+		final.Model = response.Model
+	}
+	if response.Usage != nil {
+		final.Usage.PromptTokens += response.Usage.PromptTokens
+		final.Usage.CompletionTokens += response.Usage.CompletionTokens
+		final.Usage.TotalTokens += response.Usage.TotalTokens
+	}
+	latency := final.ExtraFields.Latency + response.ExtraFields.Latency
+	final.ExtraFields = response.ExtraFields
+	final.ExtraFields.Latency = latency
+	final.ExtraFields.RawRequest = nil
+	final.ExtraFields.RawResponse = nil
+}
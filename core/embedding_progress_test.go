@@ -0,0 +1,98 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a large embedding request is split into sub-batches, reports progress and keeps input order
+func TestEmbeddingRequestWithProgress(t *testing.T) {
+	var requests atomic.Int32
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var body struct {
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Embed every input as its numeric value, returned in reverse order to exercise re-ordering
+		data := make([]map[string]any, 0, len(body.Input))
+		for i := len(body.Input) - 1; i >= 0; i-- {
+			value, _ := strconv.Atoi(body.Input[i])
+			data = append(data, map[string]any{"index": i, "object": "embedding", "embedding": []float32{float32(value)}})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"object": "list",
+			"model":  "text-embedding-3-small",
+			"data":   data,
+			"usage":  map[string]any{"prompt_tokens": len(body.Input), "total_tokens": len(body.Input)},
+		})
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	inputs := make([]string, 10)
+	for i := range inputs {
+		inputs[i] = fmt.Sprint(i)
+	}
+	progress, bifrostErr := client.EmbeddingRequestWithProgress(context.Background(), &schemas.BifrostEmbeddingRequest{
+		Provider: schemas.OpenAI,
+		Model:    "text-embedding-3-small",
+		Input:    &schemas.EmbeddingInput{Texts: inputs},
+	}, 4)
+	if bifrostErr != nil {
+		t.Fatalf("Expected request to start, got error: %v", GetErrorMessage(bifrostErr))
+	}
+
+	var events []*schemas.BifrostEmbeddingProgress
+	for event := range progress {
+		if event.Error != nil {
+			t.Fatalf("Unexpected error event: %v", GetErrorMessage(event.Error))
+		}
+		events = append(events, event)
+	}
+
+	if got := requests.Load(); got != 3 {
+		t.Errorf("Expected 3 sub-batch requests, got %d", got)
+	}
+	expectedCompleted := []int{4, 8, 10}
+	if len(events) != len(expectedCompleted) {
+		t.Fatalf("Expected %d progress events, got %d", len(expectedCompleted), len(events))
+	}
+	for i, event := range events {
+		if event.Completed != expectedCompleted[i] || event.Total != 10 {
+			t.Errorf("Event %d: expected %d of 10, got %d of %d", i, expectedCompleted[i], event.Completed, event.Total)
+		}
+		if last := i == len(events)-1; last != (event.Response != nil) {
+			t.Errorf("Event %d: expected response only on the last event", i)
+		}
+	}
+
+	final := events[len(events)-1].Response
+	if len(final.Data) != 10 {
+		t.Fatalf("Expected 10 embeddings, got %d", len(final.Data))
+	}
+	for i, data := range final.Data {
+		if data.Index != i {
+			t.Errorf("Expected embedding %d to have index %d, got %d", i, i, data.Index)
+		}
+		if len(data.Embedding.EmbeddingArray) != 1 || data.Embedding.EmbeddingArray[0] != float32(i) {
+			t.Errorf("Expected embedding %d to belong to input %d, got %v", i, i, data.Embedding.EmbeddingArray)
+		}
+	}
+	if final.Usage == nil || final.Usage.PromptTokens != 10 {
+		t.Errorf("Expected summed usage of 10 prompt tokens, got %+v", final.Usage)
+	}
+}
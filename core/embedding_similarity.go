@@ -0,0 +1,72 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// EmbeddingSimilarity returns the cosine similarity of two texts, embedded with the given model, a "provider/model"
+// string. The key is sent as the direct key of the request, nil selects one of the provider's configured keys.
+// Both texts are embedded in a single embedding request, so the request goes through the plugins, retries and
+// fallbacks like any other. The similarity ranges from -1 (opposite) to 1 (identical meaning).
+func (bifrost *Bifrost) EmbeddingSimilarity(ctx context.Context, key *schemas.Key, a, b string, model string) (float64, *schemas.BifrostError) {
+	provider, model := bifrost.ParseModelString(model, "")
+	if provider == "" {
+		return 0, newBifrostErrorFromMsg(fmt.Sprintf("model %q must be in the provider/model format", model))
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+	if key != nil {
+		ctx = context.WithValue(ctx, schemas.BifrostContextKeyDirectKey, *key)
+	}
+	response, bifrostErr := bifrost.EmbeddingRequest(ctx, &schemas.BifrostEmbeddingRequest{
+		Provider: provider,
+		Model:    model,
+		Input:    &schemas.EmbeddingInput{Texts: []string{a, b}},
+	})
+	if bifrostErr != nil {
+		return 0, bifrostErr
+	}
+
+	// Providers are not required to return embeddings in input order
+	embeddings := make([][]float32, 2)
+	for _, data := range response.Data {
+		if data.Index < 0 || data.Index >= len(embeddings) {
+			continue
+		}
+		if data.Embedding.EmbeddingArray == nil {
+			return 0, newBifrostErrorFromMsg("embedding similarity requires float embeddings")
+		}
+		embeddings[data.Index] = data.Embedding.EmbeddingArray
+	}
+	if embeddings[0] == nil || embeddings[1] == nil {
+		return 0, newBifrostErrorFromMsg(fmt.Sprintf("expected 2 embeddings, got %d", len(response.Data)))
+	}
+
+	similarity, err := cosineSimilarity(embeddings[0], embeddings[1])
+	if err != nil {
+		return 0, newBifrostError(err)
+	}
+	return similarity, nil
+}
+
+// cosineSimilarity returns the cosine of the angle between two vectors of the same dimension.
+func cosineSimilarity(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("embeddings have different dimensions: %d and %d", len(a), len(b))
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0, fmt.Errorf("cannot compute the similarity of a zero embedding")
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB)), nil
+}
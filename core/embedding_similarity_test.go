@@ -0,0 +1,81 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that identical texts have a similarity of 1 and unrelated texts a similarity of 0
+func TestEmbeddingSimilarity(t *testing.T) {
+	// Embed each known text as a fixed vector, "cat" and "dog" are orthogonal
+	vectors := map[string][]float32{
+		"cat":    {1, 0, 0},
+		"dog":    {0, 2, 0},
+		"kitten": {3, 0, 0},
+	}
+	var (
+		requests      atomic.Int32
+		authorization atomic.Value // Authorization header of the last request
+	)
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		authorization.Store(r.Header.Get("Authorization"))
+		var body struct {
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Return the embeddings in reverse order to exercise re-ordering
+		data := make([]map[string]any, 0, len(body.Input))
+		for i := len(body.Input) - 1; i >= 0; i-- {
+			data = append(data, map[string]any{"index": i, "object": "embedding", "embedding": vectors[body.Input[i]]})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"object": "list", "model": "text-embedding-3-small", "data": data})
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	tests := []struct {
+		a, b     string
+		key      *schemas.Key
+		expected float64
+	}{
+		{"cat", "cat", nil, 1},
+		{"cat", "kitten", nil, 1},
+		{"cat", "dog", nil, 0},
+		{"dog", "dog", &schemas.Key{ID: "direct-key", Value: "sk-direct", Weight: 1}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			requests.Store(0)
+			similarity, bifrostErr := client.EmbeddingSimilarity(context.Background(), tt.key, tt.a, tt.b, "openai/text-embedding-3-small")
+			if bifrostErr != nil {
+				t.Fatalf("Expected similarity to be computed, got error: %v", GetErrorMessage(bifrostErr))
+			}
+			if math.Abs(similarity-tt.expected) > 1e-6 {
+				t.Errorf("Expected similarity %v, got %v", tt.expected, similarity)
+			}
+			if got := requests.Load(); got != 1 {
+				t.Errorf("Expected both texts to be embedded in 1 request, got %d", got)
+			}
+			if tt.key != nil && authorization.Load() != "Bearer "+tt.key.Value {
+				t.Errorf("Expected the request to be sent with the given key, got %v", authorization.Load())
+			}
+		})
+	}
+
+	if _, bifrostErr := client.EmbeddingSimilarity(context.Background(), nil, "cat", "dog", "text-embedding-3-small"); bifrostErr == nil {
+		t.Error("Expected a model without provider to be rejected")
+	}
+}
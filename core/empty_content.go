@@ -0,0 +1,92 @@
+package bifrost
+
+import (
+	"fmt"
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// getEmptyContentHandling returns the configured handling of empty message content.
+func (bifrost *Bifrost) getEmptyContentHandling() schemas.EmptyContentHandling {
+	if handling, ok := bifrost.emptyContent.Load().(schemas.EmptyContentHandling); ok {
+		return handling
+	}
+	return schemas.EmptyContentHandlingPassthrough
+}
+
+// normalizeEmptyContent drops or rejects chat messages with empty content, according to the configured handling.
+// Some providers reject messages with null or empty content with an opaque 400, this surfaces a clear error instead.
+// The request's message list is replaced rather than modified in place, so the caller's request is left untouched.
+func (bifrost *Bifrost) normalizeEmptyContent(req *schemas.BifrostRequest) *schemas.BifrostError {
+	handling := bifrost.getEmptyContentHandling()
+	if handling == schemas.EmptyContentHandlingPassthrough || req.ChatRequest == nil {
+		return nil
+	}
+
+	var emptyIndexes []string
+	messages := req.ChatRequest.Input
+	for i := range messages {
+		if isChatMessageContentEmpty(&messages[i]) {
+			emptyIndexes = append(emptyIndexes, fmt.Sprint(i))
+		}
+	}
+	if len(emptyIndexes) == 0 {
+		return nil
+	}
+
+	switch handling {
+	case schemas.EmptyContentHandlingReject:
+		return newEmptyContentError(fmt.Sprintf("messages with empty content are not allowed (message indexes: %s)", strings.Join(emptyIndexes, ", ")))
+	case schemas.EmptyContentHandlingDrop:
+		if len(emptyIndexes) == len(messages) {
+			return newEmptyContentError("all messages have empty content, at least one message with non-empty content is required")
+		}
+		filtered := make([]schemas.ChatMessage, 0, len(messages)-len(emptyIndexes))
+		for i := range messages {
+			if !isChatMessageContentEmpty(&messages[i]) {
+				filtered = append(filtered, messages[i])
+			}
+		}
+		chatRequest := *req.ChatRequest
+		chatRequest.Input = filtered
+		req.ChatRequest = &chatRequest
+		bifrost.logger.Debug(fmt.Sprintf("dropped %d messages with empty content", len(emptyIndexes)))
+	}
+	return nil
+}
+
+// isChatMessageContentEmpty reports whether a chat message has null or blank content.
+// Tool results and assistant tool calls are never considered empty, as dropping them would break the tool call flow.
+func isChatMessageContentEmpty(message *schemas.ChatMessage) bool {
+	if message.Role == schemas.ChatMessageRoleTool || message.ChatToolMessage != nil {
+		return false
+	}
+	if message.ChatAssistantMessage != nil && (len(message.ChatAssistantMessage.ToolCalls) > 0 || message.ChatAssistantMessage.Refusal != nil) {
+		return false
+	}
+	content := message.Content
+	if content == nil {
+		return true
+	}
+	if content.ContentStr != nil {
+		return strings.TrimSpace(*content.ContentStr) == ""
+	}
+	for _, block := range content.ContentBlocks {
+		if block.Type != schemas.ChatContentBlockTypeText {
+			return false
+		}
+		if block.Text != nil && strings.TrimSpace(*block.Text) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// newEmptyContentError creates the 400 returned when a request is rejected because of empty message content.
+func newEmptyContentError(message string) *schemas.BifrostError {
+	bifrostErr := newBifrostErrorFromMsg(message)
+	bifrostErr.StatusCode = schemas.Ptr(fasthttp.StatusBadRequest)
+	return bifrostErr
+}
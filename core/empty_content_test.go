@@ -0,0 +1,105 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+func initEmptyContentTestClient(t *testing.T, handling schemas.EmptyContentHandling, sentMessages *atomic.Int32) *Bifrost {
+	t.Helper()
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []json.RawMessage `json:"messages"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sentMessages.Store(int32(len(body.Messages)))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	return initTestClient(t, account, schemas.BifrostConfig{
+		EmptyContentHandling: handling,
+	})
+}
+
+func newEmptyContentTestRequest(contents ...*schemas.ChatMessageContent) *schemas.BifrostChatRequest {
+	req := newTestChatRequest(schemas.OpenAI)
+	req.Input = nil
+	for _, content := range contents {
+		req.Input = append(req.Input, schemas.ChatMessage{Role: schemas.ChatMessageRoleUser, Content: content})
+	}
+	return req
+}
+
+// Test that empty-content messages are dropped before dispatch in drop mode
+func TestEmptyContent_Drop(t *testing.T) {
+	var sentMessages atomic.Int32
+	client := initEmptyContentTestClient(t, schemas.EmptyContentHandlingDrop, &sentMessages)
+
+	req := newEmptyContentTestRequest(
+		&schemas.ChatMessageContent{ContentStr: schemas.Ptr("hello")},
+		nil,
+		&schemas.ChatMessageContent{ContentStr: schemas.Ptr("   ")},
+		&schemas.ChatMessageContent{ContentBlocks: []schemas.ChatContentBlock{{Type: schemas.ChatContentBlockTypeText, Text: schemas.Ptr("")}}},
+		&schemas.ChatMessageContent{ContentStr: schemas.Ptr("world")},
+	)
+	// Assistant tool calls are kept even without content
+	req.Input = append(req.Input, schemas.ChatMessage{
+		Role: schemas.ChatMessageRoleAssistant,
+		ChatAssistantMessage: &schemas.ChatAssistantMessage{
+			ToolCalls: []schemas.ChatAssistantMessageToolCall{{ID: schemas.Ptr("call_1"), Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("lookup")}}},
+		},
+	})
+
+	if _, bifrostErr := client.ChatCompletionRequest(context.Background(), req); bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if got := sentMessages.Load(); got != 3 {
+		t.Errorf("Expected 3 messages to be sent to the provider, got %d", got)
+	}
+	if len(req.Input) != 6 {
+		t.Errorf("Expected caller's request to be left untouched, got %d messages", len(req.Input))
+	}
+}
+
+// Test that empty conversations in drop mode, and any empty message in reject mode, are rejected with a 400
+// instead of being sent to the provider
+func TestEmptyContent_Rejected(t *testing.T) {
+	tests := []struct {
+		name            string
+		handling        schemas.EmptyContentHandling
+		contents        []*schemas.ChatMessageContent
+		expectedMessage string
+	}{
+		{"DropAllEmpty", schemas.EmptyContentHandlingDrop, []*schemas.ChatMessageContent{nil, {ContentStr: schemas.Ptr("")}}, "all messages have empty content"},
+		{"RejectAnyEmpty", schemas.EmptyContentHandlingReject, []*schemas.ChatMessageContent{{ContentStr: schemas.Ptr("hello")}, nil}, "message indexes: 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sentMessages atomic.Int32
+			client := initEmptyContentTestClient(t, tt.handling, &sentMessages)
+
+			_, bifrostErr := client.ChatCompletionRequest(context.Background(), newEmptyContentTestRequest(tt.contents...))
+			if bifrostErr == nil {
+				t.Fatal("Expected the request to be rejected")
+			}
+			if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected status 400, got %v", bifrostErr.StatusCode)
+			}
+			if !strings.Contains(GetErrorMessage(bifrostErr), tt.expectedMessage) {
+				t.Errorf("Unexpected error message: %s", GetErrorMessage(bifrostErr))
+			}
+			if sentMessages.Load() != 0 {
+				t.Error("Expected no request to reach the provider")
+			}
+		})
+	}
+}
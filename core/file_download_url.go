@@ -0,0 +1,90 @@
+package bifrost
+
+import (
+	"context"
+	"time"
+
+	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// FileDownloadURLRequest returns a short-lived URL to download a file directly from the provider's storage, so
+// that large files do not have to be streamed through the gateway. The URL is signed with the key a file content
+// request would select, and is valid for the requested TTL (schemas.DefaultFileDownloadURLTTL if unset). Only
+// providers implementing schemas.FileDownloader support it. Like the other file requests, it goes through the
+// plugins and the key selection of the provider's queue.
+func (bifrost *Bifrost) FileDownloadURLRequest(ctx context.Context, req *schemas.BifrostFileDownloadURLRequest) (*schemas.BifrostFileDownloadURLResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "file download url request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for file download url request",
+			},
+		}
+	}
+	if req.FileID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "file_id is required for file download url request",
+			},
+		}
+	}
+	if req.TTL < 0 {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "ttl must not be negative for file download url request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.FileDownloadURLRequest
+	bifrostReq.FileDownloadURLRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.FileDownloadURLResponse, nil
+}
+
+// fileDownloadURL returns the download URL of a file from a provider implementing schemas.FileDownloader, signed
+// with the selected key.
+func fileDownloadURL(ctx context.Context, provider schemas.Provider, key schemas.Key, req *schemas.BifrostFileDownloadURLRequest) (*schemas.BifrostFileDownloadURLResponse, *schemas.BifrostError) {
+	downloader, ok := provider.(schemas.FileDownloader)
+	if !ok {
+		return nil, providerUtils.NewUnsupportedOperationError(schemas.FileDownloadURLRequest, provider.GetProviderKey())
+	}
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = schemas.DefaultFileDownloadURLTTL
+	}
+	startTime := time.Now()
+	url, expiresAt, bifrostErr := downloader.FileDownloadURL(ctx, key, req.FileID, ttl)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	return &schemas.BifrostFileDownloadURLResponse{
+		FileID:    req.FileID,
+		URL:       url,
+		ExpiresAt: expiresAt,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.FileDownloadURLRequest,
+			Provider:    provider.GetProviderKey(),
+			Latency:     time.Since(startTime).Milliseconds(),
+		},
+	}, nil
+}
@@ -0,0 +1,112 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// hedgeAttempt is the outcome of one of the requests of a hedged request.
+type hedgeAttempt struct {
+	resp  *schemas.BifrostResponse
+	err   *schemas.BifrostError
+	hedge bool
+}
+
+// isHedgeableRequestType returns whether requests of a type are idempotent and interactive enough to be hedged.
+// Requests with side effects (files, batches) are never sent twice.
+func isHedgeableRequestType(requestType schemas.RequestType) bool {
+	switch requestType {
+	case schemas.TextCompletionRequest, schemas.ChatCompletionRequest, schemas.ResponsesRequest, schemas.EmbeddingRequest:
+		return true
+	}
+	return false
+}
+
+// hedgeDelay returns how long the primary provider is given before a request is hedged, 0 if it is not hedged.
+// Hedging must be configured, the request opted in with BifrostContextKeyHedge, of a hedgeable type, and have a
+// fallback to send the hedge to.
+func (bifrost *Bifrost) hedgeDelay(ctx context.Context, req *schemas.BifrostRequest) time.Duration {
+	config, _ := bifrost.hedging.Load().(*schemas.HedgingConfig)
+	if config == nil || config.Delay <= 0 {
+		return 0
+	}
+	if hedge, ok := ctx.Value(schemas.BifrostContextKeyHedge).(bool); !ok || !hedge {
+		return 0
+	}
+	if _, _, fallbacks := req.GetRequestFields(); len(fallbacks) == 0 || !isHedgeableRequestType(req.RequestType) {
+		return 0
+	}
+	return config.Delay
+}
+
+// tryHedgedRequest sends a request to the primary provider, and to the fallback too if the primary has not
+// answered within delay. The first successful response is used and the other request is cancelled, its
+// outcome is discarded. If both fail, the primary's error is returned. It reports whether the hedge was sent,
+// in which case the response records the winner in its extra fields.
+func (bifrost *Bifrost) tryHedgedRequest(ctx context.Context, req *schemas.BifrostRequest, fallback schemas.Fallback, delay time.Duration) (*schemas.BifrostResponse, *schemas.BifrostError, bool) {
+	// The loser may outlive the caller's request, which is released once this returns, so both send copies
+	attempts := make(chan hedgeAttempt, 2)
+	primaryCtx, cancelPrimary := context.WithCancel(ctx)
+	primaryReq := *req
+	go func() {
+		resp, err := bifrost.tryRequest(primaryCtx, &primaryReq)
+		attempts <- hedgeAttempt{resp: resp, err: err}
+	}()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case attempt := <-attempts:
+		cancelPrimary()
+		return attempt.resp, attempt.err, false
+	case <-timer.C:
+	}
+
+	hedgeReq := bifrost.prepareFallbackRequest(req, fallback)
+	if hedgeReq == nil {
+		attempt := <-attempts
+		cancelPrimary()
+		return attempt.resp, attempt.err, false
+	}
+	bifrost.logger.Debug(fmt.Sprintf("primary provider did not respond within %s, hedging to provider %s with model %s", delay, fallback.Provider, fallback.Model))
+	hedgeCtx := context.WithValue(ctx, schemas.BifrostContextKeyFallbackIndex, 1)
+	hedgeCtx = context.WithValue(hedgeCtx, schemas.BifrostContextKeyFallbackRequestID, uuid.New().String())
+	hedgeCtx, cancelHedge := context.WithCancel(hedgeCtx)
+	go func() {
+		resp, err := bifrost.tryRequest(hedgeCtx, hedgeReq)
+		attempts <- hedgeAttempt{resp: resp, err: err, hedge: true}
+	}()
+	// The loser is cancelled once a winner is known, its outcome is left in the buffered channel
+	defer cancelPrimary()
+	defer cancelHedge()
+
+	first := <-attempts
+	if first.err == nil {
+		return setHedgeWinner(first), nil, true
+	}
+	second := <-attempts
+	if second.err == nil {
+		return setHedgeWinner(second), nil, true
+	}
+	if first.hedge {
+		return nil, second.err, true
+	}
+	return nil, first.err, true
+}
+
+// setHedgeWinner records in the response of a hedged request which request returned it.
+func setHedgeWinner(attempt hedgeAttempt) *schemas.BifrostResponse {
+	if attempt.resp == nil {
+		return nil
+	}
+	winner := schemas.HedgeWinnerPrimary
+	if attempt.hedge {
+		winner = schemas.HedgeWinnerHedge
+	}
+	attempt.resp.GetExtraFields().HedgeWinner = winner
+	return attempt.resp
+}
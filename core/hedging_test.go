@@ -0,0 +1,73 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a request opted in to hedging is sent to its first fallback when the primary is slow, and that the
+// hedge's response is used
+func TestHedging_HedgeWinsWhenPrimaryIsSlow(t *testing.T) {
+	var primaryRequests, hedgeRequests atomic.Int32
+	newServer := func(requests *atomic.Int32, delay time.Duration) *httptest.Server {
+		server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(mockChatCompletionBody))
+		})
+		return server
+	}
+
+	const primaryProvider = schemas.ModelProvider("slow-primary")
+	const hedgeProvider = schemas.ModelProvider("fast-hedge")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(primaryProvider, newServer(&primaryRequests, time.Second).URL, nil)
+	account.addOpenAICompatibleProvider(hedgeProvider, newServer(&hedgeRequests, 0).URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		Hedging: &schemas.HedgingConfig{Delay: 50 * time.Millisecond},
+	})
+
+	newRequest := func() *schemas.BifrostChatRequest {
+		req := newTestChatRequest(primaryProvider)
+		req.Fallbacks = []schemas.Fallback{{Provider: hedgeProvider, Model: req.Model}}
+		return req
+	}
+
+	ctx := context.WithValue(context.Background(), schemas.BifrostContextKeyHedge, true)
+	start := time.Now()
+	resp, bifrostErr := client.ChatCompletionRequest(ctx, newRequest())
+	elapsed := time.Since(start)
+	if bifrostErr != nil {
+		t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+	}
+	if resp.ExtraFields.Provider != hedgeProvider || resp.ExtraFields.HedgeWinner != schemas.HedgeWinnerHedge {
+		t.Errorf("Expected the hedge to win, got provider %s and winner %q", resp.ExtraFields.Provider, resp.ExtraFields.HedgeWinner)
+	}
+	if elapsed >= 500*time.Millisecond {
+		t.Errorf("Expected the hedge's response before the primary's, took %v", elapsed)
+	}
+	if primaryRequests.Load() != 1 || hedgeRequests.Load() != 1 {
+		t.Errorf("Expected one request to each provider, got %d to the primary and %d to the hedge", primaryRequests.Load(), hedgeRequests.Load())
+	}
+
+	// Requests not opted in are not hedged
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if _, bifrostErr := client.ChatCompletionRequest(ctx, newRequest()); bifrostErr == nil {
+		t.Error("Expected the request not opted in to wait for the slow primary")
+	}
+	if hedgeRequests.Load() != 1 {
+		t.Errorf("Expected no hedge for a request not opted in, got %d hedge requests", hedgeRequests.Load())
+	}
+}
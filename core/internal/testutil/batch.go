@@ -42,7 +42,7 @@ func RunBatchCreateTest(t *testing.T, client *bifrost.Bifrost, ctx context.Conte
 		response, err := client.BatchCreateRequest(ctx, request)
 		if err != nil {
 			// Check if this is an unsupported operation error
-			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == "unsupported_operation") {
+			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error", testConfig.Provider)
 				return
 			}
@@ -82,7 +82,7 @@ func RunBatchListTest(t *testing.T, client *bifrost.Bifrost, ctx context.Context
 		response, err := client.BatchListRequest(ctx, request)
 		if err != nil {
 			// Check if this is an unsupported operation error
-			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == "unsupported_operation") {
+			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error", testConfig.Provider)
 				return
 			}
@@ -133,7 +133,7 @@ func RunBatchRetrieveTest(t *testing.T, client *bifrost.Bifrost, ctx context.Con
 		createResponse, createErr := client.BatchCreateRequest(ctx, createRequest)
 		if createErr != nil {
 			// Check if this is an unsupported operation error
-			if createErr.Error != nil && (createErr.Error.Code != nil && *createErr.Error.Code == "unsupported_operation") {
+			if createErr.Error != nil && (createErr.Error.Code != nil && *createErr.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error for create", testConfig.Provider)
 				return
 			}
@@ -205,7 +205,7 @@ func RunBatchCancelTest(t *testing.T, client *bifrost.Bifrost, ctx context.Conte
 		createResponse, createErr := client.BatchCreateRequest(ctx, createRequest)
 		if createErr != nil {
 			// Check if this is an unsupported operation error
-			if createErr.Error != nil && (createErr.Error.Code != nil && *createErr.Error.Code == "unsupported_operation") {
+			if createErr.Error != nil && (createErr.Error.Code != nil && *createErr.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error for create", testConfig.Provider)
 				return
 			}
@@ -317,7 +317,7 @@ func RunBatchUnsupportedTest(t *testing.T, client *bifrost.Bifrost, ctx context.
 		}
 
 		// Verify it's an unsupported operation error
-		if err.Error != nil && (err.Error.Code == nil || *err.Error.Code != "unsupported_operation") {
+		if err.Error != nil && (err.Error.Code == nil || *err.Error.Code != schemas.UnsupportedOperationErrorCode) {
 			t.Errorf("Expected unsupported_operation error, got: %v", err)
 			return
 		}
@@ -355,7 +355,7 @@ func RunFileUploadTest(t *testing.T, client *bifrost.Bifrost, ctx context.Contex
 		response, err := client.FileUploadRequest(ctx, request)
 		if err != nil {
 			// Check if this is an unsupported operation error
-			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == "unsupported_operation") {
+			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error", testConfig.Provider)
 				return
 			}
@@ -396,7 +396,7 @@ func RunFileListTest(t *testing.T, client *bifrost.Bifrost, ctx context.Context,
 		response, err := client.FileListRequest(ctx, request)
 		if err != nil {
 			// Check if this is an unsupported operation error
-			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == "unsupported_operation") {
+			if err.Error != nil && (err.Error.Code != nil && *err.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error", testConfig.Provider)
 				return
 			}
@@ -437,7 +437,7 @@ func RunFileRetrieveTest(t *testing.T, client *bifrost.Bifrost, ctx context.Cont
 
 		uploadResponse, uploadErr := client.FileUploadRequest(ctx, uploadRequest)
 		if uploadErr != nil {
-			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == "unsupported_operation") {
+			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error for upload", testConfig.Provider)
 				return
 			}
@@ -500,7 +500,7 @@ func RunFileDeleteTest(t *testing.T, client *bifrost.Bifrost, ctx context.Contex
 
 		uploadResponse, uploadErr := client.FileUploadRequest(ctx, uploadRequest)
 		if uploadErr != nil {
-			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == "unsupported_operation") {
+			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error for upload", testConfig.Provider)
 				return
 			}
@@ -563,7 +563,7 @@ func RunFileContentTest(t *testing.T, client *bifrost.Bifrost, ctx context.Conte
 
 		uploadResponse, uploadErr := client.FileUploadRequest(ctx, uploadRequest)
 		if uploadErr != nil {
-			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == "unsupported_operation") {
+			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error for upload", testConfig.Provider)
 				return
 			}
@@ -637,7 +637,7 @@ func RunFileUnsupportedTest(t *testing.T, client *bifrost.Bifrost, ctx context.C
 		}
 
 		// Verify it's an unsupported operation error
-		if err.Error != nil && (err.Error.Code == nil || *err.Error.Code != "unsupported_operation") {
+		if err.Error != nil && (err.Error.Code == nil || *err.Error.Code != schemas.UnsupportedOperationErrorCode) {
 			t.Errorf("Expected unsupported_operation error, got: %v", err)
 			return
 		}
@@ -673,7 +673,7 @@ func RunFileAndBatchIntegrationTest(t *testing.T, client *bifrost.Bifrost, ctx c
 
 		uploadResponse, uploadErr := client.FileUploadRequest(ctx, uploadRequest)
 		if uploadErr != nil {
-			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == "unsupported_operation") {
+			if uploadErr.Error != nil && (uploadErr.Error.Code != nil && *uploadErr.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error for upload", testConfig.Provider)
 				return
 			}
@@ -700,7 +700,7 @@ func RunFileAndBatchIntegrationTest(t *testing.T, client *bifrost.Bifrost, ctx c
 
 		batchResponse, batchErr := client.BatchCreateRequest(ctx, batchRequest)
 		if batchErr != nil {
-			if batchErr.Error != nil && (batchErr.Error.Code != nil && *batchErr.Error.Code == "unsupported_operation") {
+			if batchErr.Error != nil && (batchErr.Error.Code != nil && *batchErr.Error.Code == schemas.UnsupportedOperationErrorCode) {
 				t.Logf("[EXPECTED] Provider %s returned unsupported operation error for batch create", testConfig.Provider)
 				return
 			}
@@ -0,0 +1,79 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// sleepingPlugin delays its PreHook and PostHook
+type sleepingPlugin struct {
+	preHookDelay  time.Duration
+	postHookDelay time.Duration
+}
+
+func (p *sleepingPlugin) GetName() string { return "sleeping" }
+
+func (p *sleepingPlugin) TransportInterceptor(ctx *schemas.BifrostContext, url string, headers map[string]string, body map[string]any) (map[string]string, map[string]any, error) {
+	return headers, body, nil
+}
+
+func (p *sleepingPlugin) PreHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error) {
+	time.Sleep(p.preHookDelay)
+	return req, nil, nil
+}
+
+func (p *sleepingPlugin) PostHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	time.Sleep(p.postHookDelay)
+	return result, err, nil
+}
+
+func (p *sleepingPlugin) Cleanup() error { return nil }
+
+// Test that the latency breakdown attributes the time of each stage and sums to roughly the total latency
+func TestLatencyBreakdown_SumsToTotal(t *testing.T) {
+	const upstreamDelay = 60 * time.Millisecond
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(upstreamDelay)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	plugin := &sleepingPlugin{preHookDelay: 30 * time.Millisecond, postHookDelay: 20 * time.Millisecond}
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		Plugins: []schemas.Plugin{plugin},
+	})
+
+	for range 2 {
+		requestStart := time.Now()
+		resp, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+		elapsed := time.Since(requestStart).Milliseconds()
+		if bifrostErr != nil {
+			t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+		}
+		breakdown := resp.ExtraFields.LatencyBreakdown
+		if breakdown == nil {
+			t.Fatal("Expected a latency breakdown in the extra fields")
+		}
+
+		if breakdown.PreHooks < plugin.preHookDelay.Milliseconds() || breakdown.PostHooks < plugin.postHookDelay.Milliseconds() {
+			t.Errorf("Expected at least %v of pre-hooks and %v of post-hooks, got %+v", plugin.preHookDelay, plugin.postHookDelay, breakdown)
+		}
+		if breakdown.Upstream < upstreamDelay.Milliseconds() {
+			t.Errorf("Expected at least %v upstream, got %+v", upstreamDelay, breakdown)
+		}
+		if breakdown.Total > elapsed {
+			t.Errorf("Expected a total of at most the %dms the request took, got %+v", elapsed, breakdown)
+		}
+		// Every stage is truncated to the millisecond, the handoffs between stages are not attributed
+		sum := breakdown.PreHooks + breakdown.Queue + breakdown.KeySelection + breakdown.Connect + breakdown.Upstream + breakdown.Decode + breakdown.PostHooks
+		if sum > breakdown.Total || breakdown.Total-sum > 15 {
+			t.Errorf("Expected the stages to sum to roughly the total, got a sum of %dms for %+v", sum, breakdown)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// finishReasonLength is the finish reason of responses truncated by the output token limit.
+const finishReasonLength = "length"
+
+// maxContinuations returns the follow-up requests allowed to continue a truncated chat response, from the
+// request context if set, else from the LengthContinuation config. 0 disables continuation.
+func (bifrost *Bifrost) maxContinuations(ctx context.Context) int {
+	if maxContinuations, ok := ctx.Value(schemas.BifrostContextKeyMaxContinuations).(int); ok {
+		return max(maxContinuations, 0)
+	}
+	config, _ := bifrost.lengthContinuation.Load().(*schemas.LengthContinuationConfig)
+	if config == nil {
+		return 0
+	}
+	return max(config.MaxContinuations, 0)
+}
+
+// continuableChoice returns the choice of a chat response made of a single text choice, the only responses that
+// can be stitched together. Responses with several choices, tool calls or refusals are not continued.
+func continuableChoice(resp *schemas.BifrostChatResponse) (*schemas.BifrostResponseChoice, string, bool) {
+	if resp == nil || len(resp.Choices) != 1 {
+		return nil, "", false
+	}
+	choice := &resp.Choices[0]
+	if choice.ChatNonStreamResponseChoice == nil || choice.Message == nil || choice.Message.Content == nil || choice.Message.Content.ContentStr == nil {
+		return nil, "", false
+	}
+	if assistant := choice.Message.ChatAssistantMessage; assistant != nil && (len(assistant.ToolCalls) > 0 || assistant.Refusal != nil) {
+		return nil, "", false
+	}
+	return choice, *choice.Message.Content.ContentStr, true
+}
+
+// continueTruncatedChat continues a chat response truncated by the output token limit, up to the allowed number
+// of follow-up requests. Each follow-up sends the text generated so far as a trailing assistant message, and its
+// text is appended to the response, whose finish reason, usage and latency are updated accordingly.
+// Follow-ups are sent without fallbacks, and a derived max_completion_tokens is derived again for each follow-up,
+// whose input grows with the generated text. A failed follow-up ends the continuation and the response generated
+// so far is returned. Each follow-up has its own request ID, with the caller's request ID as parent, as fallbacks do.
+func (bifrost *Bifrost) continueTruncatedChat(ctx context.Context, req *schemas.BifrostChatRequest, resp *schemas.BifrostChatResponse) *schemas.BifrostChatResponse {
+	maxContinuations := bifrost.maxContinuations(ctx)
+	if maxContinuations == 0 {
+		return resp
+	}
+	_, derivedMaxTokens := ctx.Value(schemas.BifrostContextKeyDerivedMaxTokens).(int)
+	choice, text, ok := continuableChoice(resp)
+	for ok && resp.ExtraFields.Continuations < maxContinuations && choice.FinishReason != nil && *choice.FinishReason == finishReasonLength {
+		followUp := *req
+		followUp.Input = append(slices.Clip(req.Input), schemas.ChatMessage{
+			Role:    schemas.ChatMessageRoleAssistant,
+			Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(text)},
+		})
+		followUpReq, followUpCtx := &followUp, ctx
+		if derivedMaxTokens && req.Params != nil {
+			params := *req.Params
+			params.MaxCompletionTokens = nil
+			followUp.Params = &params
+			var maxTokens *int
+			followUpReq, maxTokens = bifrost.deriveMaxTokens(&followUp)
+			if maxTokens == nil {
+				bifrost.logger.Warn(fmt.Sprintf("stopping continuation of truncated chat response after %d continuations: no output tokens left in the context window", resp.ExtraFields.Continuations))
+				return resp
+			}
+			followUpCtx = context.WithValue(ctx, schemas.BifrostContextKeyDerivedMaxTokens, *maxTokens)
+		}
+
+		followUpCtx = context.WithValue(followUpCtx, schemas.BifrostContextKeyFallbackRequestID, uuid.New().String())
+
+		bifrostReq := bifrost.getBifrostRequest()
+		bifrostReq.RequestType = schemas.ChatCompletionRequest
+		bifrostReq.ChatRequest = followUpReq
+		bifrostReq.SetFallbacks(nil)
+		next, err := bifrost.handleRequest(followUpCtx, bifrostReq)
+		if err != nil {
+			bifrost.logger.Warn(fmt.Sprintf("failed to continue truncated chat response after %d continuations: %s", resp.ExtraFields.Continuations, GetErrorMessage(err)))
+			return resp
+		}
+		nextChoice, nextText, nextOk := continuableChoice(next.ChatResponse)
+		if !nextOk {
+			bifrost.logger.Warn(fmt.Sprintf("stopping continuation of truncated chat response after %d continuations: the follow-up response is not a single text choice", resp.ExtraFields.Continuations))
+			return resp
+		}
+
+		text += nextText
+		choice.Message.Content = &schemas.ChatMessageContent{ContentStr: schemas.Ptr(text)}
+		choice.FinishReason = nextChoice.FinishReason
+		addChatUsage(resp, next.ChatResponse.Usage)
+		resp.ExtraFields.Latency += next.ChatResponse.ExtraFields.Latency
+		resp.ExtraFields.Continuations++
+	}
+	return resp
+}
+
+// addChatUsage adds the token usage of a follow-up response to the usage of a chat response.
+func addChatUsage(resp *schemas.BifrostChatResponse, usage *schemas.BifrostLLMUsage) {
+	if usage == nil {
+		return
+	}
+	if resp.Usage == nil {
+		resp.Usage = &schemas.BifrostLLMUsage{}
+	}
+	resp.Usage.PromptTokens += usage.PromptTokens
+	resp.Usage.CompletionTokens += usage.CompletionTokens
+	resp.Usage.TotalTokens += usage.TotalTokens
+}
@@ -0,0 +1,185 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// requestIDRecordingPlugin records the request ID each PreHook runs with, the fallback request ID when set
+type requestIDRecordingPlugin struct {
+	mu         sync.Mutex
+	requestIDs []string
+}
+
+func (p *requestIDRecordingPlugin) GetName() string { return "request-id-recorder" }
+
+func (p *requestIDRecordingPlugin) TransportInterceptor(ctx *schemas.BifrostContext, url string, headers map[string]string, body map[string]any) (map[string]string, map[string]any, error) {
+	return headers, body, nil
+}
+
+func (p *requestIDRecordingPlugin) PreHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error) {
+	requestID, _ := ctx.Value(schemas.BifrostContextKeyRequestID).(string)
+	if fallbackRequestID, ok := ctx.Value(schemas.BifrostContextKeyFallbackRequestID).(string); ok && fallbackRequestID != "" {
+		requestID = fallbackRequestID
+	}
+	p.mu.Lock()
+	p.requestIDs = append(p.requestIDs, requestID)
+	p.mu.Unlock()
+	return req, nil, nil
+}
+
+func (p *requestIDRecordingPlugin) PostHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	return result, err, nil
+}
+
+func (p *requestIDRecordingPlugin) Cleanup() error { return nil }
+
+// Test that a response truncated by the output token limit is continued once and stitched with the follow-up
+func TestLengthContinuation_ContinuesTruncatedResponse(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests [][]schemas.ChatMessage
+	)
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []schemas.ChatMessage `json:"messages"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		requests = append(requests, body.Messages)
+		first := len(requests) == 1
+		mu.Unlock()
+
+		content, finishReason := "Hello, wor", "length"
+		if !first {
+			content, finishReason = "ld!", "stop"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":%q},"finish_reason":%q}],"usage":{"prompt_tokens":5,"completion_tokens":3,"total_tokens":8}}`, content, finishReason)
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	recorder := &requestIDRecordingPlugin{}
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		Plugins:            []schemas.Plugin{recorder},
+		LengthContinuation: &schemas.LengthContinuationConfig{MaxContinuations: 2},
+	})
+
+	ctx := context.WithValue(context.Background(), schemas.BifrostContextKeyRequestID, "req-1")
+	resp, bifrostErr := client.ChatCompletionRequest(ctx, newTestChatRequest(schemas.OpenAI))
+	if bifrostErr != nil {
+		t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+	}
+	// The follow-up is logged under its own request ID instead of overwriting the caller's
+	if len(recorder.requestIDs) != 2 || recorder.requestIDs[0] != "req-1" || recorder.requestIDs[1] == "" || recorder.requestIDs[1] == "req-1" {
+		t.Errorf("Expected the follow-up to run with its own request ID, got %v", recorder.requestIDs)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("Expected the truncated response to be continued with one follow-up request, got %d requests", len(requests))
+	}
+	followUp := requests[1]
+	last := followUp[len(followUp)-1]
+	if len(followUp) != len(requests[0])+1 || last.Role != schemas.ChatMessageRoleAssistant || last.Content == nil || last.Content.ContentStr == nil || *last.Content.ContentStr != "Hello, wor" {
+		t.Errorf("Expected the follow-up to end with the truncated text as assistant message, got %+v", followUp)
+	}
+
+	choice := resp.Choices[0]
+	if content := *choice.Message.Content.ContentStr; content != "Hello, world!" {
+		t.Errorf("Expected the stitched content %q, got %q", "Hello, world!", content)
+	}
+	if choice.FinishReason == nil || *choice.FinishReason != "stop" {
+		t.Errorf("Expected the finish reason of the follow-up, got %v", choice.FinishReason)
+	}
+	if resp.ExtraFields.Continuations != 1 {
+		t.Errorf("Expected 1 continuation in the extra fields, got %d", resp.ExtraFields.Continuations)
+	}
+	if resp.Usage == nil || resp.Usage.CompletionTokens != 6 || resp.Usage.TotalTokens != 16 {
+		t.Errorf("Expected the usage of both requests, got %+v", resp.Usage)
+	}
+
+	// Continuation is disabled per request through the context
+	mu.Lock()
+	requests = nil
+	mu.Unlock()
+	ctx = context.WithValue(context.Background(), schemas.BifrostContextKeyMaxContinuations, 0)
+	resp, bifrostErr = client.ChatCompletionRequest(ctx, newTestChatRequest(schemas.OpenAI))
+	if bifrostErr != nil {
+		t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+	}
+	if len(requests) != 1 || resp.ExtraFields.Continuations != 0 || *resp.Choices[0].FinishReason != "length" {
+		t.Errorf("Expected the truncated response as is, got %d requests and %d continuations", len(requests), resp.ExtraFields.Continuations)
+	}
+}
+
+// Test that follow-ups are sent without fallbacks and with a max tokens derived again from their longer input
+func TestLengthContinuation_FollowUpsNotFallenBackAndMaxTokensDerived(t *testing.T) {
+	var (
+		mu            sync.Mutex
+		sentMaxTokens []int // max_completion_tokens of each request to the primary provider
+		fallbackCalls int
+	)
+	primary := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			MaxCompletionTokens int `json:"max_completion_tokens"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		sentMaxTokens = append(sentMaxTokens, body.MaxCompletionTokens)
+		first := len(sentMaxTokens) == 1
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":{"message":"unavailable","type":"server_error"}}`))
+			return
+		}
+		fmt.Fprintf(w, `{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":%q},"finish_reason":"length"}],"usage":{"prompt_tokens":104,"completion_tokens":100,"total_tokens":204}}`, strings.Repeat("b", 400))
+	})
+	fallback := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fallbackCalls++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	const fallbackProvider = schemas.ModelProvider("fallback-provider")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, primary.URL, nil)
+	account.addOpenAICompatibleProvider(fallbackProvider, fallback.URL, nil)
+	req := newTestChatRequest(schemas.OpenAI)
+	req.Input = []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(strings.Repeat("a", 400))}}}
+	req.Fallbacks = []schemas.Fallback{{Provider: fallbackProvider, Model: req.Model}}
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		LengthContinuation:  &schemas.LengthContinuationConfig{MaxContinuations: 2},
+		MaxTokensDerivation: &schemas.MaxTokensDerivationConfig{ModelLimits: map[string]schemas.ModelTokenLimits{req.Model: {ContextWindow: 1000}}},
+	})
+
+	resp, bifrostErr := client.ChatCompletionRequest(context.Background(), req)
+	if bifrostErr != nil {
+		t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if fallbackCalls != 0 {
+		t.Errorf("Expected the failed follow-up not to fall back, got %d fallback requests", fallbackCalls)
+	}
+	// 400 input characters leave 896 tokens, the follow-up also carries the 400 characters generated so far
+	if len(sentMaxTokens) < 2 || sentMaxTokens[0] != 896 || sentMaxTokens[1] != 792 {
+		t.Errorf("Expected max tokens 896 then 792 to be sent, got %v", sentMaxTokens)
+	}
+	if resp.ExtraFields.Continuations != 0 || *resp.Choices[0].FinishReason != "length" || *resp.Choices[0].Message.Content.ContentStr != strings.Repeat("b", 400) {
+		t.Errorf("Expected the truncated response as is after the failed follow-up, got %d continuations", resp.ExtraFields.Continuations)
+	}
+}
@@ -0,0 +1,98 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// mockBatchRetrieveBody is the body of a successful OpenAI batch retrieve response.
+const mockBatchRetrieveBody = `{"id":"batch_abc","object":"batch","endpoint":"/v1/chat/completions","status":"completed","created_at":1,"request_counts":{"total":1,"completed":1,"failed":0}}`
+
+// initMalformedFirstClient returns a client whose OpenAI server answers its first request with a truncated body,
+// and the following ones with a valid chat completion or batch.
+func initMalformedFirstClient(t *testing.T, retryOnMalformed bool, requests *atomic.Int32) *Bifrost {
+	t.Helper()
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body := mockChatCompletionBody
+		if r.Method == http.MethodGet {
+			body = mockBatchRetrieveBody
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) == 1 {
+			body = body[:len(body)/2]
+		}
+		w.Write([]byte(body))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.keys[schemas.OpenAI][0].UseForBatchAPI = schemas.Ptr(true)
+	account.configs[schemas.OpenAI].NetworkConfig.RetryOnMalformedResponse = retryOnMalformed
+	return initTestClient(t, account, schemas.BifrostConfig{})
+}
+
+// retrieveTestBatch retrieves the batch served by the malformed-first server.
+func retrieveTestBatch(client *Bifrost) *schemas.BifrostError {
+	_, bifrostErr := client.BatchRetrieveRequest(context.Background(), &schemas.BifrostBatchRetrieveRequest{Provider: schemas.OpenAI, BatchID: "batch_abc"})
+	return bifrostErr
+}
+
+// Test that a read-only request whose response cannot be decoded is sent again once when enabled, and that the
+// malformed response is surfaced as is when the retry is not enabled or the request is billed
+func TestRetryOnMalformedResponse(t *testing.T) {
+	sendChat := func(client *Bifrost) *schemas.BifrostError {
+		_, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+		return bifrostErr
+	}
+	tests := []struct {
+		name             string
+		retryOnMalformed bool
+		send             func(client *Bifrost) *schemas.BifrostError
+		expectedRequests int32
+	}{
+		{"Retried", true, retrieveTestBatch, 2},
+		{"Disabled", false, retrieveTestBatch, 1},
+		{"BilledRequest", true, sendChat, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			client := initMalformedFirstClient(t, tt.retryOnMalformed, &requests)
+
+			bifrostErr := tt.send(client)
+			if tt.expectedRequests > 1 {
+				if bifrostErr != nil {
+					t.Fatalf("Expected the request to succeed once sent again, got error: %v", GetErrorMessage(bifrostErr))
+				}
+			} else {
+				if bifrostErr == nil {
+					t.Fatal("Expected the malformed response to fail the request")
+				}
+				if bifrostErr.Error == nil || bifrostErr.Error.Message != schemas.ErrProviderResponseUnmarshal {
+					t.Errorf("Expected an unmarshal error, got %v", GetErrorMessage(bifrostErr))
+				}
+			}
+			if got := requests.Load(); got != tt.expectedRequests {
+				t.Errorf("Expected %d requests to be sent, got %d", tt.expectedRequests, got)
+			}
+		})
+	}
+}
+
+// Test that only read-only requests are sent again
+func TestIsIdempotentRequestType(t *testing.T) {
+	for _, requestType := range []schemas.RequestType{schemas.ListModelsRequest, schemas.FileContentRequest, schemas.BatchRetrieveRequest, schemas.BatchResultsRequest} {
+		if !isIdempotentRequestType(requestType) {
+			t.Errorf("Expected %s requests to be idempotent", requestType)
+		}
+	}
+	for _, requestType := range []schemas.RequestType{schemas.ChatCompletionRequest, schemas.EmbeddingRequest, schemas.SpeechRequest, schemas.ChatCompletionStreamRequest, schemas.BatchCreateRequest, schemas.FileUploadRequest, schemas.FileDeleteRequest, schemas.BatchCancelRequest} {
+		if isIdempotentRequestType(requestType) {
+			t.Errorf("Expected %s requests not to be idempotent", requestType)
+		}
+	}
+}
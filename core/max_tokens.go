@@ -0,0 +1,88 @@
+package bifrost
+
+import (
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+const (
+	// estimatedCharsPerToken is the average number of characters per token used to estimate input tokens.
+	// It is a deliberately simple, provider-agnostic approximation, the derived limit leaves the estimation error
+	// to the remaining window rather than to the input.
+	estimatedCharsPerToken = 4
+	// estimatedTokensPerMessage accounts for the role and formatting tokens every message adds.
+	estimatedTokensPerMessage = 4
+)
+
+// deriveMaxTokens sets max_completion_tokens on a chat request that does not set it, to the remaining
+// context window of the model after the estimated input tokens, capped by the model's max output tokens.
+// Returns the request to send (a copy if the limit was derived) and the derived limit, or nil if nothing was derived.
+func (bifrost *Bifrost) deriveMaxTokens(req *schemas.BifrostChatRequest) (*schemas.BifrostChatRequest, *int) {
+	config, _ := bifrost.maxTokensDerivation.Load().(*schemas.MaxTokensDerivationConfig)
+	if config == nil || (req.Params != nil && req.Params.MaxCompletionTokens != nil) {
+		return req, nil
+	}
+	limits, ok := config.ModelLimits[req.Model]
+	if !ok {
+		if config.DefaultLimits == nil {
+			return req, nil
+		}
+		limits = *config.DefaultLimits
+	}
+
+	maxTokens := deriveMaxOutputTokens(limits, estimateChatInputTokens(req))
+	if maxTokens <= 0 {
+		// The input alone fills the window, leave it to the provider to reject the request
+		return req, nil
+	}
+
+	derived := *req
+	params := schemas.ChatParameters{}
+	if req.Params != nil {
+		params = *req.Params
+	}
+	params.MaxCompletionTokens = schemas.Ptr(maxTokens)
+	derived.Params = &params
+	return &derived, params.MaxCompletionTokens
+}
+
+// deriveMaxOutputTokens returns the output tokens left in the context window for the given input tokens,
+// capped by the model's max output tokens.
+func deriveMaxOutputTokens(limits schemas.ModelTokenLimits, inputTokens int) int {
+	maxTokens := limits.ContextWindow - inputTokens
+	if limits.MaxOutputTokens > 0 {
+		maxTokens = min(maxTokens, limits.MaxOutputTokens)
+	}
+	return maxTokens
+}
+
+// estimateChatInputTokens estimates the input tokens of a chat request from the length of its messages and tools.
+func estimateChatInputTokens(req *schemas.BifrostChatRequest) int {
+	chars := 0
+	for _, message := range req.Input {
+		if message.Content != nil {
+			if message.Content.ContentStr != nil {
+				chars += len(*message.Content.ContentStr)
+			}
+			for _, block := range message.Content.ContentBlocks {
+				if block.Text != nil {
+					chars += len(*block.Text)
+				}
+			}
+		}
+		if message.ChatAssistantMessage != nil {
+			for _, toolCall := range message.ChatAssistantMessage.ToolCalls {
+				if toolCall.Function.Name != nil {
+					chars += len(*toolCall.Function.Name)
+				}
+				chars += len(toolCall.Function.Arguments)
+			}
+		}
+	}
+	if req.Params != nil && len(req.Params.Tools) > 0 {
+		if tools, err := serialization.Marshal(req.Params.Tools); err == nil {
+			chars += len(tools)
+		}
+	}
+	return (chars+estimatedCharsPerToken-1)/estimatedCharsPerToken + len(req.Input)*estimatedTokensPerMessage
+}
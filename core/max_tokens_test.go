@@ -0,0 +1,110 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+func initMaxTokensTestClient(t *testing.T, config *schemas.MaxTokensDerivationConfig, sentMaxTokens *atomic.Int64) *Bifrost {
+	t.Helper()
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			MaxCompletionTokens *int64 `json:"max_completion_tokens"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.MaxCompletionTokens != nil {
+			sentMaxTokens.Store(*body.MaxCompletionTokens)
+		} else {
+			sentMaxTokens.Store(-1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	return initTestClient(t, account, schemas.BifrostConfig{
+		MaxTokensDerivation: config,
+	})
+}
+
+// Test that the derived max tokens is the model window left after the estimated input tokens
+func TestDeriveMaxTokens_RemainingWindow(t *testing.T) {
+	req := newTestChatRequest(schemas.OpenAI)
+	// 400 characters is 100 tokens, plus 4 tokens for the message
+	req.Input = []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(strings.Repeat("a", 400))}}}
+	if got := estimateChatInputTokens(req); got != 104 {
+		t.Fatalf("Expected 104 estimated input tokens, got %d", got)
+	}
+
+	tests := []struct {
+		name     string
+		limits   schemas.ModelTokenLimits
+		expected int
+	}{
+		{"window only", schemas.ModelTokenLimits{ContextWindow: 1000}, 896},
+		{"capped by max output tokens", schemas.ModelTokenLimits{ContextWindow: 1000, MaxOutputTokens: 500}, 500},
+		{"max output tokens above remaining window", schemas.ModelTokenLimits{ContextWindow: 1000, MaxOutputTokens: 950}, 896},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deriveMaxOutputTokens(tt.limits, estimateChatInputTokens(req)); got != tt.expected {
+				t.Errorf("Expected %d derived max tokens, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+// Test that the derived max tokens is sent to the provider and surfaced in the response extra fields
+func TestDeriveMaxTokens_Request(t *testing.T) {
+	var sentMaxTokens atomic.Int64
+	req := newTestChatRequest(schemas.OpenAI)
+	req.Input = []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(strings.Repeat("a", 400))}}}
+
+	client := initMaxTokensTestClient(t, &schemas.MaxTokensDerivationConfig{
+		ModelLimits: map[string]schemas.ModelTokenLimits{req.Model: {ContextWindow: 1000}},
+	}, &sentMaxTokens)
+
+	response, bifrostErr := client.ChatCompletionRequest(context.Background(), req)
+	if bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if got := sentMaxTokens.Load(); got != 896 {
+		t.Errorf("Expected max_completion_tokens 896 to be sent to the provider, got %d", got)
+	}
+	if response.ExtraFields.DerivedMaxTokens == nil || *response.ExtraFields.DerivedMaxTokens != 896 {
+		t.Errorf("Expected derived max tokens 896 in extra fields, got %v", response.ExtraFields.DerivedMaxTokens)
+	}
+	if req.Params != nil && req.Params.MaxCompletionTokens != nil {
+		t.Error("Expected caller's request to be left untouched")
+	}
+
+	// An explicit max tokens is never overridden
+	req.Params = &schemas.ChatParameters{MaxCompletionTokens: schemas.Ptr(42)}
+	response, bifrostErr = client.ChatCompletionRequest(context.Background(), req)
+	if bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if got := sentMaxTokens.Load(); got != 42 {
+		t.Errorf("Expected explicit max_completion_tokens 42 to be sent, got %d", got)
+	}
+	if response.ExtraFields.DerivedMaxTokens != nil {
+		t.Errorf("Expected no derived max tokens, got %d", *response.ExtraFields.DerivedMaxTokens)
+	}
+
+	// Models without limits are left untouched when no default is configured
+	req.Params = nil
+	req.Model = "unknown-model"
+	if _, bifrostErr = client.ChatCompletionRequest(context.Background(), req); bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if got := sentMaxTokens.Load(); got != -1 {
+		t.Errorf("Expected no max_completion_tokens to be sent, got %d", got)
+	}
+}
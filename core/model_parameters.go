@@ -0,0 +1,141 @@
+package bifrost
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// getParameterRules returns the model parameter rules of a provider, or nil if none are configured.
+func (bifrost *Bifrost) getParameterRules(providerKey schemas.ModelProvider) []schemas.ModelParameterRule {
+	if value, ok := bifrost.parameterRules.Load(providerKey); ok {
+		return value.([]schemas.ModelParameterRule)
+	}
+	return nil
+}
+
+// storeParameterRules replaces (or removes) the model parameter rules of a provider according to its config.
+func (bifrost *Bifrost) storeParameterRules(providerKey schemas.ModelProvider, rules []schemas.ModelParameterRule) {
+	if len(rules) > 0 {
+		bifrost.parameterRules.Store(providerKey, slices.Clone(rules))
+		return
+	}
+	bifrost.parameterRules.Delete(providerKey)
+}
+
+// findParameterRule returns the first rule matching the model, or nil if the model has no rule.
+func findParameterRule(rules []schemas.ModelParameterRule, model string) *schemas.ModelParameterRule {
+	for i := range rules {
+		for _, pattern := range rules[i].Models {
+			if pattern == model {
+				return &rules[i]
+			}
+			if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(model, prefix) {
+				return &rules[i]
+			}
+		}
+	}
+	return nil
+}
+
+// isParameterIncompatible reports whether the rule forbids the parameter with the given JSON name.
+func isParameterIncompatible(rule *schemas.ModelParameterRule, name string) bool {
+	if len(rule.AllowedParams) > 0 && !slices.Contains(rule.AllowedParams, name) {
+		return true
+	}
+	return slices.Contains(rule.DeniedParams, name)
+}
+
+// filterModelParameters strips (or rejects) the parameters the requested model does not support, according to
+// the provider's parameter rules, so that the provider does not fail the request with an opaque 400.
+// Returns the request to send, a copy if any parameter was stripped, so the caller's request and the
+// request used for fallbacks are left untouched.
+func (bifrost *Bifrost) filterModelParameters(req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.BifrostError) {
+	provider, model, _ := req.GetRequestFields()
+	rule := findParameterRule(bifrost.getParameterRules(provider), model)
+	if rule == nil {
+		return req, nil
+	}
+
+	filtered := *req
+	var dropped []string
+	switch {
+	case req.ChatRequest != nil:
+		chatRequest := *req.ChatRequest
+		chatRequest.Params, dropped = stripParameters(chatRequest.Params, rule)
+		filtered.ChatRequest = &chatRequest
+	case req.TextCompletionRequest != nil:
+		textRequest := *req.TextCompletionRequest
+		textRequest.Params, dropped = stripParameters(textRequest.Params, rule)
+		filtered.TextCompletionRequest = &textRequest
+	case req.ResponsesRequest != nil:
+		responsesRequest := *req.ResponsesRequest
+		responsesRequest.Params, dropped = stripParameters(responsesRequest.Params, rule)
+		filtered.ResponsesRequest = &responsesRequest
+	}
+	if len(dropped) == 0 {
+		return req, nil
+	}
+
+	if rule.Action == schemas.ModelParameterActionReject {
+		bifrostErr := newBifrostErrorFromMsg(fmt.Sprintf("parameters not supported by model %s: %s", model, strings.Join(dropped, ", ")))
+		bifrostErr.StatusCode = schemas.Ptr(fasthttp.StatusBadRequest)
+		bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		return nil, bifrostErr
+	}
+	bifrost.logger.Warn(fmt.Sprintf("dropped parameters not supported by model %s (provider %s): %s", model, provider, strings.Join(dropped, ", ")))
+	return &filtered, nil
+}
+
+// stripParameters returns a copy of the params without the parameters the rule forbids, and the names of
+// the removed parameters. The params are returned as is if nothing was removed.
+// Parameters are matched by their JSON field name, extra params by their key.
+func stripParameters[T any](params *T, rule *schemas.ModelParameterRule) (*T, []string) {
+	if params == nil {
+		return nil, nil
+	}
+	stripped := *params
+	value := reflect.ValueOf(&stripped).Elem()
+	var dropped []string
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || value.Field(i).IsZero() || !isParameterIncompatible(rule, name) {
+			continue
+		}
+		value.Field(i).SetZero()
+		dropped = append(dropped, name)
+	}
+
+	// Extra params are sent to the provider as is, so they are filtered as well
+	extraParams := value.FieldByName("ExtraParams")
+	if extraParams.IsValid() && extraParams.Len() > 0 {
+		var filteredExtraParams map[string]interface{}
+		for _, key := range extraParams.MapKeys() {
+			name := key.String()
+			if isParameterIncompatible(rule, name) {
+				dropped = append(dropped, name)
+				continue
+			}
+			if filteredExtraParams == nil {
+				filteredExtraParams = make(map[string]interface{}, extraParams.Len())
+			}
+			filteredExtraParams[name] = extraParams.MapIndex(key).Interface()
+		}
+		extraParams.Set(reflect.ValueOf(filteredExtraParams))
+	}
+
+	if len(dropped) == 0 {
+		return params, nil
+	}
+	slices.Sort(dropped)
+	return &stripped, dropped
+}
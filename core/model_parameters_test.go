@@ -0,0 +1,101 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+func initParameterRulesTestClient(t *testing.T, rules []schemas.ModelParameterRule, sentBody *map[string]any, mu *sync.Mutex) *Bifrost {
+	t.Helper()
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		json.NewDecoder(r.Body).Decode(sentBody)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.configs[schemas.OpenAI].ParameterRules = rules
+	return initTestClient(t, account, schemas.BifrostConfig{})
+}
+
+// Test that temperature is stripped before dispatch for a reasoning model, and kept for other models
+func TestParameterRules_StripTemperatureOnReasoningModel(t *testing.T) {
+	var mu sync.Mutex
+	var sentBody map[string]any
+	client := initParameterRulesTestClient(t, []schemas.ModelParameterRule{
+		{Models: []string{"o1*"}, DeniedParams: []string{"temperature", "top_p"}},
+	}, &sentBody, &mu)
+
+	req := newTestChatRequest(schemas.OpenAI)
+	req.Model = "o1-mini"
+	req.Params = &schemas.ChatParameters{
+		Temperature: schemas.Ptr(0.7),
+		Seed:        schemas.Ptr(42),
+		ExtraParams: map[string]interface{}{"top_p": 0.9},
+	}
+	if _, bifrostErr := client.ChatCompletionRequest(context.Background(), req); bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	mu.Lock()
+	if _, ok := sentBody["temperature"]; ok {
+		t.Error("Expected temperature to be stripped for reasoning model")
+	}
+	if _, ok := sentBody["top_p"]; ok {
+		t.Error("Expected top_p extra param to be stripped for reasoning model")
+	}
+	if _, ok := sentBody["seed"]; !ok {
+		t.Error("Expected seed to be sent")
+	}
+	mu.Unlock()
+	if req.Params.Temperature == nil || len(req.Params.ExtraParams) != 1 {
+		t.Error("Expected caller's request to be left untouched")
+	}
+
+	// Models without a rule get every parameter
+	req.Model = "gpt-4o-mini"
+	if _, bifrostErr := client.ChatCompletionRequest(context.Background(), req); bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	mu.Lock()
+	if _, ok := sentBody["temperature"]; !ok {
+		t.Error("Expected temperature to be sent for non-reasoning model")
+	}
+	mu.Unlock()
+}
+
+// Test that incompatible parameters are rejected before dispatch in reject mode
+func TestParameterRules_Reject(t *testing.T) {
+	var mu sync.Mutex
+	var sentBody map[string]any
+	client := initParameterRulesTestClient(t, []schemas.ModelParameterRule{
+		{Models: []string{"o1-mini"}, AllowedParams: []string{"max_completion_tokens", "seed"}, Action: schemas.ModelParameterActionReject},
+	}, &sentBody, &mu)
+
+	req := newTestChatRequest(schemas.OpenAI)
+	req.Model = "o1-mini"
+	req.Params = &schemas.ChatParameters{Temperature: schemas.Ptr(0.7), Seed: schemas.Ptr(42)}
+	_, bifrostErr := client.ChatCompletionRequest(context.Background(), req)
+	if bifrostErr == nil {
+		t.Fatal("Expected request with incompatible parameters to be rejected")
+	}
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %v", bifrostErr.StatusCode)
+	}
+	if !strings.Contains(GetErrorMessage(bifrostErr), "parameters not supported by model o1-mini: temperature") {
+		t.Errorf("Unexpected error message: %s", GetErrorMessage(bifrostErr))
+	}
+	mu.Lock()
+	if sentBody != nil {
+		t.Error("Expected no request to reach the provider")
+	}
+	mu.Unlock()
+}
@@ -0,0 +1,147 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// tokenBucket is a token bucket that hands out reservations instead of rejecting when empty.
+// Callers over the rate are told how long to wait for their turn, so dispatch is spread
+// evenly at the configured rate instead of bursting.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64 // available tokens, negative when callers are waiting for their turn
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (tb *tokenBucket) reserve(now time.Time) time.Duration {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	if elapsed := now.Sub(tb.last).Seconds(); elapsed > 0 {
+		tb.tokens = min(tb.burst, tb.tokens+elapsed*tb.rate)
+		tb.last = now
+	}
+	tb.tokens--
+	if tb.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-tb.tokens / tb.rate * float64(time.Second))
+}
+
+// cancel gives back a reservation that will not be used.
+func (tb *tokenBucket) cancel() {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	tb.tokens = min(tb.burst, tb.tokens+1)
+}
+
+// outboundRateLimiter paces the requests sent to a single provider, either as a whole or per key.
+type outboundRateLimiter struct {
+	rate       float64
+	burst      int
+	perKey     bool
+	maxWait    time.Duration
+	bucket     *tokenBucket
+	keyBuckets sync.Map // key ID -> *tokenBucket, only used when pacing per key
+}
+
+// newOutboundRateLimiter creates an outbound rate limiter from the provider config.
+// Returns nil if pacing is not configured, callers must treat a nil limiter as unlimited.
+func newOutboundRateLimiter(config *schemas.OutboundRateLimitConfig) *outboundRateLimiter {
+	if config == nil || config.RequestsPerSecond <= 0 {
+		return nil
+	}
+	return &outboundRateLimiter{
+		rate:    config.RequestsPerSecond,
+		burst:   config.Burst,
+		perKey:  config.PerKey,
+		maxWait: time.Duration(max(config.MaxWaitInMs, 0)) * time.Millisecond,
+		bucket:  newTokenBucket(config.RequestsPerSecond, config.Burst),
+	}
+}
+
+// bucketFor returns the bucket pacing requests sent with the given key.
+// Keyless requests share the provider-wide bucket.
+func (l *outboundRateLimiter) bucketFor(keyID string) *tokenBucket {
+	if !l.perKey || keyID == "" {
+		return l.bucket
+	}
+	if value, ok := l.keyBuckets.Load(keyID); ok {
+		return value.(*tokenBucket)
+	}
+	value, _ := l.keyBuckets.LoadOrStore(keyID, newTokenBucket(l.rate, l.burst))
+	return value.(*tokenBucket)
+}
+
+// wait blocks until the request may be dispatched at the configured rate.
+// Returns an error if the request would be held back longer than maxWait or the context was cancelled.
+func (l *outboundRateLimiter) wait(ctx context.Context, keyID string) error {
+	if l == nil {
+		return nil
+	}
+	bucket := l.bucketFor(keyID)
+	delay := bucket.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	if l.maxWait > 0 && delay > l.maxWait {
+		bucket.cancel()
+		return fmt.Errorf("outbound rate limit of %g requests/s exceeded, next slot in %s", l.rate, delay.Round(time.Millisecond))
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		bucket.cancel()
+		return fmt.Errorf("request cancelled while waiting for outbound rate limit")
+	}
+}
+
+// getOutboundRateLimiter returns the outbound rate limiter of a provider, or nil if none is configured.
+func (bifrost *Bifrost) getOutboundRateLimiter(providerKey schemas.ModelProvider) *outboundRateLimiter {
+	if value, ok := bifrost.outboundRateLimiters.Load(providerKey); ok {
+		return value.(*outboundRateLimiter)
+	}
+	return nil
+}
+
+// storeOutboundRateLimiter creates (or removes) the outbound rate limiter of a provider according to its config.
+func (bifrost *Bifrost) storeOutboundRateLimiter(providerKey schemas.ModelProvider, config *schemas.OutboundRateLimitConfig) {
+	if limiter := newOutboundRateLimiter(config); limiter != nil {
+		bifrost.outboundRateLimiters.Store(providerKey, limiter)
+		return
+	}
+	bifrost.outboundRateLimiters.Delete(providerKey)
+}
+
+// newOutboundRateLimitError creates the error returned when a request could not be paced within its max wait.
+// It is marked as a Bifrost error so that it is not retried against the same provider, fallbacks still apply.
+func newOutboundRateLimitError(err error) *schemas.BifrostError {
+	bifrostErr := newBifrostError(err)
+	bifrostErr.IsBifrostError = true
+	bifrostErr.StatusCode = schemas.Ptr(fasthttp.StatusTooManyRequests)
+	return bifrostErr
+}
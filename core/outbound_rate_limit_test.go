@@ -0,0 +1,84 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a burst of requests is dispatched to the provider at the configured rate
+func TestOutboundRateLimit_SmoothsDispatch(t *testing.T) {
+	var mu sync.Mutex
+	var dispatched []time.Time
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		dispatched = append(dispatched, time.Now())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	const requestsPerSecond = 10
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.configs[schemas.OpenAI].OutboundRateLimit = &schemas.OutboundRateLimitConfig{RequestsPerSecond: requestsPerSecond}
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	// Fire a burst larger than the rate, all at once
+	const requests = 6
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI)); bifrostErr != nil {
+				t.Errorf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(dispatched) != requests {
+		t.Fatalf("Expected %d requests to reach the provider, got %d", requests, len(dispatched))
+	}
+	sort.Slice(dispatched, func(i, j int) bool { return dispatched[i].Before(dispatched[j]) })
+	interval := time.Second / requestsPerSecond
+	tolerance := 30 * time.Millisecond
+	for i := 1; i < len(dispatched); i++ {
+		if gap := dispatched[i].Sub(dispatched[i-1]); gap < interval-tolerance {
+			t.Errorf("Requests %d and %d were dispatched %s apart, expected at least %s", i-1, i, gap, interval)
+		}
+	}
+	if total := dispatched[len(dispatched)-1].Sub(dispatched[0]); total < time.Duration(requests-1)*interval-tolerance {
+		t.Errorf("Expected burst to be spread over at least %s, took %s", time.Duration(requests-1)*interval, total)
+	}
+}
+
+// Test that requests over the max wait are rejected and that keys are paced separately when configured
+func TestOutboundRateLimit_MaxWaitAndPerKey(t *testing.T) {
+	limiter := newOutboundRateLimiter(&schemas.OutboundRateLimitConfig{RequestsPerSecond: 1, PerKey: true, MaxWaitInMs: 10})
+	ctx := context.Background()
+
+	if err := limiter.wait(ctx, "key-1"); err != nil {
+		t.Fatalf("Expected first request to be dispatched immediately, got %v", err)
+	}
+	if err := limiter.wait(ctx, "key-1"); err == nil {
+		t.Error("Expected second request on the same key to exceed the max wait")
+	}
+	if err := limiter.wait(ctx, "key-2"); err != nil {
+		t.Errorf("Expected a different key to have its own budget, got %v", err)
+	}
+
+	if newOutboundRateLimiter(nil) != nil || newOutboundRateLimiter(&schemas.OutboundRateLimitConfig{}) != nil {
+		t.Error("Expected unconfigured limiter to be nil")
+	}
+	var unlimited *outboundRateLimiter
+	if err := unlimited.wait(ctx, "key-1"); err != nil {
+		t.Errorf("Expected nil limiter to never wait, got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package bifrost
+
+import (
+	"context"
+
+	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// isUnsupportedOperation reports whether an error is a provider refusing the request type.
+func isUnsupportedOperation(err *schemas.BifrostError) bool {
+	return err != nil && err.Error != nil && err.Error.Code != nil && *err.Error.Code == schemas.UnsupportedOperationErrorCode
+}
+
+// hasImageGenerationTool reports whether a responses request asks for image generation.
+func hasImageGenerationTool(req *schemas.BifrostResponsesRequest) bool {
+	if req.Params == nil {
+		return false
+	}
+	for _, tool := range req.Params.Tools {
+		if tool.Type == schemas.ResponsesToolTypeImageGeneration {
+			return true
+		}
+	}
+	return false
+}
+
+// singleShotImageStream serves a streamed image generation on a provider that does not stream responses: the
+// request is sent to the provider without streaming, and the response is delivered as the frames closing a stream,
+// one output_item.done frame per output item (the generated images included) followed by the response.completed
+// frame. The frames go through the PostHooks like the chunks of a provider stream, the PreHooks having run once
+// for the streamed request. No partial image frames are sent, the final images are the only ones returned.
+func singleShotImageStream(provider schemas.Provider, req *ChannelMessage, key schemas.Key, postHookRunner schemas.PostHookRunner) (chan *schemas.BifrostStream, *schemas.BifrostError) {
+	resp, bifrostError := provider.Responses(req.Context, key, req.BifrostRequest.ResponsesRequest)
+	if bifrostError != nil {
+		return nil, bifrostError
+	}
+
+	stream := make(chan *schemas.BifrostStream, len(resp.Output)+1)
+	extraFields := resp.ExtraFields
+	extraFields.RequestType = schemas.ResponsesStreamRequest
+	frames := make([]*schemas.BifrostResponsesStreamResponse, 0, len(resp.Output)+1)
+	for i := range resp.Output {
+		frames = append(frames, &schemas.BifrostResponsesStreamResponse{
+			Type:        schemas.ResponsesStreamResponseTypeOutputItemDone,
+			OutputIndex: schemas.Ptr(i),
+			Item:        &resp.Output[i],
+		})
+	}
+	frames = append(frames, &schemas.BifrostResponsesStreamResponse{
+		Type:     schemas.ResponsesStreamResponseTypeCompleted,
+		Response: resp,
+	})
+	ctx := req.Context
+	for sequence, frame := range frames {
+		frame.SequenceNumber = sequence
+		frame.ExtraFields = extraFields
+		frame.ExtraFields.ChunkIndex = sequence
+		if sequence == len(frames)-1 {
+			ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
+		}
+		providerUtils.ProcessAndSendResponse(ctx, postHookRunner, providerUtils.GetBifrostResponseForStreamResponse(nil, nil, frame, nil, nil), stream)
+	}
+	close(stream)
+	return stream, nil
+}
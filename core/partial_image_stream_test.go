@@ -0,0 +1,184 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the partial images of a streamed image generation are delivered as stream frames, in order, before
+// the final image
+func TestResponsesStream_PartialImages(t *testing.T) {
+	partialImages := []string{"cGFydGlhbC0w", "cGFydGlhbC0x"}
+	const finalImage = "ZmluYWw="
+	requestBodies := make(chan []byte, 1)
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requestBodies <- body
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+		events := []string{
+			`{"type":"response.created","sequence_number":0,"response":{"id":"resp_1","status":"in_progress"}}`,
+			`{"type":"response.image_generation_call.in_progress","sequence_number":1,"output_index":0,"item_id":"ig_1"}`,
+			`{"type":"response.image_generation_call.generating","sequence_number":2,"output_index":0,"item_id":"ig_1"}`,
+		}
+		for i, image := range partialImages {
+			events = append(events, fmt.Sprintf(`{"type":"response.image_generation_call.partial_image","sequence_number":%d,"output_index":0,"item_id":"ig_1","partial_image_index":%d,"partial_image_b64":%q}`, 3+i, i, image))
+		}
+		events = append(events,
+			`{"type":"response.image_generation_call.completed","sequence_number":5,"output_index":0,"item_id":"ig_1"}`,
+			fmt.Sprintf(`{"type":"response.output_item.done","sequence_number":6,"output_index":0,"item":{"id":"ig_1","type":"image_generation_call","status":"completed","result":%q}}`, finalImage),
+			`{"type":"response.completed","sequence_number":7,"response":{"id":"resp_1","status":"completed"}}`,
+		)
+		for _, event := range events {
+			fmt.Fprintf(w, "data: %s\n\n", event)
+			flusher.Flush()
+		}
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	stream, bifrostErr := client.ResponsesStreamRequest(context.Background(), &schemas.BifrostResponsesRequest{
+		Provider: schemas.OpenAI,
+		Model:    "gpt-4.1-mini",
+		Input: []schemas.ResponsesMessage{{
+			Role:    schemas.Ptr(schemas.ResponsesInputMessageRoleUser),
+			Content: &schemas.ResponsesMessageContent{ContentStr: schemas.Ptr("Draw a lighthouse at dusk")},
+		}},
+		Params: &schemas.ResponsesParameters{
+			Tools: []schemas.ResponsesTool{{
+				Type:                         schemas.ResponsesToolTypeImageGeneration,
+				ResponsesToolImageGeneration: &schemas.ResponsesToolImageGeneration{PartialImages: schemas.Ptr(len(partialImages))},
+			}},
+		},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("Expected stream to start, got error: %v", GetErrorMessage(bifrostErr))
+	}
+
+	var received []string
+	var result string
+	completed := false
+	for chunk := range stream {
+		if chunk.BifrostError != nil {
+			t.Fatalf("Unexpected stream error: %v", GetErrorMessage(chunk.BifrostError))
+		}
+		frame := chunk.BifrostResponsesStreamResponse
+		if frame == nil {
+			continue
+		}
+		switch frame.Type {
+		case schemas.ResponsesStreamResponseTypeImageGenerationCallPartialImage:
+			if frame.PartialImageB64 == nil || frame.PartialImageIndex == nil || *frame.PartialImageIndex != len(received) {
+				t.Fatalf("Expected partial image %d, got %+v", len(received), frame)
+			}
+			if result != "" {
+				t.Error("Expected the partial images before the final image")
+			}
+			received = append(received, *frame.PartialImageB64)
+		case schemas.ResponsesStreamResponseTypeOutputItemDone:
+			if item := frame.Item; item != nil && item.ResponsesToolMessage != nil && item.ResponsesToolMessage.ResponsesImageGenerationCall != nil {
+				result = item.ResponsesToolMessage.ResponsesImageGenerationCall.Result
+			}
+		case schemas.ResponsesStreamResponseTypeCompleted:
+			completed = true
+		}
+	}
+
+	requestBody := <-requestBodies
+	var sent struct {
+		Tools []struct {
+			PartialImages int `json:"partial_images"`
+		} `json:"tools"`
+	}
+	if err := json.Unmarshal(requestBody, &sent); err != nil || len(sent.Tools) != 1 || sent.Tools[0].PartialImages != len(partialImages) {
+		t.Errorf("Expected the partial images to be requested from the provider, got %s", requestBody)
+	}
+	if strings.Join(received, ",") != strings.Join(partialImages, ",") {
+		t.Errorf("Expected the partial images %v, got %v", partialImages, received)
+	}
+	if result != finalImage || !completed {
+		t.Errorf("Expected the final image and the completed frame, got %q, completed=%v", result, completed)
+	}
+}
+
+// Test that a streamed image generation on a provider that does not stream responses falls back to a single-shot
+// request delivered as the closing frames of a stream, the plugins running once for the request
+func TestResponsesStream_ImageGenerationSingleShotFallback(t *testing.T) {
+	const finalImage = "ZmluYWw="
+	requestBodies := make(chan []byte, 1)
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requestBodies <- body
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":"resp_1","object":"response","created_at":1,"status":"completed","model":"gpt-4.1-mini","output":[{"id":"ig_1","type":"image_generation_call","status":"completed","result":%q}]}`, finalImage)
+	})
+
+	const imageProvider = schemas.ModelProvider("image-provider")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(imageProvider, server.URL, nil)
+	account.configs[imageProvider].CustomProviderConfig.AllowedRequests = &schemas.AllowedRequests{Responses: true}
+	recorder := &pluginOrderRecorder{}
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		Plugins: []schemas.Plugin{&orderRecordingPlugin{name: "recorder", recorder: recorder}},
+	})
+
+	newRequest := func(tools []schemas.ResponsesTool) *schemas.BifrostResponsesRequest {
+		return &schemas.BifrostResponsesRequest{
+			Provider: imageProvider,
+			Model:    "gpt-4.1-mini",
+			Input: []schemas.ResponsesMessage{{
+				Role:    schemas.Ptr(schemas.ResponsesInputMessageRoleUser),
+				Content: &schemas.ResponsesMessageContent{ContentStr: schemas.Ptr("Draw a lighthouse at dusk")},
+			}},
+			Params: &schemas.ResponsesParameters{Tools: tools},
+		}
+	}
+	stream, bifrostErr := client.ResponsesStreamRequest(context.Background(), newRequest([]schemas.ResponsesTool{{
+		Type:                         schemas.ResponsesToolTypeImageGeneration,
+		ResponsesToolImageGeneration: &schemas.ResponsesToolImageGeneration{PartialImages: schemas.Ptr(2)},
+	}}))
+	if bifrostErr != nil {
+		t.Fatalf("Expected the single-shot fallback, got error: %v", GetErrorMessage(bifrostErr))
+	}
+
+	var frames []*schemas.BifrostResponsesStreamResponse
+	for chunk := range stream {
+		if chunk.BifrostError != nil {
+			t.Fatalf("Unexpected stream error: %v", GetErrorMessage(chunk.BifrostError))
+		}
+		frames = append(frames, chunk.BifrostResponsesStreamResponse)
+	}
+	if len(frames) != 2 || frames[0].Type != schemas.ResponsesStreamResponseTypeOutputItemDone || frames[1].Type != schemas.ResponsesStreamResponseTypeCompleted {
+		t.Fatalf("Expected an output_item.done frame then a completed frame, got %+v", frames)
+	}
+	if item := frames[0].Item; item == nil || item.ResponsesToolMessage == nil || item.ResponsesToolMessage.ResponsesImageGenerationCall == nil || item.ResponsesToolMessage.ResponsesImageGenerationCall.Result != finalImage {
+		t.Errorf("Expected the final image in the output item frame, got %+v", frames[0].Item)
+	}
+	if frames[1].SequenceNumber != 1 || frames[1].Response == nil || frames[1].ExtraFields.RequestType != schemas.ResponsesStreamRequest {
+		t.Errorf("Expected the completed frame to carry the response, got %+v", frames[1])
+	}
+	if hooks := recorder.take(); !slices.Equal(hooks, []string{"recorder.pre", "recorder.post", "recorder.post"}) {
+		t.Errorf("Expected one PreHook and a PostHook per frame, got %v", hooks)
+	}
+	var sent struct {
+		Stream bool `json:"stream"`
+	}
+	if requestBody := <-requestBodies; json.Unmarshal(requestBody, &sent) != nil || sent.Stream {
+		t.Errorf("Expected a non-streaming request to be sent, got %s", requestBody)
+	}
+
+	// Other streamed requests are still refused
+	if _, bifrostErr = client.ResponsesStreamRequest(context.Background(), newRequest(nil)); !isUnsupportedOperation(bifrostErr) {
+		t.Errorf("Expected an unsupported operation error, got %v", GetErrorMessage(bifrostErr))
+	}
+}
@@ -0,0 +1,76 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// getPinnedProvider returns the provider the request is pinned to with BifrostContextKeyPinnedProvider, or nil.
+func getPinnedProvider(ctx context.Context) *schemas.PinnedProvider {
+	if ctx == nil {
+		return nil
+	}
+	switch pinned := ctx.Value(schemas.BifrostContextKeyPinnedProvider).(type) {
+	case *schemas.PinnedProvider:
+		if pinned != nil && pinned.Provider != "" {
+			return pinned
+		}
+	case schemas.PinnedProvider:
+		if pinned.Provider != "" {
+			return &pinned
+		}
+	}
+	return nil
+}
+
+// pinRequest returns a copy of the request sent to the pinned provider, with the same model and without fallbacks.
+// The caller's request is left untouched.
+func (bifrost *Bifrost) pinRequest(req *schemas.BifrostRequest, pinned *schemas.PinnedProvider) (*schemas.BifrostRequest, *schemas.BifrostError) {
+	_, model, _ := req.GetRequestFields()
+	if _, err := bifrost.account.GetConfigForProvider(pinned.Provider); err != nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+			Error: &schemas.ErrorField{
+				Message: fmt.Sprintf("pinned provider %s is not configured", pinned.Provider),
+				Error:   err,
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    req.RequestType,
+				Provider:       pinned.Provider,
+				ModelRequested: model,
+			},
+		}
+	}
+	pinnedReq := bifrost.prepareFallbackRequest(req, schemas.Fallback{Provider: pinned.Provider, Model: model})
+	pinnedReq.SetFallbacks(nil)
+	return pinnedReq, nil
+}
+
+// selectPinnedKey returns the key a pinned request is sent with: the pinned key, matched by ID or name, or the
+// first key supporting the model, so that pinned requests always use the same key.
+func selectPinnedKey(pinned *schemas.PinnedProvider, supportedKeys []schemas.Key, providerKey schemas.ModelProvider, model string) (schemas.Key, error) {
+	if pinned.Key == "" {
+		return supportedKeys[0], nil
+	}
+	for _, key := range supportedKeys {
+		if key.ID == pinned.Key || key.Name == pinned.Key {
+			return key, nil
+		}
+	}
+	return schemas.Key{}, fmt.Errorf("pinned key %q of provider %s not found or does not support model: %s", pinned.Key, providerKey, model)
+}
+
+// setPinnedKey reports the key a pinned request was sent with in the response extra fields.
+func setPinnedKey(ctx context.Context, result *schemas.BifrostResponse, key schemas.Key) {
+	if result == nil || getPinnedProvider(ctx) == nil {
+		return
+	}
+	if extraFields := result.GetExtraFields(); extraFields != nil {
+		extraFields.SelectedKeyID = key.ID
+		extraFields.SelectedKeyName = key.Name
+	}
+}
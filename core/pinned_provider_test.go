@@ -0,0 +1,98 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a pinned request goes to the pinned provider and key only, regardless of fallbacks and key weights
+func TestPinnedProvider_BypassesRouting(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []string // "<server>:<authorization>" of each request
+	)
+	newServer := func(name string, status int) *httptest.Server {
+		server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			received = append(received, name+":"+r.Header.Get("Authorization"))
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			if status == http.StatusOK {
+				w.Write([]byte(mockChatCompletionBody))
+			} else {
+				w.Write([]byte(`{"error":{"message":"unavailable","type":"server_error"}}`))
+			}
+		})
+		return server
+	}
+
+	const primaryProvider = schemas.ModelProvider("primary-provider")
+	const fallbackProvider = schemas.ModelProvider("fallback-provider")
+	const pinnedProvider = schemas.ModelProvider("pinned-provider")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(primaryProvider, newServer("primary", http.StatusOK).URL, nil)
+	account.addOpenAICompatibleProvider(fallbackProvider, newServer("fallback", http.StatusOK).URL, nil)
+	account.addOpenAICompatibleProvider(pinnedProvider, newServer("pinned", http.StatusOK).URL, nil)
+	account.keys[pinnedProvider] = []schemas.Key{
+		{ID: "heavy-key", Name: "heavy", Value: "sk-heavy", Weight: 1000},
+		{ID: "light-key", Name: "light", Value: "sk-light", Weight: 0.001},
+	}
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	newRequest := func() *schemas.BifrostChatRequest {
+		req := newTestChatRequest(primaryProvider)
+		req.Fallbacks = []schemas.Fallback{{Provider: fallbackProvider, Model: req.Model}}
+		return req
+	}
+
+	// The pinned key is used every time, even though key selection would almost always pick the other one
+	ctx := context.WithValue(context.Background(), schemas.BifrostContextKeyPinnedProvider, &schemas.PinnedProvider{Provider: pinnedProvider, Key: "light"})
+	for range 5 {
+		resp, bifrostErr := client.ChatCompletionRequest(ctx, newRequest())
+		if bifrostErr != nil {
+			t.Fatalf("Expected no error, got: %v", GetErrorMessage(bifrostErr))
+		}
+		if resp.ExtraFields.Provider != pinnedProvider || resp.ExtraFields.SelectedKeyID != "light-key" || resp.ExtraFields.SelectedKeyName != "light" {
+			t.Errorf("Expected the pinned provider and key in the extra fields, got %+v", resp.ExtraFields)
+		}
+	}
+	mu.Lock()
+	for _, request := range received {
+		if request != "pinned:Bearer sk-light" {
+			t.Errorf("Expected every request to reach the pinned provider with the pinned key, got %q", request)
+		}
+	}
+	received = nil
+	mu.Unlock()
+
+	// Pinned requests are not retried on the fallbacks when the pinned provider fails
+	account.configs[pinnedProvider].NetworkConfig.BaseURL = newServer("pinned-failing", http.StatusServiceUnavailable).URL
+	if err := client.UpdateProvider(pinnedProvider); err != nil {
+		t.Fatalf("Failed to update provider: %v", err)
+	}
+	if _, bifrostErr := client.ChatCompletionRequest(ctx, newRequest()); bifrostErr == nil {
+		t.Fatal("Expected the pinned provider's error")
+	}
+	mu.Lock()
+	if len(received) == 0 {
+		t.Error("Expected the request to reach the pinned provider")
+	}
+	for _, request := range received {
+		if request != "pinned-failing:Bearer sk-light" {
+			t.Errorf("Expected no fallback request, got %q", request)
+		}
+	}
+	mu.Unlock()
+
+	// An unknown pinned key is rejected
+	ctx = context.WithValue(context.Background(), schemas.BifrostContextKeyPinnedProvider, &schemas.PinnedProvider{Provider: primaryProvider, Key: "missing"})
+	if _, bifrostErr := client.ChatCompletionRequest(ctx, newRequest()); bifrostErr == nil {
+		t.Error("Expected an error for an unknown pinned key")
+	}
+}
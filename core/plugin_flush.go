@@ -0,0 +1,69 @@
+package bifrost
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// setPluginFlushTimeout sets how long plugins are given to flush their pending asynchronous work.
+// Non-positive values select the default timeout.
+func (bifrost *Bifrost) setPluginFlushTimeout(timeout time.Duration) {
+	bifrost.pluginFlushTimeout.Store(int64(timeout))
+}
+
+// flushPlugins flushes the pending asynchronous work of the plugins implementing schemas.FlushablePlugin.
+// Plugins are flushed concurrently and share the flush timeout, so that shutdown is bounded whatever the
+// number of plugins. Flush errors and timeouts are logged, as the plugins are cleaned up anyway.
+func (bifrost *Bifrost) flushPlugins(plugins []schemas.Plugin) {
+	timeout := time.Duration(bifrost.pluginFlushTimeout.Load())
+	if timeout <= 0 {
+		timeout = schemas.DefaultPluginFlushTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, plugin := range plugins {
+		flushable, ok := plugin.(schemas.FlushablePlugin)
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := flushable.Flush(ctx); err != nil {
+				bifrost.logger.Warn("failed to flush plugin %s: %v", flushable.GetName(), err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// cleanupPlugins flushes the plugins and then cleans them up.
+func (bifrost *Bifrost) cleanupPlugins(plugins []schemas.Plugin) {
+	bifrost.flushPlugins(plugins)
+	for _, plugin := range plugins {
+		if err := plugin.Cleanup(); err != nil {
+			bifrost.logger.Warn("failed to cleanup plugin %s: %v", plugin.GetName(), err)
+		}
+	}
+}
+
+// WaitWithContext waits for the wait group to be done, or for the context to be done, in which case the context
+// error is returned. It helps plugins implement schemas.FlushablePlugin by tracking their pending work in a wait group.
+func WaitWithContext(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,135 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// bufferingPlugin records an event asynchronously from its PostHook, like the logging and telemetry plugins
+type bufferingPlugin struct {
+	recordDelay time.Duration
+	blockFlush  bool // Flush waits for the context to be done, simulating a stuck flush
+
+	pending         sync.WaitGroup
+	recorded        atomic.Int32
+	recordedAtClean atomic.Int32
+	cleaned         atomic.Bool
+}
+
+func (p *bufferingPlugin) GetName() string { return "buffering-plugin" }
+
+func (p *bufferingPlugin) TransportInterceptor(ctx *schemas.BifrostContext, url string, headers map[string]string, body map[string]any) (map[string]string, map[string]any, error) {
+	return headers, body, nil
+}
+
+func (p *bufferingPlugin) PreHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error) {
+	return req, nil, nil
+}
+
+func (p *bufferingPlugin) PostHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	p.pending.Add(1)
+	go func() {
+		defer p.pending.Done()
+		time.Sleep(p.recordDelay)
+		p.recorded.Add(1)
+	}()
+	return result, err, nil
+}
+
+func (p *bufferingPlugin) Flush(ctx context.Context) error {
+	if p.blockFlush {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return WaitWithContext(ctx, &p.pending)
+}
+
+func (p *bufferingPlugin) Cleanup() error {
+	p.recordedAtClean.Store(p.recorded.Load())
+	p.cleaned.Store(true)
+	return nil
+}
+
+func initPluginFlushTestClient(t *testing.T, plugin schemas.Plugin, flushTimeout time.Duration) *Bifrost {
+	t.Helper()
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	client, err := Init(context.Background(), schemas.BifrostConfig{
+		Account:            account,
+		Plugins:            []schemas.Plugin{plugin},
+		Logger:             NewDefaultLogger(schemas.LogLevelError),
+		PluginFlushTimeout: flushTimeout,
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize Bifrost: %v", err)
+	}
+	return client
+}
+
+// Test that events buffered by PostHooks are flushed on shutdown before the plugin is cleaned up
+func TestShutdown_FlushesPluginsBeforeCleanup(t *testing.T) {
+	const requests = 5
+	plugin := &bufferingPlugin{recordDelay: 100 * time.Millisecond}
+	client := initPluginFlushTestClient(t, plugin, 0)
+
+	for i := 0; i < requests; i++ {
+		if _, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI)); bifrostErr != nil {
+			t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+		}
+	}
+	if recorded := plugin.recorded.Load(); recorded == requests {
+		t.Fatalf("Expected events to still be buffered after the requests, got %d recorded", recorded)
+	}
+
+	client.Shutdown()
+
+	if !plugin.cleaned.Load() {
+		t.Fatal("Expected the plugin to be cleaned up")
+	}
+	if recorded := plugin.recordedAtClean.Load(); recorded != requests {
+		t.Errorf("Expected %d events to be flushed before cleanup, got %d", requests, recorded)
+	}
+}
+
+// Test that a plugin failing to flush does not block shutdown beyond the flush timeout
+func TestShutdown_PluginFlushTimeout(t *testing.T) {
+	plugin := &bufferingPlugin{blockFlush: true}
+	client := initPluginFlushTestClient(t, plugin, 50*time.Millisecond)
+
+	start := time.Now()
+	client.Shutdown()
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected shutdown to be bounded by the flush timeout, took %v", elapsed)
+	}
+	if !plugin.cleaned.Load() {
+		t.Error("Expected the plugin to be cleaned up after the flush timed out")
+	}
+}
+
+// Test that a removed plugin is flushed before it is cleaned up
+func TestRemovePlugin_FlushesPlugin(t *testing.T) {
+	plugin := &bufferingPlugin{recordDelay: 100 * time.Millisecond}
+	client := initPluginFlushTestClient(t, plugin, 0)
+	t.Cleanup(client.Shutdown)
+
+	if _, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI)); bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if err := client.RemovePlugin(plugin.GetName()); err != nil {
+		t.Fatalf("Failed to remove plugin: %v", err)
+	}
+	if !plugin.cleaned.Load() || plugin.recordedAtClean.Load() != 1 {
+		t.Errorf("Expected the buffered event to be flushed before cleanup, got %d", plugin.recordedAtClean.Load())
+	}
+}
@@ -0,0 +1,50 @@
+package bifrost
+
+import (
+	"slices"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// orderPlugins returns the plugins with the ones named in order first, in that order, followed by the others
+// in their current order. The plugins slice is not modified.
+func orderPlugins(plugins []schemas.Plugin, order []string) []schemas.Plugin {
+	ordered := make([]schemas.Plugin, len(plugins))
+	copy(ordered, plugins)
+	if len(order) == 0 {
+		return ordered
+	}
+	rank := func(plugin schemas.Plugin) int {
+		if i := slices.Index(order, plugin.GetName()); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	slices.SortStableFunc(ordered, func(a, b schemas.Plugin) int {
+		return rank(a) - rank(b)
+	})
+	return ordered
+}
+
+// getPluginOrder returns the names of the plugins run first, in that order.
+func (bifrost *Bifrost) getPluginOrder() []string {
+	if order := bifrost.pluginOrder.Load(); order != nil {
+		return *order
+	}
+	return nil
+}
+
+// setPluginOrder sets the names of the plugins run first and reorders the loaded plugins accordingly.
+func (bifrost *Bifrost) setPluginOrder(order []string) {
+	bifrost.pluginOrder.Store(&order)
+	for {
+		oldPlugins := bifrost.plugins.Load()
+		if oldPlugins == nil {
+			return
+		}
+		newPlugins := orderPlugins(*oldPlugins, order)
+		if bifrost.plugins.CompareAndSwap(oldPlugins, &newPlugins) {
+			return
+		}
+	}
+}
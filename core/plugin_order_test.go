@@ -0,0 +1,113 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// pluginOrderRecorder records the order in which the hooks of the plugins sharing it run
+type pluginOrderRecorder struct {
+	mu    sync.Mutex
+	hooks []string
+}
+
+func (r *pluginOrderRecorder) record(hook string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hooks = append(r.hooks, hook)
+}
+
+func (r *pluginOrderRecorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hooks := r.hooks
+	r.hooks = nil
+	return hooks
+}
+
+// orderRecordingPlugin records its PreHook and PostHook in the shared recorder
+type orderRecordingPlugin struct {
+	name     string
+	recorder *pluginOrderRecorder
+}
+
+func (p *orderRecordingPlugin) GetName() string { return p.name }
+
+func (p *orderRecordingPlugin) TransportInterceptor(ctx *schemas.BifrostContext, url string, headers map[string]string, body map[string]any) (map[string]string, map[string]any, error) {
+	return headers, body, nil
+}
+
+func (p *orderRecordingPlugin) PreHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error) {
+	p.recorder.record(p.name + ".pre")
+	return req, nil, nil
+}
+
+func (p *orderRecordingPlugin) PostHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	p.recorder.record(p.name + ".post")
+	return result, err, nil
+}
+
+func (p *orderRecordingPlugin) Cleanup() error { return nil }
+
+// Test that the plugins listed in the plugin order run first, on init, when plugins are added and on config reload
+func TestPluginOrder(t *testing.T) {
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	recorder := &pluginOrderRecorder{}
+	newPlugin := func(name string) schemas.Plugin {
+		return &orderRecordingPlugin{name: name, recorder: recorder}
+	}
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	config := schemas.BifrostConfig{
+		Account:     account,
+		Plugins:     []schemas.Plugin{newPlugin("custom"), newPlugin("governance"), newPlugin("logging")},
+		Logger:      NewDefaultLogger(schemas.LogLevelError),
+		PluginOrder: []string{"governance", "logging"},
+	}
+	client, err := Init(context.Background(), config)
+	if err != nil {
+		t.Fatalf("Failed to initialize Bifrost: %v", err)
+	}
+	defer client.Shutdown()
+
+	assertHooks := func(expected ...string) {
+		t.Helper()
+		if _, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI)); bifrostErr != nil {
+			t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+		}
+		if hooks := recorder.take(); !slices.Equal(hooks, expected) {
+			t.Errorf("Expected hooks %v, got %v", expected, hooks)
+		}
+	}
+	assertHooks("governance.pre", "logging.pre", "custom.pre", "custom.post", "logging.post", "governance.post")
+
+	// A plugin listed in the order runs before the plugins added earlier, other plugins run last
+	config.PluginOrder = []string{"governance", "telemetry", "logging"}
+	if err := client.ReloadConfig(config); err != nil {
+		t.Fatalf("Failed to reload config: %v", err)
+	}
+	for _, name := range []string{"late", "telemetry"} {
+		if err := client.ReloadPlugin(newPlugin(name)); err != nil {
+			t.Fatalf("Failed to add plugin %s: %v", name, err)
+		}
+	}
+	assertHooks("governance.pre", "telemetry.pre", "logging.pre", "custom.pre", "late.pre",
+		"late.post", "custom.post", "logging.post", "telemetry.post", "governance.post")
+
+	// Reloading the config reorders the loaded plugins
+	config.PluginOrder = []string{"custom"}
+	if err := client.ReloadConfig(config); err != nil {
+		t.Fatalf("Failed to reload config: %v", err)
+	}
+	assertHooks("custom.pre", "governance.pre", "telemetry.pre", "logging.pre", "late.pre",
+		"late.post", "logging.post", "telemetry.post", "governance.post", "custom.post")
+}
@@ -3,18 +3,15 @@ package anthropic
 
 import (
 	"bufio"
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
-	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
@@ -23,55 +20,25 @@ import (
 
 // AnthropicProvider implements the Provider interface for Anthropic's Claude API.
 type AnthropicProvider struct {
-	logger               schemas.Logger                // Logger for provider operations
-	client               *fasthttp.Client              // HTTP client for API requests
-	apiVersion           string                        // API version for the provider
-	networkConfig        schemas.NetworkConfig         // Network configuration including extra headers
-	sendBackRawRequest   bool                          // Whether to include raw request in BifrostResponse
-	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
-	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
+	providerUtils.BaseProvider
+	client     *fasthttp.Client // HTTP client for API requests
+	apiVersion string           // API version for the provider
 }
 
 // anthropicMessageResponsePool provides a pool for Anthropic chat response objects.
-var anthropicMessageResponsePool = sync.Pool{
-	New: func() interface{} {
-		return &AnthropicMessageResponse{}
-	},
-}
+var anthropicMessageResponsePool = providerUtils.NewResponsePool[AnthropicMessageResponse]()
 
 // anthropicTextResponsePool provides a pool for Anthropic text response objects.
-var anthropicTextResponsePool = sync.Pool{
-	New: func() interface{} {
-		return &AnthropicTextResponse{}
-	},
-}
+var anthropicTextResponsePool = providerUtils.NewResponsePool[AnthropicTextResponse]()
 
 // AcquireAnthropicMessageResponse gets an Anthropic chat response from the pool.
 func AcquireAnthropicMessageResponse() *AnthropicMessageResponse {
-	resp := anthropicMessageResponsePool.Get().(*AnthropicMessageResponse)
-	*resp = AnthropicMessageResponse{} // Reset the struct
-	return resp
+	return anthropicMessageResponsePool.Acquire()
 }
 
 // ReleaseAnthropicMessageResponse returns an Anthropic chat response to the pool.
 func ReleaseAnthropicMessageResponse(resp *AnthropicMessageResponse) {
-	if resp != nil {
-		anthropicMessageResponsePool.Put(resp)
-	}
-}
-
-// acquireAnthropicTextResponse gets an Anthropic text response from the pool.
-func acquireAnthropicTextResponse() *AnthropicTextResponse {
-	resp := anthropicTextResponsePool.Get().(*AnthropicTextResponse)
-	*resp = AnthropicTextResponse{} // Reset the struct
-	return resp
-}
-
-// releaseAnthropicTextResponse returns an Anthropic text response to the pool.
-func releaseAnthropicTextResponse(resp *AnthropicTextResponse) {
-	if resp != nil {
-		anthropicTextResponsePool.Put(resp)
-	}
+	anthropicMessageResponsePool.Release(resp)
 }
 
 // NewAnthropicProvider creates a new Anthropic provider instance.
@@ -89,41 +56,27 @@ func NewAnthropicProvider(config *schemas.ProviderConfig, logger schemas.Logger)
 	}
 
 	// Pre-warm response pools
-	for i := 0; i < config.ConcurrencyAndBufferSize.Concurrency; i++ {
-		anthropicTextResponsePool.Put(&AnthropicTextResponse{})
-		anthropicMessageResponsePool.Put(&AnthropicMessageResponse{})
-	}
+	anthropicTextResponsePool.Prewarm(config.ConcurrencyAndBufferSize.Concurrency)
+	anthropicMessageResponsePool.Prewarm(config.ConcurrencyAndBufferSize.Concurrency)
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://api.anthropic.com"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &AnthropicProvider{
-		logger:               logger,
-		client:               client,
-		apiVersion:           "2023-06-01",
-		networkConfig:        config.NetworkConfig,
-		sendBackRawRequest:   config.SendBackRawRequest,
-		sendBackRawResponse:  config.SendBackRawResponse,
-		customProviderConfig: config.CustomProviderConfig,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Anthropic, config, logger),
+		client:       client,
+		apiVersion:   "2023-06-01",
 	}
 }
 
-// GetProviderKey returns the provider identifier for Anthropic.
-func (provider *AnthropicProvider) GetProviderKey() schemas.ModelProvider {
-	return providerUtils.GetProviderName(schemas.Anthropic, provider.customProviderConfig)
-}
-
-// buildRequestURL constructs the full request URL using the provider's configuration.
-func (provider *AnthropicProvider) buildRequestURL(ctx context.Context, defaultPath string, requestType schemas.RequestType) string {
-	return provider.networkConfig.BaseURL + providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType)
-}
-
 // completeRequest sends a request to Anthropic's API and handles the response.
 // It constructs the API URL, sets up authentication, and processes the response.
 // Returns the response body or an error if the request fails.
@@ -135,7 +88,7 @@ func (provider *AnthropicProvider) completeRequest(ctx context.Context, jsonData
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	req.SetRequestURI(url)
 	req.Header.SetMethod(http.MethodPost)
@@ -155,7 +108,7 @@ func (provider *AnthropicProvider) completeRequest(ctx context.Context, jsonData
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", provider.GetProviderKey(), string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", provider.GetProviderKey(), string(resp.Body())))
 		return nil, latency, parseAnthropicError(resp, meta)
 	}
 
@@ -181,10 +134,10 @@ func (provider *AnthropicProvider) listModelsByKey(ctx context.Context, key sche
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Build URL using centralized URL construction
-	req.SetRequestURI(provider.buildRequestURL(ctx, fmt.Sprintf("/v1/models?limit=%d", schemas.DefaultPageSize), schemas.ListModelsRequest))
+	req.SetRequestURI(provider.BuildRequestURL(ctx, fmt.Sprintf("/v1/models?limit=%d", schemas.DefaultPageSize), schemas.ListModelsRequest))
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
 	if key.Value != "" {
@@ -208,7 +161,7 @@ func (provider *AnthropicProvider) listModelsByKey(ctx context.Context, key sche
 
 	// Parse Anthropic's response
 	var anthropicResponse AnthropicListModelsResponse
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), &anthropicResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), &anthropicResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -218,12 +171,12 @@ func (provider *AnthropicProvider) listModelsByKey(ctx context.Context, key sche
 	response.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -235,10 +188,10 @@ func (provider *AnthropicProvider) listModelsByKey(ctx context.Context, key sche
 // Uses a best-effort approach: continues with remaining keys even if some fail.
 // Requests are made concurrently for improved performance.
 func (provider *AnthropicProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.ListModelsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.ListModelsRequest); err != nil {
 		return nil, err
 	}
-	if provider.customProviderConfig != nil && provider.customProviderConfig.IsKeyLess {
+	if provider.CustomProviderConfig() != nil && provider.CustomProviderConfig().IsKeyLess {
 		return provider.listModelsByKey(ctx, schemas.Key{}, request)
 	}
 	return providerUtils.HandleMultipleListModelsRequests(
@@ -246,7 +199,7 @@ func (provider *AnthropicProvider) ListModels(ctx context.Context, keys []schema
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -254,7 +207,7 @@ func (provider *AnthropicProvider) ListModels(ctx context.Context, keys []schema
 // It formats the request, sends it to Anthropic, and processes the response.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *AnthropicProvider) TextCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostTextCompletionRequest) (*schemas.BifrostTextCompletionResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.TextCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.TextCompletionRequest); err != nil {
 		return nil, err
 	}
 
@@ -269,7 +222,7 @@ func (provider *AnthropicProvider) TextCompletion(ctx context.Context, key schem
 	}
 
 	// Use struct directly for JSON marshaling
-	responseBody, latency, err := provider.completeRequest(ctx, jsonData, provider.buildRequestURL(ctx, "/v1/complete", schemas.TextCompletionRequest), key.Value, &providerUtils.RequestMetadata{
+	responseBody, latency, err := provider.completeRequest(ctx, jsonData, provider.BuildRequestURL(ctx, "/v1/complete", schemas.TextCompletionRequest), key.Value, &providerUtils.RequestMetadata{
 		Provider:    provider.GetProviderKey(),
 		Model:       request.Model,
 		RequestType: schemas.TextCompletionRequest,
@@ -279,10 +232,10 @@ func (provider *AnthropicProvider) TextCompletion(ctx context.Context, key schem
 	}
 
 	// Create response object from pool
-	response := acquireAnthropicTextResponse()
-	defer releaseAnthropicTextResponse(response)
+	response := anthropicTextResponsePool.Acquire()
+	defer anthropicTextResponsePool.Release(response)
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -296,12 +249,12 @@ func (provider *AnthropicProvider) TextCompletion(ctx context.Context, key schem
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -319,7 +272,7 @@ func (provider *AnthropicProvider) TextCompletionStream(ctx context.Context, pos
 // It formats the request, sends it to Anthropic, and processes the response.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *AnthropicProvider) ChatCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.ChatCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.ChatCompletionRequest); err != nil {
 		return nil, err
 	}
 
@@ -334,7 +287,7 @@ func (provider *AnthropicProvider) ChatCompletion(ctx context.Context, key schem
 	}
 
 	// Use struct directly for JSON marshaling
-	responseBody, latency, err := provider.completeRequest(ctx, jsonData, provider.buildRequestURL(ctx, "/v1/messages", schemas.ChatCompletionRequest), key.Value, &providerUtils.RequestMetadata{
+	responseBody, latency, err := provider.completeRequest(ctx, jsonData, provider.BuildRequestURL(ctx, "/v1/messages", schemas.ChatCompletionRequest), key.Value, &providerUtils.RequestMetadata{
 		Provider:    provider.GetProviderKey(),
 		Model:       request.Model,
 		RequestType: schemas.ChatCompletionRequest,
@@ -347,7 +300,7 @@ func (provider *AnthropicProvider) ChatCompletion(ctx context.Context, key schem
 	response := AcquireAnthropicMessageResponse()
 	defer ReleaseAnthropicMessageResponse(response)
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -361,12 +314,12 @@ func (provider *AnthropicProvider) ChatCompletion(ctx context.Context, key schem
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -377,7 +330,7 @@ func (provider *AnthropicProvider) ChatCompletion(ctx context.Context, key schem
 // It supports real-time streaming of responses using Server-Sent Events (SSE).
 // Returns a channel containing BifrostResponse objects representing the stream or an error if the request fails.
 func (provider *AnthropicProvider) ChatCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.ChatCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.ChatCompletionStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -413,16 +366,16 @@ func (provider *AnthropicProvider) ChatCompletionStream(ctx context.Context, pos
 	return HandleAnthropicChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/messages", schemas.ChatCompletionStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/messages", schemas.ChatCompletionStreamRequest),
 		jsonData,
 		headers,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
-		provider.logger,
+		provider.Logger(),
 		&providerUtils.RequestMetadata{
 			Provider:    provider.GetProviderKey(),
 			Model:       request.Model,
@@ -554,7 +507,7 @@ func HandleAnthropicChatCompletionStreaming(
 			}
 
 			var event AnthropicStreamEvent
-			if err := sonic.Unmarshal([]byte(eventData), &event); err != nil {
+			if err := serialization.Unmarshal([]byte(eventData), &event); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to parse message_start event: %v", err))
 				continue
 			}
@@ -691,7 +644,7 @@ func HandleAnthropicChatCompletionStreaming(
 // It formats the request, sends it to Anthropic, and processes the response.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *AnthropicProvider) Responses(ctx context.Context, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.ResponsesRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.ResponsesRequest); err != nil {
 		return nil, err
 	}
 
@@ -701,7 +654,7 @@ func (provider *AnthropicProvider) Responses(ctx context.Context, key schemas.Ke
 	}
 
 	// Use struct directly for JSON marshaling
-	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.buildRequestURL(ctx, "/v1/messages", schemas.ResponsesRequest), key.Value, &providerUtils.RequestMetadata{
+	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.BuildRequestURL(ctx, "/v1/messages", schemas.ResponsesRequest), key.Value, &providerUtils.RequestMetadata{
 		Provider:    provider.GetProviderKey(),
 		Model:       request.Model,
 		RequestType: schemas.ResponsesRequest,
@@ -714,7 +667,7 @@ func (provider *AnthropicProvider) Responses(ctx context.Context, key schemas.Ke
 	response := AcquireAnthropicMessageResponse()
 	defer ReleaseAnthropicMessageResponse(response)
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -729,12 +682,12 @@ func (provider *AnthropicProvider) Responses(ctx context.Context, key schemas.Ke
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -743,7 +696,7 @@ func (provider *AnthropicProvider) Responses(ctx context.Context, key schemas.Ke
 
 // ResponsesStream performs a streaming responses request to the Anthropic API.
 func (provider *AnthropicProvider) ResponsesStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.ResponsesStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.ResponsesStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -767,16 +720,16 @@ func (provider *AnthropicProvider) ResponsesStream(ctx context.Context, postHook
 	return HandleAnthropicResponsesStream(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/messages", schemas.ResponsesStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/messages", schemas.ResponsesStreamRequest),
 		jsonBody,
 		headers,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
-		provider.logger,
+		provider.Logger(),
 		&providerUtils.RequestMetadata{
 			Provider:    provider.GetProviderKey(),
 			Model:       request.Model,
@@ -906,7 +859,7 @@ func HandleAnthropicResponsesStream(
 			}
 
 			var event AnthropicStreamEvent
-			if err := sonic.Unmarshal([]byte(eventData), &event); err != nil {
+			if err := serialization.Unmarshal([]byte(eventData), &event); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to parse message_start event: %v", err))
 				continue
 			}
@@ -1032,25 +985,82 @@ func HandleAnthropicResponsesStream(
 
 // BatchCreate creates a new batch job.
 func (provider *AnthropicProvider) BatchCreate(ctx context.Context, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.BatchCreateRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.BatchCreateRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
-	if len(request.Requests) == 0 {
-		return nil, providerUtils.NewBifrostOperationError("requests array is required for Anthropic batch API", nil, providerName)
-	}
-
 	// Create request
 	req := fasthttp.AcquireRequest()
 	resp := fasthttp.AcquireResponse()
 	defer fasthttp.ReleaseRequest(req)
 	defer fasthttp.ReleaseResponse(resp)
 
+	jsonData, bifrostErr := provider.prepareBatchCreateRequest(ctx, req, key, request)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	// Make request
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	// Handle error response
+	if resp.StatusCode() != fasthttp.StatusOK {
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		return nil, ParseAnthropicError(resp, schemas.BatchCreateRequest, providerName, "")
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
+	}
+
+	var anthropicResp AnthropicBatchResponse
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	return anthropicResp.ToBifrostBatchCreateResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
+}
+
+// RenderBatchCreate renders the batch create request BatchCreate would send, without sending it.
+func (provider *AnthropicProvider) RenderBatchCreate(ctx context.Context, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BatchRenderedRequest, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.BatchCreateRequest); err != nil {
+		return nil, err
+	}
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	if _, bifrostErr := provider.prepareBatchCreateRequest(ctx, req, key, request); bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	return providerUtils.RenderBatchRequest(req), nil
+}
+
+// prepareBatchCreateRequest validates a batch create request and sets the URL, headers and body of the HTTP
+// request creating the message batch. Returns the request body.
+func (provider *AnthropicProvider) prepareBatchCreateRequest(ctx context.Context, req *fasthttp.Request, key schemas.Key, request *schemas.BifrostBatchCreateRequest) ([]byte, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if len(request.Requests) == 0 {
+		return nil, providerUtils.NewBifrostOperationError("requests array is required for Anthropic batch API", nil, providerName)
+	}
+
+	// Message batches only run messages requests
+	if _, err := providerUtils.NormalizeBatchEndpoint(schemas.Anthropic, request.Endpoint); err != nil {
+		return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
+
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.buildRequestURL(ctx, "/v1/messages/batches", schemas.BatchCreateRequest))
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+	req.SetRequestURI(provider.BuildRequestURL(ctx, "/v1/messages/batches", schemas.BatchCreateRequest))
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType("application/json")
 
@@ -1075,52 +1085,31 @@ func (provider *AnthropicProvider) BatchCreate(ctx context.Context, key schemas.
 		}
 	}
 
-	jsonData, err := sonic.Marshal(anthropicReq)
+	jsonData, err := serialization.Marshal(anthropicReq)
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
 	}
 	req.SetBody(jsonData)
-
-	// Make request
-	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
-	if bifrostErr != nil {
-		return nil, bifrostErr
-	}
-
-	// Handle error response
-	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
-		return nil, ParseAnthropicError(resp, schemas.BatchCreateRequest, providerName, "")
-	}
-
-	body, err := providerUtils.CheckAndDecodeBody(resp)
-	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-	}
-
-	var anthropicResp AnthropicBatchResponse
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &anthropicResp, jsonData, sendBackRawRequest, sendBackRawResponse)
-	if bifrostErr != nil {
-		return nil, bifrostErr
-	}
-
-	return anthropicResp.ToBifrostBatchCreateResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
+	return jsonData, nil
 }
 
 // BatchList lists batch jobs using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *AnthropicProvider) BatchList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.BatchListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.BatchListRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	if request.AllKeys {
+		return providerUtils.ListBatchesAcrossKeys(ctx, providerName, keys, request.AfterID, request.Limit, func(ctx context.Context, key schemas.Key, after string, limit int) ([]schemas.BifrostBatchRetrieveResponse, bool, time.Duration, *schemas.BifrostError) {
+			return provider.listBatchPage(ctx, key, after, "", limit)
+		})
+	}
 
 	// Initialize serial pagination helper (Anthropic uses AfterID for pagination)
-	helper, err := providerUtils.NewSerialListHelper(keys, request.AfterID, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.AfterID, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -1140,6 +1129,48 @@ func (provider *AnthropicProvider) BatchList(ctx context.Context, keys []schemas
 		}, nil
 	}
 
+	// Use native cursor from serial helper instead of request.AfterID
+	beforeID := ""
+	if request.BeforeID != nil {
+		beforeID = *request.BeforeID
+	}
+	batches, keyHasMore, latency, bifrostErr := provider.listBatchPage(ctx, key, nativeCursor, beforeID, request.Limit)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	var lastBatchID string
+	if len(batches) > 0 {
+		lastBatchID = batches[len(batches)-1].ID
+	}
+
+	// Build cursor for next request
+	// Anthropic uses LastID as the cursor for pagination
+	nextCursor, hasMore := helper.BuildNextCursor(keyHasMore, lastBatchID)
+
+	// Convert to Bifrost response
+	bifrostResp := &schemas.BifrostBatchListResponse{
+		Object:  "list",
+		Data:    batches,
+		HasMore: hasMore,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.BatchListRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}
+	if nextCursor != "" {
+		bifrostResp.NextCursor = &nextCursor
+	}
+
+	return bifrostResp, nil
+}
+
+// listBatchPage lists a page of the message batches of a key, after the batch with ID afterID and before the batch
+// with ID beforeID (unbounded if empty). Returns the batches, whether the key has more, and the request latency.
+func (provider *AnthropicProvider) listBatchPage(ctx context.Context, key schemas.Key, afterID, beforeID string, limit int) ([]schemas.BifrostBatchRetrieveResponse, bool, time.Duration, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+
 	// Create request
 	req := fasthttp.AcquireRequest()
 	resp := fasthttp.AcquireResponse()
@@ -1147,17 +1178,16 @@ func (provider *AnthropicProvider) BatchList(ctx context.Context, keys []schemas
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL with query params
-	baseURL := provider.buildRequestURL(ctx, "/v1/messages/batches", schemas.BatchListRequest)
+	baseURL := provider.BuildRequestURL(ctx, "/v1/messages/batches", schemas.BatchListRequest)
 	values := url.Values{}
-	if request.Limit > 0 {
-		values.Set("limit", fmt.Sprintf("%d", request.Limit))
+	if limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", limit))
 	}
-	if request.BeforeID != nil && *request.BeforeID != "" {
-		values.Set("before_id", *request.BeforeID)
+	if beforeID != "" {
+		values.Set("before_id", beforeID)
 	}
-	// Use native cursor from serial helper instead of request.AfterID
-	if nativeCursor != "" {
-		values.Set("after_id", nativeCursor)
+	if afterID != "" {
+		values.Set("after_id", afterID)
 	}
 	requestURL := baseURL
 	if encodedValues := values.Encode(); encodedValues != "" {
@@ -1165,7 +1195,7 @@ func (provider *AnthropicProvider) BatchList(ctx context.Context, keys []schemas
 	}
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -1178,59 +1208,37 @@ func (provider *AnthropicProvider) BatchList(ctx context.Context, keys []schemas
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
 	if bifrostErr != nil {
-		return nil, bifrostErr
+		return nil, false, 0, bifrostErr
 	}
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
-		return nil, ParseAnthropicError(resp, schemas.BatchListRequest, providerName, "")
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		return nil, false, 0, ParseAnthropicError(resp, schemas.BatchListRequest, providerName, "")
 	}
 
 	body, decodeErr := providerUtils.CheckAndDecodeBody(resp)
 	if decodeErr != nil {
-		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, decodeErr, providerName)
+		return nil, false, 0, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, decodeErr, providerName)
 	}
 
 	var anthropicResp AnthropicBatchListResponse
-	_, _, bifrostErr = providerUtils.HandleProviderResponse(body, &anthropicResp, nil, false, sendBackRawResponse)
+	_, _, bifrostErr = providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, nil, false, sendBackRawResponse)
 	if bifrostErr != nil {
-		return nil, bifrostErr
+		return nil, false, 0, bifrostErr
 	}
 
 	// Convert batches to Bifrost format
 	batches := make([]schemas.BifrostBatchRetrieveResponse, 0, len(anthropicResp.Data))
-	var lastBatchID string
 	for _, batch := range anthropicResp.Data {
 		batches = append(batches, *batch.ToBifrostBatchRetrieveResponse(providerName, latency, false, false, nil, nil))
-		lastBatchID = batch.ID
 	}
-
-	// Build cursor for next request
-	// Anthropic uses LastID as the cursor for pagination
-	nextCursor, hasMore := helper.BuildNextCursor(anthropicResp.HasMore, lastBatchID)
-
-	// Convert to Bifrost response
-	bifrostResp := &schemas.BifrostBatchListResponse{
-		Object:  "list",
-		Data:    batches,
-		HasMore: hasMore,
-		ExtraFields: schemas.BifrostResponseExtraFields{
-			RequestType: schemas.BatchListRequest,
-			Provider:    providerName,
-			Latency:     latency.Milliseconds(),
-		},
-	}
-	if nextCursor != "" {
-		bifrostResp.NextCursor = &nextCursor
-	}
-
-	return bifrostResp, nil
+	return batches, anthropicResp.HasMore, latency, nil
 }
 
 // BatchRetrieve retrieves a specific batch job by trying each key until found.
 func (provider *AnthropicProvider) BatchRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.BatchRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.BatchRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -1240,8 +1248,8 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx context.Context, keys []sch
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -1250,8 +1258,8 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx context.Context, keys []sch
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.buildRequestURL(
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.BuildRequestURL(
 			ctx,
 			"/v1/messages/batches/"+url.PathEscape(request.BatchID),
 			schemas.BatchRetrieveRequest,
@@ -1275,7 +1283,7 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx context.Context, keys []sch
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseAnthropicError(resp, schemas.BatchRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1291,7 +1299,7 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx context.Context, keys []sch
 		}
 
 		var anthropicResp AnthropicBatchResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1312,7 +1320,7 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx context.Context, keys []sch
 
 // BatchCancel cancels a batch job by trying each key until successful.
 func (provider *AnthropicProvider) BatchCancel(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.BatchCancelRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.BatchCancelRequest); err != nil {
 		return nil, err
 	}
 
@@ -1322,8 +1330,8 @@ func (provider *AnthropicProvider) BatchCancel(ctx context.Context, keys []schem
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -1332,8 +1340,8 @@ func (provider *AnthropicProvider) BatchCancel(ctx context.Context, keys []schem
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/messages/batches/" + request.BatchID + "/cancel")
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/messages/batches/" + request.BatchID + "/cancel")
 		req.Header.SetMethod(http.MethodPost)
 		req.Header.SetContentType("application/json")
 
@@ -1353,7 +1361,7 @@ func (provider *AnthropicProvider) BatchCancel(ctx context.Context, keys []schem
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseAnthropicError(resp, schemas.BatchCancelRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1369,7 +1377,7 @@ func (provider *AnthropicProvider) BatchCancel(ctx context.Context, keys []schem
 		}
 
 		var anthropicResp AnthropicBatchResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1420,7 +1428,7 @@ func (provider *AnthropicProvider) BatchCancel(ctx context.Context, keys []schem
 
 // BatchResults retrieves batch results by trying each key until found.
 func (provider *AnthropicProvider) BatchResults(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.BatchResultsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.BatchResultsRequest); err != nil {
 		return nil, err
 	}
 
@@ -1437,8 +1445,8 @@ func (provider *AnthropicProvider) BatchResults(ctx context.Context, keys []sche
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/messages/batches/" + request.BatchID + "/results")
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/messages/batches/" + request.BatchID + "/results")
 		req.Header.SetMethod(http.MethodGet)
 
 		if key.Value != "" {
@@ -1457,7 +1465,7 @@ func (provider *AnthropicProvider) BatchResults(ctx context.Context, keys []sche
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseAnthropicError(resp, schemas.BatchResultsRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1478,10 +1486,10 @@ func (provider *AnthropicProvider) BatchResults(ctx context.Context, keys []sche
 		// Parse JSONL content - each line is a separate result
 		var results []schemas.BatchResultItem
 
-		parseResult := providerUtils.ParseJSONL(body, func(line []byte) error {
+		parseResult, nextCursor, err := providerUtils.ParseJSONLPage(body, request.After, request.Limit, func(line []byte) error {
 			var anthropicResult AnthropicBatchResultItem
-			if err := sonic.Unmarshal(line, &anthropicResult); err != nil {
-				provider.logger.Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
+			if err := serialization.Unmarshal(line, &anthropicResult); err != nil {
+				provider.Logger().Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
 				return err
 			}
 
@@ -1504,6 +1512,9 @@ func (provider *AnthropicProvider) BatchResults(ctx context.Context, keys []sche
 			results = append(results, resultItem)
 			return nil
 		})
+		if err != nil {
+			return nil, providerUtils.NewBifrostOperationError("invalid batch results cursor", err, providerName)
+		}
 
 		batchResultsResp := &schemas.BifrostBatchResultsResponse{
 			BatchID: request.BatchID,
@@ -1515,6 +1526,11 @@ func (provider *AnthropicProvider) BatchResults(ctx context.Context, keys []sche
 			},
 		}
 
+		if nextCursor != nil {
+			batchResultsResp.HasMore = true
+			batchResultsResp.NextCursor = nextCursor
+		}
+
 		if len(parseResult.Errors) > 0 {
 			batchResultsResp.ExtraFields.ParseErrors = parseResult.Errors
 		}
@@ -1572,41 +1588,27 @@ func (provider *AnthropicProvider) TranscriptionStream(ctx context.Context, post
 func parseStreamAnthropicError(resp *fasthttp.Response, providerType schemas.ModelProvider) *schemas.BifrostError {
 	statusCode := resp.StatusCode()
 	body := resp.Body()
-	return providerUtils.NewProviderAPIError(string(body), nil, statusCode, providerType, nil, nil)
+	bifrostErr := providerUtils.NewProviderAPIError(string(body), nil, statusCode, providerType, nil, nil)
+	providerUtils.ClassifyRateLimitError(bifrostErr)
+	return bifrostErr
 }
 
 // FileUpload uploads a file to Anthropic's Files API.
 func (provider *AnthropicProvider) FileUpload(ctx context.Context, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.FileUploadRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.FileUploadRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
-	if len(request.File) == 0 {
+	if !providerUtils.HasFileUploadContent(request) {
 		return nil, providerUtils.NewBifrostOperationError("file content is required", nil, providerName)
 	}
 
-	// Create multipart form data
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
-
-	// Add file field
 	filename := request.Filename
 	if filename == "" {
 		filename = "file"
 	}
-	part, err := writer.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to create form file", err, providerName)
-	}
-	if _, err := part.Write(request.File); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to write file content", err, providerName)
-	}
-
-	if err := writer.Close(); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to close multipart writer", err, providerName)
-	}
 
 	// Create request
 	req := fasthttp.AcquireRequest()
@@ -1615,10 +1617,9 @@ func (provider *AnthropicProvider) FileUpload(ctx context.Context, key schemas.K
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.buildRequestURL(ctx, "/v1/files", schemas.FileUploadRequest))
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+	req.SetRequestURI(provider.BuildRequestURL(ctx, "/v1/files", schemas.FileUploadRequest))
 	req.Header.SetMethod(http.MethodPost)
-	req.Header.SetContentType(writer.FormDataContentType())
 
 	if key.Value != "" {
 		req.Header.Set("x-api-key", key.Value)
@@ -1626,7 +1627,10 @@ func (provider *AnthropicProvider) FileUpload(ctx context.Context, key schemas.K
 	req.Header.Set("anthropic-version", provider.apiVersion)
 	req.Header.Set("anthropic-beta", AnthropicFilesAPIBetaHeader)
 
-	req.SetBody(buf.Bytes())
+	// Set the multipart form with the file, streamed if it is read from a reader
+	if err := providerUtils.SetMultipartFileUploadBody(req, request, filename); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to create multipart form data", err, providerName)
+	}
 
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
@@ -1636,7 +1640,7 @@ func (provider *AnthropicProvider) FileUpload(ctx context.Context, key schemas.K
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK && resp.StatusCode() != fasthttp.StatusCreated {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, ParseAnthropicError(resp, schemas.FileUploadRequest, providerName, "")
 	}
 
@@ -1646,9 +1650,9 @@ func (provider *AnthropicProvider) FileUpload(ctx context.Context, key schemas.K
 	}
 
 	var anthropicResp AnthropicFileResponse
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1660,16 +1664,16 @@ func (provider *AnthropicProvider) FileUpload(ctx context.Context, key schemas.K
 // FileList lists files using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *AnthropicProvider) FileList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.FileListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.FileListRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	// Initialize serial pagination helper
-	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -1696,7 +1700,7 @@ func (provider *AnthropicProvider) FileList(ctx context.Context, keys []schemas.
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL with query params
-	requestURL := provider.buildRequestURL(ctx, "/v1/files", schemas.FileListRequest)
+	requestURL := provider.BuildRequestURL(ctx, "/v1/files", schemas.FileListRequest)
 	values := url.Values{}
 	if request.Limit > 0 {
 		values.Set("limit", fmt.Sprintf("%d", request.Limit))
@@ -1710,7 +1714,7 @@ func (provider *AnthropicProvider) FileList(ctx context.Context, keys []schemas.
 	}
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -1729,7 +1733,7 @@ func (provider *AnthropicProvider) FileList(ctx context.Context, keys []schemas.
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, ParseAnthropicError(resp, schemas.FileListRequest, providerName, "")
 	}
 
@@ -1739,7 +1743,7 @@ func (provider *AnthropicProvider) FileList(ctx context.Context, keys []schemas.
 	}
 
 	var anthropicResp AnthropicFileListResponse
-	_, _, bifrostErr = providerUtils.HandleProviderResponse(body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
+	_, _, bifrostErr = providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1784,7 +1788,7 @@ func (provider *AnthropicProvider) FileList(ctx context.Context, keys []schemas.
 
 // FileRetrieve retrieves file metadata from Anthropic's Files API by trying each key until found.
 func (provider *AnthropicProvider) FileRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.FileRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.FileRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -1794,8 +1798,8 @@ func (provider *AnthropicProvider) FileRetrieve(ctx context.Context, keys []sche
 		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -1804,8 +1808,8 @@ func (provider *AnthropicProvider) FileRetrieve(ctx context.Context, keys []sche
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.buildRequestURL(
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.BuildRequestURL(
 			ctx,
 			"/v1/files/"+url.PathEscape(request.FileID),
 			schemas.FileRetrieveRequest,
@@ -1830,7 +1834,7 @@ func (provider *AnthropicProvider) FileRetrieve(ctx context.Context, keys []sche
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseAnthropicError(resp, schemas.FileRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1846,7 +1850,7 @@ func (provider *AnthropicProvider) FileRetrieve(ctx context.Context, keys []sche
 		}
 
 		var anthropicResp AnthropicFileResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1865,7 +1869,7 @@ func (provider *AnthropicProvider) FileRetrieve(ctx context.Context, keys []sche
 
 // FileDelete deletes a file from Anthropic's Files API by trying each key until successful.
 func (provider *AnthropicProvider) FileDelete(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.FileDeleteRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.FileDeleteRequest); err != nil {
 		return nil, err
 	}
 
@@ -1875,8 +1879,8 @@ func (provider *AnthropicProvider) FileDelete(ctx context.Context, keys []schema
 		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -1885,8 +1889,8 @@ func (provider *AnthropicProvider) FileDelete(ctx context.Context, keys []schema
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/files/" + request.FileID)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/files/" + request.FileID)
 		req.Header.SetMethod(http.MethodDelete)
 		req.Header.SetContentType("application/json")
 
@@ -1907,7 +1911,7 @@ func (provider *AnthropicProvider) FileDelete(ctx context.Context, keys []schema
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK && resp.StatusCode() != fasthttp.StatusNoContent {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseAnthropicError(resp, schemas.FileDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1939,7 +1943,7 @@ func (provider *AnthropicProvider) FileDelete(ctx context.Context, keys []schema
 		}
 
 		var anthropicResp AnthropicFileDeleteResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &anthropicResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1978,7 +1982,7 @@ func (provider *AnthropicProvider) FileDelete(ctx context.Context, keys []schema
 // FileContent downloads file content from Anthropic's Files API by trying each key until found.
 // Note: Only files created by skills or the code execution tool can be downloaded.
 func (provider *AnthropicProvider) FileContent(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.FileContentRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.CustomProviderConfig(), schemas.FileContentRequest); err != nil {
 		return nil, err
 	}
 
@@ -1995,8 +1999,8 @@ func (provider *AnthropicProvider) FileContent(ctx context.Context, keys []schem
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/files/" + request.FileID + "/content")
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/files/" + request.FileID + "/content")
 		req.Header.SetMethod(http.MethodGet)
 
 		if key.Value != "" {
@@ -2016,7 +2020,7 @@ func (provider *AnthropicProvider) FileContent(ctx context.Context, keys []schem
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseAnthropicError(resp, schemas.FileContentRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
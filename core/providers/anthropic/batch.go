@@ -240,6 +240,7 @@ func ParseAnthropicError(resp *fasthttp.Response, requestType schemas.RequestTyp
 			bifrostErr.Error.Message = errorResp.Error.Message
 		}
 	}
+	providerUtils.ClassifyRateLimitError(bifrostErr)
 	bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 		RequestType:    requestType,
 		Provider:       providerName,
@@ -369,7 +370,7 @@ func toAnthropicProcessingStatus(status schemas.BatchStatus) string {
 		return "in_progress"
 	case schemas.BatchStatusCancelling:
 		return "canceling"
-	case schemas.BatchStatusEnded, schemas.BatchStatusCompleted, schemas.BatchStatusCancelled:
+	case schemas.BatchStatusEnded, schemas.BatchStatusCompleted, schemas.BatchStatusPartiallyCompleted, schemas.BatchStatusCancelled:
 		return "ended"
 	default:
 		return string(status)
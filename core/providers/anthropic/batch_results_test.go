@@ -0,0 +1,63 @@
+package anthropic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+func TestBatchResults_Pagination(t *testing.T) {
+	const totalResults = 3000
+	var results strings.Builder
+	for i := range totalResults {
+		fmt.Fprintf(&results, `{"custom_id":"request-%d","result":{"type":"succeeded"}}`+"\n", i)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/messages/batches/msgbatch_abc/results" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/binary")
+		w.Write([]byte(results.String()))
+	}))
+	defer server.Close()
+
+	provider := NewAnthropicProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, nil)
+	keys := []schemas.Key{{ID: "key-1", Value: "sk-ant-test"}}
+
+	var after *string
+	pages, seen := 0, 0
+	for {
+		resp, bifrostErr := provider.BatchResults(context.Background(), keys, &schemas.BifrostBatchResultsRequest{
+			Provider: schemas.Anthropic,
+			BatchID:  "msgbatch_abc",
+			Limit:    1000,
+			After:    after,
+		})
+		if bifrostErr != nil {
+			t.Fatalf("expected page %d to succeed, got error: %v", pages, bifrostErr.Error.Message)
+		}
+		pages++
+		for _, result := range resp.Results {
+			if expected := fmt.Sprintf("request-%d", seen); result.CustomID != expected {
+				t.Fatalf("expected result %s, got %s", expected, result.CustomID)
+			}
+			seen++
+		}
+		if !resp.HasMore {
+			break
+		}
+		after = resp.NextCursor
+	}
+	if pages != 3 || seen != totalResults {
+		t.Errorf("expected %d results in 3 pages, got %d results in %d pages", totalResults, seen, pages)
+	}
+}
@@ -3,7 +3,9 @@ package anthropic
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
@@ -38,6 +40,11 @@ func ToAnthropicChatRequest(bifrostReq *schemas.BifrostChatRequest) (*AnthropicM
 		if bifrostReq.Params.ResponseFormat != nil {
 			anthropicReq.OutputFormat = convertChatResponseFormatToAnthropicOutputFormat(bifrostReq.Params.ResponseFormat)
 		}
+		if bifrostReq.Params.User != nil {
+			anthropicReq.Metadata = &AnthropicMetaData{
+				UserID: bifrostReq.Params.User,
+			}
+		}
 
 		// Convert tools
 		if bifrostReq.Params.Tools != nil {
@@ -285,12 +292,32 @@ func ToAnthropicChatRequest(bifrostReq *schemas.BifrostChatRequest) (*AnthropicM
 		}
 	}
 
+	// A trailing assistant message is a prefill Anthropic continues from, it must not end with whitespace
+	if len(anthropicMessages) > 0 && anthropicMessages[len(anthropicMessages)-1].Role == AnthropicMessageRoleAssistant {
+		trimPrefillTrailingWhitespace(&anthropicMessages[len(anthropicMessages)-1].Content)
+	}
+
 	anthropicReq.Messages = anthropicMessages
 	anthropicReq.System = systemContent
 
 	return anthropicReq, nil
 }
 
+// trimPrefillTrailingWhitespace removes the trailing whitespace of an assistant prefill, which Anthropic rejects.
+func trimPrefillTrailingWhitespace(content *AnthropicContent) {
+	if content.ContentStr != nil {
+		content.ContentStr = schemas.Ptr(strings.TrimRightFunc(*content.ContentStr, unicode.IsSpace))
+		return
+	}
+	if len(content.ContentBlocks) == 0 {
+		return
+	}
+	last := &content.ContentBlocks[len(content.ContentBlocks)-1]
+	if last.Type == AnthropicContentBlockTypeText && last.Text != nil {
+		last.Text = schemas.Ptr(strings.TrimRightFunc(*last.Text, unicode.IsSpace))
+	}
+}
+
 // ToBifrostChatResponse converts an Anthropic message response to Bifrost format
 func (response *AnthropicMessageResponse) ToBifrostChatResponse() *schemas.BifrostChatResponse {
 	if response == nil {
@@ -403,6 +430,9 @@ func (response *AnthropicMessageResponse) ToBifrostChatResponse() *schemas.Bifro
 		Content:              &messageContent,
 		ChatAssistantMessage: assistantMessage,
 	}
+	if response.StopReason == AnthropicStopReasonRefusal {
+		message.SetRefusal("Anthropic declined to respond to this request")
+	}
 
 	// Create choice
 	choice := schemas.BifrostResponseChoice{
@@ -513,6 +543,15 @@ func ToAnthropicChatResponse(bifrostResp *schemas.BifrostChatResponse) *Anthropi
 			}
 		}
 
+		// Refusals are returned as text with the refusal stop reason
+		if choice.ChatNonStreamResponseChoice != nil && choice.Message != nil && choice.Message.ChatAssistantMessage != nil && choice.Message.ChatAssistantMessage.Refusal != nil {
+			content = append(content, AnthropicContentBlock{
+				Type: AnthropicContentBlockTypeText,
+				Text: choice.Message.ChatAssistantMessage.Refusal,
+			})
+			anthropicResp.StopReason = AnthropicStopReasonRefusal
+		}
+
 		// Add tool calls as tool_use content
 		if choice.ChatNonStreamResponseChoice != nil && choice.Message != nil && choice.Message.ChatAssistantMessage != nil && choice.Message.ChatAssistantMessage.ToolCalls != nil {
 			for _, toolCall := range choice.Message.ChatAssistantMessage.ToolCalls {
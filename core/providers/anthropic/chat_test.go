@@ -0,0 +1,132 @@
+package anthropic
+
+import (
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the results of parallel tool calls are sent as tool_result blocks of a single user message
+func TestToAnthropicChatRequest_ToolResults(t *testing.T) {
+	toolCall := func(id, city string) schemas.ChatAssistantMessageToolCall {
+		return schemas.ChatAssistantMessageToolCall{
+			ID:       schemas.Ptr(id),
+			Type:     schemas.Ptr("function"),
+			Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("get_weather"), Arguments: `{"city":"` + city + `"}`},
+		}
+	}
+	toolResult := func(id, output string) schemas.ChatMessage {
+		return schemas.ChatMessage{
+			Role:            schemas.ChatMessageRoleTool,
+			Content:         &schemas.ChatMessageContent{ContentStr: schemas.Ptr(output)},
+			ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: schemas.Ptr(id)},
+		}
+	}
+	req, err := ToAnthropicChatRequest(&schemas.BifrostChatRequest{
+		Provider: schemas.Anthropic,
+		Model:    "claude-sonnet-4-5",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Weather in Paris and Rome?")}},
+			{
+				Role:                 schemas.ChatMessageRoleAssistant,
+				ChatAssistantMessage: &schemas.ChatAssistantMessage{ToolCalls: []schemas.ChatAssistantMessageToolCall{toolCall("call_1", "Paris"), toolCall("call_2", "Rome")}},
+			},
+			toolResult("call_1", "20C"),
+			toolResult("call_2", "25C"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to convert request: %v", err)
+	}
+	if len(req.Messages) != 3 {
+		t.Fatalf("Expected 3 messages, got %d", len(req.Messages))
+	}
+	results := req.Messages[2]
+	if results.Role != "user" || len(results.Content.ContentBlocks) != 2 {
+		t.Fatalf("Expected a user message with 2 tool results, got %+v", results)
+	}
+	for i, expected := range []struct{ id, output string }{{"call_1", "20C"}, {"call_2", "25C"}} {
+		block := results.Content.ContentBlocks[i]
+		if block.Type != "tool_result" || block.ToolUseID == nil || *block.ToolUseID != expected.id {
+			t.Errorf("Expected tool_result block for %s, got %+v", expected.id, block)
+			continue
+		}
+		if block.Content == nil || block.Content.ContentStr == nil || *block.Content.ContentStr != expected.output {
+			t.Errorf("Expected tool result %q, got %+v", expected.output, block.Content)
+		}
+	}
+}
+
+// Test that a trailing assistant message is sent as a prefill, without the trailing whitespace Anthropic rejects
+func TestToAnthropicChatRequest_AssistantPrefill(t *testing.T) {
+	req, err := ToAnthropicChatRequest(&schemas.BifrostChatRequest{
+		Provider: schemas.Anthropic,
+		Model:    "claude-sonnet-4-5",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("List three colors as JSON")}},
+			{Role: schemas.ChatMessageRoleAssistant, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("{\"colors\": [ \n")}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to convert request: %v", err)
+	}
+	if len(req.Messages) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(req.Messages))
+	}
+	prefill := req.Messages[1]
+	if prefill.Role != AnthropicMessageRoleAssistant {
+		t.Fatalf("Expected the prefill to be the last assistant message, got role %s", prefill.Role)
+	}
+	if prefill.Content.ContentStr == nil || *prefill.Content.ContentStr != "{\"colors\": [" {
+		t.Errorf("Expected the prefill without trailing whitespace, got %+v", prefill.Content)
+	}
+}
+
+// Test that the user of a chat request is sent as metadata.user_id
+func TestToAnthropicChatRequest_UserMetadata(t *testing.T) {
+	req, err := ToAnthropicChatRequest(&schemas.BifrostChatRequest{
+		Provider: schemas.Anthropic,
+		Model:    "claude-sonnet-4-5",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Hello")}},
+		},
+		Params: &schemas.ChatParameters{User: schemas.Ptr("user-123")},
+	})
+	if err != nil {
+		t.Fatalf("Failed to convert request: %v", err)
+	}
+	if req.Metadata == nil || req.Metadata.UserID == nil || *req.Metadata.UserID != "user-123" {
+		t.Errorf("Expected metadata.user_id user-123, got %+v", req.Metadata)
+	}
+}
+
+// Test that a response stopped with the refusal stop reason is returned as a refusal rather than as content
+func TestToBifrostChatResponse_Refusal(t *testing.T) {
+	response := &AnthropicMessageResponse{
+		ID:         "msg_1",
+		Model:      "claude-sonnet-4-5",
+		StopReason: AnthropicStopReasonRefusal,
+		Content:    []AnthropicContentBlock{{Type: AnthropicContentBlockTypeText, Text: schemas.Ptr("I can't help with that.")}},
+	}
+
+	message := response.ToBifrostChatResponse().Choices[0].Message
+	if message.ChatAssistantMessage == nil || message.ChatAssistantMessage.Refusal == nil || *message.ChatAssistantMessage.Refusal != "I can't help with that." {
+		t.Fatalf("Expected the text as refusal, got %+v", message.ChatAssistantMessage)
+	}
+	if message.Content != nil {
+		t.Errorf("Expected no content besides the refusal, got %+v", message.Content)
+	}
+
+	output := response.ToBifrostResponsesResponse().Output
+	if len(output) != 1 || output[0].Content == nil || len(output[0].Content.ContentBlocks) != 1 ||
+		output[0].Content.ContentBlocks[0].ResponsesOutputMessageContentRefusal == nil ||
+		output[0].Content.ContentBlocks[0].ResponsesOutputMessageContentRefusal.Refusal != "I can't help with that." {
+		t.Errorf("Expected a refusal output item, got %+v", output)
+	}
+
+	// Refusals of other providers are returned to Anthropic clients with the refusal stop reason
+	anthropicResp := ToAnthropicChatResponse(response.ToBifrostChatResponse())
+	if anthropicResp.StopReason != AnthropicStopReasonRefusal || len(anthropicResp.Content) != 1 || *anthropicResp.Content[0].Text != "I can't help with that." {
+		t.Errorf("Expected the refusal as text with the refusal stop reason, got %+v", anthropicResp)
+	}
+}
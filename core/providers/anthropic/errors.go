@@ -15,10 +15,15 @@ func ToAnthropicChatCompletionError(bifrostErr *schemas.BifrostError) *Anthropic
 		return nil
 	}
 
-	// Provide blank strings for nil pointer fields
+	// Prefer the error type reported by the provider, falling back to the Anthropic type for the status code
 	errorType := ""
 	if bifrostErr.Type != nil {
 		errorType = *bifrostErr.Type
+	} else if bifrostErr.Error != nil && bifrostErr.Error.Type != nil {
+		errorType = *bifrostErr.Error.Type
+	}
+	if errorType == "" {
+		errorType = anthropicErrorTypeForStatus(bifrostErr.StatusCode)
 	}
 
 	// Safely extract message from nested error
@@ -57,6 +62,32 @@ func ToAnthropicResponsesStreamError(bifrostErr *schemas.BifrostError) string {
 	return fmt.Sprintf("event: error\ndata: %s\n\n", jsonData)
 }
 
+// anthropicErrorTypeForStatus returns the Anthropic error type matching an HTTP status code.
+// See https://docs.anthropic.com/en/api/errors
+func anthropicErrorTypeForStatus(statusCode *int) string {
+	if statusCode == nil {
+		return "api_error"
+	}
+	switch *statusCode {
+	case fasthttp.StatusBadRequest:
+		return "invalid_request_error"
+	case fasthttp.StatusUnauthorized:
+		return "authentication_error"
+	case fasthttp.StatusForbidden:
+		return "permission_error"
+	case fasthttp.StatusNotFound:
+		return "not_found_error"
+	case fasthttp.StatusRequestEntityTooLarge:
+		return "request_too_large"
+	case fasthttp.StatusTooManyRequests:
+		return "rate_limit_error"
+	case 529:
+		return "overloaded_error"
+	default:
+		return "api_error"
+	}
+}
+
 func parseAnthropicError(resp *fasthttp.Response, meta *providerUtils.RequestMetadata) *schemas.BifrostError {
 	var errorResp AnthropicError
 	bifrostErr := providerUtils.HandleProviderAPIError(resp, &errorResp)
@@ -67,6 +98,8 @@ func parseAnthropicError(resp *fasthttp.Response, meta *providerUtils.RequestMet
 		bifrostErr.Error.Type = &errorResp.Error.Type
 		bifrostErr.Error.Message = errorResp.Error.Message
 	}
+	// Anthropic uses rate_limit_error for both kinds of 429, a used up quota is told apart by its message
+	providerUtils.ClassifyRateLimitError(bifrostErr)
 	if meta != nil {
 		bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 			Provider:       meta.Provider,
@@ -0,0 +1,91 @@
+package anthropic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+func TestToAnthropicResponsesStreamError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *schemas.BifrostError
+		expected string
+	}{
+		{
+			name: "provider error type is preserved",
+			err: &schemas.BifrostError{
+				StatusCode: schemas.Ptr(529),
+				Error: &schemas.ErrorField{
+					Type:    schemas.Ptr("overloaded_error"),
+					Message: "Overloaded",
+				},
+			},
+			expected: `{"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`,
+		},
+		{
+			name: "type derived from status code",
+			err: &schemas.BifrostError{
+				StatusCode: schemas.Ptr(429),
+				Error:      &schemas.ErrorField{Message: "rate limited"},
+			},
+			expected: `{"type":"error","error":{"type":"rate_limit_error","message":"rate limited"}}`,
+		},
+		{
+			name:     "mid-stream failure without status",
+			err:      &schemas.BifrostError{Error: &schemas.ErrorField{Message: "connection reset"}},
+			expected: `{"type":"error","error":{"type":"api_error","message":"connection reset"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := ToAnthropicResponsesStreamError(tt.err)
+			if !strings.HasPrefix(event, "event: error\ndata: ") || !strings.HasSuffix(event, "\n\n") {
+				t.Fatalf("expected an SSE error event, got %q", event)
+			}
+			data := strings.TrimSuffix(strings.TrimPrefix(event, "event: error\ndata: "), "\n\n")
+			if data != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+// Test that 429 rate limits and used up usage limits are told apart from the Anthropic error message
+func TestParseAnthropicError_RateLimitKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected schemas.RateLimitKind
+	}{
+		{
+			name:     "rate limit",
+			body:     `{"type":"error","error":{"type":"rate_limit_error","message":"This request would exceed the rate limit for your organization of 50 requests per minute."}}`,
+			expected: schemas.RateLimitKindRateLimited,
+		},
+		{
+			name:     "usage limit reached",
+			body:     `{"type":"error","error":{"type":"rate_limit_error","message":"You have reached your specified API usage limits. You will regain access on 2026-11-01 at 00:00 UTC."}}`,
+			expected: schemas.RateLimitKindQuotaExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseResponse(resp)
+			resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			resp.SetBodyString(tt.body)
+
+			bifrostErr := parseAnthropicError(resp, nil)
+			if bifrostErr.RateLimitKind != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, bifrostErr.RateLimitKind)
+			}
+			if bifrostErr := ParseAnthropicError(resp, schemas.BatchCreateRequest, schemas.Anthropic, ""); bifrostErr.RateLimitKind != tt.expected {
+				t.Errorf("expected %q for batch operations, got %q", tt.expected, bifrostErr.RateLimitKind)
+			}
+		})
+	}
+}
@@ -1554,6 +1554,9 @@ func (response *AnthropicMessageResponse) ToBifrostResponsesResponse() *schemas.
 			bifrostResp.Output = outputMessages
 		}
 	}
+	if response.StopReason == AnthropicStopReasonRefusal {
+		bifrostResp.Output = schemas.SetResponsesRefusal(bifrostResp.Output, "Anthropic declined to respond to this request")
+	}
 
 	bifrostResp.Model = response.Model
 
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // Since Anthropic always needs to have a max_tokens parameter, we set a default value if not provided.
@@ -112,13 +112,13 @@ func (mr *AnthropicMessageRequest) UnmarshalJSON(data []byte) error {
 		Alias: (*Alias)(mr),
 	}
 
-	if err := sonic.Unmarshal(data, aux); err != nil {
+	if err := serialization.Unmarshal(data, aux); err != nil {
 		return err
 	}
 
 	// Parse JSON to extract unknown fields
 	var rawData map[string]json.RawMessage
-	if err := sonic.Unmarshal(data, &rawData); err != nil {
+	if err := serialization.Unmarshal(data, &rawData); err != nil {
 		return err
 	}
 
@@ -131,7 +131,7 @@ func (mr *AnthropicMessageRequest) UnmarshalJSON(data []byte) error {
 	for key, value := range rawData {
 		if !anthropicMessageRequestKnownFields[key] {
 			var v interface{}
-			if err := sonic.Unmarshal(value, &v); err != nil {
+			if err := serialization.Unmarshal(value, &v); err != nil {
 				continue // Skip fields that can't be unmarshaled
 			}
 			mr.ExtraParams[key] = v
@@ -169,13 +169,13 @@ func (mc AnthropicContent) MarshalJSON() ([]byte, error) {
 	}
 
 	if mc.ContentStr != nil {
-		return sonic.Marshal(*mc.ContentStr)
+		return serialization.Marshal(*mc.ContentStr)
 	}
 	if mc.ContentBlocks != nil {
-		return sonic.Marshal(mc.ContentBlocks)
+		return serialization.Marshal(mc.ContentBlocks)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for AnthropicContent.
@@ -183,14 +183,14 @@ func (mc AnthropicContent) MarshalJSON() ([]byte, error) {
 func (mc *AnthropicContent) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		mc.ContentStr = &stringContent
 		return nil
 	}
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var arrayContent []AnthropicContentBlock
-	if err := sonic.Unmarshal(data, &arrayContent); err == nil {
+	if err := serialization.Unmarshal(data, &arrayContent); err == nil {
 		mc.ContentBlocks = arrayContent
 		return nil
 	}
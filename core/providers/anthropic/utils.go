@@ -5,9 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/bytedance/sonic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 var (
@@ -36,7 +36,7 @@ func getRequestBodyForResponses(ctx context.Context, request *schemas.BifrostRes
 		jsonBody = request.GetRawRequestBody()
 		// Unmarshal and check if model and region are present
 		var requestBody map[string]interface{}
-		if err := sonic.Unmarshal(jsonBody, &requestBody); err != nil {
+		if err := serialization.Unmarshal(jsonBody, &requestBody); err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrRequestBodyConversion, fmt.Errorf("failed to unmarshal request body: %w", err), providerName)
 		}
 		// Add max_tokens if not present
@@ -47,7 +47,7 @@ func getRequestBodyForResponses(ctx context.Context, request *schemas.BifrostRes
 		if isStreaming {
 			requestBody["stream"] = true
 		}
-		jsonBody, err = sonic.Marshal(requestBody)
+		jsonBody, err = serialization.Marshal(requestBody)
 		if err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
 		}
@@ -66,7 +66,7 @@ func getRequestBodyForResponses(ctx context.Context, request *schemas.BifrostRes
 		}
 
 		// Convert struct to map
-		jsonBody, err = sonic.Marshal(reqBody)
+		jsonBody, err = serialization.Marshal(reqBody)
 		if err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, fmt.Errorf("failed to marshal request body: %w", err), providerName)
 		}
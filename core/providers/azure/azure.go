@@ -8,16 +8,15 @@ import (
 	"fmt"
 	"io"
 	"maps"
-	"mime/multipart"
 	"net/http"
 	"net/url"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	"github.com/maximhq/bifrost/core/providers/openai"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 
 	"github.com/valyala/fasthttp"
 )
@@ -27,11 +26,8 @@ const AzureAuthorizationTokenKey schemas.BifrostContextKey = "azure-authorizatio
 
 // AzureProvider implements the Provider interface for Azure's API.
 type AzureProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewAzureProvider creates a new Azure provider instance.
@@ -50,21 +46,15 @@ func NewAzureProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*A
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	return &AzureProvider{
-		logger:              logger,
-		client:              client,
-		networkConfig:       config.NetworkConfig,
-		sendBackRawRequest:  config.SendBackRawRequest,
-		sendBackRawResponse: config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Azure, config, logger),
+		client:       client,
 	}, nil
 }
 
-// GetProviderKey returns the provider identifier for Azure.
-func (provider *AzureProvider) GetProviderKey() schemas.ModelProvider {
-	return schemas.Azure
-}
-
 // completeRequest sends a request to Azure's API and handles the response.
 // It constructs the API URL, sets up authentication, and processes the response.
 // Returns the response body, request latency, or an error if the request fails.
@@ -84,7 +74,7 @@ func (provider *AzureProvider) completeRequest(
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType("application/json")
 
@@ -164,7 +154,7 @@ func (provider *AzureProvider) listModelsByKey(ctx context.Context, key schemas.
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	req.SetRequestURI(key.AzureKeyConfig.Endpoint + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("/openai/models?api-version=%s", *apiVersion)))
 	req.Header.SetMethod(http.MethodGet)
@@ -201,7 +191,7 @@ func (provider *AzureProvider) listModelsByKey(ctx context.Context, key schemas.
 
 	// Parse Azure-specific response
 	azureResponse := &AzureListModelsResponse{}
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, azureResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, azureResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -215,12 +205,12 @@ func (provider *AzureProvider) listModelsByKey(ctx context.Context, key schemas.
 	response.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -236,7 +226,7 @@ func (provider *AzureProvider) ListModels(ctx context.Context, keys []schemas.Ke
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -278,7 +268,7 @@ func (provider *AzureProvider) TextCompletion(ctx context.Context, key schemas.K
 
 	response := &schemas.BifrostTextCompletionResponse{}
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -290,12 +280,12 @@ func (provider *AzureProvider) TextCompletion(ctx context.Context, key schemas.K
 	response.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -343,13 +333,13 @@ func (provider *AzureProvider) TextCompletionStream(ctx context.Context, postHoo
 		url,
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		customPostResponseConverter,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -415,13 +405,13 @@ func (provider *AzureProvider) ChatCompletion(ctx context.Context, key schemas.K
 	if schemas.IsAnthropicModel(deployment) {
 		anthropicResponse := anthropic.AcquireAnthropicMessageResponse()
 		defer anthropic.ReleaseAnthropicMessageResponse(anthropicResponse)
-		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(responseBody, anthropicResponse, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(ctx, responseBody, anthropicResponse, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
 		response = anthropicResponse.ToBifrostChatResponse()
 	} else {
-		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
@@ -434,12 +424,12 @@ func (provider *AzureProvider) ChatCompletion(ctx context.Context, key schemas.K
 	response.ExtraFields.RequestType = schemas.ChatCompletionRequest
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -498,13 +488,13 @@ func (provider *AzureProvider) ChatCompletionStream(ctx context.Context, postHoo
 			url,
 			jsonData,
 			authHeader,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			provider.GetProviderKey(),
 			postHookRunner,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 			&providerUtils.RequestMetadata{
 				Provider:    provider.GetProviderKey(),
 				Model:       request.Model,
@@ -531,15 +521,15 @@ func (provider *AzureProvider) ChatCompletionStream(ctx context.Context, postHoo
 			url,
 			request,
 			authHeader,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			provider.GetProviderKey(),
 			postHookRunner,
 			nil,
 			nil,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 		)
 	}
 }
@@ -605,13 +595,13 @@ func (provider *AzureProvider) Responses(ctx context.Context, key schemas.Key, r
 	if schemas.IsAnthropicModel(deployment) {
 		anthropicResponse := anthropic.AcquireAnthropicMessageResponse()
 		defer anthropic.ReleaseAnthropicMessageResponse(anthropicResponse)
-		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(responseBody, anthropicResponse, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(ctx, responseBody, anthropicResponse, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
 		response = anthropicResponse.ToBifrostResponsesResponse()
 	} else {
-		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
@@ -624,12 +614,12 @@ func (provider *AzureProvider) Responses(ctx context.Context, key schemas.Key, r
 	response.ExtraFields.RequestType = schemas.ResponsesRequest
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -671,13 +661,13 @@ func (provider *AzureProvider) ResponsesStream(ctx context.Context, postHookRunn
 			url,
 			jsonData,
 			authHeader,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			provider.GetProviderKey(),
 			postHookRunner,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 			&providerUtils.RequestMetadata{
 				Provider:    provider.GetProviderKey(),
 				Model:       request.Model,
@@ -705,14 +695,14 @@ func (provider *AzureProvider) ResponsesStream(ctx context.Context, postHookRunn
 			url,
 			request,
 			authHeader,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			provider.GetProviderKey(),
 			postHookRunner,
 			postRequestConverter,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 		)
 	}
 }
@@ -756,7 +746,7 @@ func (provider *AzureProvider) Embedding(ctx context.Context, key schemas.Key, r
 	response := &schemas.BifrostEmbeddingResponse{}
 
 	// Use enhanced response handler with pre-allocated response
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonData, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -768,12 +758,12 @@ func (provider *AzureProvider) Embedding(ctx context.Context, key schemas.Key, r
 	response.ExtraFields.RequestType = schemas.EmbeddingRequest
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -804,10 +794,10 @@ func (provider *AzureProvider) Speech(ctx context.Context, key schemas.Key, requ
 		url,
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		provider.Logger(),
 	)
 
 	if err != nil {
@@ -866,15 +856,15 @@ func (provider *AzureProvider) SpeechStream(ctx context.Context, postHookRunner
 	req.SetRequestURI(url)
 	req.Header.SetContentType("application/json")
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Set headers
 	for k, v := range headers {
 		req.Header.Set(k, v)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	// Build request body
 	jsonBody, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
@@ -989,7 +979,7 @@ func (provider *AzureProvider) SpeechStream(ctx context.Context, postHookRunner
 
 					// First, try to parse as JSON error response (these would be valid JSON text)
 					var bifrostErr schemas.BifrostError
-					if err := sonic.Unmarshal(audioData, &bifrostErr); err == nil {
+					if err := serialization.Unmarshal(audioData, &bifrostErr); err == nil {
 						if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
 							bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 								Provider:       provider.GetProviderKey(),
@@ -997,7 +987,7 @@ func (provider *AzureProvider) SpeechStream(ctx context.Context, postHookRunner
 								RequestType:    schemas.SpeechStreamRequest,
 							}
 							ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-							providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, &bifrostErr, responseChan, provider.logger)
+							providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, &bifrostErr, responseChan, provider.Logger())
 							return
 						}
 					}
@@ -1036,7 +1026,7 @@ func (provider *AzureProvider) SpeechStream(ctx context.Context, postHookRunner
 			// Handle read errors
 			if readErr != nil {
 				if readErr != io.EOF {
-					provider.logger.Warn(fmt.Sprintf("Error reading stream: %v", readErr))
+					provider.Logger().Warn(fmt.Sprintf("Error reading stream: %v", readErr))
 				}
 				break
 			}
@@ -1088,7 +1078,12 @@ func (provider *AzureProvider) Transcription(ctx context.Context, key schemas.Ke
 		apiVersion = schemas.Ptr(AzureAPIVersionDefault)
 	}
 
-	url := fmt.Sprintf("%s/openai/deployments/%s/audio/transcriptions?api-version=%s", key.AzureKeyConfig.Endpoint, deployment, *apiVersion)
+	// Translations to English have their own endpoint
+	operation := "transcriptions"
+	if request.Params.IsTranslation() {
+		operation = "translations"
+	}
+	url := fmt.Sprintf("%s/openai/deployments/%s/audio/%s?api-version=%s", key.AzureKeyConfig.Endpoint, deployment, operation, *apiVersion)
 
 	response, err := openai.HandleOpenAITranscriptionRequest(
 		ctx,
@@ -1096,10 +1091,10 @@ func (provider *AzureProvider) Transcription(ctx context.Context, key schemas.Ke
 		url,
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 
 	if err != nil {
@@ -1169,7 +1164,7 @@ func (provider *AzureProvider) FileUpload(ctx context.Context, key schemas.Key,
 
 	providerName := provider.GetProviderKey()
 
-	if len(request.File) == 0 {
+	if !providerUtils.HasFileUploadContent(request) {
 		return nil, providerUtils.NewBifrostOperationError("file content is required", nil, providerName)
 	}
 
@@ -1183,31 +1178,10 @@ func (provider *AzureProvider) FileUpload(ctx context.Context, key schemas.Key,
 		apiVersion = schemas.Ptr(AzureAPIVersionDefault)
 	}
 
-	// Create multipart form data
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
-
-	// Add purpose field
-	if err := writer.WriteField("purpose", string(request.Purpose)); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to write purpose field", err, providerName)
-	}
-
-	// Add file field
 	filename := request.Filename
 	if filename == "" {
 		filename = "file.jsonl"
 	}
-	part, err := writer.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to create form file", err, providerName)
-	}
-	if _, err := part.Write(request.File); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to write file content", err, providerName)
-	}
-
-	if err := writer.Close(); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to close multipart writer", err, providerName)
-	}
 
 	// Create request
 	req := fasthttp.AcquireRequest()
@@ -1222,15 +1196,17 @@ func (provider *AzureProvider) FileUpload(ctx context.Context, key schemas.Key,
 	requestURL := baseURL + "?" + values.Encode()
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodPost)
-	req.Header.SetContentType(writer.FormDataContentType())
 
 	// Set Azure authentication
 	provider.setAzureAuth(ctx, req, key)
 
-	req.SetBody(buf.Bytes())
+	// Set the multipart form with the purpose and the file, streamed if it is read from a reader
+	if err := providerUtils.SetMultipartFileUploadBody(req, request, filename, providerUtils.MultipartField{Name: "purpose", Value: string(request.Purpose)}); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to create multipart form data", err, providerName)
+	}
 
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
@@ -1240,7 +1216,7 @@ func (provider *AzureProvider) FileUpload(ctx context.Context, key schemas.Key,
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK && resp.StatusCode() != fasthttp.StatusCreated {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, openai.ParseOpenAIError(resp, schemas.FileUploadRequest, providerName, "")
 	}
 
@@ -1250,9 +1226,9 @@ func (provider *AzureProvider) FileUpload(ctx context.Context, key schemas.Key,
 	}
 
 	var openAIResp openai.OpenAIFileResponse
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1270,11 +1246,11 @@ func (provider *AzureProvider) FileList(ctx context.Context, keys []schemas.Key,
 		return nil, providerUtils.NewConfigurationError("no Azure keys available for file list operation", providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	// Initialize serial pagination helper
-	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -1327,7 +1303,7 @@ func (provider *AzureProvider) FileList(ctx context.Context, keys []schemas.Key,
 	}
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -1343,7 +1319,7 @@ func (provider *AzureProvider) FileList(ctx context.Context, keys []schemas.Key,
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, openai.ParseOpenAIError(resp, schemas.FileListRequest, providerName, "")
 	}
 
@@ -1353,7 +1329,7 @@ func (provider *AzureProvider) FileList(ctx context.Context, keys []schemas.Key,
 	}
 
 	var openAIResp openai.OpenAIFileListResponse
-	_, _, bifrostErr = providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	_, _, bifrostErr = providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1404,8 +1380,8 @@ func (provider *AzureProvider) FileRetrieve(ctx context.Context, keys []schemas.
 		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -1431,7 +1407,7 @@ func (provider *AzureProvider) FileRetrieve(ctx context.Context, keys []schemas.
 		requestURL := baseURL + "?" + values.Encode()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 		req.SetRequestURI(requestURL)
 		req.Header.SetMethod(http.MethodGet)
 		req.Header.SetContentType("application/json")
@@ -1450,7 +1426,7 @@ func (provider *AzureProvider) FileRetrieve(ctx context.Context, keys []schemas.
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = openai.ParseOpenAIError(resp, schemas.FileRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1466,7 +1442,7 @@ func (provider *AzureProvider) FileRetrieve(ctx context.Context, keys []schemas.
 		}
 
 		var openAIResp openai.OpenAIFileResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1495,8 +1471,8 @@ func (provider *AzureProvider) FileDelete(ctx context.Context, keys []schemas.Ke
 		return nil, providerUtils.NewConfigurationError("no Azure keys available for file delete operation", providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -1522,7 +1498,7 @@ func (provider *AzureProvider) FileDelete(ctx context.Context, keys []schemas.Ke
 		requestURL := baseURL + "?" + values.Encode()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 		req.SetRequestURI(requestURL)
 		req.Header.SetMethod(http.MethodDelete)
 		req.Header.SetContentType("application/json")
@@ -1541,7 +1517,7 @@ func (provider *AzureProvider) FileDelete(ctx context.Context, keys []schemas.Ke
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK && resp.StatusCode() != fasthttp.StatusNoContent {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = openai.ParseOpenAIError(resp, schemas.FileDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1572,7 +1548,7 @@ func (provider *AzureProvider) FileDelete(ctx context.Context, keys []schemas.Ke
 		}
 
 		var openAIResp openai.OpenAIFileDeleteResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1645,7 +1621,7 @@ func (provider *AzureProvider) FileContent(ctx context.Context, keys []schemas.K
 		requestURL := baseURL + "?" + values.Encode()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 		req.SetRequestURI(requestURL)
 		req.Header.SetMethod(http.MethodGet)
 
@@ -1663,7 +1639,7 @@ func (provider *AzureProvider) FileContent(ctx context.Context, keys []schemas.K
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = openai.ParseOpenAIError(resp, schemas.FileContentRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -1712,6 +1688,12 @@ func (provider *AzureProvider) BatchCreate(ctx context.Context, key schemas.Key,
 
 	providerName := provider.GetProviderKey()
 
+	// Normalize the endpoint to the Azure OpenAI endpoint
+	endpoint, err := providerUtils.NormalizeBatchEndpoint(schemas.Azure, request.Endpoint)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
+
 	inputFileID := request.InputFileID
 
 	// If no file_id provided but inline requests are available, upload them first
@@ -1760,7 +1742,7 @@ func (provider *AzureProvider) BatchCreate(ctx context.Context, key schemas.Key,
 	requestURL := baseURL + "?" + values.Encode()
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType("application/json")
@@ -1771,7 +1753,7 @@ func (provider *AzureProvider) BatchCreate(ctx context.Context, key schemas.Key,
 	// Build request body
 	openAIReq := &openai.OpenAIBatchRequest{
 		InputFileID:      inputFileID,
-		Endpoint:         string(request.Endpoint),
+		Endpoint:         string(endpoint),
 		CompletionWindow: request.CompletionWindow,
 		Metadata:         request.Metadata,
 	}
@@ -1781,7 +1763,7 @@ func (provider *AzureProvider) BatchCreate(ctx context.Context, key schemas.Key,
 		openAIReq.CompletionWindow = "24h"
 	}
 
-	jsonData, err := sonic.Marshal(openAIReq)
+	jsonData, err := serialization.Marshal(openAIReq)
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
 	}
@@ -1795,7 +1777,7 @@ func (provider *AzureProvider) BatchCreate(ctx context.Context, key schemas.Key,
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK && resp.StatusCode() != fasthttp.StatusCreated {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, openai.ParseOpenAIError(resp, schemas.BatchCreateRequest, providerName, "")
 	}
 
@@ -1805,9 +1787,9 @@ func (provider *AzureProvider) BatchCreate(ctx context.Context, key schemas.Key,
 	}
 
 	var openAIResp openai.OpenAIBatchResponse
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1820,15 +1802,15 @@ func (provider *AzureProvider) BatchCreate(ctx context.Context, key schemas.Key,
 // Exhausts all pages from one key before moving to the next.
 func (provider *AzureProvider) BatchList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
 	providerName := provider.GetProviderKey()
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	if len(keys) == 0 {
 		return nil, providerUtils.NewConfigurationError("no Azure keys available for batch list operation", providerName)
 	}
 
 	// Initialize serial pagination helper
-	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -1879,7 +1861,7 @@ func (provider *AzureProvider) BatchList(ctx context.Context, keys []schemas.Key
 	requestURL := baseURL + "?" + values.Encode()
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -1895,7 +1877,7 @@ func (provider *AzureProvider) BatchList(ctx context.Context, keys []schemas.Key
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, openai.ParseOpenAIError(resp, schemas.BatchListRequest, providerName, "")
 	}
 
@@ -1905,7 +1887,7 @@ func (provider *AzureProvider) BatchList(ctx context.Context, keys []schemas.Key
 	}
 
 	var openAIResp openai.OpenAIBatchListResponse
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1951,8 +1933,8 @@ func (provider *AzureProvider) BatchRetrieve(ctx context.Context, keys []schemas
 		return nil, providerUtils.NewConfigurationError("no Azure keys available for batch retrieve operation", providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -1978,7 +1960,7 @@ func (provider *AzureProvider) BatchRetrieve(ctx context.Context, keys []schemas
 		requestURL := baseURL + "?" + values.Encode()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 		req.SetRequestURI(requestURL)
 		req.Header.SetMethod(http.MethodGet)
 		req.Header.SetContentType("application/json")
@@ -1997,7 +1979,7 @@ func (provider *AzureProvider) BatchRetrieve(ctx context.Context, keys []schemas
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = openai.ParseOpenAIError(resp, schemas.BatchRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2013,7 +1995,7 @@ func (provider *AzureProvider) BatchRetrieve(ctx context.Context, keys []schemas
 		}
 
 		var openAIResp openai.OpenAIBatchResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2044,8 +2026,8 @@ func (provider *AzureProvider) BatchCancel(ctx context.Context, keys []schemas.K
 		return nil, providerUtils.NewConfigurationError("no Azure keys available for batch cancel operation", providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -2071,7 +2053,7 @@ func (provider *AzureProvider) BatchCancel(ctx context.Context, keys []schemas.K
 		requestURL := baseURL + "?" + values.Encode()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 		req.SetRequestURI(requestURL)
 		req.Header.SetMethod(http.MethodPost)
 		req.Header.SetContentType("application/json")
@@ -2090,7 +2072,7 @@ func (provider *AzureProvider) BatchCancel(ctx context.Context, keys []schemas.K
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = openai.ParseOpenAIError(resp, schemas.BatchCancelRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2106,7 +2088,7 @@ func (provider *AzureProvider) BatchCancel(ctx context.Context, keys []schemas.K
 		}
 
 		var openAIResp openai.OpenAIBatchResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2184,8 +2166,8 @@ func (provider *AzureProvider) BatchResults(ctx context.Context, keys []schemas.
 
 	parseResult := providerUtils.ParseJSONL(fileContentResp.Content, func(line []byte) error {
 		var resultItem schemas.BatchResultItem
-		if err := sonic.Unmarshal(line, &resultItem); err != nil {
-			provider.logger.Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
+		if err := serialization.Unmarshal(line, &resultItem); err != nil {
+			provider.Logger().Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
 			return err
 		}
 		results = append(results, resultItem)
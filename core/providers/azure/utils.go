@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 func getRequestBodyForAnthropicResponses(ctx context.Context, request *schemas.BifrostResponsesRequest, deployment string, providerName schemas.ModelProvider, isStreaming bool) ([]byte, *schemas.BifrostError) {
@@ -19,7 +19,7 @@ func getRequestBodyForAnthropicResponses(ctx context.Context, request *schemas.B
 		jsonBody = request.GetRawRequestBody()
 		// Unmarshal and check if model and region are present
 		var requestBody map[string]interface{}
-		if err := sonic.Unmarshal(jsonBody, &requestBody); err != nil {
+		if err := serialization.Unmarshal(jsonBody, &requestBody); err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrRequestBodyConversion, fmt.Errorf("failed to unmarshal request body: %w", err), providerName)
 		}
 		// Add max_tokens if not present
@@ -32,7 +32,7 @@ func getRequestBodyForAnthropicResponses(ctx context.Context, request *schemas.B
 		if isStreaming {
 			requestBody["stream"] = true
 		}
-		jsonBody, err = sonic.Marshal(requestBody)
+		jsonBody, err = serialization.Marshal(requestBody)
 		if err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
 		}
@@ -53,7 +53,7 @@ func getRequestBodyForAnthropicResponses(ctx context.Context, request *schemas.B
 		}
 
 		// Convert struct to map
-		jsonBody, err = sonic.Marshal(reqBody)
+		jsonBody, err = serialization.Marshal(reqBody)
 		if err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, fmt.Errorf("failed to marshal request body: %w", err), providerName)
 		}
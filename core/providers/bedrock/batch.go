@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/bytedance/sonic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // BedrockBatchJobRequest represents a request to create a batch inference job.
@@ -132,7 +132,9 @@ func ToBifrostBatchStatus(status string) schemas.BatchStatus {
 		return schemas.BatchStatusInProgress
 	case "Completed":
 		return schemas.BatchStatusCompleted
-	case "Failed", "PartiallyCompleted":
+	case "PartiallyCompleted":
+		return schemas.BatchStatusPartiallyCompleted
+	case "Failed":
 		return schemas.BatchStatusFailed
 	case "Stopping":
 		return schemas.BatchStatusCancelling
@@ -154,8 +156,8 @@ func parseBatchResultsJSONL(content []byte, provider *BedrockProvider) ([]schema
 
 	parseResult := providerUtils.ParseJSONL(content, func(line []byte) error {
 		var bedrockResult BedrockBatchResultRecord
-		if err := sonic.Unmarshal(line, &bedrockResult); err != nil {
-			provider.logger.Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
+		if err := serialization.Unmarshal(line, &bedrockResult); err != nil {
+			provider.Logger().Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
 			return err
 		}
 
@@ -315,6 +317,8 @@ func toBedrockBatchStatus(status schemas.BatchStatus) string {
 		fallthrough
 	case schemas.BatchStatusEnded:
 		return "Completed"
+	case schemas.BatchStatusPartiallyCompleted:
+		return "PartiallyCompleted"
 	case schemas.BatchStatusFailed:
 		return "Failed"
 	case schemas.BatchStatusCancelling:
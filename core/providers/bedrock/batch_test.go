@@ -0,0 +1,70 @@
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a partially completed batch is reported as such and that its results include both
+// the succeeded and the errored records
+func TestBatch_PartiallyCompleted(t *testing.T) {
+	status := ToBifrostBatchStatus("PartiallyCompleted")
+	if status != schemas.BatchStatusPartiallyCompleted {
+		t.Fatalf("expected status %s, got %s", schemas.BatchStatusPartiallyCompleted, status)
+	}
+	if got := toBedrockBatchStatus(status); got != "PartiallyCompleted" {
+		t.Errorf("expected PartiallyCompleted when converting back to Bedrock, got %s", got)
+	}
+	if got := ToBifrostBatchStatus("Failed"); got != schemas.BatchStatusFailed {
+		t.Errorf("expected Failed to stay %s, got %s", schemas.BatchStatusFailed, got)
+	}
+
+	provider := &BedrockProvider{}
+	output := []byte(`{"recordId":"record-1","modelInput":{},"modelOutput":{"stop_reason":"end_turn"}}
+{"recordId":"record-2","modelInput":{},"error":{"errorCode":400,"errorMessage":"Malformed input request"}}
+{"recordId":"record-3","modelInput":{},"modelOutput":{"stop_reason":"end_turn"}}
+`)
+	results, parseErrors := parseBatchResultsJSONL(output, provider)
+	if len(parseErrors) > 0 {
+		t.Fatalf("expected no parse errors, got %v", parseErrors)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for _, i := range []int{0, 2} {
+		if results[i].Error != nil || results[i].Response == nil || results[i].Response.StatusCode != 200 {
+			t.Errorf("expected record %s to have succeeded, got %+v", results[i].CustomID, results[i])
+		}
+	}
+	errored := results[1]
+	if errored.CustomID != "record-2" || errored.Error == nil || errored.Error.Code != "400" || errored.Response == nil || errored.Response.StatusCode != 400 {
+		t.Errorf("expected record-2 to carry its error, got %+v", errored)
+	}
+}
+
+// Test that an explicit input S3 location is used over the one derived from the output S3 URI
+func TestResolveBatchInputS3URI(t *testing.T) {
+	const outputS3URI = "s3://output-only-bucket/results/"
+	const inputKey = "bifrost-batch-input/job-1.jsonl"
+	keyConfig := &schemas.BedrockKeyConfig{BatchS3Config: &schemas.BatchS3Config{InputS3URI: "s3://key-input-bucket"}}
+
+	tests := []struct {
+		name        string
+		extraParams map[string]interface{}
+		keyConfig   *schemas.BedrockKeyConfig
+		expected    string
+	}{
+		{"derived from output", nil, &schemas.BedrockKeyConfig{}, "s3://output-only-bucket/bifrost-batch-input/job-1.jsonl"},
+		{"key config bucket", nil, keyConfig, "s3://key-input-bucket/bifrost-batch-input/job-1.jsonl"},
+		{"extra param prefix over key config", map[string]interface{}{"input_s3_uri": "s3://input-bucket/batches/"}, keyConfig, "s3://input-bucket/batches/bifrost-batch-input/job-1.jsonl"},
+		{"extra param bucket name", map[string]interface{}{"input_s3_uri": "input-bucket"}, nil, "s3://input-bucket/bifrost-batch-input/job-1.jsonl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveBatchInputS3URI(tt.extraParams, tt.keyConfig, outputS3URI, inputKey); got != tt.expected {
+				t.Errorf("expected input S3 URI %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
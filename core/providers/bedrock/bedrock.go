@@ -11,51 +11,28 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/bytedance/sonic"
 	"github.com/google/uuid"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	"github.com/maximhq/bifrost/core/providers/cohere"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // BedrockProvider implements the Provider interface for AWS Bedrock.
 type BedrockProvider struct {
-	logger               schemas.Logger                // Logger for provider operations
-	client               *http.Client                  // HTTP client for API requests
-	networkConfig        schemas.NetworkConfig         // Network configuration including extra headers
-	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
-	sendBackRawRequest   bool                          // Whether to include raw request in BifrostResponse
-	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
+	providerUtils.BaseProvider
+	client *http.Client // HTTP client for API requests
 }
 
 // bedrockChatResponsePool provides a pool for Bedrock response objects.
-var bedrockChatResponsePool = sync.Pool{
-	New: func() interface{} {
-		return &BedrockConverseResponse{}
-	},
-}
-
-// acquireBedrockChatResponse gets a Bedrock response from the pool and resets it.
-func acquireBedrockChatResponse() *BedrockConverseResponse {
-	resp := bedrockChatResponsePool.Get().(*BedrockConverseResponse)
-	*resp = BedrockConverseResponse{} // Reset the struct
-	return resp
-}
-
-// releaseBedrockChatResponse returns a Bedrock response to the pool.
-func releaseBedrockChatResponse(resp *BedrockConverseResponse) {
-	if resp != nil {
-		bedrockChatResponsePool.Put(resp)
-	}
-}
+var bedrockChatResponsePool = providerUtils.NewResponsePool[BedrockConverseResponse]()
 
 // NewBedrockProvider creates a new Bedrock provider instance.
 // It initializes the HTTP client with the provided configuration and sets up response pools.
@@ -63,28 +40,31 @@ func releaseBedrockChatResponse(resp *BedrockConverseResponse) {
 func NewBedrockProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*BedrockProvider, error) {
 	config.CheckAndSetDefaults()
 
-	client := &http.Client{Timeout: time.Second * time.Duration(config.NetworkConfig.DefaultRequestTimeoutInSeconds)}
+	tlsConfig, err := providerUtils.BuildTLSConfig(nil, config.NetworkConfig.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS configuration: %w", err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
 
-	// Pre-warm response pools
-	for i := 0; i < config.ConcurrencyAndBufferSize.Concurrency; i++ {
-		bedrockChatResponsePool.Put(&BedrockConverseResponse{})
+	baseProvider := providerUtils.NewBaseProvider(schemas.Bedrock, config, logger)
+	client := &http.Client{
+		Timeout: time.Second * time.Duration(config.NetworkConfig.DefaultRequestTimeoutInSeconds),
+		Transport: &s3MetricsTransport{
+			base:     newConnectionRetryTransport(transport, config.NetworkConfig, logger),
+			provider: baseProvider.GetProviderKey(),
+		},
 	}
 
+	// Pre-warm response pools
+	bedrockChatResponsePool.Prewarm(config.ConcurrencyAndBufferSize.Concurrency)
+
 	return &BedrockProvider{
-		logger:               logger,
-		client:               client,
-		networkConfig:        config.NetworkConfig,
-		customProviderConfig: config.CustomProviderConfig,
-		sendBackRawRequest:   config.SendBackRawRequest,
-		sendBackRawResponse:  config.SendBackRawResponse,
+		BaseProvider: baseProvider,
+		client:       client,
 	}, nil
 }
 
-// GetProviderKey returns the provider identifier for Bedrock.
-func (provider *BedrockProvider) GetProviderKey() schemas.ModelProvider {
-	return providerUtils.GetProviderName(schemas.Bedrock, provider.customProviderConfig)
-}
-
 // completeRequest sends a request to Bedrock's API and handles the response.
 // It constructs the API URL, sets up AWS authentication, and processes the response.
 // Returns the response body, request latency, or an error if the request fails.
@@ -109,7 +89,7 @@ func (provider *BedrockProvider) completeRequest(ctx context.Context, jsonData [
 	}
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeadersHTTP(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeadersHTTP(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// If Value is set, use API Key authentication - else use IAM role authentication
 	if key.Value != "" {
@@ -164,7 +144,7 @@ func (provider *BedrockProvider) completeRequest(ctx context.Context, jsonData [
 	if resp.StatusCode != http.StatusOK {
 		var errorResp BedrockError
 
-		if err := sonic.Unmarshal(body, &errorResp); err != nil {
+		if err := serialization.Unmarshal(body, &errorResp); err != nil {
 			return nil, latency, &schemas.BifrostError{
 				IsBifrostError: true,
 				StatusCode:     &resp.StatusCode,
@@ -175,12 +155,14 @@ func (provider *BedrockProvider) completeRequest(ctx context.Context, jsonData [
 			}
 		}
 
-		return nil, latency, &schemas.BifrostError{
+		bifrostErr := &schemas.BifrostError{
 			StatusCode: &resp.StatusCode,
 			Error: &schemas.ErrorField{
-				Message: errorResp.Message,
+				Message: bedrockErrorMessage(&errorResp, body),
 			},
 		}
+		providerUtils.ClassifyRateLimitError(bifrostErr, bedrockExceptionName(errorResp.Type, resp.Header))
+		return nil, latency, bifrostErr
 	}
 
 	return body, latency, nil
@@ -211,7 +193,7 @@ func (provider *BedrockProvider) makeStreamingRequest(ctx context.Context, jsonD
 	}
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeadersHTTP(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeadersHTTP(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// If Value is set, use API Key authentication - else use IAM role authentication
 	req.Header.Set("Accept", "application/vnd.amazon.eventstream")
@@ -245,7 +227,11 @@ func (provider *BedrockProvider) makeStreamingRequest(ctx context.Context, jsonD
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		resp.Body.Close()
-		return nil, deployment, providerUtils.NewProviderAPIError(fmt.Sprintf("HTTP error from %s: %d", providerName, resp.StatusCode), fmt.Errorf("%s", string(body)), resp.StatusCode, providerName, nil, nil)
+		bifrostErr := providerUtils.NewProviderAPIError(fmt.Sprintf("HTTP error from %s: %d", providerName, resp.StatusCode), fmt.Errorf("%s", string(body)), resp.StatusCode, providerName, nil, nil)
+		var errorResp BedrockError
+		serialization.Unmarshal(body, &errorResp)
+		providerUtils.ClassifyRateLimitError(bifrostErr, bedrockExceptionName(errorResp.Type, resp.Header))
+		return nil, deployment, bifrostErr
 	}
 
 	return resp, deployment, nil
@@ -381,7 +367,7 @@ func (provider *BedrockProvider) listModelsByKey(ctx context.Context, key schema
 	}
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeadersHTTP(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeadersHTTP(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// If Value is set, use API Key authentication - else use IAM role authentication
 	if key.Value != "" {
@@ -435,7 +421,7 @@ func (provider *BedrockProvider) listModelsByKey(ctx context.Context, key schema
 	if resp.StatusCode != http.StatusOK {
 		var errorResp BedrockError
 
-		if err := sonic.Unmarshal(responseBody, &errorResp); err != nil {
+		if err := serialization.Unmarshal(responseBody, &errorResp); err != nil {
 			return nil, &schemas.BifrostError{
 				IsBifrostError: true,
 				StatusCode:     &resp.StatusCode,
@@ -448,14 +434,14 @@ func (provider *BedrockProvider) listModelsByKey(ctx context.Context, key schema
 		return nil, &schemas.BifrostError{
 			StatusCode: &resp.StatusCode,
 			Error: &schemas.ErrorField{
-				Message: errorResp.Message,
+				Message: bedrockErrorMessage(&errorResp, responseBody),
 			},
 		}
 	}
 
 	// Parse Bedrock-specific response
 	bedrockResponse := &BedrockListModelsResponse{}
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, bedrockResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, bedrockResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -469,12 +455,12 @@ func (provider *BedrockProvider) listModelsByKey(ctx context.Context, key schema
 	response.ExtraFields.Latency = time.Since(startTime).Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -485,7 +471,7 @@ func (provider *BedrockProvider) listModelsByKey(ctx context.Context, key schema
 // It retrieves all foundation models available in Amazon Bedrock.
 // Requests are made concurrently for improved performance.
 func (provider *BedrockProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.ListModelsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.ListModelsRequest); err != nil {
 		return nil, err
 	}
 	return providerUtils.HandleMultipleListModelsRequests(
@@ -493,7 +479,7 @@ func (provider *BedrockProvider) ListModels(ctx context.Context, keys []schemas.
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -501,7 +487,7 @@ func (provider *BedrockProvider) ListModels(ctx context.Context, keys []schemas.
 // It formats the request, sends it to Bedrock, and processes the response.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *BedrockProvider) TextCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostTextCompletionRequest) (*schemas.BifrostTextCompletionResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.TextCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.TextCompletionRequest); err != nil {
 		return nil, err
 	}
 
@@ -531,14 +517,14 @@ func (provider *BedrockProvider) TextCompletion(ctx context.Context, key schemas
 	switch {
 	case schemas.IsAnthropicModel(deployment):
 		var response BedrockAnthropicTextResponse
-		if err := sonic.Unmarshal(body, &response); err != nil {
+		if err := serialization.Unmarshal(body, &response); err != nil {
 			return nil, providerUtils.NewBifrostOperationError("error parsing anthropic response", err, providerName)
 		}
 		bifrostResponse = response.ToBifrostTextCompletionResponse()
 
 	case schemas.IsMistralModel(deployment):
 		var response BedrockMistralTextResponse
-		if err := sonic.Unmarshal(body, &response); err != nil {
+		if err := serialization.Unmarshal(body, &response); err != nil {
 			return nil, providerUtils.NewBifrostOperationError("error parsing mistral response", err, providerName)
 		}
 		bifrostResponse = response.ToBifrostTextCompletionResponse()
@@ -555,14 +541,14 @@ func (provider *BedrockProvider) TextCompletion(ctx context.Context, key schemas
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&bifrostResponse.ExtraFields, jsonData)
 	}
 
 	// Parse raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		var rawResponse interface{}
-		if err := sonic.Unmarshal(body, &rawResponse); err != nil {
+		if err := serialization.Unmarshal(body, &rawResponse); err != nil {
 			return nil, providerUtils.NewBifrostOperationError("error parsing raw response", err, providerName)
 		}
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
@@ -575,7 +561,7 @@ func (provider *BedrockProvider) TextCompletion(ctx context.Context, key schemas
 // It formats the request, sends it to Bedrock, and processes the response.
 // Returns a channel of BifrostStream objects or an error if the request fails.
 func (provider *BedrockProvider) TextCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTextCompletionRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.TextCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.TextCompletionStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -621,8 +607,8 @@ func (provider *BedrockProvider) TextCompletionStream(ctx context.Context, postH
 					// End of stream - this is normal
 					break
 				}
-				provider.logger.Warn(fmt.Sprintf("Error decoding %s EventStream message: %v", providerName, err))
-				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TextCompletionStreamRequest, providerName, request.Model, provider.logger)
+				provider.Logger().Warn(fmt.Sprintf("Error decoding %s EventStream message: %v", providerName, err))
+				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TextCompletionStreamRequest, providerName, request.Model, provider.Logger())
 				return
 			}
 
@@ -638,11 +624,11 @@ func (provider *BedrockProvider) TextCompletionStream(ctx context.Context, postH
 						}
 						errMsg := string(message.Payload)
 						var bedrockErr BedrockError
-						if err := sonic.Unmarshal(message.Payload, &bedrockErr); err == nil && bedrockErr.Message != "" {
+						if err := serialization.Unmarshal(message.Payload, &bedrockErr); err == nil && bedrockErr.Message != "" {
 							errMsg = bedrockErr.Message
 						}
 						err := fmt.Errorf("%s stream %s: %s", providerName, excType, errMsg)
-						providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TextCompletionStreamRequest, providerName, request.Model, provider.logger)
+						providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TextCompletionStreamRequest, providerName, request.Model, provider.Logger())
 						return
 					}
 				}
@@ -651,9 +637,9 @@ func (provider *BedrockProvider) TextCompletionStream(ctx context.Context, postH
 				var chunkPayload struct {
 					Bytes []byte `json:"bytes"`
 				}
-				if err := sonic.Unmarshal(message.Payload, &chunkPayload); err != nil {
-					provider.logger.Debug(fmt.Sprintf("Failed to parse JSON from event buffer: %v, data: %s", err, string(message.Payload)))
-					providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TextCompletionStreamRequest, providerName, request.Model, provider.logger)
+				if err := serialization.Unmarshal(message.Payload, &chunkPayload); err != nil {
+					provider.Logger().Debug(fmt.Sprintf("Failed to parse JSON from event buffer: %v, data: %s", err, string(message.Payload)))
+					providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TextCompletionStreamRequest, providerName, request.Model, provider.Logger())
 					return
 				}
 
@@ -682,7 +668,7 @@ func (provider *BedrockProvider) TextCompletionStream(ctx context.Context, postH
 // It formats the request, sends it to Bedrock, and processes the response.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *BedrockProvider) ChatCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.ChatCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.ChatCompletionRequest); err != nil {
 		return nil, err
 	}
 
@@ -712,11 +698,11 @@ func (provider *BedrockProvider) ChatCompletion(ctx context.Context, key schemas
 	}
 
 	// pool the response
-	bedrockResponse := acquireBedrockChatResponse()
-	defer releaseBedrockChatResponse(bedrockResponse)
+	bedrockResponse := bedrockChatResponsePool.Acquire()
+	defer bedrockChatResponsePool.Release(bedrockResponse)
 
 	// Parse the response using the new Bedrock type
-	if err := sonic.Unmarshal(responseBody, bedrockResponse); err != nil {
+	if err := serialization.Unmarshal(responseBody, bedrockResponse); err != nil {
 		return nil, providerUtils.NewBifrostOperationError("failed to parse bedrock response", err, providerName)
 	}
 
@@ -734,14 +720,14 @@ func (provider *BedrockProvider) ChatCompletion(ctx context.Context, key schemas
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&bifrostResponse.ExtraFields, jsonData)
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		var rawResponse interface{}
-		if err := sonic.Unmarshal(responseBody, &rawResponse); err == nil {
+		if err := serialization.Unmarshal(responseBody, &rawResponse); err == nil {
 			bifrostResponse.ExtraFields.RawResponse = rawResponse
 		}
 	}
@@ -753,7 +739,7 @@ func (provider *BedrockProvider) ChatCompletion(ctx context.Context, key schemas
 // It formats the request, sends it to Bedrock, and processes the streaming response.
 // Returns a channel for streaming BifrostResponse objects or an error if the request fails.
 func (provider *BedrockProvider) ChatCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.ChatCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.ChatCompletionStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -803,8 +789,8 @@ func (provider *BedrockProvider) ChatCompletionStream(ctx context.Context, postH
 					// End of stream - this is normal
 					break
 				}
-				provider.logger.Warn(fmt.Sprintf("Error decoding %s EventStream message: %v", providerName, err))
-				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.logger)
+				provider.Logger().Warn(fmt.Sprintf("Error decoding %s EventStream message: %v", providerName, err))
+				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.Logger())
 				return
 			}
 
@@ -820,16 +806,16 @@ func (provider *BedrockProvider) ChatCompletionStream(ctx context.Context, postH
 						}
 						errMsg := string(message.Payload)
 						err := fmt.Errorf("%s stream %s: %s", providerName, excType, errMsg)
-						providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.logger)
+						providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.Logger())
 						return
 					}
 				}
 
 				// Parse the JSON event into our typed structure
 				var streamEvent BedrockStreamEvent
-				if err := sonic.Unmarshal(message.Payload, &streamEvent); err != nil {
-					provider.logger.Debug(fmt.Sprintf("Failed to parse JSON from event buffer: %v, data: %s", err, string(message.Payload)))
-					providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.logger)
+				if err := serialization.Unmarshal(message.Payload, &streamEvent); err != nil {
+					provider.Logger().Debug(fmt.Sprintf("Failed to parse JSON from event buffer: %v, data: %s", err, string(message.Payload)))
+					providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.Logger())
 					return
 				}
 
@@ -876,7 +862,7 @@ func (provider *BedrockProvider) ChatCompletionStream(ctx context.Context, postH
 						ModelRequested: request.Model,
 					}
 					ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.logger)
+					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.Logger())
 					return
 				}
 				if response != nil {
@@ -893,7 +879,7 @@ func (provider *BedrockProvider) ChatCompletionStream(ctx context.Context, postH
 					chunkIndex++
 					lastChunkTime = time.Now()
 
-					if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+					if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 						response.ExtraFields.RawResponse = string(message.Payload)
 					}
 
@@ -906,7 +892,7 @@ func (provider *BedrockProvider) ChatCompletionStream(ctx context.Context, postH
 		response := providerUtils.CreateBifrostChatCompletionChunkResponse(id, usage, finishReason, chunkIndex, schemas.ChatCompletionStreamRequest, providerName, request.Model)
 		response.ExtraFields.ModelDeployment = deployment
 		// Set raw request if enabled
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonData)
 		}
 		response.ExtraFields.Latency = time.Since(startTime).Milliseconds()
@@ -921,7 +907,7 @@ func (provider *BedrockProvider) ChatCompletionStream(ctx context.Context, postH
 // It formats the request, sends it to Anthropic, and processes the response.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *BedrockProvider) Responses(ctx context.Context, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.ResponsesRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.ResponsesRequest); err != nil {
 		return nil, err
 	}
 
@@ -951,11 +937,11 @@ func (provider *BedrockProvider) Responses(ctx context.Context, key schemas.Key,
 	}
 
 	// pool the response
-	bedrockResponse := acquireBedrockChatResponse()
-	defer releaseBedrockChatResponse(bedrockResponse)
+	bedrockResponse := bedrockChatResponsePool.Acquire()
+	defer bedrockChatResponsePool.Release(bedrockResponse)
 
 	// Parse the response using the new Bedrock type
-	if err := sonic.Unmarshal(responseBody, bedrockResponse); err != nil {
+	if err := serialization.Unmarshal(responseBody, bedrockResponse); err != nil {
 		return nil, providerUtils.NewBifrostOperationError("failed to parse bedrock response", err, providerName)
 	}
 
@@ -975,14 +961,14 @@ func (provider *BedrockProvider) Responses(ctx context.Context, key schemas.Key,
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&bifrostResponse.ExtraFields, jsonData)
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		var rawResponse interface{}
-		if err := sonic.Unmarshal(responseBody, &rawResponse); err == nil {
+		if err := serialization.Unmarshal(responseBody, &rawResponse); err == nil {
 			bifrostResponse.ExtraFields.RawResponse = rawResponse
 		}
 	}
@@ -994,7 +980,7 @@ func (provider *BedrockProvider) Responses(ctx context.Context, key schemas.Key,
 // It formats the request, sends it to Bedrock, and processes the streaming response.
 // Returns a channel for streaming BifrostResponse objects or an error if the request fails.
 func (provider *BedrockProvider) ResponsesStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.ResponsesStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.ResponsesStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -1056,14 +1042,14 @@ func (provider *BedrockProvider) ResponsesStream(ctx context.Context, postHookRu
 						chunkIndex++
 						lastChunkTime = time.Now()
 
-						if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+						if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 							finalResponse.ExtraFields.RawResponse = "{}" // Final event has no payload
 						}
 
 						if i == len(finalResponses)-1 {
 							// Set raw request if enabled
 							ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-							if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+							if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 								providerUtils.ParseAndSetRawRequest(&finalResponse.ExtraFields, jsonData)
 							}
 							finalResponse.ExtraFields.Latency = time.Since(startTime).Milliseconds()
@@ -1074,8 +1060,8 @@ func (provider *BedrockProvider) ResponsesStream(ctx context.Context, postHookRu
 					break
 				}
 				ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-				provider.logger.Warn(fmt.Sprintf("Error decoding %s EventStream message: %v", providerName, err))
-				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.logger)
+				provider.Logger().Warn(fmt.Sprintf("Error decoding %s EventStream message: %v", providerName, err))
+				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.Logger())
 				return
 			}
 
@@ -1091,16 +1077,16 @@ func (provider *BedrockProvider) ResponsesStream(ctx context.Context, postHookRu
 						}
 						errMsg := string(message.Payload)
 						err := fmt.Errorf("%s stream %s: %s", providerName, excType, errMsg)
-						providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.logger)
+						providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.Logger())
 						return
 					}
 				}
 
 				// Parse the JSON event into our typed structure
 				var streamEvent BedrockStreamEvent
-				if err := sonic.Unmarshal(message.Payload, &streamEvent); err != nil {
-					provider.logger.Debug(fmt.Sprintf("Failed to parse JSON from event buffer: %v, data: %s", err, string(message.Payload)))
-					providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.logger)
+				if err := serialization.Unmarshal(message.Payload, &streamEvent); err != nil {
+					provider.Logger().Debug(fmt.Sprintf("Failed to parse JSON from event buffer: %v, data: %s", err, string(message.Payload)))
+					providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.Logger())
 					return
 				}
 
@@ -1143,7 +1129,7 @@ func (provider *BedrockProvider) ResponsesStream(ctx context.Context, postHookRu
 						ModelRequested: request.Model,
 					}
 					ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.logger)
+					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.Logger())
 					return
 				}
 				for _, response := range responses {
@@ -1159,7 +1145,7 @@ func (provider *BedrockProvider) ResponsesStream(ctx context.Context, postHookRu
 						chunkIndex++
 						lastChunkTime = time.Now()
 
-						if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+						if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 							response.ExtraFields.RawResponse = string(message.Payload)
 						}
 
@@ -1176,7 +1162,7 @@ func (provider *BedrockProvider) ResponsesStream(ctx context.Context, postHookRu
 // Embedding generates embeddings for the given input text(s) using Amazon Bedrock.
 // Supports Titan and Cohere embedding models. Returns a BifrostResponse containing the embedding(s) and any error that occurred.
 func (provider *BedrockProvider) Embedding(ctx context.Context, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.EmbeddingRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.EmbeddingRequest); err != nil {
 		return nil, err
 	}
 
@@ -1236,7 +1222,7 @@ func (provider *BedrockProvider) Embedding(ctx context.Context, key schemas.Key,
 	switch modelType {
 	case "titan":
 		var titanResp BedrockTitanEmbeddingResponse
-		if err := sonic.Unmarshal(rawResponse, &titanResp); err != nil {
+		if err := serialization.Unmarshal(rawResponse, &titanResp); err != nil {
 			return nil, providerUtils.NewBifrostOperationError("error parsing Titan embedding response", err, providerName)
 		}
 		bifrostResponse = titanResp.ToBifrostEmbeddingResponse()
@@ -1244,7 +1230,7 @@ func (provider *BedrockProvider) Embedding(ctx context.Context, key schemas.Key,
 
 	case "cohere":
 		var cohereResp cohere.CohereEmbeddingResponse
-		if err := sonic.Unmarshal(rawResponse, &cohereResp); err != nil {
+		if err := serialization.Unmarshal(rawResponse, &cohereResp); err != nil {
 			return nil, providerUtils.NewBifrostOperationError("error parsing Cohere embedding response", err, providerName)
 		}
 		bifrostResponse = cohereResp.ToBifrostEmbeddingResponse()
@@ -1259,9 +1245,9 @@ func (provider *BedrockProvider) Embedding(ctx context.Context, key schemas.Key,
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		var rawResponseData interface{}
-		if err := sonic.Unmarshal(rawResponse, &rawResponseData); err == nil {
+		if err := serialization.Unmarshal(rawResponse, &rawResponseData); err == nil {
 			bifrostResponse.ExtraFields.RawResponse = rawResponseData
 		}
 	}
@@ -1292,9 +1278,9 @@ func (provider *BedrockProvider) TranscriptionStream(ctx context.Context, postHo
 // FileUpload uploads a file to S3 for Bedrock batch processing.
 func (provider *BedrockProvider) FileUpload(ctx context.Context, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
 
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.FileUploadRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.FileUploadRequest); err != nil {
 		if err.Error != nil {
-			provider.logger.Error("file upload operation not allowed: %s", err.Error.Message)
+			provider.Logger().Error("file upload operation not allowed: %s", err.Error.Message)
 		}
 		return nil, err
 	}
@@ -1302,7 +1288,7 @@ func (provider *BedrockProvider) FileUpload(ctx context.Context, key schemas.Key
 	providerName := provider.GetProviderKey()
 
 	if key.BedrockKeyConfig == nil {
-		provider.logger.Error("bedrock key config is is missing in file upload request")
+		provider.Logger().Error("bedrock key config is is missing in file upload request")
 		return nil, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
 	}
 
@@ -1326,7 +1312,7 @@ func (provider *BedrockProvider) FileUpload(ctx context.Context, key schemas.Key
 	}
 
 	if s3Bucket == "" {
-		provider.logger.Error("s3_bucket is required for Bedrock file operations (provide in storage_config.s3 or extra_params)")
+		provider.Logger().Error("s3_bucket is required for Bedrock file operations (provide in storage_config.s3 or extra_params)")
 		return nil, providerUtils.NewBifrostOperationError("s3_bucket is required for Bedrock file operations (provide in storage_config.s3 or extra_params)", nil, providerName)
 	}
 
@@ -1353,7 +1339,12 @@ func (provider *BedrockProvider) FileUpload(ctx context.Context, key schemas.Key
 		s3Key = cleanedPrefix + "/" + filename
 	}
 
-	provider.logger.Debug("uploading file to s3: %s", s3Key)
+	provider.Logger().Debug("uploading file to s3: %s", s3Key)
+
+	// Streamed content is uploaded with a multipart upload, as its size is unknown and may exceed memory
+	if request.FileReader != nil {
+		return provider.uploadFileMultipart(ctx, key, request, region, bucketName, s3Key, filename)
+	}
 
 	// Build S3 PUT request URL
 	// Escape each path segment individually to handle special characters while preserving "/"
@@ -1369,7 +1360,7 @@ func (provider *BedrockProvider) FileUpload(ctx context.Context, key schemas.Key
 
 	// Sign request for S3
 	if err := signAWSRequest(ctx, httpReq, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, region, "s3", providerName); err != nil {
-		provider.logger.Error("error signing request: %s", err.Error.Message)
+		provider.Logger().Error("error signing request: %s", err.Error.Message)
 		return nil, err
 	}
 
@@ -1394,7 +1385,7 @@ func (provider *BedrockProvider) FileUpload(ctx context.Context, key schemas.Key
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		body, _ := io.ReadAll(resp.Body)
-		provider.logger.Error("s3 upload failed: %d", resp.StatusCode)
+		provider.Logger().Error("s3 upload failed: %d", resp.StatusCode)
 		return nil, providerUtils.NewProviderAPIError(fmt.Sprintf("S3 upload failed: %s", string(body)), nil, resp.StatusCode, providerName, nil, nil)
 	}
 
@@ -1419,11 +1410,59 @@ func (provider *BedrockProvider) FileUpload(ctx context.Context, key schemas.Key
 	}, nil
 }
 
+// uploadFileMultipart uploads a streamed file to S3 with a multipart upload, see FileUpload.
+func (provider *BedrockProvider) uploadFileMultipart(ctx context.Context, key schemas.Key, request *schemas.BifrostFileUploadRequest, region, bucketName, s3Key, filename string) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	client, bifrostErr := newS3Client(ctx, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, region, providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	startTime := time.Now()
+	size, err := uploadToS3Multipart(ctx, client, bucketName, s3Key, request.FileReader, s3MultipartPartSize)
+	latency := time.Since(startTime)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil, &schemas.BifrostError{
+				IsBifrostError: false,
+				Error: &schemas.ErrorField{
+					Type:    schemas.Ptr(schemas.RequestCancelled),
+					Message: schemas.ErrRequestCancelled,
+					Error:   err,
+				},
+			}
+		}
+		provider.Logger().Error("s3 multipart upload failed: %v", err)
+		return nil, providerUtils.NewBifrostOperationError(fmt.Sprintf("S3 multipart upload failed: %v", err), err, providerName)
+	}
+
+	// Return S3 URI as the file ID
+	s3URI := fmt.Sprintf("s3://%s/%s", bucketName, s3Key)
+
+	return &schemas.BifrostFileUploadResponse{
+		ID:             s3URI,
+		Object:         "file",
+		Bytes:          size,
+		CreatedAt:      time.Now().Unix(),
+		Filename:       filename,
+		Purpose:        request.Purpose,
+		Status:         schemas.FileStatusProcessed,
+		StorageBackend: schemas.FileStorageS3,
+		StorageURI:     s3URI,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.FileUploadRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}, nil
+}
+
 // FileList lists files in the S3 bucket used for Bedrock batch processing from all provided keys.
 // FileList lists S3 files using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *BedrockProvider) FileList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.FileListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.FileListRequest); err != nil {
 		return nil, err
 	}
 
@@ -1459,7 +1498,7 @@ func (provider *BedrockProvider) FileList(ctx context.Context, keys []schemas.Ke
 	}
 
 	// Initialize serial pagination helper
-	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -1590,7 +1629,7 @@ func (provider *BedrockProvider) FileList(ctx context.Context, keys []schemas.Ke
 
 // FileRetrieve retrieves S3 object metadata for Bedrock batch processing by trying each key until found.
 func (provider *BedrockProvider) FileRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.FileRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.FileRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -1697,7 +1736,7 @@ func (provider *BedrockProvider) FileRetrieve(ctx context.Context, keys []schema
 
 // FileDelete deletes an S3 object used for Bedrock batch processing by trying each key until successful.
 func (provider *BedrockProvider) FileDelete(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.FileDeleteRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.FileDeleteRequest); err != nil {
 		return nil, err
 	}
 
@@ -1785,9 +1824,45 @@ func (provider *BedrockProvider) FileDelete(ctx context.Context, keys []schemas.
 	return nil, lastErr
 }
 
+// FileDownloadURL returns a presigned URL to download an S3 file (s3://bucket/key) directly from S3, valid for ttl.
+// The URL is signed with the key's credentials without checking that the object exists or can be read.
+func (provider *BedrockProvider) FileDownloadURL(ctx context.Context, key schemas.Key, fileID string, ttl time.Duration) (string, int64, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.FileContentRequest); err != nil {
+		return "", 0, err
+	}
+
+	providerName := provider.GetProviderKey()
+
+	if key.BedrockKeyConfig == nil {
+		return "", 0, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
+	}
+	bucketName, s3Key := parseS3URI(fileID)
+	if bucketName == "" || s3Key == "" {
+		return "", 0, providerUtils.NewBifrostOperationError("invalid S3 URI format, expected s3://bucket/key", nil, providerName)
+	}
+	if ttl <= 0 {
+		ttl = schemas.DefaultFileDownloadURLTTL
+	}
+	if ttl > s3MaxPresignTTL {
+		return "", 0, providerUtils.NewBifrostOperationError(fmt.Sprintf("download URL ttl %s exceeds the S3 maximum of %s", ttl, s3MaxPresignTTL), nil, providerName)
+	}
+
+	region := DefaultBedrockRegion
+	if key.BedrockKeyConfig.Region != nil {
+		region = *key.BedrockKeyConfig.Region
+	}
+
+	signedAt := time.Now()
+	url, bifrostErr := presignS3GetObject(ctx, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, region, bucketName, s3Key, ttl, providerName)
+	if bifrostErr != nil {
+		return "", 0, bifrostErr
+	}
+	return url, signedAt.Add(ttl).Unix(), nil
+}
+
 // FileContent downloads S3 object content for Bedrock batch processing by trying each key until found.
 func (provider *BedrockProvider) FileContent(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.FileContentRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.FileContentRequest); err != nil {
 		return nil, err
 	}
 
@@ -1886,15 +1961,15 @@ func (provider *BedrockProvider) FileContent(ctx context.Context, keys []schemas
 
 // BatchCreate creates a new batch inference job on AWS Bedrock.
 func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.BatchCreateRequest); err != nil {
-		provider.logger.Error("batch create is not allowed for Bedrock provider", "error", err)
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.BatchCreateRequest); err != nil {
+		provider.Logger().Error("batch create is not allowed for Bedrock provider", "error", err)
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
 	if key.BedrockKeyConfig == nil {
-		provider.logger.Error("bedrock key config is not provided")
+		provider.Logger().Error("bedrock key config is not provided")
 		return nil, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
 	}
 
@@ -1914,7 +1989,7 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 	}
 	// And if still we don't get role ARN
 	if roleArn == "" {
-		provider.logger.Error("role_arn is required for Bedrock batch API (provide in extra_params)")
+		provider.Logger().Error("role_arn is required for Bedrock batch API (provide in extra_params)")
 		return nil, providerUtils.NewBifrostOperationError("role_arn is required for Bedrock batch API (provide in extra_params)", nil, providerName)
 	}
 	// Get output S3 URI from extra params
@@ -1925,15 +2000,20 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 		}
 	}
 	if outputS3Uri == "" {
-		provider.logger.Error("output_s3_uri is required for Bedrock batch API (provide in extra_params)")
+		provider.Logger().Error("output_s3_uri is required for Bedrock batch API (provide in extra_params)")
 		return nil, providerUtils.NewBifrostOperationError("output_s3_uri is required for Bedrock batch API (provide in extra_params)", nil, providerName)
 	}
 
 	if request.Model == nil {
-		provider.logger.Error("model is required for Bedrock batch API")
+		provider.Logger().Error("model is required for Bedrock batch API")
 		return nil, providerUtils.NewBifrostOperationError("model is required for Bedrock batch API", nil, providerName)
 	}
 
+	// Model invocation jobs only run model invocations
+	if _, err := providerUtils.NormalizeBatchEndpoint(schemas.Bedrock, request.Endpoint); err != nil {
+		return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
+
 	// Get model ID
 
 	var modelID *string
@@ -1946,13 +2026,16 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 		modelID = request.Model
 	}
 
-	// Generate job name
-	jobName := fmt.Sprintf("bifrost-batch-%d", time.Now().Unix())
+	// Generate job name, an explicit job name takes precedence over the naming template
+	jobName, err := provider.BatchName(ctx, request, fmt.Sprintf("bifrost-batch-%d", time.Now().Unix()))
 	if request.Metadata != nil {
 		if name, ok := request.Metadata["job_name"]; ok {
-			jobName = name
+			jobName, err = name, nil
 		}
 	}
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
 
 	// Determine input file ID (S3 URI)
 	inputFileID := request.InputFileID
@@ -1974,8 +2057,8 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 		// Generate S3 key for the input file
 		inputKey := generateBatchInputS3Key(jobName)
 
-		// Derive bucket from output S3 URI
-		inputS3URI := deriveInputS3URIFromOutput(outputS3Uri, inputKey)
+		// Use the configured input location, or derive the bucket from the output S3 URI
+		inputS3URI := resolveBatchInputS3URI(request.ExtraParams, key.BedrockKeyConfig, outputS3Uri, inputKey)
 		bucket, s3Key := parseS3URI(inputS3URI)
 
 		// Upload to S3 using Bedrock credentials
@@ -1998,7 +2081,7 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 
 	// Validate that we have an input file ID (either provided or uploaded)
 	if inputFileID == "" {
-		provider.logger.Error("either input_file_id (S3 URI) or requests array is required for Bedrock batch API")
+		provider.Logger().Error("either input_file_id (S3 URI) or requests array is required for Bedrock batch API")
 		return nil, providerUtils.NewBifrostOperationError("either input_file_id (S3 URI) or requests array is required for Bedrock batch API", nil, providerName)
 	}
 
@@ -2028,7 +2111,7 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 		}
 	}
 
-	jsonData, err := sonic.Marshal(bedrockReq)
+	jsonData, err := serialization.Marshal(bedrockReq)
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
 	}
@@ -2076,14 +2159,14 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
 		var errorResp BedrockError
-		if err := sonic.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
+		if err := serialization.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
 			return nil, providerUtils.NewProviderAPIError(errorResp.Message, nil, resp.StatusCode, providerName, nil, nil)
 		}
 		return nil, providerUtils.NewProviderAPIError(string(body), nil, resp.StatusCode, providerName, nil, nil)
 	}
 
 	var bedrockResp BedrockBatchJobResponse
-	if err := sonic.Unmarshal(body, &bedrockResp); err != nil {
+	if err := serialization.Unmarshal(body, &bedrockResp); err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
@@ -2132,14 +2215,14 @@ func (provider *BedrockProvider) BatchCreate(ctx context.Context, key schemas.Ke
 // BatchList lists batch inference jobs using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *BedrockProvider) BatchList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.BatchListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.BatchListRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
 	// Initialize serial pagination helper (Bedrock uses PageToken for pagination)
-	helper, err := providerUtils.NewSerialListHelper(keys, request.PageToken, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.PageToken, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -2219,14 +2302,14 @@ func (provider *BedrockProvider) BatchList(ctx context.Context, keys []schemas.K
 
 	if resp.StatusCode != http.StatusOK {
 		var errorResp BedrockError
-		if err := sonic.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
+		if err := serialization.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
 			return nil, providerUtils.NewProviderAPIError(errorResp.Message, nil, resp.StatusCode, providerName, nil, nil)
 		}
 		return nil, providerUtils.NewProviderAPIError(string(body), nil, resp.StatusCode, providerName, nil, nil)
 	}
 
 	var bedrockResp BedrockBatchJobListResponse
-	if err := sonic.Unmarshal(body, &bedrockResp); err != nil {
+	if err := serialization.Unmarshal(body, &bedrockResp); err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
@@ -2309,19 +2392,19 @@ func (provider *BedrockProvider) fetchBatchManifest(ctx context.Context, key sch
 
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
-		provider.logger.Error("failed to create manifest request: %v", err)
+		provider.Logger().Error("failed to create manifest request: %v", err)
 		return nil
 	}
 
 	// Sign request for S3
 	if err := signAWSRequest(ctx, httpReq, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, region, "s3", provider.GetProviderKey()); err != nil {
-		provider.logger.Error("failed to sign manifest request: %v", err)
+		provider.Logger().Error("failed to sign manifest request: %v", err)
 		return nil
 	}
 
 	resp, err := provider.client.Do(httpReq)
 	if err != nil {
-		provider.logger.Error("failed to fetch manifest: %v", err)
+		provider.Logger().Error("failed to fetch manifest: %v", err)
 		return nil
 	}
 	defer resp.Body.Close()
@@ -2333,13 +2416,13 @@ func (provider *BedrockProvider) fetchBatchManifest(ctx context.Context, key sch
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		provider.logger.Debug("failed to read manifest body: %v", err)
+		provider.Logger().Debug("failed to read manifest body: %v", err)
 		return nil
 	}
 
 	var manifest BedrockBatchManifest
-	if err := sonic.Unmarshal(body, &manifest); err != nil {
-		provider.logger.Error("failed to parse manifest: %v", err)
+	if err := serialization.Unmarshal(body, &manifest); err != nil {
+		provider.Logger().Error("failed to parse manifest: %v", err)
 		return nil
 	}
 
@@ -2348,7 +2431,7 @@ func (provider *BedrockProvider) fetchBatchManifest(ctx context.Context, key sch
 
 // BatchRetrieve retrieves a specific batch inference job from AWS Bedrock by trying each key until found.
 func (provider *BedrockProvider) BatchRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.BatchRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.BatchRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -2414,7 +2497,7 @@ func (provider *BedrockProvider) BatchRetrieve(ctx context.Context, keys []schem
 
 		if resp.StatusCode != http.StatusOK {
 			var errorResp BedrockError
-			if err := sonic.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
+			if err := serialization.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
 				lastErr = providerUtils.NewProviderAPIError(errorResp.Message, nil, resp.StatusCode, providerName, nil, nil)
 			} else {
 				lastErr = providerUtils.NewProviderAPIError(string(body), nil, resp.StatusCode, providerName, nil, nil)
@@ -2423,7 +2506,7 @@ func (provider *BedrockProvider) BatchRetrieve(ctx context.Context, keys []schem
 		}
 
 		var bedrockResp BedrockBatchJobResponse
-		if err := sonic.Unmarshal(body, &bedrockResp); err != nil {
+		if err := serialization.Unmarshal(body, &bedrockResp); err != nil {
 			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 			continue
 		}
@@ -2497,7 +2580,7 @@ func (provider *BedrockProvider) BatchRetrieve(ctx context.Context, keys []schem
 
 // BatchCancel stops a batch inference job on AWS Bedrock by trying each key until successful.
 func (provider *BedrockProvider) BatchCancel(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.BatchCancelRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.BatchCancelRequest); err != nil {
 		return nil, err
 	}
 
@@ -2563,7 +2646,7 @@ func (provider *BedrockProvider) BatchCancel(ctx context.Context, keys []schemas
 
 		if resp.StatusCode != http.StatusOK {
 			var errorResp BedrockError
-			if err := sonic.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
+			if err := serialization.Unmarshal(body, &errorResp); err == nil && errorResp.Message != "" {
 				lastErr = providerUtils.NewProviderAPIError(errorResp.Message, nil, resp.StatusCode, providerName, nil, nil)
 			} else {
 				lastErr = providerUtils.NewProviderAPIError(string(body), nil, resp.StatusCode, providerName, nil, nil)
@@ -2611,7 +2694,7 @@ func (provider *BedrockProvider) BatchCancel(ctx context.Context, keys []schemas
 // For Bedrock, results are stored in S3 at the output S3 URI prefix.
 // The output includes JSONL files with results (*.jsonl.out) and a manifest file.
 func (provider *BedrockProvider) BatchResults(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.BatchResultsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.CustomProviderConfig(), schemas.BatchResultsRequest); err != nil {
 		return nil, err
 	}
 
@@ -2698,7 +2781,7 @@ func (provider *BedrockProvider) BatchResults(ctx context.Context, keys []schema
 				FileID:   file.ID,
 			})
 			if fileErr != nil {
-				provider.logger.Warn(fmt.Sprintf("failed to download batch result file %s: %v", file.ID, fileErr))
+				provider.Logger().Warn(fmt.Sprintf("failed to download batch result file %s: %v", file.ID, fileErr))
 				continue
 			}
 
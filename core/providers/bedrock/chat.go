@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/google/uuid"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // ToBedrockChatCompletionRequest converts a Bifrost request to Bedrock Converse API format
@@ -75,7 +75,7 @@ func (response *BedrockConverseResponse) ToBifrostChatResponse(ctx context.Conte
 				if structuredOutputToolName, ok := ctx.Value(schemas.BifrostContextKeyStructuredOutputToolName).(string); ok && contentBlock.ToolUse.Name == structuredOutputToolName {
 					// This is structured output - set contentStr and skip adding to toolCalls
 					if contentBlock.ToolUse.Input != nil {
-						if argBytes, err := sonic.Marshal(contentBlock.ToolUse.Input); err == nil {
+						if argBytes, err := serialization.Marshal(contentBlock.ToolUse.Input); err == nil {
 							jsonStr := string(argBytes)
 							contentStr = &jsonStr
 						} else {
@@ -89,7 +89,7 @@ func (response *BedrockConverseResponse) ToBifrostChatResponse(ctx context.Conte
 				// Regular tool call processing
 				var arguments string
 				if contentBlock.ToolUse.Input != nil {
-					if argBytes, err := sonic.Marshal(contentBlock.ToolUse.Input); err == nil {
+					if argBytes, err := serialization.Marshal(contentBlock.ToolUse.Input); err == nil {
 						arguments = string(argBytes)
 					} else {
 						arguments = fmt.Sprintf("%v", contentBlock.ToolUse.Input)
@@ -154,16 +154,22 @@ func (response *BedrockConverseResponse) ToBifrostChatResponse(ctx context.Conte
 		assistantMessage.Reasoning = schemas.Ptr(reasoningText)
 	}
 
+	message := &schemas.ChatMessage{
+		Role:                 schemas.ChatMessageRoleAssistant,
+		Content:              &messageContent,
+		ChatAssistantMessage: assistantMessage,
+	}
+	// Guardrail and content filter interventions return the blocked message as text, it is the refusal
+	if response.StopReason == BedrockStopReasonGuardrailIntervened || response.StopReason == BedrockStopReasonContentFiltered {
+		message.SetRefusal("Bedrock blocked the response")
+	}
+
 	// Create the response choice
 	choices := []schemas.BifrostResponseChoice{
 		{
 			Index: 0,
 			ChatNonStreamResponseChoice: &schemas.ChatNonStreamResponseChoice{
-				Message: &schemas.ChatMessage{
-					Role:                 schemas.ChatMessageRoleAssistant,
-					Content:              &messageContent,
-					ChatAssistantMessage: assistantMessage,
-				},
+				Message: message,
 			},
 			FinishReason: schemas.Ptr(anthropic.ConvertAnthropicFinishReasonToBifrost(anthropic.AnthropicStopReason(response.StopReason))),
 		},
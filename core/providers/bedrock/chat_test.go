@@ -0,0 +1,88 @@
+package bedrock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that tool results are sent as toolResult blocks, the results of parallel tool calls in a single user message
+func TestConvertMessages_ToolResults(t *testing.T) {
+	toolCall := func(id, city string) schemas.ChatAssistantMessageToolCall {
+		return schemas.ChatAssistantMessageToolCall{
+			ID:       schemas.Ptr(id),
+			Type:     schemas.Ptr("function"),
+			Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("get_weather"), Arguments: `{"city":"` + city + `"}`},
+		}
+	}
+	messages, _, err := convertMessages([]schemas.ChatMessage{
+		{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Weather in Paris and Rome?")}},
+		{
+			Role:                 schemas.ChatMessageRoleAssistant,
+			ChatAssistantMessage: &schemas.ChatAssistantMessage{ToolCalls: []schemas.ChatAssistantMessageToolCall{toolCall("call_1", "Paris"), toolCall("call_2", "Rome")}},
+		},
+		{
+			Role:            schemas.ChatMessageRoleTool,
+			Content:         &schemas.ChatMessageContent{ContentStr: schemas.Ptr(`{"temperature":20}`)},
+			ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: schemas.Ptr("call_1")},
+		},
+		{
+			Role:            schemas.ChatMessageRoleTool,
+			ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: schemas.Ptr("call_2")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to convert messages: %v", err)
+	}
+	if len(messages) != 3 {
+		t.Fatalf("Expected 3 messages, got %d", len(messages))
+	}
+	results := messages[2]
+	if results.Role != "user" || len(results.Content) != 2 {
+		t.Fatalf("Expected a user message with 2 tool results, got %+v", results)
+	}
+	first, second := results.Content[0].ToolResult, results.Content[1].ToolResult
+	if first == nil || first.ToolUseID != "call_1" || len(first.Content) != 1 {
+		t.Fatalf("Unexpected first tool result: %+v", first)
+	}
+	if output, ok := first.Content[0].JSON.(map[string]interface{}); !ok || output["temperature"] != float64(20) {
+		t.Errorf("Expected the JSON tool output to be parsed, got %+v", first.Content[0])
+	}
+	if second == nil || second.ToolUseID != "call_2" {
+		t.Errorf("Unexpected second tool result: %+v", second)
+	}
+}
+
+// Test that a response blocked by a guardrail is returned as a refusal carrying the guardrail's blocked message
+func TestToBifrostChatResponse_GuardrailRefusal(t *testing.T) {
+	response := &BedrockConverseResponse{
+		StopReason: BedrockStopReasonGuardrailIntervened,
+		Output: &BedrockConverseOutput{Message: &BedrockMessage{
+			Role:    BedrockMessageRoleAssistant,
+			Content: []BedrockContentBlock{{Text: schemas.Ptr("Sorry, the model cannot answer this question.")}},
+		}},
+	}
+
+	chatResponse, err := response.ToBifrostChatResponse(context.Background(), "anthropic.claude-3-haiku")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	message := chatResponse.Choices[0].Message
+	if message.ChatAssistantMessage == nil || message.ChatAssistantMessage.Refusal == nil || *message.ChatAssistantMessage.Refusal != "Sorry, the model cannot answer this question." {
+		t.Fatalf("Expected the blocked message as refusal, got %+v", message.ChatAssistantMessage)
+	}
+	if message.Content != nil {
+		t.Errorf("Expected no content besides the refusal, got %+v", message.Content)
+	}
+
+	ctx := context.Background()
+	responsesResponse, err := response.ToBifrostResponsesResponse(&ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	output := responsesResponse.Output
+	if len(output) != 1 || *output[0].Type != schemas.ResponsesMessageTypeRefusal {
+		t.Errorf("Expected a refusal output item, got %+v", output)
+	}
+}
@@ -0,0 +1,84 @@
+package bedrock
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that throttling and used up service quotas are told apart from the Bedrock exception name,
+// including ServiceQuotaExceededException which Bedrock returns as a 400
+func TestCompleteRequest_RateLimitKind(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		header     string
+		expected   schemas.RateLimitKind
+	}{
+		{
+			name:       "throttling from body",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{"__type":"ThrottlingException","message":"Too many requests, please wait before trying again."}`,
+			expected:   schemas.RateLimitKindRateLimited,
+		},
+		{
+			name:       "throttling from header",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{"message":"Too many requests, please wait before trying again."}`,
+			header:     "ThrottlingException:http://internal.amazon.com/coral/com.amazon.bedrock/",
+			expected:   schemas.RateLimitKindRateLimited,
+		},
+		{
+			name:       "service quota exceeded from body",
+			statusCode: http.StatusBadRequest,
+			body:       `{"__type":"com.amazonaws.bedrock#ServiceQuotaExceededException","message":"Your request exceeds the service quota for your account."}`,
+			expected:   schemas.RateLimitKindQuotaExhausted,
+		},
+		{
+			name:       "service quota exceeded from header",
+			statusCode: http.StatusBadRequest,
+			body:       `{"message":"Your request exceeds the service quota for your account."}`,
+			header:     "ServiceQuotaExceededException:http://internal.amazon.com/coral/com.amazon.bedrock/",
+			expected:   schemas.RateLimitKindQuotaExhausted,
+		},
+		{
+			name:       "validation error is not a rate limit",
+			statusCode: http.StatusBadRequest,
+			body:       `{"__type":"ValidationException","message":"Malformed input request."}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := newConnectionRetryTestProvider(t)
+			provider.client.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				header := http.Header{}
+				if tt.header != "" {
+					header.Set("x-amzn-ErrorType", tt.header)
+				}
+				return &http.Response{
+					StatusCode: tt.statusCode,
+					Header:     header,
+					Body:       io.NopCloser(strings.NewReader(tt.body)),
+					Request:    req,
+				}, nil
+			})
+
+			key := schemas.Key{Value: "test-key", BedrockKeyConfig: &schemas.BedrockKeyConfig{}}
+			_, _, bifrostErr := provider.completeRequest(context.Background(), []byte(`{}`), "test-model/converse", key)
+			if bifrostErr == nil {
+				t.Fatal("expected an error")
+			}
+			if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != tt.statusCode {
+				t.Errorf("expected status %d, got %v", tt.statusCode, bifrostErr.StatusCode)
+			}
+			if bifrostErr.RateLimitKind != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, bifrostErr.RateLimitKind)
+			}
+		})
+	}
+}
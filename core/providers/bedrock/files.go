@@ -7,8 +7,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // escapeS3KeyForURL escapes each segment of an S3 key path individually.
@@ -60,7 +60,7 @@ type S3Object struct {
 func parseS3ListResponse(body []byte, resp *S3ListObjectsResponse) error {
 	// S3 returns XML, so we need to parse it
 	// Try JSON first (some S3-compatible services return JSON)
-	if err := sonic.Unmarshal(body, resp); err == nil && len(resp.Contents) > 0 {
+	if err := serialization.Unmarshal(body, resp); err == nil && len(resp.Contents) > 0 {
 		return nil
 	}
 
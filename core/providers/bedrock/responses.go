@@ -7,11 +7,11 @@ import (
 	"sync"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/google/uuid"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // BedrockResponsesStreamState tracks state during streaming conversion for responses API
@@ -1600,6 +1600,13 @@ func ToBedrockResponsesRequest(ctx *context.Context, bifrostReq *schemas.Bifrost
 					}
 				}
 			}
+
+			// Handle request metadata
+			if reqMetadata, exists := bifrostReq.Params.ExtraParams["requestMetadata"]; exists {
+				if metadata, ok := reqMetadata.(map[string]string); ok {
+					bedrockReq.RequestMetadata = metadata
+				}
+			}
 		}
 
 		bedrockReq.InferenceConfig = inferenceConfig
@@ -1700,6 +1707,10 @@ func (response *BedrockConverseResponse) ToBifrostResponsesResponse(ctx *context
 		outputMessages := ConvertBedrockMessagesToBifrostMessages(ctx, []BedrockMessage{*response.Output.Message}, []BedrockSystemMessage{}, true)
 		bifrostResp.Output = outputMessages
 	}
+	// Guardrail and content filter interventions return the blocked message as text, it is the refusal
+	if response.StopReason == BedrockStopReasonGuardrailIntervened || response.StopReason == BedrockStopReasonContentFiltered {
+		bifrostResp.Output = schemas.SetResponsesRefusal(bifrostResp.Output, "Bedrock blocked the response")
+	}
 
 	if response.Usage != nil {
 		// Convert usage information
@@ -2162,7 +2173,7 @@ func ConvertBifrostMessagesToBedrockMessages(bifrostMessages []schemas.Responses
 					}
 					// Parse arguments
 					var input interface{}
-					if err := sonic.Unmarshal([]byte(toolCall.Arguments), &input); err != nil {
+					if err := serialization.Unmarshal([]byte(toolCall.Arguments), &input); err != nil {
 						input = map[string]interface{}{}
 					}
 					toolUseBlock.ToolUse.Input = input
@@ -2298,7 +2309,7 @@ func ConvertBifrostMessagesToBedrockMessages(bifrostMessages []schemas.Responses
 								},
 							}
 							var input interface{}
-							if err := sonic.Unmarshal([]byte(toolCall.Arguments), &input); err != nil {
+							if err := serialization.Unmarshal([]byte(toolCall.Arguments), &input); err != nil {
 								input = map[string]interface{}{}
 							}
 							toolUseBlock.ToolUse.Input = input
@@ -2366,7 +2377,7 @@ func ConvertBifrostMessagesToBedrockMessages(bifrostMessages []schemas.Responses
 						}
 						// Parse arguments
 						var input interface{}
-						if err := sonic.Unmarshal([]byte(toolCall.Arguments), &input); err != nil {
+						if err := serialization.Unmarshal([]byte(toolCall.Arguments), &input); err != nil {
 							input = map[string]interface{}{}
 						}
 						toolUseBlock.ToolUse.Input = input
@@ -4,27 +4,32 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/bytedance/sonic"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
-// uploadToS3 uploads content to an S3 bucket using the provided credentials.
-func uploadToS3(
+// s3MultipartPartSize is the size of the parts of S3 multipart uploads.
+// S3 requires at least 5 MiB for every part but the last one, and at most 10,000 parts (about 80 GiB with 8 MiB parts).
+const s3MultipartPartSize = 8 * 1024 * 1024
+
+// newS3Client creates an S3 client using the provided credentials, or the default credentials chain if they are empty.
+func newS3Client(
 	ctx context.Context,
 	accessKey, secretKey string,
 	sessionToken *string,
 	region string,
-	bucket, key string,
-	content []byte,
 	providerName schemas.ModelProvider,
-) *schemas.BifrostError {
+) (*s3.Client, *schemas.BifrostError) {
 	// Create AWS config with credentials
 	var cfg aws.Config
 	var err error
@@ -46,16 +51,60 @@ func uploadToS3(
 		// Use default credentials chain (IAM role, env vars, etc.)
 		cfg, err = config.LoadDefaultConfig(ctx, config.WithRegion(region))
 	}
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to load AWS config for S3", err, providerName)
+	}
 
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.HTTPClient = &s3MetricsHTTPClient{base: o.HTTPClient, provider: providerName}
+	}), nil
+}
+
+// s3MaxPresignTTL is the longest validity S3 accepts for presigned URLs signed with long-term credentials.
+// URLs signed with temporary credentials stop working when the credentials expire, whichever comes first.
+const s3MaxPresignTTL = 7 * 24 * time.Hour
+
+// presignS3GetObject returns a presigned URL to download an S3 object, valid for ttl.
+func presignS3GetObject(
+	ctx context.Context,
+	accessKey, secretKey string,
+	sessionToken *string,
+	region string,
+	bucket, key string,
+	ttl time.Duration,
+	providerName schemas.ModelProvider,
+) (string, *schemas.BifrostError) {
+	client, bifrostErr := newS3Client(ctx, accessKey, secretKey, sessionToken, region, providerName)
+	if bifrostErr != nil {
+		return "", bifrostErr
+	}
+	presigned, err := s3.NewPresignClient(client).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(ttl))
 	if err != nil {
-		return providerUtils.NewBifrostOperationError("failed to load AWS config for S3", err, providerName)
+		return "", providerUtils.NewBifrostOperationError("failed to presign S3 download URL", err, providerName)
 	}
+	return presigned.URL, nil
+}
 
-	// Create S3 client
-	client := s3.NewFromConfig(cfg)
+// uploadToS3 uploads content to an S3 bucket using the provided credentials.
+func uploadToS3(
+	ctx context.Context,
+	accessKey, secretKey string,
+	sessionToken *string,
+	region string,
+	bucket, key string,
+	content []byte,
+	providerName schemas.ModelProvider,
+) *schemas.BifrostError {
+	client, bifrostErr := newS3Client(ctx, accessKey, secretKey, sessionToken, region, providerName)
+	if bifrostErr != nil {
+		return bifrostErr
+	}
 
 	// Upload the content
-	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+	_, err := client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(bucket),
 		Key:         aws.String(key),
 		Body:        bytes.NewReader(content),
@@ -69,12 +118,119 @@ func uploadToS3(
 	return nil
 }
 
+// s3MultipartUploadAPI is the subset of the S3 client used by multipart uploads.
+type s3MultipartUploadAPI interface {
+	CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+// uploadToS3Multipart uploads the content read from the reader to S3 with a multipart upload, holding a single part
+// in memory at a time, so that content generated on the fly or larger than memory can be uploaded.
+// The upload is aborted if it fails, so that no incomplete parts are left in the bucket.
+// Returns the number of bytes uploaded.
+func uploadToS3Multipart(ctx context.Context, client s3MultipartUploadAPI, bucket, key string, content io.Reader, partSize int) (int64, error) {
+	created, err := client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String("application/octet-stream"),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+
+	size, err := uploadS3Parts(ctx, client, bucket, key, created.UploadId, content, partSize)
+	if err != nil {
+		// Abort with a fresh context, as the request context may be the cause of the failure
+		abortCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+		if _, abortErr := client.AbortMultipartUpload(abortCtx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucket),
+			Key:      aws.String(key),
+			UploadId: created.UploadId,
+		}); abortErr != nil {
+			return 0, fmt.Errorf("%w (failed to abort multipart upload: %v)", err, abortErr)
+		}
+		return 0, err
+	}
+	return size, nil
+}
+
+// uploadS3Parts uploads the content as the parts of a multipart upload and completes it.
+func uploadS3Parts(ctx context.Context, client s3MultipartUploadAPI, bucket, key string, uploadID *string, content io.Reader, partSize int) (int64, error) {
+	var size int64
+	var parts []types.CompletedPart
+	buf := make([]byte, partSize)
+	for partNumber := int32(1); ; partNumber++ {
+		n, readErr := io.ReadFull(content, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return 0, fmt.Errorf("failed to read file content: %w", readErr)
+		}
+		// An empty content is uploaded as a single empty part
+		if n == 0 && len(parts) > 0 {
+			break
+		}
+
+		uploaded, err := client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(bucket),
+			Key:        aws.String(key),
+			UploadId:   uploadID,
+			PartNumber: aws.Int32(partNumber),
+			Body:       bytes.NewReader(buf[:n]),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+		}
+		parts = append(parts, types.CompletedPart{ETag: uploaded.ETag, PartNumber: aws.Int32(partNumber)})
+		size += int64(n)
+
+		// A short read means the content is exhausted
+		if readErr != nil {
+			break
+		}
+	}
+
+	if _, err := client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+	}); err != nil {
+		return 0, fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	return size, nil
+}
+
 // generateBatchInputS3Key generates a unique S3 key for batch input files.
 func generateBatchInputS3Key(jobName string) string {
 	timestamp := time.Now().UnixNano()
 	return fmt.Sprintf("bifrost-batch-input/%s-%d.jsonl", jobName, timestamp)
 }
 
+// resolveBatchInputS3URI returns the S3 URI to upload a batch input file to. The input location is taken from
+// the input_s3_uri extra param, then from the key's batch S3 config, and is derived from the output S3 URI
+// if neither is set. An explicit location is a bucket or a prefix, e.g. s3://bucket or s3://bucket/batches/.
+func resolveBatchInputS3URI(extraParams map[string]interface{}, keyConfig *schemas.BedrockKeyConfig, outputS3URI, inputKey string) string {
+	inputS3URI := ""
+	if extraParams != nil {
+		if i, ok := extraParams["input_s3_uri"].(string); ok {
+			inputS3URI = i
+		}
+	}
+	if inputS3URI == "" && keyConfig != nil && keyConfig.BatchS3Config != nil {
+		inputS3URI = keyConfig.BatchS3Config.InputS3URI
+	}
+	if inputS3URI == "" {
+		return deriveInputS3URIFromOutput(outputS3URI, inputKey)
+	}
+	bucket, prefix := parseS3URI(inputS3URI)
+	if prefix = strings.Trim(prefix, "/"); prefix != "" {
+		return fmt.Sprintf("s3://%s/%s/%s", bucket, prefix, inputKey)
+	}
+	return fmt.Sprintf("s3://%s/%s", bucket, inputKey)
+}
+
 // deriveInputS3URIFromOutput derives an input S3 URI from the output S3 URI.
 // It uses the same bucket but with a different path for input files.
 func deriveInputS3URIFromOutput(outputS3URI, inputKey string) string {
@@ -120,7 +276,7 @@ func ConvertBedrockRequestsToJSONL(requests []schemas.BatchRequestItem, modelID
 		}
 
 		// Marshal the request as a JSON line
-		line, err := sonic.Marshal(bedrockReq)
+		line, err := serialization.Marshal(bedrockReq)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal batch request item %s: %w", req.CustomID, err)
 		}
@@ -0,0 +1,138 @@
+package bedrock
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// s3OperationKey identifies the S3 operations of one kind sent by a provider.
+type s3OperationKey struct {
+	provider  schemas.ModelProvider
+	operation string
+}
+
+// s3OperationCounters counts the S3 operations of one kind sent by a provider.
+type s3OperationCounters struct {
+	count    atomic.Uint64
+	errors   atomic.Uint64
+	duration atomic.Int64 // nanoseconds
+}
+
+// s3Operations holds the *s3OperationCounters of every provider and operation, shared by all Bifrost instances of
+// the process like the response pools.
+var s3Operations sync.Map
+
+// recordS3Operation counts an S3 request sent by a provider, as failed if it got no response or an error response.
+func recordS3Operation(provider schemas.ModelProvider, req *http.Request, duration time.Duration, resp *http.Response, err error) {
+	key := s3OperationKey{provider: provider, operation: s3OperationName(req)}
+	value, ok := s3Operations.Load(key)
+	if !ok {
+		value, _ = s3Operations.LoadOrStore(key, &s3OperationCounters{})
+	}
+	counters := value.(*s3OperationCounters)
+	counters.count.Add(1)
+	counters.duration.Add(int64(duration))
+	if err != nil || resp == nil || resp.StatusCode >= 400 {
+		counters.errors.Add(1)
+	}
+}
+
+// GetS3OperationStats returns the S3 operations sent by Bedrock providers so far, keyed by provider and operation
+// name (e.g. PutObject). It is intended to be polled by metrics exporters.
+func GetS3OperationStats() map[schemas.ModelProvider]map[string]schemas.S3OperationStats {
+	stats := make(map[schemas.ModelProvider]map[string]schemas.S3OperationStats)
+	s3Operations.Range(func(k, v any) bool {
+		key := k.(s3OperationKey)
+		counters := v.(*s3OperationCounters)
+		if stats[key.provider] == nil {
+			stats[key.provider] = make(map[string]schemas.S3OperationStats)
+		}
+		stats[key.provider][key.operation] = schemas.S3OperationStats{
+			Count:           counters.count.Load(),
+			Errors:          counters.errors.Load(),
+			DurationSeconds: time.Duration(counters.duration.Load()).Seconds(),
+		}
+		return true
+	})
+	return stats
+}
+
+// isS3Request reports whether a request is sent to S3 rather than to the Bedrock APIs.
+func isS3Request(req *http.Request) bool {
+	host := req.URL.Hostname()
+	return strings.HasSuffix(host, ".amazonaws.com") && (strings.HasPrefix(host, "s3.") || strings.Contains(host, ".s3."))
+}
+
+// s3OperationName returns the name of the S3 API operation of a request, as named in the S3 API reference.
+func s3OperationName(req *http.Request) string {
+	query := req.URL.Query()
+	_, multipart := query["uploadId"]
+	switch req.Method {
+	case http.MethodGet:
+		if query.Has("list-type") || strings.Trim(req.URL.Path, "/") == "" {
+			return "ListObjectsV2"
+		}
+		return "GetObject"
+	case http.MethodHead:
+		return "HeadObject"
+	case http.MethodPut:
+		if multipart {
+			return "UploadPart"
+		}
+		return "PutObject"
+	case http.MethodPost:
+		if query.Has("uploads") {
+			return "CreateMultipartUpload"
+		}
+		if multipart {
+			return "CompleteMultipartUpload"
+		}
+		if query.Has("delete") {
+			return "DeleteObjects"
+		}
+	case http.MethodDelete:
+		if multipart {
+			return "AbortMultipartUpload"
+		}
+		return "DeleteObject"
+	}
+	return req.Method
+}
+
+// s3MetricsTransport counts the S3 requests sent through the provider's HTTP client. Requests to the Bedrock APIs
+// are passed through as is.
+type s3MetricsTransport struct {
+	base     http.RoundTripper
+	provider schemas.ModelProvider
+}
+
+// RoundTrip sends the request, counting it if it is sent to S3.
+func (t *s3MetricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isS3Request(req) {
+		return t.base.RoundTrip(req)
+	}
+	startTime := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	recordS3Operation(t.provider, req, time.Since(startTime), resp, err)
+	return resp, err
+}
+
+// s3MetricsHTTPClient counts the requests sent by the S3 SDK client, one per attempt.
+type s3MetricsHTTPClient struct {
+	base     s3.HTTPClient
+	provider schemas.ModelProvider
+}
+
+// Do sends the request and counts it.
+func (c *s3MetricsHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	startTime := time.Now()
+	resp, err := c.base.Do(req)
+	recordS3Operation(c.provider, req, time.Since(startTime), resp, err)
+	return resp, err
+}
@@ -0,0 +1,101 @@
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// connectionRetryTransport retries idempotent requests (GET, HEAD) that failed with a connection error,
+// such as a connection reset, an unexpected EOF or a temporary DNS failure.
+// Requests that reached Bedrock and got an HTTP response, including throttling responses, are never retried
+// here: they are handled by the callers and by Bifrost's provider-level retries.
+type connectionRetryTransport struct {
+	base           http.RoundTripper
+	maxRetries     int
+	backoffInitial time.Duration
+	backoffMax     time.Duration
+	logger         schemas.Logger
+}
+
+// newConnectionRetryTransport wraps the base transport with connection-level retries configured by the network config.
+// The base transport is returned as is if connection retries are disabled.
+func newConnectionRetryTransport(base http.RoundTripper, networkConfig schemas.NetworkConfig, logger schemas.Logger) http.RoundTripper {
+	if networkConfig.ConnectionRetries <= 0 {
+		return base
+	}
+	return &connectionRetryTransport{
+		base:           base,
+		maxRetries:     networkConfig.ConnectionRetries,
+		backoffInitial: networkConfig.RetryBackoffInitial,
+		backoffMax:     networkConfig.RetryBackoffMax,
+		logger:         logger,
+	}
+}
+
+// RoundTrip sends the request, retrying it on connection errors if it is idempotent.
+func (t *connectionRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotentRequest(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err == nil || attempt >= t.maxRetries || !isRetryableConnectionError(err) {
+			return resp, err
+		}
+
+		backoff := t.backoff(attempt)
+		if t.logger != nil {
+			t.logger.Debug(fmt.Sprintf("retrying bedrock %s request after connection error (attempt %d/%d, backoff %v): %v", req.Method, attempt+1, t.maxRetries, backoff, err))
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// backoff returns the exponential backoff with jitter before the given retry.
+func (t *connectionRetryTransport) backoff(attempt int) time.Duration {
+	backoff := min(t.backoffInitial*time.Duration(1<<uint(attempt)), t.backoffMax)
+	// Add jitter (20%)
+	jitter := float64(backoff) * (0.8 + 0.4*rand.Float64())
+	return min(time.Duration(jitter), t.backoffMax)
+}
+
+// isIdempotentRequest reports whether the request can be safely sent again.
+// PUT and DELETE are idempotent per HTTP semantics but are not retried automatically, as S3 uploads and
+// deletions must not be repeated without the caller knowing.
+func isIdempotentRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody
+}
+
+// isRetryableConnectionError reports whether the error is a connection error worth retrying.
+// Cancellations and timeouts of the request itself are not retried.
+func isRetryableConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package bedrock
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// newConnectionResetServer returns a server resetting the connection of the first request and answering the next ones
+func newConnectionResetServer(t *testing.T, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("failed to hijack connection: %v", err)
+				return
+			}
+			// Closing with no linger sends a TCP reset
+			conn.(*net.TCPConn).SetLinger(0)
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{"modelSummaries":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newConnectionRetryTestProvider(t *testing.T) *BedrockProvider {
+	t.Helper()
+	provider, err := NewBedrockProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			RetryBackoffInitial: time.Millisecond,
+			RetryBackoffMax:     5 * time.Millisecond,
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+	return provider
+}
+
+// Test that a GET failing with a connection reset is retried and succeeds
+func TestConnectionRetry_GetRetriedOnConnectionReset(t *testing.T) {
+	var calls atomic.Int32
+	server := newConnectionResetServer(t, &calls)
+	provider := newConnectionRetryTestProvider(t)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/foundation-models", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	resp, err := provider.client.Do(req)
+	if err != nil {
+		t.Fatalf("expected the GET to succeed on retry, got %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), "modelSummaries") {
+		t.Errorf("unexpected response %d: %s", resp.StatusCode, body)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+// Test that non-idempotent requests are not retried on connection errors
+func TestConnectionRetry_PostNotRetried(t *testing.T) {
+	var calls atomic.Int32
+	server := newConnectionResetServer(t, &calls)
+	provider := newConnectionRetryTestProvider(t)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL+"/model-invocation-job", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if resp, err := provider.client.Do(req); err == nil {
+		resp.Body.Close()
+		t.Fatal("expected the POST to fail")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected a single attempt, got %d", got)
+	}
+}
+
+// Test that connection retries can be disabled
+func TestConnectionRetry_Disabled(t *testing.T) {
+	base := http.DefaultTransport
+	if transport := newConnectionRetryTransport(base, schemas.NetworkConfig{ConnectionRetries: -1}, nil); transport != base {
+		t.Errorf("expected the base transport when connection retries are disabled, got %T", transport)
+	}
+}
+
+// Test that the TLS config applies to the net/http client, refusing a server that only offers TLS 1.2
+func TestTLSConfig_RefusesDisallowedVersion(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"modelSummaries":[]}`))
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	for _, tc := range []struct {
+		minVersion string
+		wantErr    bool
+	}{
+		{minVersion: "1.3", wantErr: true},
+		{minVersion: "1.2", wantErr: false},
+	} {
+		provider, err := NewBedrockProvider(&schemas.ProviderConfig{
+			NetworkConfig: schemas.NetworkConfig{
+				ConnectionRetries: -1,
+				TLS:               &schemas.TLSConfig{MinVersion: tc.minVersion, InsecureSkipVerify: true},
+			},
+		}, nil)
+		if err != nil {
+			t.Fatalf("failed to create provider: %v", err)
+		}
+		resp, err := provider.client.Get(server.URL + "/foundation-models")
+		if err == nil {
+			resp.Body.Close()
+		}
+		if (err != nil) != tc.wantErr {
+			t.Errorf("min version %s: expected error %v, got %v", tc.minVersion, tc.wantErr, err)
+		}
+	}
+}
+
+// Test that an invalid TLS config is rejected when creating the provider
+func TestTLSConfig_Invalid(t *testing.T) {
+	_, err := NewBedrockProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{TLS: &schemas.TLSConfig{MinVersion: "1.0"}},
+	}, nil)
+	if err == nil {
+		t.Error("expected an error for an unsupported TLS min version")
+	}
+}
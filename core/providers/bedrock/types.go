@@ -3,8 +3,8 @@ package bedrock
 import (
 	"encoding/json"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // DefaultBedrockRegion is the default region for Bedrock
@@ -110,13 +110,13 @@ func (r *BedrockConverseRequest) UnmarshalJSON(data []byte) error {
 		Alias: (*Alias)(r),
 	}
 
-	if err := sonic.Unmarshal(data, aux); err != nil {
+	if err := serialization.Unmarshal(data, aux); err != nil {
 		return err
 	}
 
 	// Parse JSON to extract unknown fields
 	var rawData map[string]json.RawMessage
-	if err := sonic.Unmarshal(data, &rawData); err != nil {
+	if err := serialization.Unmarshal(data, &rawData); err != nil {
 		return err
 	}
 
@@ -129,7 +129,7 @@ func (r *BedrockConverseRequest) UnmarshalJSON(data []byte) error {
 	for key, value := range rawData {
 		if !bedrockConverseRequestKnownFields[key] {
 			var v interface{}
-			if err := sonic.Unmarshal(value, &v); err != nil {
+			if err := serialization.Unmarshal(value, &v); err != nil {
 				continue // Skip fields that can't be unmarshaled
 			}
 			r.ExtraParams[key] = v
@@ -353,6 +353,12 @@ type BedrockMistralTextResponse struct {
 	} `json:"outputs"` // Array of output choices
 }
 
+// Stop reasons of Converse responses blocked by a guardrail or a content filter
+const (
+	BedrockStopReasonGuardrailIntervened = "guardrail_intervened"
+	BedrockStopReasonContentFiltered     = "content_filtered"
+)
+
 // BedrockConverseResponse represents a Bedrock Converse API response
 type BedrockConverseResponse struct {
 	Output                        *BedrockConverseOutput    `json:"output"`                                  // Required: Response output
@@ -4,12 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // convertParameters handles parameter conversion
@@ -265,7 +266,7 @@ func convertMessages(bifrostMessages []schemas.ChatMessage) ([]BedrockMessage, [
 	var messages []BedrockMessage
 	var systemMessages []BedrockSystemMessage
 
-	for _, msg := range bifrostMessages {
+	for i, msg := range bifrostMessages {
 		switch msg.Role {
 		case schemas.ChatMessageRoleSystem:
 			// Convert system message
@@ -289,6 +290,12 @@ func convertMessages(bifrostMessages []schemas.ChatMessage) ([]BedrockMessage, [
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to convert tool message: %w", err)
 			}
+			// Results of parallel tool calls must be sent in a single user message
+			if i > 0 && bifrostMessages[i-1].Role == schemas.ChatMessageRoleTool && len(messages) > 0 {
+				last := &messages[len(messages)-1]
+				last.Content = append(last.Content, bedrockMsg.Content...)
+				continue
+			}
 			messages = append(messages, bedrockMsg)
 
 		default:
@@ -369,7 +376,9 @@ func convertToolMessage(msg schemas.ChatMessage) (BedrockMessage, error) {
 
 	// Convert content to tool result
 	var toolResultContent []BedrockContentBlock
-	if msg.Content.ContentStr != nil {
+	if msg.Content == nil {
+		// No output to convert, the tool result is sent without content
+	} else if msg.Content.ContentStr != nil {
 		// Bedrock expects JSON to be a parsed object, not a string
 		// Try to unmarshal the string content as JSON
 		var parsedOutput interface{}
@@ -862,7 +871,7 @@ func convertToolCallToContentBlock(toolCall schemas.ChatAssistantMessageToolCall
 
 	// Parse JSON arguments to object
 	var input interface{}
-	if err := sonic.Unmarshal([]byte(toolCall.Function.Arguments), &input); err != nil {
+	if err := serialization.Unmarshal([]byte(toolCall.Function.Arguments), &input); err != nil {
 		input = map[string]interface{}{} // Fallback to empty object
 	}
 
@@ -875,6 +884,32 @@ func convertToolCallToContentBlock(toolCall schemas.ChatAssistantMessageToolCall
 	}
 }
 
+// bedrockExceptionName returns the name of the exception of a Bedrock error response (e.g. ThrottlingException),
+// from the __type of the body or else the x-amzn-ErrorType header, which may be qualified as
+// "com.amazonaws.bedrock#ThrottlingException" or "ThrottlingException:http://internal.amazon.com/...".
+func bedrockExceptionName(errorType string, header http.Header) string {
+	if errorType == "" {
+		errorType = header.Get("X-Amzn-Errortype")
+	}
+	if _, name, found := strings.Cut(errorType, "#"); found {
+		errorType = name
+	}
+	name, _, _ := strings.Cut(errorType, ":")
+	return name
+}
+
+// bedrockErrorMessage returns the message of a Bedrock error, extracted from the other shapes of error bodies
+// (e.g. from a proxy or an S3 endpoint) when it is not in the Bedrock one.
+func bedrockErrorMessage(errorResp *BedrockError, body []byte) string {
+	if errorResp.Message != "" {
+		return errorResp.Message
+	}
+	if info := providerUtils.ExtractProviderError(body); info != nil {
+		return info.ErrorField().Message
+	}
+	return ""
+}
+
 // ToBedrockError converts a BifrostError to BedrockError
 // This is a standalone function similar to ToAnthropicChatCompletionError
 func ToBedrockError(bifrostErr *schemas.BifrostError) *BedrockError {
@@ -3,7 +3,6 @@ package cerebras
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/maximhq/bifrost/core/providers/openai"
@@ -14,11 +13,8 @@ import (
 
 // CerebrasProvider implements the Provider interface for Cerebras's API.
 type CerebrasProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewCerebrasProvider creates a new Cerebras provider instance.
@@ -37,40 +33,34 @@ func NewCerebrasProvider(config *schemas.ProviderConfig, logger schemas.Logger)
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://api.cerebras.ai"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &CerebrasProvider{
-		logger:              logger,
-		client:              client,
-		networkConfig:       config.NetworkConfig,
-		sendBackRawRequest:  config.SendBackRawRequest,
-		sendBackRawResponse: config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Cerebras, config, logger),
+		client:       client,
 	}, nil
 }
 
-// GetProviderKey returns the provider identifier for Cerebras.
-func (provider *CerebrasProvider) GetProviderKey() schemas.ModelProvider {
-	return schemas.Cerebras
-}
-
 // ListModels performs a list models request to Cerebras's API.
 func (provider *CerebrasProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
 	return openai.HandleOpenAIListModelsRequest(
 		ctx,
 		provider.client,
 		request,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/models"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/models"),
 		keys,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -81,14 +71,14 @@ func (provider *CerebrasProvider) TextCompletion(ctx context.Context, key schema
 	return openai.HandleOpenAITextCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/completions"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/completions"),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -104,16 +94,16 @@ func (provider *CerebrasProvider) TextCompletionStream(ctx context.Context, post
 	return openai.HandleOpenAITextCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+"/v1/completions",
+		provider.NetworkConfig().BaseURL+"/v1/completions",
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -122,14 +112,14 @@ func (provider *CerebrasProvider) ChatCompletion(ctx context.Context, key schema
 	return openai.HandleOpenAIChatCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -146,18 +136,18 @@ func (provider *CerebrasProvider) ChatCompletionStream(ctx context.Context, post
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+"/v1/chat/completions",
+		provider.NetworkConfig().BaseURL+"/v1/chat/completions",
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		schemas.Cerebras,
 		postHookRunner,
 		nil,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
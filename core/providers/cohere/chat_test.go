@@ -0,0 +1,52 @@
+package cohere
+
+import (
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that tool results are sent as tool messages referencing their tool call, and converted back
+func TestToCohereChatCompletionRequest_ToolResultRoundTrip(t *testing.T) {
+	req, err := ToCohereChatCompletionRequest(&schemas.BifrostChatRequest{
+		Provider: schemas.Cohere,
+		Model:    "command-a-03-2025",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("What is the weather in Paris?")}},
+			{
+				Role: schemas.ChatMessageRoleAssistant,
+				ChatAssistantMessage: &schemas.ChatAssistantMessage{ToolCalls: []schemas.ChatAssistantMessageToolCall{{
+					ID:       schemas.Ptr("call_1"),
+					Type:     schemas.Ptr("function"),
+					Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("get_weather"), Arguments: `{"city":"Paris"}`},
+				}}},
+			},
+			{
+				Role:            schemas.ChatMessageRoleTool,
+				Content:         &schemas.ChatMessageContent{ContentStr: schemas.Ptr(`{"temperature":20}`)},
+				ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: schemas.Ptr("call_1")},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to convert request: %v", err)
+	}
+	if len(req.Messages) != 3 {
+		t.Fatalf("Expected 3 messages, got %+v", req)
+	}
+	result := req.Messages[2]
+	if result.Role != "tool" || result.ToolCallID == nil || *result.ToolCallID != "call_1" {
+		t.Fatalf("Expected a tool message for call_1, got %+v", result)
+	}
+	if result.Content == nil || result.Content.GetString() == nil || *result.Content.GetString() != `{"temperature":20}` {
+		t.Errorf("Expected the tool output as content, got %+v", result.Content)
+	}
+
+	message := result.ToBifrostChatMessage()
+	if message.Role != schemas.ChatMessageRoleTool || message.ChatToolMessage == nil || *message.ChatToolMessage.ToolCallID != "call_1" {
+		t.Fatalf("Expected the tool message to convert back, got %+v", message)
+	}
+	if message.Content == nil || message.Content.ContentStr == nil || *message.Content.ContentStr != `{"temperature":20}` {
+		t.Errorf("Expected the tool output to convert back, got %+v", message.Content)
+	}
+}
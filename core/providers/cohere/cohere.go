@@ -7,13 +7,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"net/http"
 	"net/url"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
@@ -22,55 +21,15 @@ import (
 )
 
 // cohereResponsePool provides a pool for Cohere v2 response objects.
-var cohereResponsePool = sync.Pool{
-	New: func() interface{} {
-		return &CohereChatResponse{}
-	},
-}
+var cohereResponsePool = providerUtils.NewResponsePool[CohereChatResponse]()
 
 // cohereEmbeddingResponsePool provides a pool for Cohere embedding response objects.
-var cohereEmbeddingResponsePool = sync.Pool{
-	New: func() interface{} {
-		return &CohereEmbeddingResponse{}
-	},
-}
-
-// acquireCohereEmbeddingResponse gets a Cohere embedding response from the pool and resets it.
-func acquireCohereEmbeddingResponse() *CohereEmbeddingResponse {
-	resp := cohereEmbeddingResponsePool.Get().(*CohereEmbeddingResponse)
-	*resp = CohereEmbeddingResponse{} // Reset the struct
-	return resp
-}
-
-// releaseCohereEmbeddingResponse returns a Cohere embedding response to the pool.
-func releaseCohereEmbeddingResponse(resp *CohereEmbeddingResponse) {
-	if resp != nil {
-		cohereEmbeddingResponsePool.Put(resp)
-	}
-}
-
-// acquireCohereResponse gets a Cohere v2 response from the pool and resets it.
-func acquireCohereResponse() *CohereChatResponse {
-	resp := cohereResponsePool.Get().(*CohereChatResponse)
-	*resp = CohereChatResponse{} // Reset the struct
-	return resp
-}
-
-// releaseCohereResponse returns a Cohere v2 response to the pool.
-func releaseCohereResponse(resp *CohereChatResponse) {
-	if resp != nil {
-		cohereResponsePool.Put(resp)
-	}
-}
+var cohereEmbeddingResponsePool = providerUtils.NewResponsePool[CohereEmbeddingResponse]()
 
 // CohereProvider implements the Provider interface for Cohere.
 type CohereProvider struct {
-	logger               schemas.Logger                // Logger for provider operations
-	client               *fasthttp.Client              // HTTP client for API requests
-	networkConfig        schemas.NetworkConfig         // Network configuration including extra headers
-	sendBackRawRequest   bool                          // Whether to include raw request in BifrostResponse
-	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
-	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewCohereProvider creates a new Cohere provider instance.
@@ -89,39 +48,25 @@ func NewCohereProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*
 
 	// Setting proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Pre-warm response pools
-	for i := 0; i < config.ConcurrencyAndBufferSize.Concurrency; i++ {
-		cohereResponsePool.Put(&CohereChatResponse{})
-		cohereEmbeddingResponsePool.Put(&CohereEmbeddingResponse{})
-	}
+	cohereResponsePool.Prewarm(config.ConcurrencyAndBufferSize.Concurrency)
+	cohereEmbeddingResponsePool.Prewarm(config.ConcurrencyAndBufferSize.Concurrency)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://api.cohere.ai"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &CohereProvider{
-		logger:               logger,
-		client:               client,
-		networkConfig:        config.NetworkConfig,
-		customProviderConfig: config.CustomProviderConfig,
-		sendBackRawRequest:   config.SendBackRawRequest,
-		sendBackRawResponse:  config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Cohere, config, logger),
+		client:       client,
 	}, nil
 }
 
-// GetProviderKey returns the provider identifier for Cohere.
-func (provider *CohereProvider) GetProviderKey() schemas.ModelProvider {
-	return providerUtils.GetProviderName(schemas.Cohere, provider.customProviderConfig)
-}
-
-// buildRequestURL constructs the full request URL using the provider's configuration.
-func (provider *CohereProvider) buildRequestURL(ctx context.Context, defaultPath string, requestType schemas.RequestType) string {
-	return provider.networkConfig.BaseURL + providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType)
-}
-
 // completeRequest sends a request to Cohere's API and handles the response.
 // It constructs the API URL, sets up authentication, and processes the response.
 // Returns the response body or an error if the request fails.
@@ -133,7 +78,7 @@ func (provider *CohereProvider) completeRequest(ctx context.Context, jsonData []
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	req.SetRequestURI(url)
 	req.Header.SetMethod(http.MethodPost)
@@ -152,7 +97,7 @@ func (provider *CohereProvider) completeRequest(ctx context.Context, jsonData []
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", provider.GetProviderKey(), string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", provider.GetProviderKey(), string(resp.Body())))
 		return nil, latency, parseCohereError(resp, meta)
 	}
 
@@ -180,7 +125,7 @@ func (provider *CohereProvider) listModelsByKey(ctx context.Context, key schemas
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Build query parameters
 	params := url.Values{}
@@ -195,7 +140,7 @@ func (provider *CohereProvider) listModelsByKey(ctx context.Context, key schemas
 	}
 
 	// Build URL
-	req.SetRequestURI(provider.buildRequestURL(ctx, fmt.Sprintf("/v1/models?%s", params.Encode()), schemas.ListModelsRequest))
+	req.SetRequestURI(provider.BuildRequestURL(ctx, fmt.Sprintf("/v1/models?%s", params.Encode()), schemas.ListModelsRequest))
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
 	if key.Value != "" {
@@ -223,7 +168,7 @@ func (provider *CohereProvider) listModelsByKey(ctx context.Context, key schemas
 
 	// Parse Cohere list models response
 	var cohereResponse CohereListModelsResponse
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &cohereResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &cohereResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -234,12 +179,12 @@ func (provider *CohereProvider) listModelsByKey(ctx context.Context, key schemas
 	response.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -249,10 +194,10 @@ func (provider *CohereProvider) listModelsByKey(ctx context.Context, key schemas
 // ListModels performs a list models request to Cohere's API.
 // Requests are made concurrently for improved performance.
 func (provider *CohereProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.customProviderConfig, schemas.ListModelsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.CustomProviderConfig(), schemas.ListModelsRequest); err != nil {
 		return nil, err
 	}
-	if provider.customProviderConfig != nil && provider.customProviderConfig.IsKeyLess {
+	if provider.CustomProviderConfig() != nil && provider.CustomProviderConfig().IsKeyLess {
 		return provider.listModelsByKey(ctx, schemas.Key{}, request)
 	}
 	return providerUtils.HandleMultipleListModelsRequests(
@@ -260,7 +205,7 @@ func (provider *CohereProvider) ListModels(ctx context.Context, keys []schemas.K
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -282,7 +227,7 @@ func (provider *CohereProvider) TextCompletionStream(ctx context.Context, postHo
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *CohereProvider) ChatCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
 	// Check if chat completion is allowed
-	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.customProviderConfig, schemas.ChatCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.CustomProviderConfig(), schemas.ChatCompletionRequest); err != nil {
 		return nil, err
 	}
 
@@ -296,7 +241,7 @@ func (provider *CohereProvider) ChatCompletion(ctx context.Context, key schemas.
 		return nil, err
 	}
 
-	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.buildRequestURL(ctx, "/v2/chat", schemas.ChatCompletionRequest), key.Value, &providerUtils.RequestMetadata{
+	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.BuildRequestURL(ctx, "/v2/chat", schemas.ChatCompletionRequest), key.Value, &providerUtils.RequestMetadata{
 		Provider:    provider.GetProviderKey(),
 		Model:       request.Model,
 		RequestType: schemas.ChatCompletionRequest,
@@ -306,10 +251,10 @@ func (provider *CohereProvider) ChatCompletion(ctx context.Context, key schemas.
 	}
 
 	// Create response object from pool
-	response := acquireCohereResponse()
-	defer releaseCohereResponse(response)
+	response := cohereResponsePool.Acquire()
+	defer cohereResponsePool.Release(response)
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -323,12 +268,12 @@ func (provider *CohereProvider) ChatCompletion(ctx context.Context, key schemas.
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -340,7 +285,7 @@ func (provider *CohereProvider) ChatCompletion(ctx context.Context, key schemas.
 // Returns a channel containing BifrostResponse objects representing the stream or an error if the request fails.
 func (provider *CohereProvider) ChatCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if chat completion stream is allowed
-	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.customProviderConfig, schemas.ChatCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.CustomProviderConfig(), schemas.ChatCompletionStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -367,11 +312,11 @@ func (provider *CohereProvider) ChatCompletionStream(ctx context.Context, postHo
 	defer fasthttp.ReleaseRequest(req)
 
 	req.Header.SetMethod(http.MethodPost)
-	req.SetRequestURI(provider.buildRequestURL(ctx, "/v2/chat", schemas.ChatCompletionStreamRequest))
+	req.SetRequestURI(provider.BuildRequestURL(ctx, "/v2/chat", schemas.ChatCompletionStreamRequest))
 	req.Header.SetContentType("application/json")
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Set headers
 	if key.Value != "" {
@@ -443,14 +388,14 @@ func (provider *CohereProvider) ChatCompletionStream(ctx context.Context, postHo
 
 				// Handle [DONE] marker
 				if strings.TrimSpace(eventData) == "[DONE]" {
-					provider.logger.Debug("Received [DONE] marker, ending stream")
+					provider.Logger().Debug("Received [DONE] marker, ending stream")
 					return
 				}
 
 				// Parse the unified streaming event
 				var event CohereStreamEvent
-				if err := sonic.Unmarshal([]byte(eventData), &event); err != nil {
-					provider.logger.Warn(fmt.Sprintf("Failed to parse stream event: %v", err))
+				if err := serialization.Unmarshal([]byte(eventData), &event); err != nil {
+					provider.Logger().Warn(fmt.Sprintf("Failed to parse stream event: %v", err))
 					continue
 				}
 
@@ -467,7 +412,7 @@ func (provider *CohereProvider) ChatCompletionStream(ctx context.Context, postHo
 						ModelRequested: request.Model,
 					}
 					ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.logger)
+					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.Logger())
 					break
 				}
 				if response != nil {
@@ -483,13 +428,13 @@ func (provider *CohereProvider) ChatCompletionStream(ctx context.Context, postHo
 					lastChunkTime = time.Now()
 					chunkIndex++
 
-					if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+					if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 						response.ExtraFields.RawResponse = eventData
 					}
 
 					if isLastChunk {
 						// Set raw request if enabled
-						if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+						if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 							providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonBody)
 						}
 						response.ExtraFields.Latency = time.Since(startTime).Milliseconds()
@@ -503,8 +448,8 @@ func (provider *CohereProvider) ChatCompletionStream(ctx context.Context, postHo
 		}
 
 		if err := scanner.Err(); err != nil {
-			provider.logger.Warn(fmt.Sprintf("Error reading stream: %v", err))
-			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.logger)
+			provider.Logger().Warn(fmt.Sprintf("Error reading stream: %v", err))
+			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.Logger())
 		}
 	}()
 
@@ -514,7 +459,7 @@ func (provider *CohereProvider) ChatCompletionStream(ctx context.Context, postHo
 // Responses performs a responses request to the Cohere API using v2 converter.
 func (provider *CohereProvider) Responses(ctx context.Context, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
 	// Check if chat completion is allowed
-	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.customProviderConfig, schemas.ResponsesRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.CustomProviderConfig(), schemas.ResponsesRequest); err != nil {
 		return nil, err
 	}
 
@@ -528,7 +473,7 @@ func (provider *CohereProvider) Responses(ctx context.Context, key schemas.Key,
 	}
 
 	// Convert to Cohere v2 request
-	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.buildRequestURL(ctx, "/v2/chat", schemas.ResponsesRequest), key.Value, &providerUtils.RequestMetadata{
+	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.BuildRequestURL(ctx, "/v2/chat", schemas.ResponsesRequest), key.Value, &providerUtils.RequestMetadata{
 		Provider:    provider.GetProviderKey(),
 		Model:       request.Model,
 		RequestType: schemas.ResponsesRequest,
@@ -538,10 +483,10 @@ func (provider *CohereProvider) Responses(ctx context.Context, key schemas.Key,
 	}
 
 	// Create response object from pool
-	response := acquireCohereResponse()
-	defer releaseCohereResponse(response)
+	response := cohereResponsePool.Acquire()
+	defer cohereResponsePool.Release(response)
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -557,12 +502,12 @@ func (provider *CohereProvider) Responses(ctx context.Context, key schemas.Key,
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -572,7 +517,7 @@ func (provider *CohereProvider) Responses(ctx context.Context, key schemas.Key,
 // ResponsesStream performs a streaming responses request to the Cohere API.
 func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if responses stream is allowed
-	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.customProviderConfig, schemas.ResponsesStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.CustomProviderConfig(), schemas.ResponsesStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -602,9 +547,9 @@ func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRun
 	defer fasthttp.ReleaseRequest(req)
 
 	req.Header.SetMethod(http.MethodPost)
-	req.SetRequestURI(provider.buildRequestURL(ctx, "/v2/chat", schemas.ResponsesStreamRequest))
+	req.SetRequestURI(provider.BuildRequestURL(ctx, "/v2/chat", schemas.ResponsesStreamRequest))
 	req.Header.SetContentType("application/json")
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Set headers
 	if key.Value != "" {
@@ -692,14 +637,14 @@ func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRun
 
 			// Handle [DONE] marker
 			if strings.TrimSpace(eventData) == "[DONE]" {
-				provider.logger.Debug("Received [DONE] marker, ending stream")
+				provider.Logger().Debug("Received [DONE] marker, ending stream")
 				return
 			}
 
 			// Parse the unified streaming event
 			var event CohereStreamEvent
-			if err := sonic.Unmarshal([]byte(eventData), &event); err != nil {
-				provider.logger.Warn(fmt.Sprintf("Failed to parse stream event: %v", err))
+			if err := serialization.Unmarshal([]byte(eventData), &event); err != nil {
+				provider.Logger().Warn(fmt.Sprintf("Failed to parse stream event: %v", err))
 				continue
 			}
 
@@ -714,7 +659,7 @@ func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRun
 					ModelRequested: request.Model,
 				}
 				ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-				providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.logger)
+				providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.Logger())
 				break
 			}
 			// Handle each response in the slice
@@ -730,7 +675,7 @@ func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRun
 					lastChunkTime = time.Now()
 					chunkIndex++
 
-					if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+					if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 						response.ExtraFields.RawResponse = eventData
 					}
 
@@ -739,7 +684,7 @@ func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRun
 							response.Response = &schemas.BifrostResponsesResponse{}
 						}
 						// Set raw request if enabled
-						if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+						if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 							providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonBody)
 						}
 						response.ExtraFields.Latency = time.Since(startTime).Milliseconds()
@@ -756,8 +701,8 @@ func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRun
 		}
 
 		if err := scanner.Err(); err != nil {
-			provider.logger.Warn(fmt.Sprintf("Error reading %s stream: %v", providerName, err))
-			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.logger)
+			provider.Logger().Warn(fmt.Sprintf("Error reading %s stream: %v", providerName, err))
+			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ResponsesStreamRequest, providerName, request.Model, provider.Logger())
 		}
 	}()
 
@@ -768,7 +713,7 @@ func (provider *CohereProvider) ResponsesStream(ctx context.Context, postHookRun
 // Supports Cohere's embedding models and returns a BifrostResponse containing the embedding(s).
 func (provider *CohereProvider) Embedding(ctx context.Context, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
 	// Check if embedding is allowed
-	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.customProviderConfig, schemas.EmbeddingRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Cohere, provider.CustomProviderConfig(), schemas.EmbeddingRequest); err != nil {
 		return nil, err
 	}
 
@@ -782,7 +727,7 @@ func (provider *CohereProvider) Embedding(ctx context.Context, key schemas.Key,
 	}
 
 	// Create Bifrost request for conversion
-	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.buildRequestURL(ctx, "/v2/embed", schemas.EmbeddingRequest), key.Value, &providerUtils.RequestMetadata{
+	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, provider.BuildRequestURL(ctx, "/v2/embed", schemas.EmbeddingRequest), key.Value, &providerUtils.RequestMetadata{
 		Provider:    provider.GetProviderKey(),
 		Model:       request.Model,
 		RequestType: schemas.EmbeddingRequest,
@@ -792,10 +737,10 @@ func (provider *CohereProvider) Embedding(ctx context.Context, key schemas.Key,
 	}
 
 	// Create response object from pool
-	response := acquireCohereEmbeddingResponse()
-	defer releaseCohereEmbeddingResponse(response)
+	response := cohereEmbeddingResponsePool.Acquire()
+	defer cohereEmbeddingResponsePool.Release(response)
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -809,12 +754,12 @@ func (provider *CohereProvider) Embedding(ctx context.Context, key schemas.Key,
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
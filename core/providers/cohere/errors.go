@@ -13,7 +13,9 @@ func parseCohereError(resp *fasthttp.Response, meta *providerUtils.RequestMetada
 	if bifrostErr.Error == nil {
 		bifrostErr.Error = &schemas.ErrorField{}
 	}
-	bifrostErr.Error.Message = errorResp.Message
+	if errorResp.Message != "" {
+		bifrostErr.Error.Message = errorResp.Message
+	}
 	if errorResp.Code != nil {
 		bifrostErr.Error.Code = errorResp.Code
 	}
@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 const MinimumReasoningMaxTokens = 1
@@ -424,12 +424,12 @@ type CohereStreamToolCallStruct struct {
 // JSON marshaling for CohereStreamToolCall
 func (c *CohereStreamToolCallStruct) MarshalJSON() ([]byte, error) {
 	if c.CohereToolCallObject != nil {
-		return sonic.Marshal(c.CohereToolCallObject)
+		return serialization.Marshal(c.CohereToolCallObject)
 	}
 	if c.CohereToolCallArray != nil {
-		return sonic.Marshal(c.CohereToolCallArray)
+		return serialization.Marshal(c.CohereToolCallArray)
 	}
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 func (c *CohereStreamToolCallStruct) UnmarshalJSON(data []byte) error {
@@ -438,14 +438,14 @@ func (c *CohereStreamToolCallStruct) UnmarshalJSON(data []byte) error {
 	}
 	// Try to unmarshal as array first
 	var toolCallArray []CohereToolCall
-	if err := sonic.Unmarshal(data, &toolCallArray); err == nil {
+	if err := serialization.Unmarshal(data, &toolCallArray); err == nil {
 		c.CohereToolCallArray = toolCallArray
 		return nil
 	}
 
 	// Try to unmarshal as single object
 	var toolCallObject CohereToolCall
-	if err := sonic.Unmarshal(data, &toolCallObject); err == nil {
+	if err := serialization.Unmarshal(data, &toolCallObject); err == nil {
 		c.CohereToolCallObject = &toolCallObject
 		return nil
 	}
@@ -460,12 +460,12 @@ type CohereStreamContentStruct struct {
 
 func (c *CohereStreamContentStruct) MarshalJSON() ([]byte, error) {
 	if c.CohereStreamContentObject != nil {
-		return sonic.Marshal(c.CohereStreamContentObject)
+		return serialization.Marshal(c.CohereStreamContentObject)
 	}
 	if c.CohereStreamContentArray != nil {
-		return sonic.Marshal(c.CohereStreamContentArray)
+		return serialization.Marshal(c.CohereStreamContentArray)
 	}
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 func (c *CohereStreamContentStruct) UnmarshalJSON(data []byte) error {
@@ -474,14 +474,14 @@ func (c *CohereStreamContentStruct) UnmarshalJSON(data []byte) error {
 	}
 	// Try to unmarshal as array first
 	var contentArray []CohereStreamContent
-	if err := sonic.Unmarshal(data, &contentArray); err == nil {
+	if err := serialization.Unmarshal(data, &contentArray); err == nil {
 		c.CohereStreamContentArray = contentArray
 		return nil
 	}
 
 	// Try to unmarshal as single object
 	var contentObject CohereStreamContent
-	if err := sonic.Unmarshal(data, &contentObject); err == nil {
+	if err := serialization.Unmarshal(data, &contentObject); err == nil {
 		c.CohereStreamContentObject = &contentObject
 		return nil
 	}
@@ -496,12 +496,12 @@ type CohereStreamCitationStruct struct {
 
 func (c *CohereStreamCitationStruct) MarshalJSON() ([]byte, error) {
 	if c.CohereStreamCitationObject != nil {
-		return sonic.Marshal(c.CohereStreamCitationObject)
+		return serialization.Marshal(c.CohereStreamCitationObject)
 	}
 	if c.CohereStreamCitationArray != nil {
-		return sonic.Marshal(c.CohereStreamCitationArray)
+		return serialization.Marshal(c.CohereStreamCitationArray)
 	}
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 func (c *CohereStreamCitationStruct) UnmarshalJSON(data []byte) error {
@@ -510,14 +510,14 @@ func (c *CohereStreamCitationStruct) UnmarshalJSON(data []byte) error {
 	}
 	// Try to unmarshal as array first
 	var citationArray []CohereCitation
-	if err := sonic.Unmarshal(data, &citationArray); err == nil {
+	if err := serialization.Unmarshal(data, &citationArray); err == nil {
 		c.CohereStreamCitationArray = citationArray
 		return nil
 	}
 
 	// Try to unmarshal as single object
 	var citationObject CohereCitation
-	if err := sonic.Unmarshal(data, &citationObject); err == nil {
+	if err := serialization.Unmarshal(data, &citationObject); err == nil {
 		c.CohereStreamCitationObject = &citationObject
 		return nil
 	}
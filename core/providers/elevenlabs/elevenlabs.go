@@ -14,19 +14,15 @@ import (
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
 type ElevenlabsProvider struct {
-	logger               schemas.Logger                // Logger for provider operations
-	client               *fasthttp.Client              // HTTP client for API requests
-	networkConfig        schemas.NetworkConfig         // Network configuration including extra headers
-	sendBackRawRequest   bool                          // Whether to include raw request in BifrostResponse
-	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
-	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewElevenlabsProvider creates a new Elevenlabs provider instance.
@@ -45,28 +41,21 @@ func NewElevenlabsProvider(config *schemas.ProviderConfig, logger schemas.Logger
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://api.elevenlabs.io"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &ElevenlabsProvider{
-		logger:               logger,
-		client:               client,
-		networkConfig:        config.NetworkConfig,
-		customProviderConfig: config.CustomProviderConfig,
-		sendBackRawRequest:   config.SendBackRawRequest,
-		sendBackRawResponse:  config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Elevenlabs, config, logger),
+		client:       client,
 	}
 }
 
-// GetProviderKey returns the provider identifier for Elevenlabs.
-func (provider *ElevenlabsProvider) GetProviderKey() schemas.ModelProvider {
-	return providerUtils.GetProviderName(schemas.Elevenlabs, provider.customProviderConfig)
-}
-
 // listModelsByKey performs a list models request for a single key.
 // Returns the response and latency, or an error if the request fails.
 func (provider *ElevenlabsProvider) listModelsByKey(ctx context.Context, key schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
@@ -79,10 +68,10 @@ func (provider *ElevenlabsProvider) listModelsByKey(ctx context.Context, key sch
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Build URL using centralized URL construction
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/models"))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/models"))
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
 
@@ -103,7 +92,7 @@ func (provider *ElevenlabsProvider) listModelsByKey(ctx context.Context, key sch
 	}
 
 	var elevenlabsResponse ElevenlabsListModelsResponse
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), &elevenlabsResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), &elevenlabsResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -113,12 +102,12 @@ func (provider *ElevenlabsProvider) listModelsByKey(ctx context.Context, key sch
 	response.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -128,7 +117,7 @@ func (provider *ElevenlabsProvider) listModelsByKey(ctx context.Context, key sch
 // ListModels performs a list models request to Elevenlabs' API.
 // Requests are made concurrently for improved performance.
 func (provider *ElevenlabsProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.customProviderConfig, schemas.ListModelsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.CustomProviderConfig(), schemas.ListModelsRequest); err != nil {
 		return nil, err
 	}
 	return providerUtils.HandleMultipleListModelsRequests(
@@ -136,7 +125,7 @@ func (provider *ElevenlabsProvider) ListModels(ctx context.Context, keys []schem
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -177,7 +166,7 @@ func (provider *ElevenlabsProvider) Embedding(ctx context.Context, key schemas.K
 
 // Speech performs a text to speech request
 func (provider *ElevenlabsProvider) Speech(ctx context.Context, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.customProviderConfig, schemas.SpeechRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.CustomProviderConfig(), schemas.SpeechRequest); err != nil {
 		return nil, err
 	}
 
@@ -190,7 +179,7 @@ func (provider *ElevenlabsProvider) Speech(ctx context.Context, key schemas.Key,
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	withTimestampsRequest := request.Params != nil && request.Params.WithTimestamps != nil && *request.Params.WithTimestamps
 
@@ -236,7 +225,7 @@ func (provider *ElevenlabsProvider) Speech(ctx context.Context, key schemas.Key,
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, parseElevenlabsError(resp, &providerUtils.RequestMetadata{
 			Provider:    providerName,
 			Model:       request.Model,
@@ -260,13 +249,13 @@ func (provider *ElevenlabsProvider) Speech(ctx context.Context, key schemas.Key,
 		},
 	}
 
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&bifrostResponse.ExtraFields, jsonData)
 	}
 
 	if withTimestampsRequest {
 		var timestampResponse ElevenlabsSpeechWithTimestampsResponse
-		if err := sonic.Unmarshal(body, &timestampResponse); err != nil {
+		if err := serialization.Unmarshal(body, &timestampResponse); err != nil {
 			return nil, providerUtils.NewBifrostOperationError("failed to parse with-timestamps response", err, providerName)
 		}
 
@@ -297,7 +286,7 @@ func (provider *ElevenlabsProvider) Speech(ctx context.Context, key schemas.Key,
 
 // SpeechStream performs a text to speech stream request
 func (provider *ElevenlabsProvider) SpeechStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.customProviderConfig, schemas.SpeechStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.CustomProviderConfig(), schemas.SpeechStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -325,7 +314,7 @@ func (provider *ElevenlabsProvider) SpeechStream(ctx context.Context, postHookRu
 	defer fasthttp.ReleaseRequest(req)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	if request.Params == nil || request.Params.VoiceConfig == nil || request.Params.VoiceConfig.Voice == nil {
 		return nil, providerUtils.NewBifrostOperationError("voice parameter is required", nil, providerName)
@@ -399,8 +388,8 @@ func (provider *ElevenlabsProvider) SpeechStream(ctx context.Context, postHookRu
 				if err == io.EOF {
 					break
 				}
-				provider.logger.Warn(fmt.Sprintf("Error reading stream: %v", err))
-				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.SpeechStreamRequest, providerName, request.Model, provider.logger)
+				provider.Logger().Warn(fmt.Sprintf("Error reading stream: %v", err))
+				providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.SpeechStreamRequest, providerName, request.Model, provider.Logger())
 				return
 			}
 
@@ -423,7 +412,7 @@ func (provider *ElevenlabsProvider) SpeechStream(ctx context.Context, postHookRu
 
 				lastChunkTime = time.Now()
 
-				if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+				if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 					response.ExtraFields.RawResponse = audioChunk
 				}
 
@@ -445,7 +434,7 @@ func (provider *ElevenlabsProvider) SpeechStream(ctx context.Context, postHookRu
 		}
 
 		// Set raw request if enabled
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			providerUtils.ParseAndSetRawRequest(&finalResponse.ExtraFields, jsonBody)
 		}
 		ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
@@ -457,11 +446,14 @@ func (provider *ElevenlabsProvider) SpeechStream(ctx context.Context, postHookRu
 
 // Transcription performs a transcription request
 func (provider *ElevenlabsProvider) Transcription(ctx context.Context, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.customProviderConfig, schemas.TranscriptionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Elevenlabs, provider.CustomProviderConfig(), schemas.TranscriptionRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
+	if err := providerUtils.CheckTranslationSupported(providerName, schemas.TranscriptionRequest, request); err != nil {
+		return nil, err
+	}
 
 	reqBody := ToElevenlabsTranscriptionRequest(request)
 	if reqBody == nil {
@@ -494,9 +486,9 @@ func (provider *ElevenlabsProvider) Transcription(ctx context.Context, key schem
 	defer fasthttp.ReleaseRequest(req)
 	defer fasthttp.ReleaseResponse(resp)
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetRequestPath(ctx, "/v1/speech-to-text", provider.customProviderConfig, schemas.TranscriptionRequest))
+	req.SetRequestURI(provider.BuildRequestURL(ctx, "/v1/speech-to-text", schemas.TranscriptionRequest))
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType(contentType)
 	if key.Value != "" {
@@ -509,7 +501,7 @@ func (provider *ElevenlabsProvider) Transcription(ctx context.Context, key schem
 		return nil, bifrostErr
 	}
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, parseElevenlabsError(resp, &providerUtils.RequestMetadata{
 			Provider:    providerName,
 			Model:       request.Model,
@@ -550,9 +542,9 @@ func (provider *ElevenlabsProvider) Transcription(ctx context.Context, key schem
 		Latency:        latency.Milliseconds(),
 	}
 
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		var rawResponse interface{}
-		if err := sonic.Unmarshal(responseBody, &rawResponse); err != nil {
+		if err := serialization.Unmarshal(responseBody, &rawResponse); err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRawResponseUnmarshal, err, providerName)
 		}
 		response.ExtraFields.RawResponse = rawResponse
@@ -619,7 +611,7 @@ func writeTranscriptionMultipart(writer *multipart.Writer, reqBody *ElevenlabsTr
 	}
 
 	if len(reqBody.AdditionalFormats) > 0 {
-		payload, err := sonic.Marshal(reqBody.AdditionalFormats)
+		payload, err := serialization.Marshal(reqBody.AdditionalFormats)
 		if err != nil {
 			return providerUtils.NewBifrostOperationError("failed to marshal additional_formats", err, providerName)
 		}
@@ -673,7 +665,7 @@ func writeTranscriptionMultipart(writer *multipart.Writer, reqBody *ElevenlabsTr
 				}
 			}
 		default:
-			payload, err := sonic.Marshal(v)
+			payload, err := serialization.Marshal(v)
 			if err != nil {
 				return providerUtils.NewBifrostOperationError("failed to marshal webhook_metadata", err, providerName)
 			}
@@ -693,8 +685,8 @@ func (provider *ElevenlabsProvider) TranscriptionStream(ctx context.Context, pos
 
 // buildSpeechRequestURL constructs the full request URL using the provider's configuration for speech.
 func (provider *ElevenlabsProvider) buildBaseSpeechRequestURL(ctx context.Context, defaultPath string, requestType schemas.RequestType, request *schemas.BifrostSpeechRequest) string {
-	baseURL := provider.networkConfig.BaseURL
-	requestPath := providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType)
+	baseURL := provider.NetworkConfig().BaseURL
+	requestPath := providerUtils.GetRequestPath(ctx, defaultPath, provider.CustomProviderConfig(), requestType)
 
 	u, parseErr := url.Parse(baseURL)
 	if parseErr != nil {
@@ -4,8 +4,8 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 func ToElevenlabsTranscriptionRequest(bifrostReq *schemas.BifrostTranscriptionRequest) *ElevenlabsTranscriptionRequest {
@@ -235,19 +235,19 @@ func convertWords(words []ElevenlabsSpeechToTextWord) ([]schemas.TranscriptionWo
 
 func parseTranscriptionResponse(body []byte) ([]ElevenlabsSpeechToTextChunkResponse, error) {
 	var multichannel ElevenlabsMultichannelSpeechToTextResponse
-	if err := sonic.Unmarshal(body, &multichannel); err == nil && len(multichannel.Transcripts) > 0 {
+	if err := serialization.Unmarshal(body, &multichannel); err == nil && len(multichannel.Transcripts) > 0 {
 		return multichannel.Transcripts, nil
 	}
 
 	var single ElevenlabsSpeechToTextChunkResponse
-	if err := sonic.Unmarshal(body, &single); err == nil {
+	if err := serialization.Unmarshal(body, &single); err == nil {
 		if single.LanguageCode != "" || single.Text != "" || len(single.Words) > 0 {
 			return []ElevenlabsSpeechToTextChunkResponse{single}, nil
 		}
 	}
 
 	var webhook ElevenlabsSpeechToTextWebhookResponse
-	if err := sonic.Unmarshal(body, &webhook); err == nil && strings.TrimSpace(webhook.Message) != "" {
+	if err := serialization.Unmarshal(body, &webhook); err == nil && strings.TrimSpace(webhook.Message) != "" {
 		return nil, errors.New(webhook.Message)
 	}
 
@@ -3,7 +3,7 @@ package elevenlabs
 import (
 	"strings"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // SPEECH TYPES
@@ -185,7 +185,7 @@ func (d *ElevenlabsErrorDetail) UnmarshalJSON(data []byte) error {
 	trimmed := strings.TrimSpace(string(data))
 	if len(trimmed) > 0 && trimmed[0] == '[' {
 		var validationErrors []ElevenlabsValidationError
-		if err := sonic.Unmarshal(data, &validationErrors); err != nil {
+		if err := serialization.Unmarshal(data, &validationErrors); err != nil {
 			return err
 		}
 		d.ValidationErrors = validationErrors
@@ -208,7 +208,7 @@ func (d *ElevenlabsErrorDetail) UnmarshalJSON(data []byte) error {
 		Status  *string  `json:"status,omitempty"`
 		Msg     *string  `json:"msg,omitempty"` // Some APIs use "msg" instead of "message"
 	}
-	if err := sonic.Unmarshal(data, &obj); err != nil {
+	if err := serialization.Unmarshal(data, &obj); err != nil {
 		return err
 	}
 
@@ -0,0 +1,85 @@
+package gemini
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+func newAttachmentTestProvider(t *testing.T) *GeminiProvider {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/files/report":
+			w.Write([]byte(`{"name":"files/report","displayName":"report.pdf","mimeType":"application/pdf","uri":"https://generativelanguage.googleapis.com/v1beta/files/report","state":"ACTIVE"}`))
+		case "/files/video":
+			w.Write([]byte(`{"name":"files/video","mimeType":"video/mp4","uri":"https://generativelanguage.googleapis.com/v1beta/files/video","state":"PROCESSING"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":404,"message":"File not found","status":"NOT_FOUND"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return NewGeminiProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, nil)
+}
+
+func TestResolveChatAttachments_GeminiFileDataPart(t *testing.T) {
+	provider := newAttachmentTestProvider(t)
+	request := &schemas.BifrostChatRequest{
+		Provider: schemas.Gemini,
+		Model:    "gemini-2.5-flash",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Summarize the attached report")}},
+		},
+		Attachments: []schemas.FileReference{{FileID: "files/report"}},
+	}
+
+	resolved, bifrostErr := provider.resolveChatAttachments(context.Background(), schemas.Key{Value: "test-key"}, request)
+	if bifrostErr != nil {
+		t.Fatalf("expected attachment to resolve, got error: %v", bifrostErr.Error.Message)
+	}
+	if request.Attachments[0].URI != nil {
+		t.Error("expected original request to be left untouched")
+	}
+
+	geminiReq := ToGeminiChatCompletionRequest(resolved)
+	if len(geminiReq.Contents) != 1 {
+		t.Fatalf("expected attachment to be added to the user content, got %d contents", len(geminiReq.Contents))
+	}
+	parts := geminiReq.Contents[0].Parts
+	if len(parts) != 2 || parts[0].Text != "Summarize the attached report" {
+		t.Fatalf("expected text part followed by file part, got %+v", parts)
+	}
+	fileData := parts[1].FileData
+	if fileData == nil {
+		t.Fatal("expected a fileData part")
+	}
+	if fileData.FileURI != "https://generativelanguage.googleapis.com/v1beta/files/report" || fileData.MIMEType != "application/pdf" || fileData.DisplayName != "report.pdf" {
+		t.Errorf("unexpected fileData part: %+v", fileData)
+	}
+}
+
+func TestResolveChatAttachments_RejectsMissingOrUnreadyFiles(t *testing.T) {
+	provider := newAttachmentTestProvider(t)
+	for fileID, expected := range map[string]string{
+		"files/video":   "not ready",
+		"files/missing": "failed to retrieve file",
+	} {
+		request := &schemas.BifrostChatRequest{
+			Provider:    schemas.Gemini,
+			Model:       "gemini-2.5-flash",
+			Attachments: []schemas.FileReference{{FileID: fileID}},
+		}
+		_, bifrostErr := provider.resolveChatAttachments(context.Background(), schemas.Key{Value: "test-key"}, request)
+		if bifrostErr == nil || bifrostErr.Error == nil || !strings.Contains(bifrostErr.Error.Message, expected) {
+			t.Errorf("%s: expected error containing %q, got %+v", fileID, expected, bifrostErr)
+		}
+	}
+}
@@ -7,9 +7,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
@@ -90,30 +90,28 @@ func buildBatchRequestItems(requests []schemas.BatchRequestItem) []GeminiBatchRe
 				}
 			}
 
-			// Process extracted messages
+			// Convert the messages as chat messages, so that content blocks, tool calls and tool results
+			// are mapped the same way as for chat completions
+			chatMessages := make([]schemas.ChatMessage, 0, len(messages))
 			for _, msgMap := range messages {
-				role := "user"
-				if r, ok := msgMap["role"].(string); ok {
-					if r == "assistant" {
-						role = "model"
-					} else if r == "system" {
-						// System messages are handled separately in Gemini
-						continue
-					} else {
-						role = r
-					}
+				var message schemas.ChatMessage
+				data, err := serialization.Marshal(msgMap)
+				if err != nil {
+					continue
 				}
-
-				parts := []*Part{}
-				if c, ok := msgMap["content"].(string); ok {
-					parts = append(parts, &Part{Text: c})
+				if err := serialization.Unmarshal(data, &message); err != nil {
+					continue
 				}
-
-				contents = append(contents, Content{
-					Role:  role,
-					Parts: parts,
-				})
+				if message.Role == "" {
+					message.Role = schemas.ChatMessageRoleUser
+				}
+				if message.Role == schemas.ChatMessageRoleSystem {
+					// System messages are handled separately in Gemini
+					continue
+				}
+				chatMessages = append(chatMessages, message)
 			}
+			contents = append(contents, convertBifrostMessagesToGemini(chatMessages)...)
 		}
 
 		item := GeminiBatchRequestItem{
@@ -147,9 +145,7 @@ func (provider *GeminiProvider) downloadBatchResultsFile(ctx context.Context, ke
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build download URL - use the download endpoint with alt=media
-	// The base URL is like https://generativelanguage.googleapis.com/v1beta
-	// We need to change it to https://generativelanguage.googleapis.com/download/v1beta
-	baseURL := strings.Replace(provider.networkConfig.BaseURL, "/v1beta", "/download/v1beta", 1)
+	baseURL := geminiDownloadURL.Apply(provider.NetworkConfig().BaseURL)
 
 	// Ensure fileName has proper format
 	fileID := fileName
@@ -157,10 +153,10 @@ func (provider *GeminiProvider) downloadBatchResultsFile(ctx context.Context, ke
 		fileID = "files/" + fileID
 	}
 
-	url := fmt.Sprintf("%s/%s:download?alt=media", baseURL, fileID)
+	url := providerUtils.JoinURL(baseURL, fileID+":download?alt=media")
 
-	provider.logger.Debug("gemini batch results file download url: " + url)
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	provider.Logger().Debug("gemini batch results file download url: " + url)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(url)
 	req.Header.SetMethod(http.MethodGet)
 	if key.Value != "" {
@@ -192,8 +188,8 @@ func (provider *GeminiProvider) downloadBatchResultsFile(ctx context.Context, ke
 
 	parseResult := providerUtils.ParseJSONL(body, func(line []byte) error {
 		var resultLine GeminiBatchFileResultLine
-		if err := sonic.Unmarshal(line, &resultLine); err != nil {
-			provider.logger.Warn("gemini batch results file parse error: " + err.Error())
+		if err := serialization.Unmarshal(line, &resultLine); err != nil {
+			provider.Logger().Warn("gemini batch results file parse error: " + err.Error())
 			return err
 		}
 
@@ -211,6 +207,10 @@ func (provider *GeminiProvider) downloadBatchResultsFile(ctx context.Context, ke
 				Code:    fmt.Sprintf("%d", resultLine.Error.Code),
 				Message: resultLine.Error.Message,
 			}
+			// Set the HTTP-equivalent status of the gRPC error code, as for other providers' errored items
+			resultItem.Response = &schemas.BatchResultResponse{
+				StatusCode: providerUtils.GoogleErrorHTTPStatus(resultLine.Error.Code, resultLine.Error.Status),
+			}
 		} else if resultLine.Response != nil {
 			// Convert the response to a map for the Body field
 			respBody := make(map[string]interface{})
@@ -0,0 +1,90 @@
+package gemini
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// testLogger is a minimal logger implementation for testing.
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+
+func TestBatchResults_InlinedErrorStatusCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"name": "batches/abc",
+			"metadata": {"state": "BATCH_STATE_SUCCEEDED"},
+			"dest": {"inlinedResponses": [
+				{"metadata": {"key": "ok"}, "response": {"candidates": [{"content": {"parts": [{"text": "hi"}]}, "finishReason": "STOP"}]}},
+				{"metadata": {"key": "invalid"}, "error": {"code": 3, "message": "Request contains an invalid argument."}},
+				{"metadata": {"key": "quota"}, "error": {"code": 8, "message": "Resource has been exhausted.", "status": "RESOURCE_EXHAUSTED"}},
+				{"metadata": {"key": "unavailable"}, "error": {"message": "The service is currently unavailable.", "status": "UNAVAILABLE"}}
+			]}
+		}`))
+	}))
+	defer server.Close()
+
+	provider := NewGeminiProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, &testLogger{})
+	resp, bifrostErr := provider.BatchResults(context.Background(), []schemas.Key{{ID: "key-1", Value: "test-key"}}, &schemas.BifrostBatchResultsRequest{
+		Provider: schemas.Gemini,
+		BatchID:  "batches/abc",
+	})
+	if bifrostErr != nil {
+		t.Fatalf("expected batch results to succeed, got error: %v", bifrostErr.Error.Message)
+	}
+
+	expected := map[string]int{"ok": 200, "invalid": 400, "quota": 429, "unavailable": 503}
+	if len(resp.Results) != len(expected) {
+		t.Fatalf("expected %d results, got %d", len(expected), len(resp.Results))
+	}
+	for _, result := range resp.Results {
+		if result.Response == nil {
+			t.Errorf("expected result %s to have a response status, got none", result.CustomID)
+			continue
+		}
+		if result.Response.StatusCode != expected[result.CustomID] {
+			t.Errorf("expected result %s to have status %d, got %d", result.CustomID, expected[result.CustomID], result.Response.StatusCode)
+		}
+		if (result.CustomID == "ok") != (result.Error == nil) {
+			t.Errorf("expected only errored results to carry an error, got %+v for %s", result.Error, result.CustomID)
+		}
+	}
+}
+
+func TestGoogleErrorHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code     int
+		status   string
+		expected int
+	}{
+		{3, "", 400},
+		{5, "", 404},
+		{7, "", 403},
+		{8, "", 429},
+		{13, "", 500},
+		{16, "", 401},
+		{429, "RESOURCE_EXHAUSTED", 429}, // Already an HTTP status
+		{0, "DEADLINE_EXCEEDED", 504},
+		{0, "", 500},
+	}
+	for _, tt := range tests {
+		if got := providerUtils.GoogleErrorHTTPStatus(tt.code, tt.status); got != tt.expected {
+			t.Errorf("expected code %d (%q) to map to %d, got %d", tt.code, tt.status, tt.expected, got)
+		}
+	}
+}
@@ -58,6 +58,9 @@ func ToGeminiChatCompletionRequest(bifrostReq *schemas.BifrostChatRequest) *Gemi
 
 	// Convert chat completion messages to Gemini format
 	geminiReq.Contents = convertBifrostMessagesToGemini(bifrostReq.Input)
+	if len(bifrostReq.Attachments) > 0 {
+		geminiReq.Contents = attachGeminiFileParts(geminiReq.Contents, bifrostReq.Attachments)
+	}
 
 	return geminiReq
 }
@@ -170,6 +173,34 @@ func (response *GenerateContentResponse) ToBifrostChatResponse() *schemas.Bifros
 				},
 			})
 		}
+		// Blocked candidates come without content, they are returned as a refusal
+		if isGeminiRefusal(candidate.FinishReason) {
+			if len(bifrostResp.Choices) == 0 {
+				bifrostResp.Choices = append(bifrostResp.Choices, schemas.BifrostResponseChoice{
+					ChatNonStreamResponseChoice: &schemas.ChatNonStreamResponseChoice{
+						Message: &schemas.ChatMessage{Role: schemas.ChatMessageRoleAssistant},
+					},
+				})
+			}
+			bifrostResp.Choices[0].Message.SetRefusal(fmt.Sprintf("Gemini blocked the response: %s", candidate.FinishReason))
+			bifrostResp.Choices[0].FinishReason = schemas.Ptr(ConvertGeminiFinishReasonToBifrost(candidate.FinishReason))
+		}
+		bifrostResp.SourceCitations = convertGeminiCandidateCitations(candidate)
+	} else if response.PromptFeedback != nil && response.PromptFeedback.BlockReason != "" {
+		// Blocked prompts get no candidate at all
+		refusal := response.PromptFeedback.BlockReasonMessage
+		if refusal == "" {
+			refusal = fmt.Sprintf("Gemini blocked the prompt: %s", response.PromptFeedback.BlockReason)
+		}
+		bifrostResp.Choices = append(bifrostResp.Choices, schemas.BifrostResponseChoice{
+			FinishReason: schemas.Ptr("content_filter"),
+			ChatNonStreamResponseChoice: &schemas.ChatNonStreamResponseChoice{
+				Message: &schemas.ChatMessage{
+					Role:                 schemas.ChatMessageRoleAssistant,
+					ChatAssistantMessage: &schemas.ChatAssistantMessage{Refusal: &refusal},
+				},
+			},
+		})
 	}
 
 	// Set usage information
@@ -303,9 +334,12 @@ func (response *GenerateContentResponse) ToBifrostChatCompletionStream() (*schem
 		}
 	}
 
+	// Grounding metadata is usually sent with the last chunks of the stream
+	streamResponse.SourceCitations = convertGeminiCandidateCitations(candidate)
+
 	// Check if delta has any content - if not and it's not the last chunk, skip it
 	hasDeltaContent := delta.Role != nil || delta.Content != nil || delta.Reasoning != nil || len(delta.ToolCalls) > 0 || len(delta.ReasoningDetails) > 0
-	if !hasDeltaContent && !isLastChunk {
+	if !hasDeltaContent && !isLastChunk && len(streamResponse.SourceCitations) == 0 {
 		return nil, nil, false
 	}
 
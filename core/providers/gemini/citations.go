@@ -0,0 +1,94 @@
+package gemini
+
+import (
+	"encoding/json"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// convertGeminiCandidateCitations converts the grounding and citation metadata of a candidate to Bifrost citations.
+// Each grounding support yields a citation per supporting chunk, with the supported text span. Grounding chunks
+// that do not support any segment are still reported, without a span.
+func convertGeminiCandidateCitations(candidate *Candidate) []schemas.Citation {
+	if candidate == nil {
+		return nil
+	}
+
+	var citations []schemas.Citation
+
+	var grounding GroundingMetadata
+	if decodeGeminiMetadata(candidate.GroundingMetadata, &grounding) {
+		cited := make([]bool, len(grounding.GroundingChunks))
+		for _, support := range grounding.GroundingSupports {
+			for i, chunkIndex := range support.GroundingChunkIndices {
+				if chunkIndex < 0 || chunkIndex >= len(grounding.GroundingChunks) {
+					continue
+				}
+				citation := groundingChunkCitation(grounding.GroundingChunks[chunkIndex])
+				if citation == nil {
+					continue
+				}
+				cited[chunkIndex] = true
+				if support.Segment != nil {
+					citation.Text = support.Segment.Text
+					citation.StartIndex = schemas.Ptr(support.Segment.StartIndex)
+					citation.EndIndex = schemas.Ptr(support.Segment.EndIndex)
+				}
+				if i < len(support.ConfidenceScores) {
+					citation.Confidence = schemas.Ptr(support.ConfidenceScores[i])
+				}
+				citations = append(citations, *citation)
+			}
+		}
+		for chunkIndex, chunk := range grounding.GroundingChunks {
+			if cited[chunkIndex] {
+				continue
+			}
+			if citation := groundingChunkCitation(chunk); citation != nil {
+				citations = append(citations, *citation)
+			}
+		}
+	}
+
+	var citationMetadata CitationMetadata
+	if decodeGeminiMetadata(candidate.CitationMetadata, &citationMetadata) {
+		for _, source := range append(citationMetadata.CitationSources, citationMetadata.Citations...) {
+			citations = append(citations, schemas.Citation{
+				URI:        source.URI,
+				Title:      source.Title,
+				StartIndex: source.StartIndex,
+				EndIndex:   source.EndIndex,
+				License:    source.License,
+			})
+		}
+	}
+
+	return citations
+}
+
+// groundingChunkCitation returns the citation of a grounding chunk source, or nil if the chunk has no known source.
+func groundingChunkCitation(chunk GroundingChunk) *schemas.Citation {
+	source := chunk.Web
+	if source == nil {
+		source = chunk.RetrievedContext
+	}
+	if source == nil {
+		return nil
+	}
+	return &schemas.Citation{
+		URI:   source.URI,
+		Title: source.Title,
+	}
+}
+
+// decodeGeminiMetadata decodes untyped candidate metadata into its typed form, returning false if it is absent or malformed.
+func decodeGeminiMetadata(metadata *map[string]any, target any) bool {
+	if metadata == nil || len(*metadata) == 0 {
+		return false
+	}
+	data, err := json.Marshal(*metadata)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, target) == nil
+}
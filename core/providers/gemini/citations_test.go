@@ -0,0 +1,83 @@
+package gemini
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// groundedResponseBody is a generateContent response grounded with Google Search
+const groundedResponseBody = `{
+	"responseId": "resp-1",
+	"modelVersion": "gemini-2.5-flash",
+	"candidates": [{
+		"content": {"role": "model", "parts": [{"text": "Spain won Euro 2024. The final was played in Berlin."}]},
+		"finishReason": "STOP",
+		"groundingMetadata": {
+			"webSearchQueries": ["who won euro 2024"],
+			"groundingChunks": [
+				{"web": {"uri": "https://example.com/euro-2024", "title": "example.com"}},
+				{"web": {"uri": "https://example.org/final", "title": "example.org"}},
+				{"web": {"uri": "https://example.net/unused", "title": "example.net"}}
+			],
+			"groundingSupports": [
+				{"segment": {"startIndex": 0, "endIndex": 20, "text": "Spain won Euro 2024."}, "groundingChunkIndices": [0, 1], "confidenceScores": [0.95, 0.7]},
+				{"segment": {"startIndex": 21, "endIndex": 53, "text": "The final was played in Berlin."}, "groundingChunkIndices": [1]}
+			]
+		},
+		"citationMetadata": {
+			"citationSources": [{"startIndex": 0, "endIndex": 20, "uri": "https://example.com/licensed", "license": "mit"}]
+		}
+	}],
+	"usageMetadata": {"promptTokenCount": 5, "candidatesTokenCount": 12, "totalTokenCount": 17}
+}`
+
+// Test that grounding supports become citations with their text spans, unused grounding chunks are still
+// reported, and citation sources are included
+func TestGroundingMetadataToCitations(t *testing.T) {
+	var response GenerateContentResponse
+	if err := json.Unmarshal([]byte(groundedResponseBody), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	expected := []schemas.Citation{
+		{URI: "https://example.com/euro-2024", Title: "example.com", Text: "Spain won Euro 2024.", StartIndex: schemas.Ptr(0), EndIndex: schemas.Ptr(20), Confidence: schemas.Ptr(0.95)},
+		{URI: "https://example.org/final", Title: "example.org", Text: "Spain won Euro 2024.", StartIndex: schemas.Ptr(0), EndIndex: schemas.Ptr(20), Confidence: schemas.Ptr(0.7)},
+		{URI: "https://example.org/final", Title: "example.org", Text: "The final was played in Berlin.", StartIndex: schemas.Ptr(21), EndIndex: schemas.Ptr(53)},
+		{URI: "https://example.net/unused", Title: "example.net"},
+		{URI: "https://example.com/licensed", StartIndex: schemas.Ptr(0), EndIndex: schemas.Ptr(20), License: "mit"},
+	}
+
+	chatResp := response.ToBifrostChatResponse()
+	assertCitations(t, "chat", chatResp.SourceCitations, expected)
+	assertCitations(t, "responses", response.ToResponsesBifrostResponsesResponse().SourceCitations, expected)
+	assertCitations(t, "chat to responses", chatResp.ToBifrostResponsesResponse().SourceCitations, expected)
+
+	streamResp, bifrostErr, _ := response.ToBifrostChatCompletionStream()
+	if bifrostErr != nil || streamResp == nil {
+		t.Fatalf("Expected a stream chunk, got error %v", bifrostErr)
+	}
+	assertCitations(t, "stream", streamResp.SourceCitations, expected)
+}
+
+// Test that a response without grounding or citation metadata has no citations
+func TestGroundingMetadataToCitations_NoMetadata(t *testing.T) {
+	var response GenerateContentResponse
+	body := `{"candidates": [{"content": {"role": "model", "parts": [{"text": "Hello"}]}, "finishReason": "STOP"}]}`
+	if err := json.Unmarshal([]byte(body), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if citations := response.ToBifrostChatResponse().SourceCitations; citations != nil {
+		t.Errorf("Expected no citations, got %+v", citations)
+	}
+}
+
+func assertCitations(t *testing.T, name string, got, expected []schemas.Citation) {
+	t.Helper()
+	gotJSON, _ := json.Marshal(got)
+	expectedJSON, _ := json.Marshal(expected)
+	if string(gotJSON) != string(expectedJSON) {
+		t.Errorf("%s: expected citations %s, got %s", name, expectedJSON, gotJSON)
+	}
+}
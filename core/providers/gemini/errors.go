@@ -59,9 +59,12 @@ func parseGeminiError(resp *fasthttp.Response, meta *providerUtils.RequestMetada
 		// Set Code from first error if available
 		if firstError != nil {
 			bifrostErr.Error.Code = schemas.Ptr(strconv.Itoa(firstError.Code))
+			providerUtils.SetRateLimitKind(bifrostErr, isGeminiQuotaExhausted(firstError))
 		}
 		// Set Message to trimmed concatenated message
-		bifrostErr.Error.Message = message
+		if message != "" {
+			bifrostErr.Error.Message = message
+		}
 		if meta != nil {
 			bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 				Provider:       meta.Provider,
@@ -80,7 +83,10 @@ func parseGeminiError(resp *fasthttp.Response, meta *providerUtils.RequestMetada
 			bifrostErr.Error = &schemas.ErrorField{}
 		}
 		bifrostErr.Error.Code = schemas.Ptr(strconv.Itoa(errorResp.Error.Code))
-		bifrostErr.Error.Message = errorResp.Error.Message
+		if errorResp.Error.Message != "" {
+			bifrostErr.Error.Message = errorResp.Error.Message
+		}
+		providerUtils.SetRateLimitKind(bifrostErr, isGeminiQuotaExhausted(errorResp.Error))
 	}
 	if meta != nil {
 		bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
@@ -91,3 +97,17 @@ func parseGeminiError(resp *fasthttp.Response, meta *providerUtils.RequestMetada
 	}
 	return bifrostErr
 }
+
+// isGeminiQuotaExhausted reports whether a RESOURCE_EXHAUSTED error is for a daily quota, which is not
+// replenished before the next day, rather than for a per-minute rate limit. Gemini returns the same
+// status and message for both, only the quota violations tell them apart.
+func isGeminiQuotaExhausted(errorResp *GeminiGenerationErrorStruct) bool {
+	for _, detail := range errorResp.Details {
+		for _, violation := range detail.Violations {
+			if strings.Contains(violation.QuotaID, "PerDay") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package gemini
+
+import (
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// Test that 429 per-minute rate limits and used up daily quotas are told apart from the Gemini quota violations
+func TestParseGeminiError_RateLimitKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		quotaID  string
+		expected schemas.RateLimitKind
+	}{
+		{
+			name:     "per minute rate limit",
+			quotaID:  "GenerateRequestsPerMinutePerProjectPerModel-FreeTier",
+			expected: schemas.RateLimitKindRateLimited,
+		},
+		{
+			name:     "daily quota exhausted",
+			quotaID:  "GenerateRequestsPerDayPerProjectPerModel-FreeTier",
+			expected: schemas.RateLimitKindQuotaExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseResponse(resp)
+			resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			resp.SetBodyString(`{"error":{"code":429,"message":"You exceeded your current quota, please check your plan and billing details.","status":"RESOURCE_EXHAUSTED","details":[` +
+				`{"@type":"type.googleapis.com/google.rpc.QuotaFailure","violations":[{"quotaMetric":"generativelanguage.googleapis.com/generate_content_free_tier_requests","quotaId":"` + tt.quotaID + `"}]},` +
+				`{"@type":"type.googleapis.com/google.rpc.RetryInfo","retryDelay":"34s"}]}}`)
+
+			bifrostErr := parseGeminiError(resp, nil)
+			if bifrostErr.RateLimitKind != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, bifrostErr.RateLimitKind)
+			}
+		})
+	}
+}
@@ -2,20 +2,18 @@ package gemini
 
 import (
 	"bufio"
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
-	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/providers/openai"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
@@ -23,13 +21,16 @@ const (
 	BifrostContextKeyResponseFormat schemas.BifrostContextKey = "bifrost_context_key_response_format"
 )
 
+// Files are uploaded and downloaded through the media endpoints, which prefix the API version of the base URL,
+// e.g. https://generativelanguage.googleapis.com/upload/v1beta
+var (
+	geminiUploadURL   = providerUtils.URLPathRewrite{Before: "v1beta", Prefix: "upload"}
+	geminiDownloadURL = providerUtils.URLPathRewrite{Before: "v1beta", Prefix: "download"}
+)
+
 type GeminiProvider struct {
-	logger               schemas.Logger                // Logger for provider operations
-	client               *fasthttp.Client              // HTTP client for API requests
-	networkConfig        schemas.NetworkConfig         // Network configuration including extra headers
-	sendBackRawRequest   bool                          // Whether to include raw request in BifrostResponse
-	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
-	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewGeminiProvider creates a new Gemini provider instance.
@@ -48,28 +49,21 @@ func NewGeminiProvider(config *schemas.ProviderConfig, logger schemas.Logger) *G
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://generativelanguage.googleapis.com/v1beta"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &GeminiProvider{
-		logger:               logger,
-		client:               client,
-		networkConfig:        config.NetworkConfig,
-		customProviderConfig: config.CustomProviderConfig,
-		sendBackRawRequest:   config.SendBackRawRequest,
-		sendBackRawResponse:  config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Gemini, config, logger),
+		client:       client,
 	}
 }
 
-// GetProviderKey returns the provider identifier for Gemini.
-func (provider *GeminiProvider) GetProviderKey() schemas.ModelProvider {
-	return providerUtils.GetProviderName(schemas.Gemini, provider.customProviderConfig)
-}
-
 // completeRequest handles the common HTTP request pattern for Gemini API calls
 func (provider *GeminiProvider) completeRequest(ctx context.Context, model string, key schemas.Key, jsonBody []byte, endpoint string, meta *providerUtils.RequestMetadata) (*GenerateContentResponse, interface{}, time.Duration, *schemas.BifrostError) {
 	providerName := provider.GetProviderKey()
@@ -81,10 +75,10 @@ func (provider *GeminiProvider) completeRequest(ctx context.Context, model strin
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Use Gemini's generateContent endpoint
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/models/"+model+endpoint))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, "/models/"+model+endpoint))
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType("application/json")
 	if key.Value != "" {
@@ -115,13 +109,13 @@ func (provider *GeminiProvider) completeRequest(ctx context.Context, model strin
 
 	// Parse Gemini's response
 	var geminiResponse GenerateContentResponse
-	if err := sonic.Unmarshal(responseBody, &geminiResponse); err != nil {
+	if err := serialization.Unmarshal(responseBody, &geminiResponse); err != nil {
 		return nil, nil, latency, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
 	var rawResponse interface{}
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
-		if err := sonic.Unmarshal(responseBody, &rawResponse); err != nil {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
+		if err := serialization.Unmarshal(responseBody, &rawResponse); err != nil {
 			return nil, nil, latency, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 		}
 	}
@@ -141,10 +135,10 @@ func (provider *GeminiProvider) listModelsByKey(ctx context.Context, key schemas
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Build URL using centralized URL construction
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("/models?pageSize=%d", schemas.DefaultPageSize)))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("/models?pageSize=%d", schemas.DefaultPageSize)))
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
 	if key.Value != "" {
@@ -167,7 +161,7 @@ func (provider *GeminiProvider) listModelsByKey(ctx context.Context, key schemas
 
 	// Parse Gemini's response
 	var geminiResponse GeminiListModelsResponse
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), &geminiResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), &geminiResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -177,12 +171,12 @@ func (provider *GeminiProvider) listModelsByKey(ctx context.Context, key schemas
 	response.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -192,10 +186,10 @@ func (provider *GeminiProvider) listModelsByKey(ctx context.Context, key schemas
 // ListModels performs a list models request to Gemini's API.
 // Requests are made concurrently for improved performance.
 func (provider *GeminiProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.ListModelsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.ListModelsRequest); err != nil {
 		return nil, err
 	}
-	if provider.customProviderConfig != nil && provider.customProviderConfig.IsKeyLess {
+	if provider.CustomProviderConfig() != nil && provider.CustomProviderConfig().IsKeyLess {
 		return provider.listModelsByKey(ctx, schemas.Key{}, request)
 	}
 	return providerUtils.HandleMultipleListModelsRequests(
@@ -203,7 +197,7 @@ func (provider *GeminiProvider) ListModels(ctx context.Context, keys []schemas.K
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -219,15 +213,62 @@ func (provider *GeminiProvider) TextCompletionStream(ctx context.Context, postHo
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.TextCompletionStreamRequest, provider.GetProviderKey())
 }
 
+// resolveChatAttachments looks up every file attached to a chat request, checking that it exists and is ready to use,
+// and fills in the file URI and MIME type Gemini needs to reference it. Returns a copy of the request if anything was resolved.
+func (provider *GeminiProvider) resolveChatAttachments(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatRequest, *schemas.BifrostError) {
+	if len(request.Attachments) == 0 {
+		return request, nil
+	}
+	providerName := provider.GetProviderKey()
+	attachments := make([]schemas.FileReference, len(request.Attachments))
+	for i, attachment := range request.Attachments {
+		if attachment.URI != nil && attachment.MimeType != nil {
+			attachments[i] = attachment
+			continue
+		}
+		if attachment.FileID == "" {
+			return nil, providerUtils.NewBifrostOperationError(fmt.Sprintf("attachment %d: file_id or uri and mime_type are required", i), nil, providerName)
+		}
+		file, _, bifrostErr := provider.getFileByKey(ctx, key, attachment.FileID)
+		if bifrostErr != nil {
+			if bifrostErr.Error != nil {
+				bifrostErr.Error.Message = fmt.Sprintf("attachment %d: failed to retrieve file %s: %s", i, attachment.FileID, bifrostErr.Error.Message)
+			}
+			return nil, bifrostErr
+		}
+		if status := ToBifrostFileStatus(file.State); status != schemas.FileStatusProcessed {
+			return nil, providerUtils.NewBifrostOperationError(fmt.Sprintf("attachment %d: file %s is not ready to use (state: %s)", i, attachment.FileID, file.State), nil, providerName)
+		}
+		if attachment.URI == nil {
+			attachment.URI = schemas.Ptr(file.URI)
+		}
+		if attachment.MimeType == nil {
+			attachment.MimeType = schemas.Ptr(file.MimeType)
+		}
+		if attachment.Filename == nil && file.DisplayName != "" {
+			attachment.Filename = schemas.Ptr(file.DisplayName)
+		}
+		attachments[i] = attachment
+	}
+	resolved := *request
+	resolved.Attachments = attachments
+	return &resolved, nil
+}
+
 // ChatCompletion performs a chat completion request to the Gemini API.
 func (provider *GeminiProvider) ChatCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
 	// Check if chat completion is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.ChatCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.ChatCompletionRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
+	request, bifrostErr := provider.resolveChatAttachments(ctx, key, request)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
 	jsonData, err := providerUtils.CheckContextAndGetRequestBody(
 		ctx,
 		request,
@@ -254,12 +295,12 @@ func (provider *GeminiProvider) ChatCompletion(ctx context.Context, key schemas.
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&bifrostResponse.ExtraFields, jsonData)
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -271,10 +312,15 @@ func (provider *GeminiProvider) ChatCompletion(ctx context.Context, key schemas.
 // Returns a channel containing BifrostResponse objects representing the stream or an error if the request fails.
 func (provider *GeminiProvider) ChatCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if chat completion stream is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.ChatCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.ChatCompletionStreamRequest); err != nil {
 		return nil, err
 	}
 
+	request, bifrostErr := provider.resolveChatAttachments(ctx, key, request)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
 	jsonData, err := providerUtils.CheckContextAndGetRequestBody(
 		ctx,
 		request,
@@ -303,17 +349,17 @@ func (provider *GeminiProvider) ChatCompletionStream(ctx context.Context, postHo
 	return HandleGeminiChatCompletionStream(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"),
 		jsonData,
 		headers,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		request.Model,
 		postHookRunner,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -524,7 +570,7 @@ func HandleGeminiChatCompletionStream(
 // It formats the request, sends it to Gemini, and processes the response.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *GeminiProvider) Responses(ctx context.Context, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.ResponsesRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.ResponsesRequest); err != nil {
 		return nil, err
 	}
 
@@ -564,12 +610,12 @@ func (provider *GeminiProvider) Responses(ctx context.Context, key schemas.Key,
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&bifrostResponse.ExtraFields, jsonData)
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -579,7 +625,7 @@ func (provider *GeminiProvider) Responses(ctx context.Context, key schemas.Key,
 // ResponsesStream performs a streaming responses request to the Gemini API.
 func (provider *GeminiProvider) ResponsesStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if responses stream is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.ResponsesStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.ResponsesStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -610,17 +656,17 @@ func (provider *GeminiProvider) ResponsesStream(ctx context.Context, postHookRun
 	return HandleGeminiResponsesStream(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"),
 		jsonData,
 		headers,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		request.Model,
 		postHookRunner,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -876,28 +922,28 @@ func HandleGeminiResponsesStream(
 // Embedding performs an embedding request to the Gemini API.
 func (provider *GeminiProvider) Embedding(ctx context.Context, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
 	// Check if embedding is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.EmbeddingRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.EmbeddingRequest); err != nil {
 		return nil, err
 	}
 	// Use the shared embedding request handler
 	return openai.HandleOpenAIEmbeddingRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/openai/embeddings"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/openai/embeddings"),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
 // Speech performs a speech synthesis request to the Gemini API.
 func (provider *GeminiProvider) Speech(ctx context.Context, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
 	// Check if speech is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.SpeechRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.SpeechRequest); err != nil {
 		return nil, err
 	}
 
@@ -932,11 +978,11 @@ func (provider *GeminiProvider) Speech(ctx context.Context, key schemas.Key, req
 	response.ExtraFields.RequestType = schemas.SpeechRequest
 	response.ExtraFields.Latency = latency.Milliseconds()
 
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonData)
 	}
 
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -946,7 +992,7 @@ func (provider *GeminiProvider) Speech(ctx context.Context, key schemas.Key, req
 // SpeechStream performs a streaming speech synthesis request to the Gemini API.
 func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if speech stream is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.SpeechStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.SpeechStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -969,7 +1015,7 @@ func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner
 	defer fasthttp.ReleaseRequest(req)
 
 	req.Header.SetMethod(http.MethodPost)
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"))
 	req.Header.SetContentType("application/json")
 
 	// Set headers for streaming
@@ -980,7 +1026,7 @@ func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner
 	req.Header.Set("Cache-Control", "no-cache")
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Set headers
 	req.SetBody(jsonBody)
@@ -1078,10 +1124,10 @@ func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner
 						},
 					}
 					ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.logger)
+					providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.Logger())
 					return
 				}
-				provider.logger.Warn(fmt.Sprintf("Failed to process chunk: %v", err))
+				provider.Logger().Warn(fmt.Sprintf("Failed to process chunk: %v", err))
 				continue
 			}
 
@@ -1129,7 +1175,7 @@ func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner
 				}
 				lastChunkTime = time.Now()
 
-				if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+				if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 					response.ExtraFields.RawResponse = jsonData
 				}
 
@@ -1140,8 +1186,8 @@ func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner
 
 		// Handle scanner errors
 		if err := scanner.Err(); err != nil {
-			provider.logger.Warn(fmt.Sprintf("Error reading stream: %v", err))
-			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.SpeechStreamRequest, providerName, request.Model, provider.logger)
+			provider.Logger().Warn(fmt.Sprintf("Error reading stream: %v", err))
+			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.SpeechStreamRequest, providerName, request.Model, provider.Logger())
 		} else {
 			response := &schemas.BifrostSpeechStreamResponse{
 				Type:  schemas.SpeechStreamResponseTypeDone,
@@ -1156,7 +1202,7 @@ func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner
 			}
 
 			// Set raw request if enabled
-			if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+			if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 				providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonBody)
 			}
 			ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
@@ -1170,7 +1216,10 @@ func (provider *GeminiProvider) SpeechStream(ctx context.Context, postHookRunner
 // Transcription performs a speech-to-text request to the Gemini API.
 func (provider *GeminiProvider) Transcription(ctx context.Context, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
 	// Check if transcription is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.TranscriptionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.TranscriptionRequest); err != nil {
+		return nil, err
+	}
+	if err := providerUtils.CheckTranslationSupported(provider.GetProviderKey(), schemas.TranscriptionRequest, request); err != nil {
 		return nil, err
 	}
 
@@ -1202,11 +1251,11 @@ func (provider *GeminiProvider) Transcription(ctx context.Context, key schemas.K
 	response.ExtraFields.RequestType = schemas.TranscriptionRequest
 	response.ExtraFields.Latency = latency.Milliseconds()
 
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonData)
 	}
 
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -1216,7 +1265,10 @@ func (provider *GeminiProvider) Transcription(ctx context.Context, key schemas.K
 // TranscriptionStream performs a streaming speech-to-text request to the Gemini API.
 func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if transcription stream is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.TranscriptionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.TranscriptionStreamRequest); err != nil {
+		return nil, err
+	}
+	if err := providerUtils.CheckTranslationSupported(provider.GetProviderKey(), schemas.TranscriptionStreamRequest, request); err != nil {
 		return nil, err
 	}
 
@@ -1239,11 +1291,11 @@ func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHoo
 	defer fasthttp.ReleaseRequest(req)
 
 	req.Header.SetMethod(http.MethodPost)
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, "/models/"+request.Model+":streamGenerateContent?alt=sse"))
 	req.Header.SetContentType("application/json")
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Set headers for streaming
 	if key.Value != "" {
@@ -1331,8 +1383,8 @@ func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHoo
 
 			// First, check if this is an error response
 			var errorCheck map[string]interface{}
-			if err := sonic.Unmarshal([]byte(jsonData), &errorCheck); err != nil {
-				provider.logger.Warn(fmt.Sprintf("Failed to parse stream data as JSON: %v", err))
+			if err := serialization.Unmarshal([]byte(jsonData), &errorCheck); err != nil {
+				provider.Logger().Warn(fmt.Sprintf("Failed to parse stream data as JSON: %v", err))
 				continue
 			}
 
@@ -1352,14 +1404,14 @@ func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHoo
 					},
 				}
 				ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-				providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.logger)
+				providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, bifrostErr, responseChan, provider.Logger())
 				return
 			}
 
 			// Parse Gemini streaming response
 			var geminiResponse GenerateContentResponse
-			if err := sonic.Unmarshal([]byte(jsonData), &geminiResponse); err != nil {
-				provider.logger.Warn(fmt.Sprintf("Failed to parse Gemini stream response: %v", err))
+			if err := serialization.Unmarshal([]byte(jsonData), &geminiResponse); err != nil {
+				provider.Logger().Warn(fmt.Sprintf("Failed to parse Gemini stream response: %v", err))
 				continue
 			}
 
@@ -1407,7 +1459,7 @@ func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHoo
 				}
 				lastChunkTime = time.Now()
 
-				if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+				if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 					response.ExtraFields.RawResponse = jsonData
 				}
 
@@ -1418,8 +1470,8 @@ func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHoo
 
 		// Handle scanner errors
 		if err := scanner.Err(); err != nil {
-			provider.logger.Warn(fmt.Sprintf("Error reading stream: %v", err))
-			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TranscriptionStreamRequest, providerName, request.Model, provider.logger)
+			provider.Logger().Warn(fmt.Sprintf("Error reading stream: %v", err))
+			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TranscriptionStreamRequest, providerName, request.Model, provider.Logger())
 		} else {
 			response := &schemas.BifrostTranscriptionStreamResponse{
 				Type: schemas.TranscriptionStreamResponseTypeDone,
@@ -1440,7 +1492,7 @@ func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHoo
 			}
 
 			// Set raw request if enabled
-			if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+			if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 				providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonBody)
 			}
 			ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
@@ -1457,7 +1509,7 @@ func (provider *GeminiProvider) TranscriptionStream(ctx context.Context, postHoo
 // Uses the asynchronous batchGenerateContent endpoint as per official documentation.
 // Supports both inline requests and file-based input (via InputFileID).
 func (provider *GeminiProvider) BatchCreate(ctx context.Context, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.BatchCreateRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.BatchCreateRequest); err != nil {
 		return nil, err
 	}
 
@@ -1475,10 +1527,20 @@ func (provider *GeminiProvider) BatchCreate(ctx context.Context, key schemas.Key
 		return nil, providerUtils.NewBifrostOperationError("cannot specify both input_file_id and requests", nil, providerName)
 	}
 
+	// batchGenerateContent only runs generate content requests
+	if _, err := providerUtils.NormalizeBatchEndpoint(schemas.Gemini, request.Endpoint); err != nil {
+		return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
+
+	displayName, err := provider.BatchName(ctx, request, fmt.Sprintf("bifrost-batch-%d", time.Now().UnixNano()))
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
+
 	// Build the batch request with proper nested structure
 	batchReq := &GeminiBatchCreateRequest{
 		Batch: GeminiBatchConfig{
-			DisplayName: fmt.Sprintf("bifrost-batch-%d", time.Now().UnixNano()),
+			DisplayName: displayName,
 		},
 	}
 
@@ -1501,7 +1563,7 @@ func (provider *GeminiProvider) BatchCreate(ctx context.Context, key schemas.Key
 		}
 	}
 
-	jsonData, err := sonic.Marshal(batchReq)
+	jsonData, err := serialization.Marshal(batchReq)
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
 	}
@@ -1521,9 +1583,9 @@ func (provider *GeminiProvider) BatchCreate(ctx context.Context, key schemas.Key
 	if model == "" {
 		model = "gemini-2.5-flash"
 	}
-	url := fmt.Sprintf("%s/models/%s:batchGenerateContent", provider.networkConfig.BaseURL, model)
+	url := fmt.Sprintf("%s/models/%s:batchGenerateContent", provider.NetworkConfig().BaseURL, model)
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(url)
 	req.Header.SetMethod(http.MethodPost)
 	if key.Value != "" {
@@ -1554,8 +1616,8 @@ func (provider *GeminiProvider) BatchCreate(ctx context.Context, key schemas.Key
 
 	// Parse the batch job response
 	var geminiResp GeminiBatchJobResponse
-	if err := sonic.Unmarshal(body, &geminiResp); err != nil {
-		provider.logger.Error("gemini batch create unmarshal error: " + err.Error())
+	if err := serialization.Unmarshal(body, &geminiResp); err != nil {
+		provider.Logger().Error("gemini batch create unmarshal error: " + err.Error())
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 	// Check for metadata
@@ -1637,7 +1699,7 @@ func (provider *GeminiProvider) batchListByKey(ctx context.Context, key schemas.
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL for listing batches
-	baseURL := fmt.Sprintf("%s/batches", provider.networkConfig.BaseURL)
+	baseURL := fmt.Sprintf("%s/batches", provider.NetworkConfig().BaseURL)
 	values := url.Values{}
 	if request.PageSize > 0 {
 		values.Set("pageSize", fmt.Sprintf("%d", request.PageSize))
@@ -1652,7 +1714,7 @@ func (provider *GeminiProvider) batchListByKey(ctx context.Context, key schemas.
 		requestURL += "?" + encodedValues
 	}
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	if key.Value != "" {
@@ -1670,7 +1732,7 @@ func (provider *GeminiProvider) batchListByKey(ctx context.Context, key schemas.
 	if resp.StatusCode() != fasthttp.StatusOK {
 		// If 404 or method not allowed, batch listing may not be available
 		if resp.StatusCode() == fasthttp.StatusNotFound || resp.StatusCode() == fasthttp.StatusMethodNotAllowed {
-			provider.logger.Debug("gemini batch list not available, returning empty list")
+			provider.Logger().Debug("gemini batch list not available, returning empty list")
 			return &schemas.BifrostBatchListResponse{
 				Object:  "list",
 				Data:    []schemas.BifrostBatchRetrieveResponse{},
@@ -1694,7 +1756,7 @@ func (provider *GeminiProvider) batchListByKey(ctx context.Context, key schemas.
 	}
 
 	var geminiResp GeminiBatchListResponse
-	if err := sonic.Unmarshal(body, &geminiResp); err != nil {
+	if err := serialization.Unmarshal(body, &geminiResp); err != nil {
 		return nil, latency, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
@@ -1738,7 +1800,7 @@ func (provider *GeminiProvider) batchListByKey(ctx context.Context, key schemas.
 // BatchList lists batch jobs using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *GeminiProvider) BatchList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.BatchListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.BatchListRequest); err != nil {
 		return nil, err
 	}
 
@@ -1749,7 +1811,7 @@ func (provider *GeminiProvider) BatchList(ctx context.Context, keys []schemas.Ke
 	}
 
 	// Initialize serial pagination helper (Gemini uses PageToken for pagination)
-	helper, err := providerUtils.NewSerialListHelper(keys, request.PageToken, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.PageToken, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -1823,12 +1885,12 @@ func (provider *GeminiProvider) batchRetrieveByKey(ctx context.Context, key sche
 	batchID := request.BatchID
 	var requestURL string
 	if strings.HasPrefix(batchID, "batches/") {
-		requestURL = fmt.Sprintf("%s/%s", provider.networkConfig.BaseURL, batchID)
+		requestURL = fmt.Sprintf("%s/%s", provider.NetworkConfig().BaseURL, batchID)
 	} else {
-		requestURL = fmt.Sprintf("%s/batches/%s", provider.networkConfig.BaseURL, batchID)
+		requestURL = fmt.Sprintf("%s/batches/%s", provider.NetworkConfig().BaseURL, batchID)
 	}
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	if key.Value != "" {
@@ -1856,7 +1918,7 @@ func (provider *GeminiProvider) batchRetrieveByKey(ctx context.Context, key sche
 	}
 
 	var geminiResp GeminiBatchJobResponse
-	if err := sonic.Unmarshal(body, &geminiResp); err != nil {
+	if err := serialization.Unmarshal(body, &geminiResp); err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
@@ -1895,7 +1957,7 @@ func (provider *GeminiProvider) batchRetrieveByKey(ctx context.Context, key sche
 
 // BatchRetrieve retrieves a specific batch job for Gemini, trying each key until successful.
 func (provider *GeminiProvider) BatchRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.BatchRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.BatchRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -1917,7 +1979,7 @@ func (provider *GeminiProvider) BatchRetrieve(ctx context.Context, keys []schema
 			return resp, nil
 		}
 		lastError = err
-		provider.logger.Debug(fmt.Sprintf("BatchRetrieve failed for key %s: %v", key.Name, err.Error.Message))
+		provider.Logger().Debug(fmt.Sprintf("BatchRetrieve failed for key %s: %v", key.Name, err.Error.Message))
 	}
 
 	// All keys failed, return the last error
@@ -1938,13 +2000,13 @@ func (provider *GeminiProvider) batchCancelByKey(ctx context.Context, key schema
 	batchID := request.BatchID
 	var requestURL string
 	if strings.HasPrefix(batchID, "batches/") {
-		requestURL = fmt.Sprintf("%s/%s:cancel", provider.networkConfig.BaseURL, batchID)
+		requestURL = fmt.Sprintf("%s/%s:cancel", provider.NetworkConfig().BaseURL, batchID)
 	} else {
-		requestURL = fmt.Sprintf("%s/batches/%s:cancel", provider.networkConfig.BaseURL, batchID)
+		requestURL = fmt.Sprintf("%s/batches/%s:cancel", provider.NetworkConfig().BaseURL, batchID)
 	}
 
-	provider.logger.Debug("gemini batch cancel url: " + requestURL)
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	provider.Logger().Debug("gemini batch cancel url: " + requestURL)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodPost)
 	if key.Value != "" {
@@ -1992,7 +2054,7 @@ func (provider *GeminiProvider) batchCancelByKey(ctx context.Context, key schema
 // BatchCancel cancels a batch job for Gemini, trying each key until successful.
 // Note: Cancellation support depends on the API version and batch state.
 func (provider *GeminiProvider) BatchCancel(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.BatchCancelRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.BatchCancelRequest); err != nil {
 		return nil, err
 	}
 
@@ -2014,7 +2076,7 @@ func (provider *GeminiProvider) BatchCancel(ctx context.Context, keys []schemas.
 			return resp, nil
 		}
 		lastError = err
-		provider.logger.Debug(fmt.Sprintf("BatchCancel failed for key %s: %v", key.Name, err.Error.Message))
+		provider.Logger().Debug(fmt.Sprintf("BatchCancel failed for key %s: %v", key.Name, err.Error.Message))
 	}
 
 	// All keys failed, return the last error
@@ -2025,7 +2087,7 @@ func (provider *GeminiProvider) BatchCancel(ctx context.Context, keys []schemas.
 func processGeminiStreamChunk(jsonData string) (*GenerateContentResponse, error) {
 	// First, check if this is an error response
 	var errorCheck map[string]interface{}
-	if err := sonic.Unmarshal([]byte(jsonData), &errorCheck); err != nil {
+	if err := serialization.Unmarshal([]byte(jsonData), &errorCheck); err != nil {
 		return nil, fmt.Errorf("failed to parse stream data as JSON: %v", err)
 	}
 
@@ -2036,7 +2098,7 @@ func processGeminiStreamChunk(jsonData string) (*GenerateContentResponse, error)
 
 	// Parse Gemini streaming response
 	var geminiResponse GenerateContentResponse
-	if err := sonic.Unmarshal([]byte(jsonData), &geminiResponse); err != nil {
+	if err := serialization.Unmarshal([]byte(jsonData), &geminiResponse); err != nil {
 		return nil, fmt.Errorf("failed to parse Gemini stream response: %v", err)
 	}
 
@@ -2057,13 +2119,13 @@ func (provider *GeminiProvider) batchResultsByKey(ctx context.Context, key schem
 	batchID := request.BatchID
 	var requestURL string
 	if strings.HasPrefix(batchID, "batches/") {
-		requestURL = fmt.Sprintf("%s/%s", provider.networkConfig.BaseURL, batchID)
+		requestURL = fmt.Sprintf("%s/%s", provider.NetworkConfig().BaseURL, batchID)
 	} else {
-		requestURL = fmt.Sprintf("%s/batches/%s", provider.networkConfig.BaseURL, batchID)
+		requestURL = fmt.Sprintf("%s/batches/%s", provider.NetworkConfig().BaseURL, batchID)
 	}
 
-	provider.logger.Debug("gemini batch results url: " + requestURL)
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	provider.Logger().Debug("gemini batch results url: " + requestURL)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	if key.Value != "" {
@@ -2091,7 +2153,7 @@ func (provider *GeminiProvider) batchResultsByKey(ctx context.Context, key schem
 	}
 
 	var geminiResp GeminiBatchJobResponse
-	if err := sonic.Unmarshal(body, &geminiResp); err != nil {
+	if err := serialization.Unmarshal(body, &geminiResp); err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
@@ -2110,7 +2172,7 @@ func (provider *GeminiProvider) batchResultsByKey(ctx context.Context, key schem
 
 	if geminiResp.Dest != nil && geminiResp.Dest.FileName != "" {
 		// File-based results: download and parse the results file
-		provider.logger.Debug("gemini batch results in file: " + geminiResp.Dest.FileName)
+		provider.Logger().Debug("gemini batch results in file: " + geminiResp.Dest.FileName)
 		fileResults, fileParseErrors, bifrostErr := provider.downloadBatchResultsFile(ctx, key, geminiResp.Dest.FileName)
 		if bifrostErr != nil {
 			return nil, bifrostErr
@@ -2135,6 +2197,10 @@ func (provider *GeminiProvider) batchResultsByKey(ctx context.Context, key schem
 					Code:    fmt.Sprintf("%d", inlineResp.Error.Code),
 					Message: inlineResp.Error.Message,
 				}
+				// Set the HTTP-equivalent status of the gRPC error code, as for other providers' errored items
+				resultItem.Response = &schemas.BatchResultResponse{
+					StatusCode: providerUtils.GoogleErrorHTTPStatus(inlineResp.Error.Code, inlineResp.Error.Status),
+				}
 			} else if inlineResp.Response != nil {
 				// Convert the response to a map for the Body field
 				respBody := make(map[string]interface{})
@@ -2205,7 +2271,7 @@ func (provider *GeminiProvider) batchResultsByKey(ctx context.Context, key schem
 // Results are extracted from dest.inlinedResponses for inline batches,
 // or downloaded from dest.fileName for file-based batches.
 func (provider *GeminiProvider) BatchResults(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.BatchResultsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.BatchResultsRequest); err != nil {
 		return nil, err
 	}
 
@@ -2227,7 +2293,7 @@ func (provider *GeminiProvider) BatchResults(ctx context.Context, keys []schemas
 			return resp, nil
 		}
 		lastError = err
-		provider.logger.Debug(fmt.Sprintf("BatchResults failed for key %s: %v", key.Name, err.Error.Message))
+		provider.Logger().Debug(fmt.Sprintf("BatchResults failed for key %s: %v", key.Name, err.Error.Message))
 	}
 
 	// All keys failed, return the last error
@@ -2236,54 +2302,31 @@ func (provider *GeminiProvider) BatchResults(ctx context.Context, keys []schemas
 
 // FileUpload uploads a file to Gemini.
 func (provider *GeminiProvider) FileUpload(ctx context.Context, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.FileUploadRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.FileUploadRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
-	if len(request.File) == 0 {
+	if !providerUtils.HasFileUploadContent(request) {
 		return nil, providerUtils.NewBifrostOperationError("file content is required", nil, providerName)
 	}
 
-	// Create multipart request
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
-
-	// Add file metadata as JSON
-	metadataField, err := writer.CreateFormField("metadata")
-	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to create metadata field", err, providerName)
-	}
+	// Create file metadata as JSON
 	metadata := map[string]interface{}{
 		"file": map[string]string{
 			"displayName": request.Filename,
 		},
 	}
-	metadataJSON, err := sonic.Marshal(metadata)
+	metadataJSON, err := serialization.Marshal(metadata)
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("failed to marshal metadata", err, providerName)
 	}
-	if _, err := metadataField.Write(metadataJSON); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to write metadata", err, providerName)
-	}
 
-	// Add file content
 	filename := request.Filename
 	if filename == "" {
 		filename = "file.bin"
 	}
-	part, err := writer.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to create form file", err, providerName)
-	}
-	if _, err := part.Write(request.File); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to write file content", err, providerName)
-	}
-
-	if err := writer.Close(); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to close multipart writer", err, providerName)
-	}
 
 	// Create request
 	req := fasthttp.AcquireRequest()
@@ -2292,17 +2335,19 @@ func (provider *GeminiProvider) FileUpload(ctx context.Context, key schemas.Key,
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL - use upload endpoint
-	baseURL := strings.Replace(provider.networkConfig.BaseURL, "/v1beta", "/upload/v1beta", 1)
-	requestURL := fmt.Sprintf("%s/files", baseURL)
+	requestURL := providerUtils.JoinURL(geminiUploadURL.Apply(provider.NetworkConfig().BaseURL), "/files")
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodPost)
-	req.Header.SetContentType(writer.FormDataContentType())
 	if key.Value != "" {
 		req.Header.Set("x-goog-api-key", key.Value)
 	}
-	req.SetBody(buf.Bytes())
+
+	// Set the multipart form with the metadata and the file, streamed if it is read from a reader
+	if err := providerUtils.SetMultipartFileUploadBody(req, request, filename, providerUtils.MultipartField{Name: "metadata", Value: string(metadataJSON)}); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to create multipart form data", err, providerName)
+	}
 
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
@@ -2327,7 +2372,7 @@ func (provider *GeminiProvider) FileUpload(ctx context.Context, key schemas.Key,
 	var responseWrapper struct {
 		File GeminiFileResponse `json:"file"`
 	}
-	if err := sonic.Unmarshal(body, &responseWrapper); err != nil {
+	if err := serialization.Unmarshal(body, &responseWrapper); err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
@@ -2381,7 +2426,7 @@ func (provider *GeminiProvider) fileListByKey(ctx context.Context, key schemas.K
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL with pagination
-	requestURL := fmt.Sprintf("%s/files", provider.networkConfig.BaseURL)
+	requestURL := fmt.Sprintf("%s/files", provider.NetworkConfig().BaseURL)
 	values := url.Values{}
 	if request.Limit > 0 {
 		values.Set("pageSize", fmt.Sprintf("%d", request.Limit))
@@ -2393,7 +2438,7 @@ func (provider *GeminiProvider) fileListByKey(ctx context.Context, key schemas.K
 		requestURL += "?" + encodedValues
 	}
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -2421,7 +2466,7 @@ func (provider *GeminiProvider) fileListByKey(ctx context.Context, key schemas.K
 	}
 
 	var geminiResp GeminiFileListResponse
-	if err := sonic.Unmarshal(body, &geminiResp); err != nil {
+	if err := serialization.Unmarshal(body, &geminiResp); err != nil {
 		return nil, latency, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 	}
 
@@ -2477,7 +2522,7 @@ func (provider *GeminiProvider) fileListByKey(ctx context.Context, key schemas.K
 // FileList lists files using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *GeminiProvider) FileList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.FileListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.FileListRequest); err != nil {
 		return nil, err
 	}
 
@@ -2488,7 +2533,7 @@ func (provider *GeminiProvider) FileList(ctx context.Context, keys []schemas.Key
 	}
 
 	// Initialize serial pagination helper
-	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -2548,8 +2593,8 @@ func (provider *GeminiProvider) FileList(ctx context.Context, keys []schemas.Key
 	return result, nil
 }
 
-// fileRetrieveByKey retrieves file metadata from Gemini for a single key.
-func (provider *GeminiProvider) fileRetrieveByKey(ctx context.Context, key schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
+// getFileByKey fetches the metadata of a Gemini file for a single key.
+func (provider *GeminiProvider) getFileByKey(ctx context.Context, key schemas.Key, fileID string) (*GeminiFileResponse, time.Duration, *schemas.BifrostError) {
 	providerName := provider.GetProviderKey()
 
 	// Create request
@@ -2559,13 +2604,12 @@ func (provider *GeminiProvider) fileRetrieveByKey(ctx context.Context, key schem
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL - file ID is the full resource name (e.g., "files/abc123")
-	fileID := request.FileID
 	if !strings.HasPrefix(fileID, "files/") {
 		fileID = "files/" + fileID
 	}
-	requestURL := fmt.Sprintf("%s/%s", provider.networkConfig.BaseURL, fileID)
+	requestURL := fmt.Sprintf("%s/%s", provider.NetworkConfig().BaseURL, fileID)
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -2576,12 +2620,12 @@ func (provider *GeminiProvider) fileRetrieveByKey(ctx context.Context, key schem
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
 	if bifrostErr != nil {
-		return nil, bifrostErr
+		return nil, latency, bifrostErr
 	}
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		return nil, parseGeminiError(resp, &providerUtils.RequestMetadata{
+		return nil, latency, parseGeminiError(resp, &providerUtils.RequestMetadata{
 			Provider:    providerName,
 			RequestType: schemas.FileRetrieveRequest,
 		})
@@ -2589,12 +2633,23 @@ func (provider *GeminiProvider) fileRetrieveByKey(ctx context.Context, key schem
 
 	body, err := providerUtils.CheckAndDecodeBody(resp)
 	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
+		return nil, latency, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 	}
 
 	var geminiResp GeminiFileResponse
-	if err := sonic.Unmarshal(body, &geminiResp); err != nil {
-		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
+	if err := serialization.Unmarshal(body, &geminiResp); err != nil {
+		return nil, latency, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
+	}
+	return &geminiResp, latency, nil
+}
+
+// fileRetrieveByKey retrieves file metadata from Gemini for a single key.
+func (provider *GeminiProvider) fileRetrieveByKey(ctx context.Context, key schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	geminiResp, latency, bifrostErr := provider.getFileByKey(ctx, key, request.FileID)
+	if bifrostErr != nil {
+		return nil, bifrostErr
 	}
 
 	var sizeBytes int64
@@ -2634,7 +2689,7 @@ func (provider *GeminiProvider) fileRetrieveByKey(ctx context.Context, key schem
 
 // FileRetrieve retrieves file metadata from Gemini, trying each key until successful.
 func (provider *GeminiProvider) FileRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.FileRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.FileRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -2656,7 +2711,7 @@ func (provider *GeminiProvider) FileRetrieve(ctx context.Context, keys []schemas
 			return resp, nil
 		}
 		lastError = err
-		provider.logger.Debug(fmt.Sprintf("FileRetrieve failed for key %s: %v", key.Name, err.Error.Message))
+		provider.Logger().Debug(fmt.Sprintf("FileRetrieve failed for key %s: %v", key.Name, err.Error.Message))
 	}
 
 	// All keys failed, return the last error
@@ -2678,9 +2733,9 @@ func (provider *GeminiProvider) fileDeleteByKey(ctx context.Context, key schemas
 	if !strings.HasPrefix(fileID, "files/") {
 		fileID = "files/" + fileID
 	}
-	requestURL := fmt.Sprintf("%s/%s", provider.networkConfig.BaseURL, fileID)
+	requestURL := fmt.Sprintf("%s/%s", provider.NetworkConfig().BaseURL, fileID)
 
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodDelete)
 	req.Header.SetContentType("application/json")
@@ -2716,7 +2771,7 @@ func (provider *GeminiProvider) fileDeleteByKey(ctx context.Context, key schemas
 
 // FileDelete deletes a file from Gemini, trying each key until successful.
 func (provider *GeminiProvider) FileDelete(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.FileDeleteRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.FileDeleteRequest); err != nil {
 		return nil, err
 	}
 
@@ -2738,7 +2793,7 @@ func (provider *GeminiProvider) FileDelete(ctx context.Context, keys []schemas.K
 			return resp, nil
 		}
 		lastError = err
-		provider.logger.Debug(fmt.Sprintf("FileDelete failed for key %s: %v", key.Name, err.Error.Message))
+		provider.Logger().Debug(fmt.Sprintf("FileDelete failed for key %s: %v", key.Name, err.Error.Message))
 	}
 
 	// All keys failed, return the last error
@@ -2749,7 +2804,7 @@ func (provider *GeminiProvider) FileDelete(ctx context.Context, keys []schemas.K
 // Note: Gemini Files API doesn't support direct content download.
 // Files are accessed via their URI in API requests.
 func (provider *GeminiProvider) FileContent(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.customProviderConfig, schemas.FileContentRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.Gemini, provider.CustomProviderConfig(), schemas.FileContentRequest); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,52 @@
+package gemini
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Test that blocked candidates and blocked prompts are returned as refusals
+func TestToBifrostChatResponse_Refusal(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		expectedRefusal string
+	}{
+		{
+			name:            "blocked candidate",
+			body:            `{"modelVersion":"gemini-2.5-flash","candidates":[{"finishReason":"SAFETY","safetyRatings":[{"category":"HARM_CATEGORY_DANGEROUS_CONTENT","probability":"HIGH","blocked":true}]}]}`,
+			expectedRefusal: "Gemini blocked the response: SAFETY",
+		},
+		{
+			name:            "blocked prompt",
+			body:            `{"modelVersion":"gemini-2.5-flash","promptFeedback":{"blockReason":"PROHIBITED_CONTENT"}}`,
+			expectedRefusal: "Gemini blocked the prompt: PROHIBITED_CONTENT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var response GenerateContentResponse
+			if err := json.Unmarshal([]byte(tt.body), &response); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+
+			chatResponse := response.ToBifrostChatResponse()
+			if len(chatResponse.Choices) != 1 {
+				t.Fatalf("Expected a single choice, got %d", len(chatResponse.Choices))
+			}
+			choice := chatResponse.Choices[0]
+			if choice.Message.ChatAssistantMessage == nil || choice.Message.ChatAssistantMessage.Refusal == nil || *choice.Message.ChatAssistantMessage.Refusal != tt.expectedRefusal {
+				t.Errorf("Expected refusal %q, got %+v", tt.expectedRefusal, choice.Message.ChatAssistantMessage)
+			}
+			if choice.FinishReason == nil || *choice.FinishReason != "content_filter" {
+				t.Errorf("Expected the content_filter finish reason, got %v", choice.FinishReason)
+			}
+
+			output := response.ToResponsesBifrostResponsesResponse().Output
+			if len(output) != 1 || output[0].Content == nil || output[0].Content.ContentBlocks[0].ResponsesOutputMessageContentRefusal == nil ||
+				output[0].Content.ContentBlocks[0].ResponsesOutputMessageContentRefusal.Refusal != tt.expectedRefusal {
+				t.Errorf("Expected a refusal output item, got %+v", output)
+			}
+		})
+	}
+}
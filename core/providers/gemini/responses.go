@@ -7,8 +7,8 @@ import (
 	"sync"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 func (request *GeminiGenerationRequest) ToBifrostResponsesRequest() *schemas.BifrostResponsesRequest {
@@ -139,6 +139,18 @@ func (response *GenerateContentResponse) ToResponsesBifrostResponsesResponse() *
 		if len(outputMessages) > 0 {
 			bifrostResp.Output = outputMessages
 		}
+		bifrostResp.SourceCitations = convertGeminiCandidateCitations(response.Candidates[0])
+		// Blocked candidates come without content, they are returned as a refusal
+		if finishReason := response.Candidates[0].FinishReason; isGeminiRefusal(finishReason) {
+			bifrostResp.Output = schemas.SetResponsesRefusal(bifrostResp.Output, fmt.Sprintf("Gemini blocked the response: %s", finishReason))
+		}
+	} else if response.PromptFeedback != nil && response.PromptFeedback.BlockReason != "" {
+		// Blocked prompts get no candidate at all
+		refusal := response.PromptFeedback.BlockReasonMessage
+		if refusal == "" {
+			refusal = fmt.Sprintf("Gemini blocked the prompt: %s", response.PromptFeedback.BlockReason)
+		}
+		bifrostResp.Output = schemas.SetResponsesRefusal(nil, refusal)
 	}
 
 	return bifrostResp
@@ -217,7 +229,7 @@ func ToGeminiResponsesResponse(bifrostResp *schemas.BifrostResponsesResponse) *G
 			if msg.Type != nil && *msg.Type == schemas.ResponsesMessageTypeFunctionCall && msg.ResponsesToolMessage != nil {
 				argsMap := make(map[string]any)
 				if msg.ResponsesToolMessage.Arguments != nil {
-					if err := sonic.Unmarshal([]byte(*msg.ResponsesToolMessage.Arguments), &argsMap); err == nil {
+					if err := serialization.Unmarshal([]byte(*msg.ResponsesToolMessage.Arguments), &argsMap); err == nil {
 						functionCall := &FunctionCall{
 							Args: argsMap,
 						}
@@ -396,7 +408,7 @@ func ToGeminiResponsesStreamResponse(bifrostResp *schemas.BifrostResponsesStream
 		if bifrostResp.Item != nil && bifrostResp.Item.ResponsesToolMessage != nil {
 			argsMap := make(map[string]any)
 			if bifrostResp.Item.ResponsesToolMessage.Arguments != nil {
-				if err := sonic.Unmarshal([]byte(*bifrostResp.Item.ResponsesToolMessage.Arguments), &argsMap); err == nil {
+				if err := serialization.Unmarshal([]byte(*bifrostResp.Item.ResponsesToolMessage.Arguments), &argsMap); err == nil {
 					functionCall := &FunctionCall{
 						Name: "",
 						Args: argsMap,
@@ -977,7 +989,7 @@ func processGeminiFunctionCallPart(part *Part, state *GeminiResponsesStreamState
 	// Convert args to JSON string
 	argsJSON := ""
 	if part.FunctionCall.Args != nil {
-		if argsBytes, err := sonic.Marshal(part.FunctionCall.Args); err == nil {
+		if argsBytes, err := serialization.Marshal(part.FunctionCall.Args); err == nil {
 			argsJSON = string(argsBytes)
 		}
 	}
@@ -1414,7 +1426,7 @@ func convertGeminiContentsToResponsesMessages(contents []Content) []schemas.Resp
 
 					argsJSON := "{}"
 					if part.FunctionCall.Args != nil {
-						if argsBytes, err := sonic.Marshal(part.FunctionCall.Args); err == nil {
+						if argsBytes, err := serialization.Marshal(part.FunctionCall.Args); err == nil {
 							argsJSON = string(argsBytes)
 						}
 					}
@@ -1457,7 +1469,7 @@ func convertGeminiContentsToResponsesMessages(contents []Content) []schemas.Resp
 					if part.FunctionResponse.Response != nil {
 						if output, ok := part.FunctionResponse.Response["output"].(string); ok {
 							responseStr = output
-						} else if responseBytes, err := sonic.Marshal(part.FunctionResponse.Response); err == nil {
+						} else if responseBytes, err := serialization.Marshal(part.FunctionResponse.Response); err == nil {
 							responseStr = string(responseBytes)
 						}
 					}
@@ -1672,7 +1684,7 @@ func convertGeminiCandidatesToResponsesOutput(candidates []*Candidate) []schemas
 				// Convert Args to JSON string if it's not already a string
 				argumentsStr := ""
 				if part.FunctionCall.Args != nil {
-					if argsBytes, err := sonic.Marshal(part.FunctionCall.Args); err == nil {
+					if argsBytes, err := serialization.Marshal(part.FunctionCall.Args); err == nil {
 						argumentsStr = string(argsBytes)
 					}
 				}
@@ -2241,7 +2253,7 @@ func convertResponsesMessagesToGeminiContents(messages []schemas.ResponsesMessag
 				if msg.ResponsesToolMessage.Name != nil {
 					argsMap := map[string]any{}
 					if msg.ResponsesToolMessage.Arguments != nil {
-						if err := sonic.Unmarshal([]byte(*msg.ResponsesToolMessage.Arguments), &argsMap); err != nil {
+						if err := serialization.Unmarshal([]byte(*msg.ResponsesToolMessage.Arguments), &argsMap); err != nil {
 							return nil, nil, fmt.Errorf("failed to decode function call arguments: %w", err)
 						}
 					}
@@ -90,8 +90,8 @@ func ToGeminiSpeechRequest(bifrostReq *schemas.BifrostSpeechRequest) (*GeminiGen
 	}
 	// Here we confirm if the response_format is wav or empty string
 	// If its anything else, we will return an error
-	if bifrostReq.Params != nil && bifrostReq.Params.ResponseFormat != "" && bifrostReq.Params.ResponseFormat != "wav" {
-		return nil, fmt.Errorf("gemini does not support response_format: %s. Only wav or empty string is supported which defaults to wav", bifrostReq.Params.ResponseFormat)
+	if bifrostReq.Params != nil && bifrostReq.Params.ResponseFormat != "" && bifrostReq.Params.ResponseFormat != "wav" && bifrostReq.Params.ResponseFormat != "pcm" {
+		return nil, fmt.Errorf("gemini does not support response_format: %s. Only wav, pcm or empty string is supported which defaults to wav", bifrostReq.Params.ResponseFormat)
 	}
 	// Create the base Gemini generation request
 	geminiReq := &GeminiGenerationRequest{
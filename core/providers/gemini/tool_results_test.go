@@ -0,0 +1,87 @@
+package gemini
+
+import (
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// toolResultConversation returns a conversation where the assistant called a tool and its result was sent back
+func toolResultConversation() []schemas.ChatMessage {
+	return []schemas.ChatMessage{
+		{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("What is the weather in Paris?")}},
+		{
+			Role: schemas.ChatMessageRoleAssistant,
+			ChatAssistantMessage: &schemas.ChatAssistantMessage{
+				ToolCalls: []schemas.ChatAssistantMessageToolCall{{
+					ID:       schemas.Ptr("call_1"),
+					Type:     schemas.Ptr("function"),
+					Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("get_weather"), Arguments: `{"city":"Paris"}`},
+				}},
+			},
+		},
+		{
+			Role:            schemas.ChatMessageRoleTool,
+			Content:         &schemas.ChatMessageContent{ContentStr: schemas.Ptr(`{"temperature":20}`)},
+			ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: schemas.Ptr("call_1")},
+		},
+	}
+}
+
+// checkGeminiToolResult checks that the tool result is a user functionResponse correlated with the function call
+func checkGeminiToolResult(t *testing.T, contents []Content) {
+	t.Helper()
+	if len(contents) != 3 {
+		t.Fatalf("Expected 3 contents, got %d", len(contents))
+	}
+	call := contents[1]
+	if call.Role != "model" || len(call.Parts) != 1 || call.Parts[0].FunctionCall == nil || call.Parts[0].FunctionCall.Name != "get_weather" {
+		t.Fatalf("Expected a model function call, got %+v", call)
+	}
+	result := contents[2]
+	if result.Role != "user" || len(result.Parts) != 1 {
+		t.Fatalf("Expected a single user part for the tool result, got %+v", result)
+	}
+	response := result.Parts[0].FunctionResponse
+	if response == nil || result.Parts[0].Text != "" {
+		t.Fatalf("Expected only a function response part, got %+v", result.Parts[0])
+	}
+	if response.ID != "call_1" || response.Name != "get_weather" || response.Response["temperature"] != float64(20) {
+		t.Errorf("Unexpected function response: %+v", response)
+	}
+}
+
+// Test that tool result messages are converted to functionResponse parts named after the called function
+func TestConvertBifrostMessagesToGemini_ToolResult(t *testing.T) {
+	checkGeminiToolResult(t, convertBifrostMessagesToGemini(toolResultConversation()))
+}
+
+// Test that batch requests convert tool calls and tool results like chat requests, instead of dropping
+// messages without string content
+func TestBuildBatchRequestItems_ToolResult(t *testing.T) {
+	items := buildBatchRequestItems([]schemas.BatchRequestItem{{
+		CustomID: "request-1",
+		Body: map[string]interface{}{
+			"messages": []interface{}{
+				map[string]interface{}{"role": "system", "content": "You are a weather assistant."},
+				map[string]interface{}{"role": "user", "content": "What is the weather in Paris?"},
+				map[string]interface{}{
+					"role": "assistant",
+					"tool_calls": []interface{}{map[string]interface{}{
+						"id":       "call_1",
+						"type":     "function",
+						"function": map[string]interface{}{"name": "get_weather", "arguments": `{"city":"Paris"}`},
+					}},
+				},
+				map[string]interface{}{"role": "tool", "tool_call_id": "call_1", "content": `{"temperature":20}`},
+			},
+		},
+	}})
+	if len(items) != 1 || items[0].Metadata == nil || items[0].Metadata.Key != "request-1" {
+		t.Fatalf("Expected 1 batch item keyed by its custom id, got %+v", items)
+	}
+	checkGeminiToolResult(t, items[0].Request.Contents)
+	if parts := items[0].Request.Contents[0].Parts; len(parts) != 1 || parts[0].Text != "What is the weather in Paris?" {
+		t.Errorf("Expected the user message to be kept, got %+v", parts)
+	}
+}
@@ -10,8 +10,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 type Role string
@@ -930,7 +930,7 @@ func (tc *GenerationConfigThinkingConfig) UnmarshalJSON(data []byte) error {
 	}
 
 	var aux Alias
-	if err := sonic.Unmarshal(data, &aux); err != nil {
+	if err := serialization.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 
@@ -1292,6 +1292,60 @@ type Candidate struct {
 	SafetyRatings []*SafetyRating `json:"safetyRatings,omitempty"`
 }
 
+// GroundingMetadata is the typed form of a candidate's groundingMetadata, limited to the fields used for citations.
+type GroundingMetadata struct {
+	// Sources retrieved by grounding, e.g. web pages found by Google Search.
+	GroundingChunks []GroundingChunk `json:"groundingChunks,omitempty"`
+	// Parts of the generated content supported by grounding chunks.
+	GroundingSupports []GroundingSupport `json:"groundingSupports,omitempty"`
+}
+
+// GroundingChunk is a source retrieved by grounding, either from the web or from a retrieval tool.
+type GroundingChunk struct {
+	Web              *GroundingChunkSource `json:"web,omitempty"`
+	RetrievedContext *GroundingChunkSource `json:"retrievedContext,omitempty"`
+}
+
+// GroundingChunkSource identifies the source of a grounding chunk.
+type GroundingChunkSource struct {
+	URI   string `json:"uri,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// GroundingSupport attributes a segment of the generated content to grounding chunks.
+type GroundingSupport struct {
+	Segment *GroundingSegment `json:"segment,omitempty"`
+	// Indices into GroundingChunks of the chunks supporting the segment.
+	GroundingChunkIndices []int `json:"groundingChunkIndices,omitempty"`
+	// Confidence of each supporting chunk, in the order of GroundingChunkIndices.
+	ConfidenceScores []float64 `json:"confidenceScores,omitempty"`
+}
+
+// GroundingSegment is a segment of the generated content. Indices are byte offsets in the part's text.
+type GroundingSegment struct {
+	PartIndex  int    `json:"partIndex,omitempty"`
+	StartIndex int    `json:"startIndex,omitempty"`
+	EndIndex   int    `json:"endIndex,omitempty"`
+	Text       string `json:"text,omitempty"`
+}
+
+// CitationMetadata is the typed form of a candidate's citationMetadata.
+type CitationMetadata struct {
+	// Gemini API field name.
+	CitationSources []CitationSource `json:"citationSources,omitempty"`
+	// Vertex AI field name.
+	Citations []CitationSource `json:"citations,omitempty"`
+}
+
+// CitationSource attributes a span of the generated content to a source.
+type CitationSource struct {
+	StartIndex *int   `json:"startIndex,omitempty"`
+	EndIndex   *int   `json:"endIndex,omitempty"`
+	URI        string `json:"uri,omitempty"`
+	Title      string `json:"title,omitempty"`
+	License    string `json:"license,omitempty"`
+}
+
 // GenerateContentResponsePromptFeedback represents content filter results for a prompt sent in the request.
 type GenerateContentResponsePromptFeedback struct {
 	// Output only. Blocked reason.
@@ -1409,6 +1463,10 @@ type GeminiGenerationErrorDetails struct {
 	FieldViolations []struct {
 		Description string `json:"description"`
 	} `json:"fieldViolations"`
+	// Quota violations of a RESOURCE_EXHAUSTED error (google.rpc.QuotaFailure)
+	Violations []struct {
+		QuotaID string `json:"quotaId"`
+	} `json:"violations,omitempty"`
 }
 
 // ==================== MODEL TYPES ====================
@@ -3,8 +3,8 @@ package gemini
 import (
 	"strings"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 func (r *GeminiGenerationRequest) convertGenerationConfigToResponsesParameters() *schemas.ResponsesParameters {
@@ -119,13 +119,13 @@ func convertSchemaToFunctionParameters(schema *Schema) schemas.ToolFunctionParam
 
 func convertSchemaToMap(schema *Schema) schemas.OrderedMap {
 	// Convert map[string]*Schema to map[string]interface{} using JSON marshaling
-	data, err := sonic.Marshal(schema.Properties)
+	data, err := serialization.Marshal(schema.Properties)
 	if err != nil {
 		return make(map[string]interface{})
 	}
 
 	var properties map[string]interface{}
-	if err := sonic.Unmarshal(data, &properties); err != nil {
+	if err := serialization.Unmarshal(data, &properties); err != nil {
 		return make(map[string]interface{})
 	}
 
@@ -232,6 +232,15 @@ var (
 	}
 )
 
+// isGeminiRefusal reports whether a finish reason means Gemini blocked the response for safety or policy reasons.
+func isGeminiRefusal(reason FinishReason) bool {
+	switch reason {
+	case FinishReasonSafety, FinishReasonBlocklist, FinishReasonProhibitedContent, FinishReasonSPII, FinishReasonImageSafety:
+		return true
+	}
+	return false
+}
+
 // ConvertGeminiFinishReasonToBifrost converts Gemini finish reasons to Bifrost format
 func ConvertGeminiFinishReasonToBifrost(providerReason FinishReason) string {
 	if bifrostReason, ok := geminiFinishReasonToBifrost[providerReason]; ok {
@@ -403,9 +412,9 @@ func convertParamsToGenerationConfig(params *schemas.ChatParameters, responseMod
 		}
 		// Override with explicit response_schema if provided in ExtraParams
 		if responseSchema, ok := params.ExtraParams["response_schema"]; ok {
-			if schemaBytes, err := sonic.Marshal(responseSchema); err == nil {
+			if schemaBytes, err := serialization.Marshal(responseSchema); err == nil {
 				schema := &Schema{}
-				if err := sonic.Unmarshal(schemaBytes, schema); err == nil {
+				if err := serialization.Unmarshal(schemaBytes, schema); err == nil {
 					config.ResponseSchema = schema
 				}
 			}
@@ -559,12 +568,14 @@ func addSpeechConfigToGenerationConfig(config *GenerationConfig, voiceConfig *sc
 // convertBifrostMessagesToGemini converts Bifrost messages to Gemini format
 func convertBifrostMessagesToGemini(messages []schemas.ChatMessage) []Content {
 	var contents []Content
+	// Gemini correlates function responses with function calls by name, tool messages only carry the call id
+	toolCallNames := make(map[string]string)
 
 	for _, message := range messages {
 		var parts []*Part
 
-		// Handle content
-		if message.Content != nil {
+		// Handle content, tool messages carry it in their function response part
+		if message.Content != nil && message.Role != schemas.ChatMessageRoleTool {
 			if message.Content.ContentStr != nil && *message.Content.ContentStr != "" {
 				parts = append(parts, &Part{
 					Text: *message.Content.ContentStr,
@@ -589,13 +600,14 @@ func convertBifrostMessagesToGemini(messages []schemas.ChatMessage) []Content {
 					// Create function call part - simplified implementation
 					argsMap := make(map[string]any)
 					if toolCall.Function.Arguments != "" {
-						sonic.Unmarshal([]byte(toolCall.Function.Arguments), &argsMap)
+						serialization.Unmarshal([]byte(toolCall.Function.Arguments), &argsMap)
 					}
 					// Handle ID: use it if available, otherwise fallback to function name
 					callID := *toolCall.Function.Name
 					if toolCall.ID != nil && strings.TrimSpace(*toolCall.ID) != "" {
 						callID = *toolCall.ID
 					}
+					toolCallNames[callID] = *toolCall.Function.Name
 
 					part := &Part{
 						FunctionCall: &FunctionCall{
@@ -645,7 +657,7 @@ func convertBifrostMessagesToGemini(messages []schemas.ChatMessage) []Content {
 
 			// Try to unmarshal as JSON
 			if contentStr != "" {
-				err := sonic.Unmarshal([]byte(contentStr), &responseData)
+				err := serialization.Unmarshal([]byte(contentStr), &responseData)
 				if err != nil {
 					// If unmarshaling fails, wrap the original string to preserve it
 					responseData = map[string]any{
@@ -663,10 +675,16 @@ func convertBifrostMessagesToGemini(messages []schemas.ChatMessage) []Content {
 				callID = *message.ChatToolMessage.ToolCallID
 			}
 
+			// Gemini uses name for correlation, fall back to the call id if the call is not in the conversation
+			name := callID
+			if toolName, ok := toolCallNames[callID]; ok {
+				name = toolName
+			}
+
 			parts = append(parts, &Part{
 				FunctionResponse: &FunctionResponse{
 					ID:       callID,
-					Name:     callID, // Gemini uses name for correlation
+					Name:     name,
 					Response: responseData,
 				},
 			})
@@ -677,7 +695,8 @@ func convertBifrostMessagesToGemini(messages []schemas.ChatMessage) []Content {
 				Parts: parts,
 				Role:  string(message.Role),
 			}
-			if message.Role == schemas.ChatMessageRoleUser {
+			// Function responses are sent back to Gemini as user turns
+			if message.Role == schemas.ChatMessageRoleUser || message.Role == schemas.ChatMessageRoleTool {
 				content.Role = "user"
 			} else {
 				content.Role = "model"
@@ -689,6 +708,38 @@ func convertBifrostMessagesToGemini(messages []schemas.ChatMessage) []Content {
 	return contents
 }
 
+// attachGeminiFileParts adds the attached files as fileData parts to the last user content,
+// or to a new user content if there is none. Attachments are expected to be resolved (see resolveChatAttachments),
+// unresolved ones are referenced by their file id.
+func attachGeminiFileParts(contents []Content, attachments []schemas.FileReference) []Content {
+	index := -1
+	for i := len(contents) - 1; i >= 0; i-- {
+		if contents[i].Role == "user" {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		contents = append(contents, Content{Role: "user"})
+		index = len(contents) - 1
+	}
+
+	for _, attachment := range attachments {
+		fileData := &FileData{FileURI: attachment.FileID}
+		if attachment.URI != nil {
+			fileData.FileURI = *attachment.URI
+		}
+		if attachment.MimeType != nil {
+			fileData.MIMEType = *attachment.MimeType
+		}
+		if attachment.Filename != nil {
+			fileData.DisplayName = *attachment.Filename
+		}
+		contents[index].Parts = append(contents[index].Parts, &Part{FileData: fileData})
+	}
+	return contents
+}
+
 // normalizeSchemaTypes recursively normalizes type values from uppercase to lowercase
 func normalizeSchemaTypes(schema map[string]interface{}) map[string]interface{} {
 	if schema == nil {
@@ -813,9 +864,9 @@ func buildOpenAIResponseFormat(responseSchema *Schema, responseJsonSchema interf
 	// Prefer responseSchema over responseJsonSchema
 	if responseSchema != nil {
 		// Convert Schema struct to map
-		schemaBytes, err := sonic.Marshal(responseSchema)
+		schemaBytes, err := serialization.Marshal(responseSchema)
 		if err == nil {
-			if err := sonic.Unmarshal(schemaBytes, &schemaMap); err == nil {
+			if err := serialization.Unmarshal(schemaBytes, &schemaMap); err == nil {
 				if responseSchema.Title != "" {
 					name = responseSchema.Title
 				}
@@ -884,13 +935,13 @@ func extractSchemaFromResponseFormat(responseFormat *interface{}) *Schema {
 	}
 
 	// Convert map to Gemini Schema type via JSON marshaling
-	schemaBytes, err := sonic.Marshal(schemaMap)
+	schemaBytes, err := serialization.Marshal(schemaMap)
 	if err != nil {
 		return nil
 	}
 
 	schema := &Schema{}
-	if err := sonic.Unmarshal(schemaBytes, schema); err != nil {
+	if err := serialization.Unmarshal(schemaBytes, schema); err != nil {
 		return nil
 	}
 
@@ -913,7 +964,7 @@ func extractFunctionResponseOutput(funcResp *FunctionResponse) string {
 	}
 
 	// If no "output" key, marshal the entire response
-	if jsonResponse, err := sonic.Marshal(funcResp.Response); err == nil {
+	if jsonResponse, err := serialization.Marshal(funcResp.Response); err == nil {
 		return string(jsonResponse)
 	}
 
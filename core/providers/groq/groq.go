@@ -3,7 +3,6 @@ package groq
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/maximhq/bifrost/core/providers/openai"
@@ -14,11 +13,8 @@ import (
 
 // GroqProvider implements the Provider interface for Groq's API.
 type GroqProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewGroqProvider creates a new Groq provider instance.
@@ -42,40 +38,34 @@ func NewGroqProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*Gr
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://api.groq.com/openai"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &GroqProvider{
-		logger:              logger,
-		client:              client,
-		networkConfig:       config.NetworkConfig,
-		sendBackRawRequest:  config.SendBackRawRequest,
-		sendBackRawResponse: config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Groq, config, logger),
+		client:       client,
 	}, nil
 }
 
-// GetProviderKey returns the provider identifier for Groq.
-func (provider *GroqProvider) GetProviderKey() schemas.ModelProvider {
-	return schemas.Groq
-}
-
 // ListModels performs a list models request to Groq's API.
 func (provider *GroqProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
 	return openai.HandleOpenAIListModelsRequest(
 		ctx,
 		provider.client,
 		request,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/models"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/models"),
 		keys,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		schemas.Groq,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -159,14 +149,14 @@ func (provider *GroqProvider) ChatCompletion(ctx context.Context, key schemas.Ke
 	return openai.HandleOpenAIChatCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -183,18 +173,18 @@ func (provider *GroqProvider) ChatCompletionStream(ctx context.Context, postHook
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+"/v1/chat/completions",
+		provider.NetworkConfig().BaseURL+"/v1/chat/completions",
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		schemas.Groq,
 		postHookRunner,
 		nil,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
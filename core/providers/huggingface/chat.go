@@ -3,7 +3,7 @@ package huggingface
 import (
 	"fmt"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 
 	schemas "github.com/maximhq/bifrost/core/schemas"
 )
@@ -54,12 +54,12 @@ func ToHuggingFaceChatCompletionRequest(bifrostReq *schemas.BifrostChatRequest)
 		// Handle response format
 		if params.ResponseFormat != nil {
 			// Convert the response format to HuggingFace format
-			responseFormatJSON, err := sonic.Marshal(params.ResponseFormat)
+			responseFormatJSON, err := serialization.Marshal(params.ResponseFormat)
 			if err != nil {
 				return nil, fmt.Errorf("failed to convert ResponseFormat (marshal): %w", err)
 			}
 			var hfResponseFormat HuggingFaceResponseFormat
-			if err := sonic.Unmarshal(responseFormatJSON, &hfResponseFormat); err != nil {
+			if err := serialization.Unmarshal(responseFormatJSON, &hfResponseFormat); err != nil {
 				return nil, fmt.Errorf("failed to convert ResponseFormat (unmarshal): %w", err)
 			}
 			hfReq.ResponseFormat = &hfResponseFormat
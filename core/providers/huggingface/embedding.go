@@ -3,8 +3,8 @@ package huggingface
 import (
 	"fmt"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // ToHuggingFaceEmbeddingRequest converts a Bifrost embedding request to HuggingFace format
@@ -91,7 +91,7 @@ func UnmarshalHuggingFaceEmbeddingResponse(data []byte, model string) (*schemas.
 		Usage *schemas.BifrostLLMUsage `json:"usage,omitempty"`
 	}
 	var obj tempResponse
-	if err := sonic.Unmarshal(data, &obj); err == nil {
+	if err := serialization.Unmarshal(data, &obj); err == nil {
 		if obj.Data != nil || obj.Model != nil || obj.Usage != nil {
 			bifrostResponse := &schemas.BifrostEmbeddingResponse{
 				Data:   obj.Data,
@@ -116,7 +116,7 @@ func UnmarshalHuggingFaceEmbeddingResponse(data []byte, model string) (*schemas.
 
 	// Try 2D array: [[num, ...], ...]
 	var arr2D [][]float64
-	if err := sonic.Unmarshal(data, &arr2D); err == nil {
+	if err := serialization.Unmarshal(data, &arr2D); err == nil {
 		embeddings := make([]schemas.EmbeddingData, len(arr2D))
 		for idx, embedding := range arr2D {
 			conv := make([]float32, len(embedding))
@@ -143,7 +143,7 @@ func UnmarshalHuggingFaceEmbeddingResponse(data []byte, model string) (*schemas.
 
 	// Try 1D array: [num, ...]
 	var arr1D []float64
-	if err := sonic.Unmarshal(data, &arr1D); err == nil {
+	if err := serialization.Unmarshal(data, &arr1D); err == nil {
 		conv := make([]float32, len(arr1D))
 		for i, v := range arr1D {
 			conv[i] = float32(v)
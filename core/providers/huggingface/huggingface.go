@@ -8,59 +8,23 @@ import (
 	"sync"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/providers/openai"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
 // HuggingFaceProvider implements the Provider interface for Hugging Face's inference APIs.
 type HuggingFaceProvider struct {
-	logger                    schemas.Logger
+	providerUtils.BaseProvider
 	client                    *fasthttp.Client
-	networkConfig             schemas.NetworkConfig
-	sendBackRawResponse       bool
-	sendBackRawRequest        bool
-	customProviderConfig      *schemas.CustomProviderConfig
 	modelProviderMappingCache *sync.Map
 }
 
-var huggingFaceTranscriptionResponsePool = sync.Pool{
-	New: func() any {
-		return &HuggingFaceTranscriptionResponse{}
-	},
-}
-
-var huggingFaceSpeechResponsePool = sync.Pool{
-	New: func() any {
-		return &HuggingFaceSpeechResponse{}
-	},
-}
-
-func acquireHuggingFaceTranscriptionResponse() *HuggingFaceTranscriptionResponse {
-	resp := huggingFaceTranscriptionResponsePool.Get().(*HuggingFaceTranscriptionResponse)
-	*resp = HuggingFaceTranscriptionResponse{} // Reset the struct
-	return resp
-}
+var huggingFaceTranscriptionResponsePool = providerUtils.NewResponsePool[HuggingFaceTranscriptionResponse]()
 
-func releaseHuggingFaceTranscriptionResponse(resp *HuggingFaceTranscriptionResponse) {
-	if resp != nil {
-		huggingFaceTranscriptionResponsePool.Put(resp)
-	}
-}
-
-func acquireHuggingFaceSpeechResponse() *HuggingFaceSpeechResponse {
-	resp := huggingFaceSpeechResponsePool.Get().(*HuggingFaceSpeechResponse)
-	*resp = HuggingFaceSpeechResponse{} // Reset the struct
-	return resp
-}
-
-func releaseHuggingFaceSpeechResponse(resp *HuggingFaceSpeechResponse) {
-	if resp != nil {
-		huggingFaceSpeechResponsePool.Put(resp)
-	}
-}
+var huggingFaceSpeechResponsePool = providerUtils.NewResponsePool[HuggingFaceSpeechResponse]()
 
 // NewHuggingFaceProvider creates a new Hugging Face provider instance configured with the provided settings.
 func NewHuggingFaceProvider(config *schemas.ProviderConfig, logger schemas.Logger) *HuggingFaceProvider {
@@ -75,44 +39,30 @@ func NewHuggingFaceProvider(config *schemas.ProviderConfig, logger schemas.Logge
 	}
 
 	// Pre-warm response pools
-	for i := 0; i < config.ConcurrencyAndBufferSize.Concurrency; i++ {
-		huggingFaceSpeechResponsePool.Put(&HuggingFaceSpeechResponse{})
-		huggingFaceTranscriptionResponsePool.Put(&HuggingFaceTranscriptionResponse{})
-	}
+	huggingFaceSpeechResponsePool.Prewarm(config.ConcurrencyAndBufferSize.Concurrency)
+	huggingFaceTranscriptionResponsePool.Prewarm(config.ConcurrencyAndBufferSize.Concurrency)
 
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = defaultInferenceBaseURL
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &HuggingFaceProvider{
-		logger:                    logger,
+		BaseProvider:              providerUtils.NewBaseProvider(schemas.HuggingFace, config, logger),
 		client:                    client,
-		networkConfig:             config.NetworkConfig,
-		sendBackRawResponse:       config.SendBackRawResponse,
-		sendBackRawRequest:        config.SendBackRawRequest,
-		customProviderConfig:      config.CustomProviderConfig,
 		modelProviderMappingCache: &sync.Map{},
 	}
 }
 
-// GetProviderKey returns the provider key, taking custom providers into account.
-func (provider *HuggingFaceProvider) GetProviderKey() schemas.ModelProvider {
-	return providerUtils.GetProviderName(schemas.HuggingFace, provider.customProviderConfig)
-}
-
-// buildRequestURL composes the final request URL based on context overrides.
-func (provider *HuggingFaceProvider) buildRequestURL(ctx context.Context, defaultPath string, requestType schemas.RequestType) string {
-	return provider.networkConfig.BaseURL + providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType)
-}
-
 // completeRequestWithModelAliasCache performs a request and retries once on 404 by clearing the cache and refetching model info
 func (provider *HuggingFaceProvider) completeRequestWithModelAliasCache(
 	ctx context.Context,
 	jsonData []byte,
-	key string,
+	key schemas.Key,
 	isHFInferenceAudioRequest bool,
 	inferenceProvider inferenceProvider,
 	originalModelName string,
@@ -126,7 +76,9 @@ func (provider *HuggingFaceProvider) completeRequestWithModelAliasCache(
 		return nil, 0, providerUtils.NewUnsupportedOperationError(requestType, provider.GetProviderKey())
 	}
 
-	modelName, err := provider.getValidatedProviderModelID(ctx, inferenceProvider, originalModelName, requiredTask, requestType)
+	allowMappingFallback := key.HuggingFaceKeyConfig != nil && key.HuggingFaceKeyConfig.ModelMappingFallback
+
+	modelName, err := provider.getValidatedProviderModelID(ctx, inferenceProvider, originalModelName, requiredTask, requestType, allowMappingFallback)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -136,23 +88,23 @@ func (provider *HuggingFaceProvider) completeRequestWithModelAliasCache(
 	if !isHFInferenceAudioRequest && requestType == schemas.EmbeddingRequest {
 		// Parse, update model field, and re-encode for embedding requests
 		var reqBody map[string]interface{}
-		if err := sonic.Unmarshal(jsonData, &reqBody); err == nil {
+		if err := serialization.Unmarshal(jsonData, &reqBody); err == nil {
 			reqBody["model"] = modelName
-			if newJSON, err := sonic.Marshal(reqBody); err == nil {
+			if newJSON, err := serialization.Marshal(reqBody); err == nil {
 				updatedJSONData = newJSON
 			}
 		}
 	}
 
 	// Make the request
-	responseBody, latency, err := provider.completeRequest(ctx, updatedJSONData, url, key, isHFInferenceAudioRequest)
+	responseBody, latency, err := provider.completeRequest(ctx, updatedJSONData, url, key.Value, isHFInferenceAudioRequest)
 	if err != nil {
 		// If we got a 404, clear cache and retry once
 		if err.StatusCode != nil && *err.StatusCode == 404 {
 			provider.modelProviderMappingCache.Delete(originalModelName)
 
 			// Retry: re-fetch the validated model ID
-			modelName, retryErr := provider.getValidatedProviderModelID(ctx, inferenceProvider, originalModelName, requiredTask, requestType)
+			modelName, retryErr := provider.getValidatedProviderModelID(ctx, inferenceProvider, originalModelName, requiredTask, requestType, allowMappingFallback)
 			if retryErr != nil {
 				return nil, 0, retryErr
 			}
@@ -160,9 +112,9 @@ func (provider *HuggingFaceProvider) completeRequestWithModelAliasCache(
 			// Update the model field in the JSON body for retry
 			if !isHFInferenceAudioRequest && requestType == schemas.EmbeddingRequest {
 				var reqBody map[string]interface{}
-				if err := sonic.Unmarshal(jsonData, &reqBody); err == nil {
+				if err := serialization.Unmarshal(jsonData, &reqBody); err == nil {
 					reqBody["model"] = modelName
-					if newJSON, err := sonic.Marshal(reqBody); err == nil {
+					if newJSON, err := serialization.Marshal(reqBody); err == nil {
 						updatedJSONData = newJSON
 					}
 				}
@@ -175,7 +127,7 @@ func (provider *HuggingFaceProvider) completeRequestWithModelAliasCache(
 			}
 
 			// Retry the request
-			responseBody, latency, err = provider.completeRequest(ctx, updatedJSONData, url, key, isHFInferenceAudioRequest)
+			responseBody, latency, err = provider.completeRequest(ctx, updatedJSONData, url, key.Value, isHFInferenceAudioRequest)
 			if err != nil {
 				return nil, 0, err
 			}
@@ -194,7 +146,7 @@ func (provider *HuggingFaceProvider) completeRequest(ctx context.Context, jsonDa
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	req.SetRequestURI(url)
 	req.Header.SetMethod(http.MethodPost)
@@ -273,7 +225,7 @@ func (provider *HuggingFaceProvider) listModelsByKey(ctx context.Context, key sc
 			defer fasthttp.ReleaseRequest(req)
 			defer fasthttp.ReleaseResponse(resp)
 
-			providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+			providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 			modelHubURL := provider.buildModelHubURL(request, inferProvider)
 			req.SetRequestURI(modelHubURL)
@@ -311,7 +263,7 @@ func (provider *HuggingFaceProvider) listModelsByKey(ctx context.Context, key sc
 			var huggingfaceAPIResponse HuggingFaceListModelsResponse
 			var rawResponse interface{}
 			var rawRequest interface{}
-			rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(body, &huggingfaceAPIResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+			rawRequest, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(ctx, body, &huggingfaceAPIResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 			if bifrostErr != nil {
 				resultsChan <- providerResult{provider: inferProvider, err: bifrostErr}
 				return
@@ -323,7 +275,7 @@ func (provider *HuggingFaceProvider) listModelsByKey(ctx context.Context, key sc
 				}
 			}
 			// If raw request was requested, attach it to the raw response map
-			if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) && rawRequest != nil {
+			if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) && rawRequest != nil {
 				if rawRespMap == nil {
 					rawRespMap = make(map[string]interface{})
 				}
@@ -385,7 +337,7 @@ func (provider *HuggingFaceProvider) listModelsByKey(ctx context.Context, key sc
 		aggregatedResponse.ExtraFields.Latency = totalLatency / int64(successCount)
 	}
 
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) && len(rawResponses) > 0 {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) && len(rawResponses) > 0 {
 		// Combine all raw responses into a single map
 		combinedRaw := make(map[string]interface{})
 		for i, raw := range rawResponses {
@@ -400,10 +352,10 @@ func (provider *HuggingFaceProvider) listModelsByKey(ctx context.Context, key sc
 // ListModels queries the Hugging Face model hub API to list models served by the inference provider.
 func (provider *HuggingFaceProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
 
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.ListModelsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.ListModelsRequest); err != nil {
 		return nil, err
 	}
-	if provider.customProviderConfig != nil && provider.customProviderConfig.IsKeyLess {
+	if provider.CustomProviderConfig() != nil && provider.CustomProviderConfig().IsKeyLess {
 		return provider.listModelsByKey(ctx, schemas.Key{}, request)
 	}
 	return providerUtils.HandleMultipleListModelsRequests(
@@ -411,7 +363,7 @@ func (provider *HuggingFaceProvider) ListModels(ctx context.Context, keys []sche
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 
 }
@@ -425,7 +377,7 @@ func (provider *HuggingFaceProvider) TextCompletionStream(ctx context.Context, p
 }
 
 func (provider *HuggingFaceProvider) ChatCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.ChatCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.ChatCompletionRequest); err != nil {
 		return nil, err
 	}
 
@@ -463,7 +415,7 @@ func (provider *HuggingFaceProvider) ChatCompletion(ctx context.Context, key sch
 		return nil, err
 	}
 
-	requestURL := provider.buildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionRequest)
+	requestURL := provider.BuildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionRequest)
 
 	responseBody, latency, err := provider.completeRequest(ctx, jsonBody, requestURL, key.Value, false)
 	if err != nil {
@@ -474,7 +426,7 @@ func (provider *HuggingFaceProvider) ChatCompletion(ctx context.Context, key sch
 
 	var rawResponse interface{}
 	var rawRequest interface{}
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, bifrostResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, bifrostResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -495,12 +447,12 @@ func (provider *HuggingFaceProvider) ChatCompletion(ctx context.Context, key sch
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
@@ -508,7 +460,7 @@ func (provider *HuggingFaceProvider) ChatCompletion(ctx context.Context, key sch
 }
 
 func (provider *HuggingFaceProvider) ChatCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.ChatCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.ChatCompletionStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -548,23 +500,23 @@ func (provider *HuggingFaceProvider) ChatCompletionStream(ctx context.Context, p
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionStreamRequest),
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		customRequestConverter,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
 func (provider *HuggingFaceProvider) Responses(ctx context.Context, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.ResponsesRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.ResponsesRequest); err != nil {
 		return nil, err
 	}
 
@@ -582,7 +534,7 @@ func (provider *HuggingFaceProvider) Responses(ctx context.Context, key schemas.
 }
 
 func (provider *HuggingFaceProvider) ResponsesStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.ResponsesStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.ResponsesStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -596,7 +548,7 @@ func (provider *HuggingFaceProvider) ResponsesStream(ctx context.Context, postHo
 }
 
 func (provider *HuggingFaceProvider) Embedding(ctx context.Context, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.EmbeddingRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.EmbeddingRequest); err != nil {
 		return nil, err
 	}
 
@@ -630,7 +582,7 @@ func (provider *HuggingFaceProvider) Embedding(ctx context.Context, key schemas.
 	responseBody, latency, err := provider.completeRequestWithModelAliasCache(
 		ctx,
 		jsonBody,
-		key.Value,
+		key,
 		false,
 		inferenceProvider,
 		modelName,
@@ -644,13 +596,13 @@ func (provider *HuggingFaceProvider) Embedding(ctx context.Context, key schemas.
 	// Handle raw request/response for tracking
 	var rawResponse interface{}
 	var rawRequest interface{}
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
-		if err := sonic.Unmarshal(jsonBody, &rawRequest); err != nil {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
+		if err := serialization.Unmarshal(jsonBody, &rawRequest); err != nil {
 			rawRequest = string(jsonBody)
 		}
 	}
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
-		if err := sonic.Unmarshal(responseBody, &rawResponse); err != nil {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
+		if err := serialization.Unmarshal(responseBody, &rawResponse); err != nil {
 			rawResponse = string(responseBody)
 		}
 	}
@@ -668,12 +620,12 @@ func (provider *HuggingFaceProvider) Embedding(ctx context.Context, key schemas.
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
@@ -682,7 +634,7 @@ func (provider *HuggingFaceProvider) Embedding(ctx context.Context, key schemas.
 
 func (provider *HuggingFaceProvider) Speech(ctx context.Context, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
 	// Check if Speech is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.SpeechRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.SpeechRequest); err != nil {
 		return nil, err
 	}
 
@@ -713,7 +665,7 @@ func (provider *HuggingFaceProvider) Speech(ctx context.Context, key schemas.Key
 	responseBody, latency, err := provider.completeRequestWithModelAliasCache(
 		ctx,
 		jsonData,
-		key.Value,
+		key,
 		false,
 		inferenceProvider,
 		modelName,
@@ -724,12 +676,12 @@ func (provider *HuggingFaceProvider) Speech(ctx context.Context, key schemas.Key
 		return nil, err
 	}
 
-	response := acquireHuggingFaceSpeechResponse()
-	defer releaseHuggingFaceSpeechResponse(response)
+	response := huggingFaceSpeechResponsePool.Acquire()
+	defer huggingFaceSpeechResponsePool.Release(response)
 
 	var rawResponse interface{}
 	var rawRequest interface{}
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -740,6 +692,13 @@ func (provider *HuggingFaceProvider) Speech(ctx context.Context, key schemas.Key
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, downloadErr, provider.GetProviderKey())
 	}
 
+	// The output format is chosen by the model, it must match the requested one
+	if request.Params != nil {
+		if err := providerUtils.CheckSpeechFormat(provider.GetProviderKey(), request.Params.ResponseFormat, audioData); err != nil {
+			return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, provider.GetProviderKey())
+		}
+	}
+
 	bifrostResponse, convErr := response.ToBifrostSpeechResponse(request.Model, audioData)
 	if convErr != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, convErr, provider.GetProviderKey())
@@ -750,12 +709,12 @@ func (provider *HuggingFaceProvider) Speech(ctx context.Context, key schemas.Key
 	bifrostResponse.ExtraFields.ModelRequested = request.Model
 	bifrostResponse.ExtraFields.RequestType = schemas.SpeechRequest
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
@@ -768,7 +727,7 @@ func (provider *HuggingFaceProvider) SpeechStream(ctx context.Context, postHookR
 
 func (provider *HuggingFaceProvider) Transcription(ctx context.Context, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
 	// Check if Transcription is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.customProviderConfig, schemas.TranscriptionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.TranscriptionRequest); err != nil {
 		return nil, err
 	}
 
@@ -811,7 +770,7 @@ func (provider *HuggingFaceProvider) Transcription(ctx context.Context, key sche
 	responseBody, latency, err := provider.completeRequestWithModelAliasCache(
 		ctx,
 		jsonData,
-		key.Value,
+		key,
 		isHFInferenceAudioRequest,
 		inferenceProvider,
 		modelName,
@@ -822,12 +781,12 @@ func (provider *HuggingFaceProvider) Transcription(ctx context.Context, key sche
 		return nil, err
 	}
 
-	response := acquireHuggingFaceTranscriptionResponse()
-	defer releaseHuggingFaceTranscriptionResponse(response)
+	response := huggingFaceTranscriptionResponsePool.Acquire()
+	defer huggingFaceTranscriptionResponsePool.Release(response)
 
 	var rawResponse interface{}
 	var rawRequest interface{}
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, response, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -842,12 +801,12 @@ func (provider *HuggingFaceProvider) Transcription(ctx context.Context, key sche
 	bifrostResponse.ExtraFields.ModelRequested = request.Model
 	bifrostResponse.ExtraFields.RequestType = schemas.TranscriptionRequest
 	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		bifrostResponse.ExtraFields.RawResponse = rawResponse
 	}
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		bifrostResponse.ExtraFields.RawRequest = rawRequest
 	}
 
@@ -855,9 +814,22 @@ func (provider *HuggingFaceProvider) Transcription(ctx context.Context, key sche
 
 }
 
-// TranscriptionStream is not supported by the Hugging Face provider.
+// TranscriptionStream is a non-streaming fallback: Hugging Face inference providers only return complete
+// transcripts, so nothing is streamed while the audio is processed. The audio is transcribed with a regular
+// request, and only once the complete transcript is back is it replayed as one delta per timestamped chunk
+// (a single delta if the provider returned no chunks), followed by the done frame with the complete transcript.
+// Chunks are returned when return_timestamps is set in the extra params.
 func (provider *HuggingFaceProvider) TranscriptionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionStreamRequest, provider.GetProviderKey())
+	if err := providerUtils.CheckOperationAllowed(schemas.HuggingFace, provider.CustomProviderConfig(), schemas.TranscriptionStreamRequest); err != nil {
+		return nil, err
+	}
+
+	response, bifrostErr := provider.Transcription(ctx, key, request)
+	if bifrostErr != nil {
+		bifrostErr.ExtraFields.RequestType = schemas.TranscriptionStreamRequest
+		return nil, bifrostErr
+	}
+	return providerUtils.StreamTranscriptionResponse(ctx, postHookRunner, response), nil
 }
 
 // BatchCreate is not supported by the Hugging Face provider.
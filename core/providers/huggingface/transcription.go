@@ -63,6 +63,12 @@ func ToHuggingFaceTranscriptionRequest(request *schemas.BifrostTranscriptionRequ
 			genParams.MinNewTokens = v
 		}
 
+		// Whisper models take the language hint and the translation task as generation parameters
+		genParams.Language = request.Params.Language
+		if request.Params.IsTranslation() {
+			genParams.Task = schemas.Ptr("translate")
+		}
+
 		if request.Params.ExtraParams != nil {
 			extra := request.Params.ExtraParams
 			if val, ok := extra["do_sample"].(bool); ok {
@@ -113,6 +119,11 @@ func ToHuggingFaceTranscriptionRequest(request *schemas.BifrostTranscriptionRequ
 		}
 
 		hfRequest.Parameters.GenerationParameters = genParams
+
+		// Subtitles are synthesized from the chunk timestamps
+		if hfRequest.Parameters.ReturnTimestamps == nil && utils.IsTimestampedTranscriptionResponseFormat(request.Params.ResponseFormat) {
+			hfRequest.Parameters.ReturnTimestamps = schemas.Ptr(true)
+		}
 	}
 
 	return hfRequest, nil
@@ -0,0 +1,124 @@
+package huggingface
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that subtitles requested from HuggingFace ask for chunk timestamps and are synthesized from the chunks
+func TestTranscription_SubtitlesFromChunks(t *testing.T) {
+	request := &schemas.BifrostTranscriptionRequest{
+		Provider: schemas.HuggingFace,
+		Model:    "hf-inference/openai/whisper-large-v3",
+		Input:    &schemas.TranscriptionInput{File: []byte("audio")},
+		Params:   &schemas.TranscriptionParameters{ResponseFormat: schemas.Ptr(schemas.TranscriptionResponseFormatVTT)},
+	}
+	hfRequest, err := ToHuggingFaceTranscriptionRequest(request)
+	if err != nil {
+		t.Fatalf("failed to convert request: %v", err)
+	}
+	if hfRequest.Parameters == nil || hfRequest.Parameters.ReturnTimestamps == nil || !*hfRequest.Parameters.ReturnTimestamps {
+		t.Errorf("expected chunk timestamps to be requested, got %+v", hfRequest.Parameters)
+	}
+
+	hfResponse := &HuggingFaceTranscriptionResponse{
+		Text: " Hello there. General Kenobi.",
+		Chunks: []HuggingFaceTranscriptionResponseChunk{
+			{Text: " Hello there.", Timestamp: []float64{0, 1.2}},
+			{Text: " General Kenobi.", Timestamp: []float64{1.2, 2.84}},
+		},
+	}
+	response, err := hfResponse.ToBifrostTranscriptionResponse(request.Model)
+	if err != nil {
+		t.Fatalf("failed to convert response: %v", err)
+	}
+	providerUtils.ApplyTranscriptionResponseFormat(response, request.Params.ResponseFormat)
+	expected := "WEBVTT\n\n00:00:00.000 --> 00:00:01.200\nHello there.\n\n00:00:01.200 --> 00:00:02.840\nGeneral Kenobi.\n\n"
+	if response.Formatted == nil || *response.Formatted != expected {
+		t.Errorf("unexpected vtt output: %v", response.Formatted)
+	}
+}
+
+// Test that the language hint and the translation task are sent as Whisper generation parameters
+func TestTranscription_LanguageAndTranslation(t *testing.T) {
+	hfRequest, err := ToHuggingFaceTranscriptionRequest(&schemas.BifrostTranscriptionRequest{
+		Provider: schemas.HuggingFace,
+		Model:    "hf-inference/openai/whisper-large-v3",
+		Input:    &schemas.TranscriptionInput{File: []byte("audio")},
+		Params:   &schemas.TranscriptionParameters{Language: schemas.Ptr("fr"), Translate: schemas.Ptr(true)},
+	})
+	if err != nil {
+		t.Fatalf("failed to convert request: %v", err)
+	}
+	genParams := hfRequest.Parameters.GenerationParameters
+	if genParams.Language == nil || *genParams.Language != "fr" {
+		t.Errorf("expected language hint fr, got %v", genParams.Language)
+	}
+	if genParams.Task == nil || *genParams.Task != "translate" {
+		t.Errorf("expected translate task, got %v", genParams.Task)
+	}
+}
+
+// Test that a streamed transcription delivers the transcript chunks as partial frames, in order, then the complete transcript
+func TestTranscriptionStream_ChunkDeltas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"text":" Hello there. General Kenobi.","chunks":[{"text":" Hello there.","timestamp":[0,1.2]},{"text":" General Kenobi.","timestamp":[1.2,2.84]}]}`))
+	}))
+	defer server.Close()
+
+	provider := newHubOutageTestProvider(t, server, &testLogger{})
+	key := schemas.Key{
+		Value:                "test-key",
+		HuggingFaceKeyConfig: &schemas.HuggingFaceKeyConfig{ModelMappingFallback: true},
+	}
+	postHookRunner := func(ctx *context.Context, response *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError) {
+		return response, err
+	}
+	stream, bifrostErr := provider.TranscriptionStream(context.Background(), postHookRunner, key, &schemas.BifrostTranscriptionRequest{
+		Provider: schemas.HuggingFace,
+		Model:    "hf-inference/openai/whisper-large-v3",
+		Input:    &schemas.TranscriptionInput{File: []byte("audio")},
+		Params:   &schemas.TranscriptionParameters{ExtraParams: map[string]interface{}{"return_timestamps": true}},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("expected the stream to start, got error: %v", bifrostErr.Error.Message)
+	}
+
+	var deltas []string
+	var done *schemas.BifrostTranscriptionStreamResponse
+	for chunk := range stream {
+		if chunk.BifrostError != nil {
+			t.Fatalf("unexpected stream error: %v", chunk.BifrostError.Error.Message)
+		}
+		frame := chunk.BifrostTranscriptionStreamResponse
+		if frame == nil {
+			t.Fatal("expected only transcription frames")
+		}
+		if done != nil {
+			t.Fatal("expected no frame after the done frame")
+		}
+		if frame.ExtraFields.RequestType != schemas.TranscriptionStreamRequest || frame.ExtraFields.ChunkIndex != len(deltas) {
+			t.Errorf("unexpected extra fields %+v", frame.ExtraFields)
+		}
+		switch frame.Type {
+		case schemas.TranscriptionStreamResponseTypeDelta:
+			deltas = append(deltas, *frame.Delta)
+		case schemas.TranscriptionStreamResponseTypeDone:
+			done = frame
+		}
+	}
+
+	if strings.Join(deltas, "|") != " Hello there.| General Kenobi." {
+		t.Errorf("expected a partial frame per chunk, got %q", deltas)
+	}
+	if done == nil || done.Text != " Hello there. General Kenobi." {
+		t.Errorf("expected the complete transcript in the done frame, got %+v", done)
+	}
+}
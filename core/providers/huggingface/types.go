@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // # MODELS TYPES
@@ -34,7 +34,7 @@ type HuggingFaceListModelsResponse struct {
 func (r *HuggingFaceListModelsResponse) UnmarshalJSON(data []byte) error {
 	// Try unmarshaling as an array first (most common for /api/models)
 	var arr []HuggingFaceModel
-	if err := sonic.Unmarshal(data, &arr); err == nil {
+	if err := serialization.Unmarshal(data, &arr); err == nil {
 		r.Models = arr
 		return nil
 	}
@@ -43,7 +43,7 @@ func (r *HuggingFaceListModelsResponse) UnmarshalJSON(data []byte) error {
 	var obj struct {
 		Models []HuggingFaceModel `json:"models"`
 	}
-	if err := sonic.Unmarshal(data, &obj); err == nil {
+	if err := serialization.Unmarshal(data, &obj); err == nil {
 		r.Models = obj.Models
 		return nil
 	}
@@ -115,10 +115,10 @@ const (
 // containing the `function` key and `type` field.
 func (t HuggingFaceToolChoice) MarshalJSON() ([]byte, error) {
 	if t.EnumValue != nil {
-		return sonic.Marshal(*t.EnumValue)
+		return serialization.Marshal(*t.EnumValue)
 	}
 	if t.Function != nil {
-		return sonic.Marshal(struct {
+		return serialization.Marshal(struct {
 			Type     string                          `json:"type"`
 			Function *schemas.ChatToolChoiceFunction `json:"function"`
 		}{
@@ -183,14 +183,14 @@ func (i *InputsCustomType) UnmarshalJSON(data []byte) error {
 
 	// Try string
 	var text string
-	if err := sonic.Unmarshal(data, &text); err == nil {
+	if err := serialization.Unmarshal(data, &text); err == nil {
 		i.Text = &text
 		return nil
 	}
 
 	// Try array
 	var texts []string
-	if err := sonic.Unmarshal(data, &texts); err == nil {
+	if err := serialization.Unmarshal(data, &texts); err == nil {
 		i.Texts = texts
 		return nil
 	}
@@ -198,7 +198,7 @@ func (i *InputsCustomType) UnmarshalJSON(data []byte) error {
 	// Try object
 	type alias InputsCustomType
 	var obj alias
-	if err := sonic.Unmarshal(data, &obj); err == nil {
+	if err := serialization.Unmarshal(data, &obj); err == nil {
 		*i = InputsCustomType(obj)
 		return nil
 	}
@@ -208,10 +208,10 @@ func (i *InputsCustomType) UnmarshalJSON(data []byte) error {
 
 func (i InputsCustomType) MarshalJSON() ([]byte, error) {
 	if len(i.Texts) > 0 {
-		return sonic.Marshal(i.Texts)
+		return serialization.Marshal(i.Texts)
 	}
 	if i.Text != nil {
-		return sonic.Marshal(*i.Text)
+		return serialization.Marshal(*i.Text)
 	}
 	return []byte("null"), nil
 }
@@ -287,6 +287,8 @@ type HuggingFaceTranscriptionGenerationParameters struct {
 	TopP          *float64                               `json:"top_p,omitempty"`
 	TypicalP      *float64                               `json:"typical_p,omitempty"`
 	UseCache      *bool                                  `json:"use_cache,omitempty"`
+	Language      *string                                `json:"language,omitempty"` // Audio language hint of Whisper models
+	Task          *string                                `json:"task,omitempty"`     // "transcribe" or "translate" (to English) for Whisper models
 }
 
 // HuggingFaceTranscriptionEarlyStopping controls the stopping condition for beam-based methods
@@ -299,10 +301,10 @@ type HuggingFaceTranscriptionEarlyStopping struct {
 // MarshalJSON implements custom JSON marshaling for HuggingFaceTranscriptionEarlyStopping
 func (e HuggingFaceTranscriptionEarlyStopping) MarshalJSON() ([]byte, error) {
 	if e.BoolValue != nil {
-		return sonic.Marshal(*e.BoolValue)
+		return serialization.Marshal(*e.BoolValue)
 	}
 	if e.StringValue != nil {
-		return sonic.Marshal(*e.StringValue)
+		return serialization.Marshal(*e.StringValue)
 	}
 	return []byte("null"), nil
 }
@@ -311,14 +313,14 @@ func (e HuggingFaceTranscriptionEarlyStopping) MarshalJSON() ([]byte, error) {
 func (e *HuggingFaceTranscriptionEarlyStopping) UnmarshalJSON(data []byte) error {
 	// Try boolean first
 	var boolVal bool
-	if err := sonic.Unmarshal(data, &boolVal); err == nil {
+	if err := serialization.Unmarshal(data, &boolVal); err == nil {
 		e.BoolValue = &boolVal
 		return nil
 	}
 
 	// Try string
 	var stringVal string
-	if err := sonic.Unmarshal(data, &stringVal); err == nil {
+	if err := serialization.Unmarshal(data, &stringVal); err == nil {
 		e.StringValue = &stringVal
 		return nil
 	}
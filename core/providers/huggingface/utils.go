@@ -8,9 +8,9 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/bytedance/sonic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
@@ -160,7 +160,7 @@ func (provider *HuggingFaceProvider) getInferenceProviderRouteURL(ctx context.Co
 		case schemas.SpeechRequest:
 			pipeline = "text-to-speech"
 		case schemas.TranscriptionRequest:
-			return provider.networkConfig.BaseURL + providerUtils.GetRequestPath(ctx, fmt.Sprintf("/hf-inference/models/%s", modelName), provider.customProviderConfig, requestType), nil
+			return provider.BuildRequestURL(ctx, fmt.Sprintf("/hf-inference/models/%s", modelName), requestType), nil
 		default:
 			pipeline = "chat-completion"
 		}
@@ -189,7 +189,7 @@ func (provider *HuggingFaceProvider) getInferenceProviderRouteURL(ctx context.Co
 	default:
 		return "", fmt.Errorf("unsupported inference provider: %s for action: %s", inferenceProvider, requestType)
 	}
-	return provider.networkConfig.BaseURL + providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType), nil
+	return provider.BuildRequestURL(ctx, defaultPath, requestType), nil
 }
 
 // convertToInferenceProviderMappings converts HuggingFaceInferenceProviderMappingResponse to a map of HuggingFaceInferenceProviderMapping with ProviderName as key
@@ -252,7 +252,7 @@ func (provider *HuggingFaceProvider) getModelInferenceProviderMapping(ctx contex
 	}
 
 	var mappingResp HuggingFaceInferenceProviderMappingResponse
-	if err := sonic.Unmarshal(body, &mappingResp); err != nil {
+	if err := serialization.Unmarshal(body, &mappingResp); err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 	}
 
@@ -266,16 +266,35 @@ func (provider *HuggingFaceProvider) getModelInferenceProviderMapping(ctx contex
 	return mappings, nil
 }
 
+// isHubOutage reports whether a failed mapping fetch is a Hub outage (a network error or a 5xx status), as opposed
+// to a rejected request such as an invalid token or an unknown model, which the fallback must not hide.
+func isHubOutage(bifrostErr *schemas.BifrostError) bool {
+	if bifrostErr.StatusCode != nil {
+		return *bifrostErr.StatusCode >= fasthttp.StatusInternalServerError
+	}
+	return bifrostErr.Error != nil && (bifrostErr.Error.Message == schemas.ErrProviderDoRequest || bifrostErr.Error.Message == schemas.ErrProviderRequestTimedOut)
+}
+
 // getValidatedProviderModelID fetches the inference provider mapping for a model
 // and validates that the given inferenceProvider has a mapping with the expected task.
 // On success it returns the provider-specific model id. On failure it returns a
 // BifrostError indicating the operation isn't supported for the requested
 // request type or provider.
-func (provider *HuggingFaceProvider) getValidatedProviderModelID(ctx context.Context, inferenceProvider inferenceProvider, huggingfaceModelName string, requiredTask string, requestType schemas.RequestType) (string, *schemas.BifrostError) {
+// If the mapping cannot be fetched because of a Hub outage and allowMappingFallback is set,
+// the Hugging Face model name is returned as is, so that requests keep working.
+func (provider *HuggingFaceProvider) getValidatedProviderModelID(ctx context.Context, inferenceProvider inferenceProvider, huggingfaceModelName string, requiredTask string, requestType schemas.RequestType, allowMappingFallback bool) (string, *schemas.BifrostError) {
 	providerName := provider.GetProviderKey()
 
 	providerMapping, bifrostErr := provider.getModelInferenceProviderMapping(ctx, huggingfaceModelName)
 	if bifrostErr != nil {
+		if allowMappingFallback && isHubOutage(bifrostErr) {
+			reason := "unknown error"
+			if bifrostErr.Error != nil {
+				reason = bifrostErr.Error.Message
+			}
+			provider.Logger().Warn(fmt.Sprintf("Failed to fetch inference provider mapping for model %s, using the model name as is with %s: %s", huggingfaceModelName, inferenceProvider, reason))
+			return huggingfaceModelName, nil
+		}
 		return "", bifrostErr
 	}
 
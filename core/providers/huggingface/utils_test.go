@@ -0,0 +1,120 @@
+package huggingface
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+// testLogger is a minimal logger implementation for testing, counting warnings.
+type testLogger struct {
+	warnings atomic.Int32
+}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      { l.warnings.Add(1) }
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+
+// newHubOutageTestProvider returns a provider routing inference requests to the server,
+// whose requests to the Hub fail as during a Hub outage.
+func newHubOutageTestProvider(t *testing.T, server *httptest.Server, logger schemas.Logger) *HuggingFaceProvider {
+	t.Helper()
+	provider := NewHuggingFaceProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, logger)
+	serverAddr := strings.TrimPrefix(server.URL, "http://")
+	provider.client.Dial = func(addr string) (net.Conn, error) {
+		if addr != serverAddr {
+			return nil, errors.New("hub unavailable")
+		}
+		return net.Dial("tcp", addr)
+	}
+	return provider
+}
+
+// Test that the model name is sent as is to the inference provider when the mapping cannot be fetched and the fallback is enabled
+func TestEmbedding_ModelMappingFallback(t *testing.T) {
+	var requestedModel atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sambanova/v1/embeddings" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var reqBody map[string]any
+		if err := serialization.Unmarshal(body, &reqBody); err == nil {
+			requestedModel.Store(reqBody["model"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"object":"embedding","index":0,"embedding":[0.1,0.2]}]}`))
+	}))
+	defer server.Close()
+
+	logger := &testLogger{}
+	provider := newHubOutageTestProvider(t, server, logger)
+	request := &schemas.BifrostEmbeddingRequest{
+		Provider: schemas.HuggingFace,
+		Model:    "sambanova/intfloat/e5-mistral-7b-instruct",
+		Input:    &schemas.EmbeddingInput{Text: schemas.Ptr("hello")},
+	}
+
+	// Without the fallback, the mapping fetch failure fails the request
+	if _, bifrostErr := provider.Embedding(context.Background(), schemas.Key{Value: "test-key"}, request); bifrostErr == nil {
+		t.Fatal("expected the request to fail without the mapping fallback")
+	}
+
+	key := schemas.Key{
+		Value:                "test-key",
+		HuggingFaceKeyConfig: &schemas.HuggingFaceKeyConfig{ModelMappingFallback: true},
+	}
+	resp, bifrostErr := provider.Embedding(context.Background(), key, request)
+	if bifrostErr != nil {
+		t.Fatalf("expected the request to succeed with the mapping fallback, got error: %v", bifrostErr.Error.Message)
+	}
+	if len(resp.Data) != 1 {
+		t.Errorf("expected 1 embedding, got %d", len(resp.Data))
+	}
+	if model := requestedModel.Load(); model != "intfloat/e5-mistral-7b-instruct" {
+		t.Errorf("expected the model name to be sent as is, got %v", model)
+	}
+	if logger.warnings.Load() == 0 {
+		t.Error("expected a warning to be logged for the mapping fallback")
+	}
+}
+
+// Test that only network errors and 5xx statuses of the Hub are treated as outages
+func TestIsHubOutage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *schemas.BifrostError
+		expected bool
+	}{
+		{"NetworkError", &schemas.BifrostError{Error: &schemas.ErrorField{Message: schemas.ErrProviderDoRequest}}, true},
+		{"Timeout", &schemas.BifrostError{Error: &schemas.ErrorField{Message: schemas.ErrProviderRequestTimedOut}}, true},
+		{"ServiceUnavailable", &schemas.BifrostError{StatusCode: schemas.Ptr(503), Error: &schemas.ErrorField{Message: "unavailable"}}, true},
+		{"Unauthorized", &schemas.BifrostError{StatusCode: schemas.Ptr(401), Error: &schemas.ErrorField{Message: "invalid token"}}, false},
+		{"Forbidden", &schemas.BifrostError{StatusCode: schemas.Ptr(403), Error: &schemas.ErrorField{Message: "gated model"}}, false},
+		{"NotFound", &schemas.BifrostError{StatusCode: schemas.Ptr(404), Error: &schemas.ErrorField{Message: "unknown model"}}, false},
+		{"Cancelled", &schemas.BifrostError{Error: &schemas.ErrorField{Message: "request cancelled", Type: schemas.Ptr(schemas.RequestCancelled)}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHubOutage(tt.err); got != tt.expected {
+				t.Errorf("expected isHubOutage to be %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -10,20 +10,17 @@ import (
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/providers/openai"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
 // MistralProvider implements the Provider interface for Mistral's API.
 type MistralProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewMistralProvider creates a new Mistral provider instance.
@@ -47,27 +44,21 @@ func NewMistralProvider(config *schemas.ProviderConfig, logger schemas.Logger) *
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://api.mistral.ai"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &MistralProvider{
-		logger:              logger,
-		client:              client,
-		networkConfig:       config.NetworkConfig,
-		sendBackRawRequest:  config.SendBackRawRequest,
-		sendBackRawResponse: config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Mistral, config, logger),
+		client:       client,
 	}
 }
 
-// GetProviderKey returns the provider identifier for Mistral.
-func (provider *MistralProvider) GetProviderKey() schemas.ModelProvider {
-	return schemas.Mistral
-}
-
 // listModelsByKey performs a list models request for a single key.
 // Returns the response and latency, or an error if the request fails.
 func (provider *MistralProvider) listModelsByKey(ctx context.Context, key schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
@@ -80,9 +71,9 @@ func (provider *MistralProvider) listModelsByKey(ctx context.Context, key schema
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/models"))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/models"))
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
 	if key.Value != "" {
@@ -106,7 +97,7 @@ func (provider *MistralProvider) listModelsByKey(ctx context.Context, key schema
 
 	// Parse Mistral's response
 	var mistralResponse MistralListModelsResponse
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, &mistralResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, &mistralResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -117,12 +108,12 @@ func (provider *MistralProvider) listModelsByKey(ctx context.Context, key schema
 	response.ExtraFields.Latency = latency.Milliseconds()
 
 	// Set raw request if enabled
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequest
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponse
 	}
 
@@ -137,7 +128,7 @@ func (provider *MistralProvider) ListModels(ctx context.Context, keys []schemas.
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -158,14 +149,14 @@ func (provider *MistralProvider) ChatCompletion(ctx context.Context, key schemas
 	return openai.HandleOpenAIChatCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -182,18 +173,18 @@ func (provider *MistralProvider) ChatCompletionStream(ctx context.Context, postH
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+"/v1/chat/completions",
+		provider.NetworkConfig().BaseURL+"/v1/chat/completions",
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		schemas.Mistral,
 		postHookRunner,
 		nil,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -230,14 +221,14 @@ func (provider *MistralProvider) Embedding(ctx context.Context, key schemas.Key,
 	return openai.HandleOpenAIEmbeddingRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/embeddings"),
+		provider.NetworkConfig().BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/embeddings"),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		schemas.Mistral,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -256,6 +247,9 @@ func (provider *MistralProvider) SpeechStream(ctx context.Context, postHookRunne
 // Returns the transcribed text and metadata, or an error if the request fails.
 func (provider *MistralProvider) Transcription(ctx context.Context, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
 	providerName := provider.GetProviderKey()
+	if err := providerUtils.CheckTranslationSupported(providerName, schemas.TranscriptionRequest, request); err != nil {
+		return nil, err
+	}
 
 	// Convert Bifrost request to Mistral format
 	mistralReq := ToMistralTranscriptionRequest(request)
@@ -276,9 +270,9 @@ func (provider *MistralProvider) Transcription(ctx context.Context, key schemas.
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/audio/transcriptions"))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/audio/transcriptions"))
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType(contentType)
 	if key.Value != "" {
@@ -295,7 +289,7 @@ func (provider *MistralProvider) Transcription(ctx context.Context, key schemas.
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, openai.ParseOpenAIError(resp, schemas.TranscriptionRequest, providerName, request.Model)
 	}
 
@@ -319,7 +313,7 @@ func (provider *MistralProvider) Transcription(ctx context.Context, key schemas.
 
 	// Parse Mistral's transcription response
 	var mistralResponse MistralTranscriptionResponse
-	if err := sonic.Unmarshal(copiedResponseBody, &mistralResponse); err != nil {
+	if err := serialization.Unmarshal(copiedResponseBody, &mistralResponse); err != nil {
 		if providerUtils.IsHTMLResponse(resp, copiedResponseBody) {
 			return nil, &schemas.BifrostError{
 				IsBifrostError: false,
@@ -345,9 +339,9 @@ func (provider *MistralProvider) Transcription(ctx context.Context, key schemas.
 	response.ExtraFields.ModelRequested = request.Model
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		var rawResponse interface{}
-		if err := sonic.Unmarshal(copiedResponseBody, &rawResponse); err == nil {
+		if err := serialization.Unmarshal(copiedResponseBody, &rawResponse); err == nil {
 			response.ExtraFields.RawResponse = rawResponse
 		}
 	}
@@ -360,6 +354,9 @@ func (provider *MistralProvider) Transcription(ctx context.Context, key schemas.
 // Returns a channel of BifrostStream objects containing transcription deltas.
 func (provider *MistralProvider) TranscriptionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	providerName := provider.GetProviderKey()
+	if err := providerUtils.CheckTranslationSupported(providerName, schemas.TranscriptionStreamRequest, request); err != nil {
+		return nil, err
+	}
 
 	// Convert Bifrost request to Mistral format
 	mistralReq := ToMistralTranscriptionRequest(request)
@@ -392,10 +389,10 @@ func (provider *MistralProvider) TranscriptionStream(ctx context.Context, postHo
 	defer fasthttp.ReleaseRequest(req)
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	req.Header.SetMethod(http.MethodPost)
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/audio/transcriptions"))
+	req.SetRequestURI(provider.NetworkConfig().BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/audio/transcriptions"))
 
 	// Set headers
 	for headerKey, value := range headers {
@@ -427,7 +424,7 @@ func (provider *MistralProvider) TranscriptionStream(ctx context.Context, postHo
 	// Check for HTTP errors
 	if resp.StatusCode() != fasthttp.StatusOK {
 		defer providerUtils.ReleaseStreamingResponse(resp)
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, openai.ParseOpenAIError(resp, schemas.TranscriptionStreamRequest, providerName, request.Model)
 	}
 
@@ -496,8 +493,8 @@ func (provider *MistralProvider) TranscriptionStream(ctx context.Context, postHo
 
 		// Handle scanner errors
 		if err := scanner.Err(); err != nil {
-			provider.logger.Warn(fmt.Sprintf("Error reading stream: %v", err))
-			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TranscriptionStreamRequest, providerName, request.Model, provider.logger)
+			provider.Logger().Warn(fmt.Sprintf("Error reading stream: %v", err))
+			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TranscriptionStreamRequest, providerName, request.Model, provider.Logger())
 		}
 	}()
 
@@ -524,7 +521,7 @@ func (provider *MistralProvider) processTranscriptionStreamEvent(
 
 	// First, check if this is an error response
 	var bifrostErr schemas.BifrostError
-	if err := sonic.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
+	if err := serialization.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
 		if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
 			bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 				Provider:       providerName,
@@ -532,15 +529,15 @@ func (provider *MistralProvider) processTranscriptionStreamEvent(
 				RequestType:    schemas.TranscriptionStreamRequest,
 			}
 			ctx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
-			providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, &bifrostErr, responseChan, provider.logger)
+			providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, &bifrostErr, responseChan, provider.Logger())
 			return
 		}
 	}
 
 	// Parse the event data
 	var eventData MistralTranscriptionStreamData
-	if err := sonic.Unmarshal([]byte(jsonData), &eventData); err != nil {
-		provider.logger.Warn(fmt.Sprintf("Failed to parse stream event data: %v", err))
+	if err := serialization.Unmarshal([]byte(jsonData), &eventData); err != nil {
+		provider.Logger().Warn(fmt.Sprintf("Failed to parse stream event data: %v", err))
 		return
 	}
 
@@ -566,7 +563,7 @@ func (provider *MistralProvider) processTranscriptionStreamEvent(
 	}
 	*lastChunkTime = time.Now()
 
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = jsonData
 	}
 
@@ -11,8 +11,8 @@ import (
 	"testing"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -542,7 +542,7 @@ func TestTranscriptionWithMockServer(t *testing.T) {
 
 				// Send response
 				w.WriteHeader(tt.statusCode)
-				responseJSON, _ := sonic.Marshal(tt.responseBody)
+				responseJSON, _ := serialization.Marshal(tt.responseBody)
 				w.Write(responseJSON)
 			}))
 			defer server.Close()
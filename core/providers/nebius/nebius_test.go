@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/maximhq/bifrost/core/internal/testutil"
+	"github.com/maximhq/bifrost/core/providers/nebius"
 
 	"github.com/maximhq/bifrost/core/schemas"
 )
@@ -54,3 +55,17 @@ func TestNebius(t *testing.T) {
 	})
 	client.Shutdown()
 }
+
+// Test that the shared base provider keeps the Nebius behavior: raw responses are sent back, raw requests are not
+func TestNewNebiusProvider_RawRequestAndResponse(t *testing.T) {
+	provider, err := nebius.NewNebiusProvider(&schemas.ProviderConfig{SendBackRawRequest: true, SendBackRawResponse: true}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create the provider: %v", err)
+	}
+	if provider.SendBackRawRequest() || !provider.SendBackRawResponse() {
+		t.Errorf("Expected only raw responses to be sent back, got raw request %v and raw response %v", provider.SendBackRawRequest(), provider.SendBackRawResponse())
+	}
+	if provider.GetProviderKey() != schemas.Nebius {
+		t.Errorf("Expected provider key %s, got %s", schemas.Nebius, provider.GetProviderKey())
+	}
+}
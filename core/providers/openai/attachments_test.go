@@ -0,0 +1,77 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// testLogger is a minimal logger implementation for testing.
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+
+func newAttachmentTestProvider(t *testing.T) *OpenAIProvider {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/files/file-report":
+			w.Write([]byte(`{"id":"file-report","object":"file","bytes":1024,"created_at":1700000000,"filename":"report.pdf","purpose":"user_data","status":"processed"}`))
+		case "/v1/files/file-failed":
+			w.Write([]byte(`{"id":"file-failed","object":"file","bytes":1024,"created_at":1700000000,"filename":"broken.pdf","purpose":"user_data","status":"error"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"No such File object","type":"invalid_request_error"}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return NewOpenAIProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, &testLogger{})
+}
+
+func TestCheckChatAttachments_AcceptsReadyFiles(t *testing.T) {
+	provider := newAttachmentTestProvider(t)
+	request := &schemas.BifrostChatRequest{
+		Provider:    schemas.OpenAI,
+		Model:       "gpt-4o",
+		Attachments: []schemas.FileReference{{FileID: "file-report"}},
+	}
+	if bifrostErr := provider.checkChatAttachments(context.Background(), schemas.Key{Value: "test-key"}, request); bifrostErr != nil {
+		t.Fatalf("expected attachment to be accepted, got error: %v", bifrostErr.Error.Message)
+	}
+}
+
+func TestCheckChatAttachments_RejectsMissingOrUnreadyFiles(t *testing.T) {
+	provider := newAttachmentTestProvider(t)
+	for fileID, expected := range map[string]string{
+		"file-failed":  "not ready to use",
+		"file-missing": "failed to retrieve file",
+		"":             "file_id is required",
+	} {
+		request := &schemas.BifrostChatRequest{
+			Provider:    schemas.OpenAI,
+			Model:       "gpt-4o",
+			Attachments: []schemas.FileReference{{FileID: fileID}},
+		}
+		bifrostErr := provider.checkChatAttachments(context.Background(), schemas.Key{Value: "test-key"}, request)
+		if bifrostErr == nil {
+			t.Errorf("expected an error for file %q", fileID)
+			continue
+		}
+		if !strings.Contains(bifrostErr.Error.Message, expected) {
+			t.Errorf("expected error for file %q to contain %q, got %q", fileID, expected, bifrostErr.Error.Message)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+func TestBatchResults_Pagination(t *testing.T) {
+	const totalResults = 5000
+	var results strings.Builder
+	for i := range totalResults {
+		fmt.Fprintf(&results, `{"custom_id":"request-%d","response":{"status_code":200,"body":{"id":"chatcmpl-%d"}}}`+"\n", i, i)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/batches/batch_abc":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"batch_abc","object":"batch","status":"completed","output_file_id":"file-out"}`))
+		case "/v1/files/file-out/content":
+			w.Header().Set("Content-Type", "application/jsonl")
+			w.Write([]byte(results.String()))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, nil)
+	keys := []schemas.Key{{ID: "key-1", Value: "sk-test"}}
+
+	// Page through all results
+	var after *string
+	pages, seen := 0, 0
+	for {
+		resp, bifrostErr := provider.BatchResults(context.Background(), keys, &schemas.BifrostBatchResultsRequest{
+			Provider: schemas.OpenAI,
+			BatchID:  "batch_abc",
+			Limit:    1200,
+			After:    after,
+		})
+		if bifrostErr != nil {
+			t.Fatalf("expected page %d to succeed, got error: %v", pages, bifrostErr.Error.Message)
+		}
+		pages++
+		for _, result := range resp.Results {
+			if expected := fmt.Sprintf("request-%d", seen); result.CustomID != expected {
+				t.Fatalf("expected result %s, got %s", expected, result.CustomID)
+			}
+			seen++
+		}
+		if !resp.HasMore {
+			if resp.NextCursor != nil {
+				t.Error("expected no next cursor on the last page")
+			}
+			break
+		}
+		if len(resp.Results) != 1200 || resp.NextCursor == nil {
+			t.Fatalf("expected full page with a next cursor, got %d results", len(resp.Results))
+		}
+		after = resp.NextCursor
+	}
+	if pages != 5 || seen != totalResults {
+		t.Errorf("expected %d results in 5 pages, got %d results in %d pages", totalResults, seen, pages)
+	}
+
+	// Without pagination all results are returned at once
+	resp, bifrostErr := provider.BatchResults(context.Background(), keys, &schemas.BifrostBatchResultsRequest{
+		Provider: schemas.OpenAI,
+		BatchID:  "batch_abc",
+	})
+	if bifrostErr != nil {
+		t.Fatalf("expected request to succeed, got error: %v", bifrostErr.Error.Message)
+	}
+	if len(resp.Results) != totalResults || resp.HasMore {
+		t.Errorf("expected all %d results in one response, got %d (has_more: %v)", totalResults, len(resp.Results), resp.HasMore)
+	}
+
+	// An invalid cursor is rejected
+	_, bifrostErr = provider.BatchResults(context.Background(), keys, &schemas.BifrostBatchResultsRequest{
+		Provider: schemas.OpenAI,
+		BatchID:  "batch_abc",
+		After:    schemas.Ptr("not-a-cursor"),
+	})
+	if bifrostErr == nil {
+		t.Error("expected invalid cursor to be rejected")
+	}
+}
@@ -27,6 +27,9 @@ func ToOpenAIChatRequest(bifrostReq *schemas.BifrostChatRequest) *OpenAIChatRequ
 		Model:    bifrostReq.Model,
 		Messages: ConvertBifrostMessagesToOpenAIMessages(bifrostReq.Input),
 	}
+	if len(bifrostReq.Attachments) > 0 {
+		openaiReq.Messages = attachFileReferences(openaiReq.Messages, bifrostReq.Attachments)
+	}
 
 	if bifrostReq.Params != nil {
 		openaiReq.ChatParameters = *bifrostReq.Params
@@ -0,0 +1,31 @@
+package openai
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the refusal field of chat responses is kept apart from the content, through the Responses conversion too
+func TestChatResponse_Refusal(t *testing.T) {
+	body := `{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"gpt-4o","choices":[{"index":0,"message":{"role":"assistant","content":null,"refusal":"I'm sorry, I can't assist with that."},"finish_reason":"stop"}]}`
+
+	var response schemas.BifrostChatResponse
+	if err := json.Unmarshal([]byte(body), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	message := response.Choices[0].Message
+	if message.ChatAssistantMessage == nil || message.ChatAssistantMessage.Refusal == nil || *message.ChatAssistantMessage.Refusal != "I'm sorry, I can't assist with that." {
+		t.Fatalf("Expected the refusal field, got %+v", message.ChatAssistantMessage)
+	}
+	if message.Content != nil && message.Content.ContentStr != nil {
+		t.Errorf("Expected no content, got %q", *message.Content.ContentStr)
+	}
+
+	output := response.ToBifrostResponsesResponse().Output
+	if len(output) != 1 || output[0].Content == nil || len(output[0].Content.ContentBlocks) != 1 ||
+		output[0].Content.ContentBlocks[0].Type != schemas.ResponsesOutputMessageContentTypeRefusal {
+		t.Errorf("Expected a refusal output item, got %+v", output)
+	}
+}
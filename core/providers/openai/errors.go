@@ -1,6 +1,9 @@
 package openai
 
 import (
+	"encoding/json"
+	"fmt"
+
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
 	"github.com/valyala/fasthttp"
@@ -20,15 +23,25 @@ func ParseOpenAIError(resp *fasthttp.Response, requestType schemas.RequestType,
 		if bifrostErr.Error == nil {
 			bifrostErr.Error = &schemas.ErrorField{}
 		}
-		bifrostErr.Error.Type = errorResp.Error.Type
-		bifrostErr.Error.Code = errorResp.Error.Code
-		bifrostErr.Error.Message = errorResp.Error.Message
+		// Fields missing from the OpenAI error shape keep what was extracted from the body
+		if errorResp.Error.Type != nil {
+			bifrostErr.Error.Type = errorResp.Error.Type
+		}
+		if errorResp.Error.Code != nil {
+			bifrostErr.Error.Code = errorResp.Error.Code
+		}
+		if errorResp.Error.Message != "" {
+			bifrostErr.Error.Message = errorResp.Error.Message
+		}
 		bifrostErr.Error.Param = errorResp.Error.Param
 		if errorResp.Error.EventID != nil {
 			bifrostErr.Error.EventID = errorResp.Error.EventID
 		}
 	}
 
+	// A 429 is either a rate limit or a used up quota, told apart by its type or code (e.g. insufficient_quota)
+	providerUtils.ClassifyRateLimitError(bifrostErr)
+
 	// Set ExtraFields unconditionally so provider/model/request metadata is always attached
 	if bifrostErr != nil {
 		bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
@@ -40,3 +53,56 @@ func ParseOpenAIError(resp *fasthttp.Response, requestType schemas.RequestType,
 
 	return bifrostErr
 }
+
+// ToOpenAIStreamError converts a BifrostError to the error event OpenAI sends on chat, text completion and audio streams.
+func ToOpenAIStreamError(bifrostErr *schemas.BifrostError) *OpenAIStreamError {
+	if bifrostErr == nil {
+		return nil
+	}
+	streamErr := &OpenAIStreamError{
+		Error: OpenAIStreamErrorField{
+			Type: openAIErrorType(bifrostErr),
+		},
+	}
+	if bifrostErr.Error != nil {
+		streamErr.Error.Message = bifrostErr.Error.Message
+		streamErr.Error.Param = bifrostErr.Error.Param
+		streamErr.Error.Code = bifrostErr.Error.Code
+	}
+	return streamErr
+}
+
+// ToOpenAIResponsesStreamError converts a BifrostError to the responses API streaming error in SSE format.
+func ToOpenAIResponsesStreamError(bifrostErr *schemas.BifrostError) string {
+	if bifrostErr == nil {
+		return ""
+	}
+	streamErr := OpenAIResponsesStreamError{
+		Type: string(schemas.ResponsesStreamResponseTypeError),
+	}
+	if bifrostErr.Error != nil {
+		streamErr.Code = bifrostErr.Error.Code
+		streamErr.Message = bifrostErr.Error.Message
+		streamErr.Param = bifrostErr.Error.Param
+	}
+
+	jsonData, err := json.Marshal(streamErr)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("event: %s\ndata: %s\n\n", schemas.ResponsesStreamResponseTypeError, jsonData)
+}
+
+// openAIErrorType returns the error type reported by the provider, falling back to the OpenAI type for the status code.
+func openAIErrorType(bifrostErr *schemas.BifrostError) string {
+	if bifrostErr.Error != nil && bifrostErr.Error.Type != nil && *bifrostErr.Error.Type != "" {
+		return *bifrostErr.Error.Type
+	}
+	if bifrostErr.Type != nil && *bifrostErr.Type != "" {
+		return *bifrostErr.Type
+	}
+	if bifrostErr.StatusCode != nil && *bifrostErr.StatusCode >= fasthttp.StatusBadRequest && *bifrostErr.StatusCode < fasthttp.StatusInternalServerError {
+		return "invalid_request_error"
+	}
+	return "server_error"
+}
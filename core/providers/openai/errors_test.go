@@ -0,0 +1,116 @@
+package openai
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+	"github.com/valyala/fasthttp"
+)
+
+func TestToOpenAIStreamError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *schemas.BifrostError
+		expected string
+	}{
+		{
+			name: "provider error fields are preserved",
+			err: &schemas.BifrostError{
+				StatusCode: schemas.Ptr(429),
+				Error: &schemas.ErrorField{
+					Type:    schemas.Ptr("requests"),
+					Code:    schemas.Ptr("rate_limit_exceeded"),
+					Message: "Rate limit reached",
+				},
+			},
+			expected: `{"error":{"message":"Rate limit reached","type":"requests","param":null,"code":"rate_limit_exceeded"}}`,
+		},
+		{
+			name: "client error without type",
+			err: &schemas.BifrostError{
+				StatusCode: schemas.Ptr(400),
+				Error:      &schemas.ErrorField{Message: "model is required"},
+			},
+			expected: `{"error":{"message":"model is required","type":"invalid_request_error","param":null,"code":null}}`,
+		},
+		{
+			name:     "mid-stream failure without status",
+			err:      &schemas.BifrostError{Error: &schemas.ErrorField{Message: "connection reset"}},
+			expected: `{"error":{"message":"connection reset","type":"server_error","param":null,"code":null}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := serialization.Marshal(ToOpenAIStreamError(tt.err))
+			if err != nil {
+				t.Fatalf("failed to marshal stream error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+
+	if ToOpenAIStreamError(nil) != nil {
+		t.Error("expected nil for nil error")
+	}
+}
+
+func TestToOpenAIResponsesStreamError(t *testing.T) {
+	event := ToOpenAIResponsesStreamError(&schemas.BifrostError{
+		StatusCode: schemas.Ptr(500),
+		Error: &schemas.ErrorField{
+			Code:    schemas.Ptr("server_error"),
+			Message: "upstream failed",
+		},
+	})
+
+	if !strings.HasPrefix(event, "event: error\ndata: ") || !strings.HasSuffix(event, "\n\n") {
+		t.Fatalf("expected an SSE error event, got %q", event)
+	}
+	data := strings.TrimSuffix(strings.TrimPrefix(event, "event: error\ndata: "), "\n\n")
+	expected := `{"type":"error","code":"server_error","message":"upstream failed","param":null,"sequence_number":0}`
+	if data != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	if ToOpenAIResponsesStreamError(nil) != "" {
+		t.Error("expected empty event for nil error")
+	}
+}
+
+// Test that 429 rate limits and used up quotas are told apart from the OpenAI error type and code
+func TestParseOpenAIError_RateLimitKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected schemas.RateLimitKind
+	}{
+		{
+			name:     "rate limit",
+			body:     `{"error":{"message":"Rate limit reached for gpt-4o in organization org-x on requests per min (RPM): Limit 500, Used 500.","type":"requests","code":"rate_limit_exceeded"}}`,
+			expected: schemas.RateLimitKindRateLimited,
+		},
+		{
+			name:     "quota exhausted",
+			body:     `{"error":{"message":"You exceeded your current quota, please check your plan and billing details.","type":"insufficient_quota","code":"insufficient_quota"}}`,
+			expected: schemas.RateLimitKindQuotaExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseResponse(resp)
+			resp.SetStatusCode(fasthttp.StatusTooManyRequests)
+			resp.SetBodyString(tt.body)
+
+			bifrostErr := ParseOpenAIError(resp, schemas.ChatCompletionRequest, schemas.OpenAI, "gpt-4o")
+			if bifrostErr.RateLimitKind != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, bifrostErr.RateLimitKind)
+			}
+		})
+	}
+}
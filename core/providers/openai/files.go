@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // OpenAI File API Types
@@ -117,7 +117,7 @@ func (r *OpenAIFileResponse) ToBifrostFileRetrieveResponse(providerName schemas.
 func ConvertRequestsToJSONL(requests []schemas.BatchRequestItem) ([]byte, error) {
 	var buf bytes.Buffer
 	for _, req := range requests {
-		line, err := sonic.Marshal(req)
+		line, err := serialization.Marshal(req)
 		if err != nil {
 			return nil, err
 		}
@@ -14,7 +14,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
@@ -23,12 +23,8 @@ import (
 
 // OpenAIProvider implements the Provider interface for OpenAI's GPT API.
 type OpenAIProvider struct {
-	logger               schemas.Logger                // Logger for provider operations
-	client               *fasthttp.Client              // HTTP client for API requests
-	networkConfig        schemas.NetworkConfig         // Network configuration including extra headers
-	sendBackRawRequest   bool                          // Whether to include raw request in BifrostResponse
-	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
-	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewOpenAIProvider creates a new OpenAI provider instance.
@@ -52,63 +48,51 @@ func NewOpenAIProvider(config *schemas.ProviderConfig, logger schemas.Logger) *O
 
 	// Configure proxy if provided
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 
 	// Set default BaseURL if not provided
 	if config.NetworkConfig.BaseURL == "" {
 		config.NetworkConfig.BaseURL = "https://api.openai.com"
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+	config.NetworkConfig.BaseURL = providerUtils.NormalizeBaseURL(config.NetworkConfig.BaseURL)
 
 	return &OpenAIProvider{
-		logger:               logger,
-		client:               client,
-		networkConfig:        config.NetworkConfig,
-		sendBackRawRequest:   config.SendBackRawRequest,
-		sendBackRawResponse:  config.SendBackRawResponse,
-		customProviderConfig: config.CustomProviderConfig,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.OpenAI, config, logger),
+		client:       client,
 	}
 }
 
-// GetProviderKey returns the provider identifier for OpenAI.
-func (provider *OpenAIProvider) GetProviderKey() schemas.ModelProvider {
-	return providerUtils.GetProviderName(schemas.OpenAI, provider.customProviderConfig)
-}
-
-// buildRequestURL constructs the full request URL using the provider's configuration.
-func (provider *OpenAIProvider) buildRequestURL(ctx context.Context, defaultPath string, requestType schemas.RequestType) string {
-	return provider.networkConfig.BaseURL + providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType)
-}
-
 func (provider *OpenAIProvider) ListModels(ctx context.Context, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.ListModelsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.ListModelsRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
-	if provider.customProviderConfig != nil && provider.customProviderConfig.IsKeyLess {
+	if provider.CustomProviderConfig() != nil && provider.CustomProviderConfig().IsKeyLess {
 		return listModelsByKey(
 			ctx,
 			provider.client,
-			provider.buildRequestURL(ctx, "/v1/models", schemas.ListModelsRequest),
+			provider.BuildRequestURL(ctx, "/v1/models", schemas.ListModelsRequest),
 			schemas.Key{},
-			provider.networkConfig.ExtraHeaders,
+			provider.NetworkConfig().ExtraHeaders,
 			providerName,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		)
 	}
 
 	return HandleOpenAIListModelsRequest(ctx,
 		provider.client,
 		request,
-		provider.buildRequestURL(ctx, "/v1/models", schemas.ListModelsRequest),
+		provider.BuildRequestURL(ctx, "/v1/models", schemas.ListModelsRequest),
 		keys,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		providerName,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -159,7 +143,7 @@ func listModelsByKey(
 	openaiResponse := &OpenAIListModelsResponse{}
 
 	// Use enhanced response handler with pre-allocated response
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, openaiResponse, nil, sendBackRawRequest, sendBackRawResponse)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, responseBody, openaiResponse, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -214,20 +198,20 @@ func HandleOpenAIListModelsRequest(
 // TextCompletion is not supported by the OpenAI provider.
 // Returns an error indicating that text completion is not available.
 func (provider *OpenAIProvider) TextCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostTextCompletionRequest) (*schemas.BifrostTextCompletionResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.TextCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.TextCompletionRequest); err != nil {
 		return nil, err
 	}
 	return HandleOpenAITextCompletionRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/completions", schemas.TextCompletionRequest),
+		provider.BuildRequestURL(ctx, "/v1/completions", schemas.TextCompletionRequest),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -290,7 +274,7 @@ func HandleOpenAITextCompletionRequest(
 
 	response := &schemas.BifrostTextCompletionResponse{}
 
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -317,7 +301,7 @@ func HandleOpenAITextCompletionRequest(
 // It formats the request, sends it to OpenAI, and processes the response.
 // Returns a channel of BifrostStream objects or an error if the request fails.
 func (provider *OpenAIProvider) TextCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTextCompletionRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.TextCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.TextCompletionStreamRequest); err != nil {
 		return nil, err
 	}
 	var authHeader map[string]string
@@ -327,16 +311,16 @@ func (provider *OpenAIProvider) TextCompletionStream(ctx context.Context, postHo
 	return HandleOpenAITextCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/completions", schemas.TextCompletionStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/completions", schemas.TextCompletionStreamRequest),
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -439,9 +423,8 @@ func HandleOpenAITextCompletionStreaming(
 		defer close(responseChan)
 		defer providerUtils.ReleaseStreamingResponse(resp)
 
-		scanner := bufio.NewScanner(resp.BodyStream())
-		buf := make([]byte, 0, 1024*1024)
-		scanner.Buffer(buf, 10*1024*1024)
+		// Providers stream SSE events or newline-delimited JSON, the reader handles both
+		reader := providerUtils.NewStreamReader(resp.BodyStream(), string(resp.Header.ContentType()))
 
 		chunkIndex := -1
 		usage := &schemas.BifrostLLMUsage{}
@@ -451,7 +434,7 @@ func HandleOpenAITextCompletionStreaming(
 		startTime := time.Now()
 		lastChunkTime := startTime
 
-		for scanner.Scan() {
+		for reader.Next() {
 			// Check if context is done before processing
 			select {
 			case <-ctx.Done():
@@ -459,36 +442,11 @@ func HandleOpenAITextCompletionStreaming(
 			default:
 			}
 
-			line := scanner.Text()
-
-			// Skip empty lines and comments
-			if line == "" || strings.HasPrefix(line, ":") {
-				continue
-			}
-
-			// Check for end of stream
-			if line == "data: [DONE]" {
-				break
-			}
-
-			var jsonData string
-
-			// Parse SSE data
-			if after, ok := strings.CutPrefix(line, "data: "); ok {
-				jsonData = after
-			} else {
-				// Handle raw JSON errors (without "data: " prefix)
-				jsonData = line
-			}
-
-			// Skip empty data
-			if strings.TrimSpace(jsonData) == "" {
-				continue
-			}
+			jsonData := reader.Data()
 
 			// First, check if this is an error response
 			var bifrostErr schemas.BifrostError
-			if err := sonic.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
 				if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
 					bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 						Provider:       providerName,
@@ -503,7 +461,7 @@ func HandleOpenAITextCompletionStreaming(
 
 			// Parse into bifrost response
 			var response schemas.BifrostTextCompletionResponse
-			if err := sonic.Unmarshal([]byte(jsonData), &response); err != nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &response); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to parse stream response: %v", err))
 				continue
 			}
@@ -586,7 +544,7 @@ func HandleOpenAITextCompletionStreaming(
 		}
 
 		// Handle scanner errors first
-		if err := scanner.Err(); err != nil {
+		if err := reader.Err(); err != nil {
 			logger.Warn(fmt.Sprintf("Error reading stream: %v", err))
 			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.TextCompletionStreamRequest, providerName, request.Model, logger)
 		} else {
@@ -607,26 +565,54 @@ func HandleOpenAITextCompletionStreaming(
 	return responseChan, nil
 }
 
+// checkChatAttachments checks that every file attached to a chat request exists and is ready to use, so that
+// a missing or failed file is reported before the model answers without it.
+func (provider *OpenAIProvider) checkChatAttachments(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) *schemas.BifrostError {
+	providerName := provider.GetProviderKey()
+	for i, attachment := range request.Attachments {
+		if attachment.FileID == "" {
+			return providerUtils.NewBifrostOperationError(fmt.Sprintf("attachment %d: file_id is required", i), nil, providerName)
+		}
+		file, bifrostErr := provider.FileRetrieve(ctx, []schemas.Key{key}, &schemas.BifrostFileRetrieveRequest{
+			Provider: request.Provider,
+			FileID:   attachment.FileID,
+		})
+		if bifrostErr != nil {
+			if bifrostErr.Error != nil {
+				bifrostErr.Error.Message = fmt.Sprintf("attachment %d: failed to retrieve file %s: %s", i, attachment.FileID, bifrostErr.Error.Message)
+			}
+			return bifrostErr
+		}
+		if file.Status != "" && file.Status != schemas.FileStatusProcessed && file.Status != schemas.FileStatusUploaded {
+			return providerUtils.NewBifrostOperationError(fmt.Sprintf("attachment %d: file %s is not ready to use (status: %s)", i, attachment.FileID, file.Status), nil, providerName)
+		}
+	}
+	return nil
+}
+
 // ChatCompletion performs a chat completion request to the OpenAI API.
 // It supports both text and image content in messages.
 // Returns a BifrostResponse containing the completion results or an error if the request fails.
 func (provider *OpenAIProvider) ChatCompletion(ctx context.Context, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
 	// Check if chat completion is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.ChatCompletionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.ChatCompletionRequest); err != nil {
+		return nil, err
+	}
+	if err := provider.checkChatAttachments(ctx, key, request); err != nil {
 		return nil, err
 	}
 
 	return HandleOpenAIChatCompletionRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionRequest),
+		provider.BuildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionRequest),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -691,7 +677,7 @@ func HandleOpenAIChatCompletionRequest(
 	response := &schemas.BifrostChatResponse{}
 
 	// Use enhanced response handler with pre-allocated response
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -719,7 +705,10 @@ func HandleOpenAIChatCompletionRequest(
 // Returns a channel for streaming responses and any error that occurred.
 func (provider *OpenAIProvider) ChatCompletionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if chat completion stream is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.ChatCompletionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.ChatCompletionStreamRequest); err != nil {
+		return nil, err
+	}
+	if err := provider.checkChatAttachments(ctx, key, request); err != nil {
 		return nil, err
 	}
 	var authHeader map[string]string
@@ -730,18 +719,18 @@ func (provider *OpenAIProvider) ChatCompletionStream(ctx context.Context, postHo
 	return HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/chat/completions", schemas.ChatCompletionStreamRequest),
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -865,9 +854,8 @@ func HandleOpenAIChatCompletionStreaming(
 		defer close(responseChan)
 		defer providerUtils.ReleaseStreamingResponse(resp)
 
-		scanner := bufio.NewScanner(resp.BodyStream())
-		buf := make([]byte, 0, 1024*1024)
-		scanner.Buffer(buf, 10*1024*1024)
+		// Providers stream SSE events or newline-delimited JSON, the reader handles both
+		reader := providerUtils.NewStreamReader(resp.BodyStream(), string(resp.Header.ContentType()))
 
 		chunkIndex := -1
 		usage := &schemas.BifrostLLMUsage{}
@@ -878,7 +866,7 @@ func HandleOpenAIChatCompletionStreaming(
 		var finishReason *string
 		var messageID string
 
-		for scanner.Scan() {
+		for reader.Next() {
 			// Check if context is done before processing
 			select {
 			case <-ctx.Done():
@@ -886,36 +874,11 @@ func HandleOpenAIChatCompletionStreaming(
 			default:
 			}
 
-			line := scanner.Text()
-
-			// Skip empty lines and comments
-			if line == "" || strings.HasPrefix(line, ":") {
-				continue
-			}
-
-			// Check for end of stream
-			if line == "data: [DONE]" {
-				break
-			}
-
-			var jsonData string
-
-			// Parse SSE data
-			if after, ok := strings.CutPrefix(line, "data: "); ok {
-				jsonData = after
-			} else {
-				// Handle raw JSON errors (without "data: " prefix)
-				jsonData = line
-			}
-
-			// Skip empty data
-			if strings.TrimSpace(jsonData) == "" {
-				continue
-			}
+			jsonData := reader.Data()
 
 			// First, check if this is an error response
 			var bifrostErr schemas.BifrostError
-			if err := sonic.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
 				if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
 					bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 						Provider:       providerName,
@@ -930,7 +893,7 @@ func HandleOpenAIChatCompletionStreaming(
 
 			// Parse into bifrost response
 			var response schemas.BifrostChatResponse
-			if err := sonic.Unmarshal([]byte(jsonData), &response); err != nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &response); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to parse stream response: %v", err))
 				continue
 			}
@@ -1076,7 +1039,7 @@ func HandleOpenAIChatCompletionStreaming(
 		}
 
 		// Handle scanner errors first
-		if err := scanner.Err(); err != nil {
+		if err := reader.Err(); err != nil {
 			logger.Warn(fmt.Sprintf("Error reading stream: %v", err))
 			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, logger)
 		} else if !isResponsesToChatCompletionsFallback {
@@ -1100,21 +1063,21 @@ func HandleOpenAIChatCompletionStreaming(
 // Responses performs a responses request to the OpenAI API.
 func (provider *OpenAIProvider) Responses(ctx context.Context, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
 	// Check if chat completion is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.ResponsesRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.ResponsesRequest); err != nil {
 		return nil, err
 	}
 
 	return HandleOpenAIResponsesRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/responses", schemas.ResponsesRequest),
+		provider.BuildRequestURL(ctx, "/v1/responses", schemas.ResponsesRequest),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -1180,7 +1143,7 @@ func HandleOpenAIResponsesRequest(
 	response := &schemas.BifrostResponsesResponse{}
 
 	// Use enhanced response handler with pre-allocated response
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1206,7 +1169,7 @@ func HandleOpenAIResponsesRequest(
 // ResponsesStream performs a streaming responses request to the OpenAI API.
 func (provider *OpenAIProvider) ResponsesStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
 	// Check if chat completion stream is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.ResponsesStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.ResponsesStreamRequest); err != nil {
 		return nil, err
 	}
 	var authHeader map[string]string
@@ -1217,17 +1180,17 @@ func (provider *OpenAIProvider) ResponsesStream(ctx context.Context, postHookRun
 	return HandleOpenAIResponsesStreaming(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/responses", schemas.ResponsesStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/responses", schemas.ResponsesStreamRequest),
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -1379,7 +1342,7 @@ func HandleOpenAIResponsesStreaming(
 
 			// Parse into bifrost response
 			var response schemas.BifrostResponsesStreamResponse
-			if err := sonic.Unmarshal([]byte(jsonData), &response); err != nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &response); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to parse stream response: %v", err))
 				continue
 			}
@@ -1459,7 +1422,7 @@ func HandleOpenAIResponsesStreaming(
 // Returns a BifrostResponse containing the embedding(s) and any error that occurred.
 func (provider *OpenAIProvider) Embedding(ctx context.Context, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
 	// Check if embedding is allowed for this provider
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.EmbeddingRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.EmbeddingRequest); err != nil {
 		return nil, err
 	}
 
@@ -1467,14 +1430,14 @@ func (provider *OpenAIProvider) Embedding(ctx context.Context, key schemas.Key,
 	return HandleOpenAIEmbeddingRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/embeddings", schemas.EmbeddingRequest),
+		provider.BuildRequestURL(ctx, "/v1/embeddings", schemas.EmbeddingRequest),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -1541,7 +1504,7 @@ func HandleOpenAIEmbeddingRequest(
 	response := &schemas.BifrostEmbeddingResponse{}
 
 	// Use enhanced response handler with pre-allocated response
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -1568,20 +1531,20 @@ func HandleOpenAIEmbeddingRequest(
 // It formats the request body, makes the API call, and returns the response.
 // Returns the response and any error that occurred.
 func (provider *OpenAIProvider) Speech(ctx context.Context, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.SpeechRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.SpeechRequest); err != nil {
 		return nil, err
 	}
 
 	return HandleOpenAISpeechRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/audio/speech", schemas.SpeechRequest),
+		provider.BuildRequestURL(ctx, "/v1/audio/speech", schemas.SpeechRequest),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		provider.logger,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		provider.Logger(),
 	)
 }
 
@@ -1667,7 +1630,7 @@ func HandleOpenAISpeechRequest(
 // It formats the request body, creates HTTP request, and uses shared streaming logic.
 // Returns a channel for streaming responses and any error that occurred.
 func (provider *OpenAIProvider) SpeechStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.SpeechStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.SpeechStreamRequest); err != nil {
 		return nil, err
 	}
 
@@ -1685,17 +1648,17 @@ func (provider *OpenAIProvider) SpeechStream(ctx context.Context, postHookRunner
 	return HandleOpenAISpeechStreamRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/audio/speech", schemas.SpeechStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/audio/speech", schemas.SpeechStreamRequest),
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -1843,7 +1806,7 @@ func HandleOpenAISpeechStreamRequest(
 
 			// First, check if this is an error response
 			var bifrostErr schemas.BifrostError
-			if err := sonic.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
 				if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
 					bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 						Provider:       providerName,
@@ -1858,7 +1821,7 @@ func HandleOpenAISpeechStreamRequest(
 
 			// Parse into bifrost response
 			var response schemas.BifrostSpeechStreamResponse
-			if err := sonic.Unmarshal([]byte(jsonData), &response); err != nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &response); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to parse stream response: %v", err))
 				continue
 			}
@@ -1913,20 +1876,26 @@ func HandleOpenAISpeechStreamRequest(
 // It creates a multipart form, adds fields, makes the API call, and returns the response.
 // Returns the response and any error that occurred.
 func (provider *OpenAIProvider) Transcription(ctx context.Context, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.TranscriptionRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.TranscriptionRequest); err != nil {
 		return nil, err
 	}
 
+	// Translations to English have their own endpoint
+	path := "/v1/audio/transcriptions"
+	if request.Params.IsTranslation() {
+		path = "/v1/audio/translations"
+	}
+
 	return HandleOpenAITranscriptionRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/audio/transcriptions", schemas.TranscriptionRequest),
+		provider.BuildRequestURL(ctx, path, schemas.TranscriptionRequest),
 		request,
 		key,
-		provider.networkConfig.ExtraHeaders,
+		provider.NetworkConfig().ExtraHeaders,
 		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.logger,
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
+		provider.Logger(),
 	)
 }
 
@@ -2002,10 +1971,29 @@ func HandleOpenAITranscriptionRequest(
 
 	copiedResponseBody := append([]byte(nil), responseBody...)
 
+	// Text, srt and vtt transcriptions are returned as plain text instead of JSON
+	if request.Params != nil && providerUtils.IsTextTranscriptionResponseFormat(request.Params.ResponseFormat) {
+		formatted := string(copiedResponseBody)
+		response := &schemas.BifrostTranscriptionResponse{Text: strings.TrimSpace(formatted), Formatted: &formatted}
+		if *request.Params.ResponseFormat != schemas.TranscriptionResponseFormatText {
+			response.Text = providerUtils.SubtitleText(formatted)
+		}
+		response.ExtraFields = schemas.BifrostResponseExtraFields{
+			RequestType:    schemas.TranscriptionRequest,
+			Provider:       providerName,
+			ModelRequested: request.Model,
+			Latency:        latency.Milliseconds(),
+		}
+		if sendBackRawResponse {
+			response.ExtraFields.RawResponse = formatted
+		}
+		return response, nil
+	}
+
 	// Parse OpenAI's transcription response directly into BifrostTranscribe
 	response := &schemas.BifrostTranscriptionResponse{}
 
-	if err := sonic.Unmarshal(copiedResponseBody, response); err != nil {
+	if err := serialization.Unmarshal(copiedResponseBody, response); err != nil {
 		// Check if it's an HTML response
 		if providerUtils.IsHTMLResponse(resp, copiedResponseBody) {
 			return nil, &schemas.BifrostError{
@@ -2022,7 +2010,7 @@ func HandleOpenAITranscriptionRequest(
 	// Parse raw response for RawResponse field
 	var rawResponse interface{}
 	if sendBackRawResponse {
-		if err := sonic.Unmarshal(copiedResponseBody, &rawResponse); err != nil {
+		if err := serialization.Unmarshal(copiedResponseBody, &rawResponse); err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRawResponseUnmarshal, err, providerName)
 		}
 	}
@@ -2043,7 +2031,12 @@ func HandleOpenAITranscriptionRequest(
 
 // TranscriptionStream performs a streaming transcription request to the OpenAI API.
 func (provider *OpenAIProvider) TranscriptionStream(ctx context.Context, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStream, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.TranscriptionStreamRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.TranscriptionStreamRequest); err != nil {
+		return nil, err
+	}
+
+	// The translations endpoint does not stream
+	if err := providerUtils.CheckTranslationSupported(provider.GetProviderKey(), schemas.TranscriptionStreamRequest, request); err != nil {
 		return nil, err
 	}
 
@@ -2055,16 +2048,16 @@ func (provider *OpenAIProvider) TranscriptionStream(ctx context.Context, postHoo
 	return HandleOpenAITranscriptionStreamRequest(
 		ctx,
 		provider.client,
-		provider.buildRequestURL(ctx, "/v1/audio/transcriptions", schemas.TranscriptionStreamRequest),
+		provider.BuildRequestURL(ctx, "/v1/audio/transcriptions", schemas.TranscriptionStreamRequest),
 		request,
 		authHeader,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.NetworkConfig().ExtraHeaders,
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 		provider.GetProviderKey(),
 		postHookRunner,
 		nil,
 		nil,
-		provider.logger,
+		provider.Logger(),
 	)
 }
 
@@ -2209,7 +2202,7 @@ func HandleOpenAITranscriptionStreamRequest(
 
 			// First, check if this is an error response
 			var bifrostErr schemas.BifrostError
-			if err := sonic.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &bifrostErr); err == nil {
 				if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
 					bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
 						Provider:       providerName,
@@ -2223,7 +2216,7 @@ func HandleOpenAITranscriptionStreamRequest(
 			}
 
 			var response schemas.BifrostTranscriptionStreamResponse
-			if err := sonic.Unmarshal([]byte(jsonData), &response); err != nil {
+			if err := serialization.Unmarshal([]byte(jsonData), &response); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to parse stream response: %v", err))
 				continue
 			}
@@ -2273,13 +2266,13 @@ func HandleOpenAITranscriptionStreamRequest(
 
 // FileUpload uploads a file to OpenAI.
 func (provider *OpenAIProvider) FileUpload(ctx context.Context, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FileUploadRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.FileUploadRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
 
-	if len(request.File) == 0 {
+	if !providerUtils.HasFileUploadContent(request) {
 		return nil, providerUtils.NewBifrostOperationError("file content is required", nil, providerName)
 	}
 
@@ -2287,31 +2280,10 @@ func (provider *OpenAIProvider) FileUpload(ctx context.Context, key schemas.Key,
 		return nil, providerUtils.NewBifrostOperationError("purpose is required", nil, providerName)
 	}
 
-	// Create multipart form data
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
-
-	// Add purpose field
-	if err := writer.WriteField("purpose", string(request.Purpose)); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to write purpose field", err, providerName)
-	}
-
-	// Add file field
 	filename := request.Filename
 	if filename == "" {
 		filename = "file.jsonl"
 	}
-	part, err := writer.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to create form file", err, providerName)
-	}
-	if _, err := part.Write(request.File); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to write file content", err, providerName)
-	}
-
-	if err := writer.Close(); err != nil {
-		return nil, providerUtils.NewBifrostOperationError("failed to close multipart writer", err, providerName)
-	}
 
 	// Create request
 	req := fasthttp.AcquireRequest()
@@ -2320,16 +2292,18 @@ func (provider *OpenAIProvider) FileUpload(ctx context.Context, key schemas.Key,
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.buildRequestURL(ctx, "/v1/files", schemas.FileUploadRequest))
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+	req.SetRequestURI(provider.BuildRequestURL(ctx, "/v1/files", schemas.FileUploadRequest))
 	req.Header.SetMethod(http.MethodPost)
-	req.Header.SetContentType(writer.FormDataContentType())
 
 	if key.Value != "" {
 		req.Header.Set("Authorization", "Bearer "+key.Value)
 	}
 
-	req.SetBody(buf.Bytes())
+	// Set the multipart form with the purpose and the file, streamed if it is read from a reader
+	if err := providerUtils.SetMultipartFileUploadBody(req, request, filename, providerUtils.MultipartField{Name: "purpose", Value: string(request.Purpose)}); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to create multipart form data", err, providerName)
+	}
 
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
@@ -2339,7 +2313,7 @@ func (provider *OpenAIProvider) FileUpload(ctx context.Context, key schemas.Key,
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, ParseOpenAIError(resp, schemas.FileUploadRequest, providerName, "")
 	}
 
@@ -2349,9 +2323,9 @@ func (provider *OpenAIProvider) FileUpload(ctx context.Context, key schemas.Key,
 	}
 
 	var openAIResp OpenAIFileResponse
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -2362,16 +2336,16 @@ func (provider *OpenAIProvider) FileUpload(ctx context.Context, key schemas.Key,
 // FileList lists files using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *OpenAIProvider) FileList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FileListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.FileListRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
 
 	// Initialize serial pagination helper
-	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -2398,7 +2372,7 @@ func (provider *OpenAIProvider) FileList(ctx context.Context, keys []schemas.Key
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL with query params
-	requestURL := provider.buildRequestURL(ctx, "/v1/files", schemas.FileListRequest)
+	requestURL := provider.BuildRequestURL(ctx, "/v1/files", schemas.FileListRequest)
 	values := url.Values{}
 	if request.Purpose != "" {
 		values.Set("purpose", string(request.Purpose))
@@ -2418,7 +2392,7 @@ func (provider *OpenAIProvider) FileList(ctx context.Context, keys []schemas.Key
 	}
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -2435,7 +2409,7 @@ func (provider *OpenAIProvider) FileList(ctx context.Context, keys []schemas.Key
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 		return nil, ParseOpenAIError(resp, schemas.FileListRequest, providerName, "")
 	}
 
@@ -2445,7 +2419,7 @@ func (provider *OpenAIProvider) FileList(ctx context.Context, keys []schemas.Key
 	}
 
 	var openAIResp OpenAIFileListResponse
-	_, _, bifrostErr = providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	_, _, bifrostErr = providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -2491,7 +2465,7 @@ func (provider *OpenAIProvider) FileList(ctx context.Context, keys []schemas.Key
 
 // FileRetrieve retrieves file metadata from OpenAI by trying each key until found.
 func (provider *OpenAIProvider) FileRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FileRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.FileRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -2501,8 +2475,8 @@ func (provider *OpenAIProvider) FileRetrieve(ctx context.Context, keys []schemas
 		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -2511,8 +2485,8 @@ func (provider *OpenAIProvider) FileRetrieve(ctx context.Context, keys []schemas
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/files/" + request.FileID)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/files/" + request.FileID)
 		req.Header.SetMethod(http.MethodGet)
 		req.Header.SetContentType("application/json")
 
@@ -2531,7 +2505,7 @@ func (provider *OpenAIProvider) FileRetrieve(ctx context.Context, keys []schemas
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseOpenAIError(resp, schemas.FileRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2547,7 +2521,7 @@ func (provider *OpenAIProvider) FileRetrieve(ctx context.Context, keys []schemas
 		}
 
 		var openAIResp OpenAIFileResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2566,7 +2540,7 @@ func (provider *OpenAIProvider) FileRetrieve(ctx context.Context, keys []schemas
 
 // FileDelete deletes a file from OpenAI by trying each key until successful.
 func (provider *OpenAIProvider) FileDelete(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FileDeleteRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.FileDeleteRequest); err != nil {
 		return nil, err
 	}
 
@@ -2576,8 +2550,8 @@ func (provider *OpenAIProvider) FileDelete(ctx context.Context, keys []schemas.K
 		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
 	}
 
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -2586,8 +2560,8 @@ func (provider *OpenAIProvider) FileDelete(ctx context.Context, keys []schemas.K
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/files/" + request.FileID)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/files/" + request.FileID)
 		req.Header.SetMethod(http.MethodDelete)
 		req.Header.SetContentType("application/json")
 
@@ -2606,7 +2580,7 @@ func (provider *OpenAIProvider) FileDelete(ctx context.Context, keys []schemas.K
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseOpenAIError(resp, schemas.FileDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2622,7 +2596,7 @@ func (provider *OpenAIProvider) FileDelete(ctx context.Context, keys []schemas.K
 		}
 
 		var openAIResp OpenAIFileDeleteResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2660,7 +2634,7 @@ func (provider *OpenAIProvider) FileDelete(ctx context.Context, keys []schemas.K
 
 // FileContent downloads file content from OpenAI by trying each key until found.
 func (provider *OpenAIProvider) FileContent(ctx context.Context, keys []schemas.Key, request *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FileContentRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.FileContentRequest); err != nil {
 		return nil, err
 	}
 
@@ -2677,8 +2651,8 @@ func (provider *OpenAIProvider) FileContent(ctx context.Context, keys []schemas.
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/files/" + request.FileID + "/content")
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/files/" + request.FileID + "/content")
 		req.Header.SetMethod(http.MethodGet)
 
 		if key.Value != "" {
@@ -2696,7 +2670,7 @@ func (provider *OpenAIProvider) FileContent(ctx context.Context, keys []schemas.
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+			provider.Logger().Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
 			lastErr = ParseOpenAIError(resp, schemas.FileContentRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -2738,7 +2712,7 @@ func (provider *OpenAIProvider) FileContent(ctx context.Context, keys []schemas.
 
 // BatchCreate creates a new batch job.
 func (provider *OpenAIProvider) BatchCreate(ctx context.Context, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.BatchCreateRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.BatchCreateRequest); err != nil {
 		return nil, err
 	}
 
@@ -2768,50 +2742,15 @@ func (provider *OpenAIProvider) BatchCreate(ctx context.Context, key schemas.Key
 		inputFileID = uploadResp.ID
 	}
 
-	// Validate that we have a file ID (either provided or uploaded)
-	if inputFileID == "" {
-		return nil, providerUtils.NewBifrostOperationError("either input_file_id or requests array is required for OpenAI batch API", nil, providerName)
-	}
-
-	// Validate that we have an endpoint
-	if request.Endpoint == "" {
-		return nil, providerUtils.NewBifrostOperationError("endpoint is required for OpenAI batch API", nil, providerName)
-	}
-
 	// Create request
 	req := fasthttp.AcquireRequest()
 	resp := fasthttp.AcquireResponse()
 	defer fasthttp.ReleaseRequest(req)
 	defer fasthttp.ReleaseResponse(resp)
 
-	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.buildRequestURL(ctx, "/v1/batches", schemas.BatchCreateRequest))
-	req.Header.SetMethod(http.MethodPost)
-	req.Header.SetContentType("application/json")
-
-	if key.Value != "" {
-		req.Header.Set("Authorization", "Bearer "+key.Value)
-	}
-
-	// Build request body
-	openAIReq := &OpenAIBatchRequest{
-		InputFileID:      inputFileID,
-		Endpoint:         string(request.Endpoint),
-		CompletionWindow: request.CompletionWindow,
-		Metadata:         request.Metadata,
-	}
-
-	// Set default completion window if not provided
-	if openAIReq.CompletionWindow == "" {
-		openAIReq.CompletionWindow = "24h"
-	}
-
-	jsonData, err := sonic.Marshal(openAIReq)
-	if err != nil {
-		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	if bifrostErr := provider.prepareBatchCreateRequest(ctx, req, key, request, inputFileID); bifrostErr != nil {
+		return nil, bifrostErr
 	}
-	req.SetBody(jsonData)
 
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
@@ -2830,9 +2769,9 @@ func (provider *OpenAIProvider) BatchCreate(ctx context.Context, key schemas.Key
 	}
 
 	var openAIResp OpenAIBatchResponse
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
 		return nil, bifrostErr
 	}
@@ -2840,19 +2779,94 @@ func (provider *OpenAIProvider) BatchCreate(ctx context.Context, key schemas.Key
 	return openAIResp.ToBifrostBatchCreateResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
 }
 
+// RenderBatchCreate renders the batch create request BatchCreate would send, without sending it.
+// Inline requests are not uploaded, the rendered request refers to their input file with a placeholder ID.
+func (provider *OpenAIProvider) RenderBatchCreate(ctx context.Context, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BatchRenderedRequest, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.BatchCreateRequest); err != nil {
+		return nil, err
+	}
+
+	inputFileID := request.InputFileID
+	if inputFileID == "" && len(request.Requests) > 0 {
+		if _, err := ConvertRequestsToJSONL(request.Requests); err != nil {
+			return nil, providerUtils.NewBifrostOperationError("failed to convert requests to JSONL", err, provider.GetProviderKey())
+		}
+		inputFileID = providerUtils.RenderedInputFileID
+	}
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	if bifrostErr := provider.prepareBatchCreateRequest(ctx, req, key, request, inputFileID); bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	return providerUtils.RenderBatchRequest(req), nil
+}
+
+// prepareBatchCreateRequest validates a batch create request and sets the URL, headers and body of the HTTP
+// request creating the batch from the input file.
+func (provider *OpenAIProvider) prepareBatchCreateRequest(ctx context.Context, req *fasthttp.Request, key schemas.Key, request *schemas.BifrostBatchCreateRequest, inputFileID string) *schemas.BifrostError {
+	providerName := provider.GetProviderKey()
+
+	// Validate that we have a file ID (either provided or uploaded)
+	if inputFileID == "" {
+		return providerUtils.NewBifrostOperationError("either input_file_id or requests array is required for OpenAI batch API", nil, providerName)
+	}
+
+	// Validate that we have an endpoint, and normalize it to the OpenAI endpoint
+	if request.Endpoint == "" {
+		return providerUtils.NewBifrostOperationError("endpoint is required for OpenAI batch API", nil, providerName)
+	}
+	endpoint, err := providerUtils.NormalizeBatchEndpoint(schemas.OpenAI, request.Endpoint)
+	if err != nil {
+		return providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
+
+	// Set headers
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+	req.SetRequestURI(provider.BuildRequestURL(ctx, "/v1/batches", schemas.BatchCreateRequest))
+	req.Header.SetMethod(http.MethodPost)
+	req.Header.SetContentType("application/json")
+
+	if key.Value != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value)
+	}
+
+	// Build request body
+	openAIReq := &OpenAIBatchRequest{
+		InputFileID:      inputFileID,
+		Endpoint:         string(endpoint),
+		CompletionWindow: request.CompletionWindow,
+		Metadata:         request.Metadata,
+	}
+
+	// Set default completion window if not provided
+	if openAIReq.CompletionWindow == "" {
+		openAIReq.CompletionWindow = "24h"
+	}
+
+	jsonData, err := serialization.Marshal(openAIReq)
+	if err != nil {
+		return providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	}
+	req.SetBody(jsonData)
+	return nil
+}
+
 // BatchList lists batch jobs using serial pagination across keys.
 // Exhausts all pages from one key before moving to the next.
 func (provider *OpenAIProvider) BatchList(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.BatchListRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.BatchListRequest); err != nil {
 		return nil, err
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	if request.AllKeys {
+		return providerUtils.ListBatchesAcrossKeys(ctx, providerName, keys, request.After, request.Limit, provider.listBatchPage)
+	}
 
 	// Initialize serial pagination helper
-	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.Logger())
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
 	}
@@ -2872,6 +2886,45 @@ func (provider *OpenAIProvider) BatchList(ctx context.Context, keys []schemas.Ke
 		}, nil
 	}
 
+	// Use native cursor from serial helper instead of request.After
+	batches, keyHasMore, latency, bifrostErr := provider.listBatchPage(ctx, key, nativeCursor, request.Limit)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	var lastBatchID string
+	if len(batches) > 0 {
+		lastBatchID = batches[len(batches)-1].ID
+	}
+
+	// Build cursor for next request
+	// OpenAI uses LastID as the cursor for pagination
+	nextCursor, hasMore := helper.BuildNextCursor(keyHasMore, lastBatchID)
+
+	// Convert to Bifrost response
+	bifrostResp := &schemas.BifrostBatchListResponse{
+		Object:  "list",
+		Data:    batches,
+		HasMore: hasMore,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.BatchListRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}
+	if nextCursor != "" {
+		bifrostResp.NextCursor = &nextCursor
+	}
+
+	return bifrostResp, nil
+}
+
+// listBatchPage lists a page of the batches of a key, after the batch with ID after (from the start if empty).
+// Returns the batches, whether the key has more, and the request latency.
+func (provider *OpenAIProvider) listBatchPage(ctx context.Context, key schemas.Key, after string, limit int) ([]schemas.BifrostBatchRetrieveResponse, bool, time.Duration, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
+
 	// Create request
 	req := fasthttp.AcquireRequest()
 	resp := fasthttp.AcquireResponse()
@@ -2879,14 +2932,13 @@ func (provider *OpenAIProvider) BatchList(ctx context.Context, keys []schemas.Ke
 	defer fasthttp.ReleaseResponse(resp)
 
 	// Build URL with query params
-	baseURL := provider.buildRequestURL(ctx, "/v1/batches", schemas.BatchListRequest)
+	baseURL := provider.BuildRequestURL(ctx, "/v1/batches", schemas.BatchListRequest)
 	values := url.Values{}
-	if request.Limit > 0 {
-		values.Set("limit", fmt.Sprintf("%d", request.Limit))
+	if limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", limit))
 	}
-	// Use native cursor from serial helper instead of request.After
-	if nativeCursor != "" {
-		values.Set("after", nativeCursor)
+	if after != "" {
+		values.Set("after", after)
 	}
 	requestURL := baseURL
 	if encodedValues := values.Encode(); encodedValues != "" {
@@ -2894,7 +2946,7 @@ func (provider *OpenAIProvider) BatchList(ctx context.Context, keys []schemas.Ke
 	}
 
 	// Set headers
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 	req.SetRequestURI(requestURL)
 	req.Header.SetMethod(http.MethodGet)
 	req.Header.SetContentType("application/json")
@@ -2906,58 +2958,36 @@ func (provider *OpenAIProvider) BatchList(ctx context.Context, keys []schemas.Ke
 	// Make request
 	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
 	if bifrostErr != nil {
-		return nil, bifrostErr
+		return nil, false, 0, bifrostErr
 	}
 
 	// Handle error response
 	if resp.StatusCode() != fasthttp.StatusOK {
-		return nil, ParseOpenAIError(resp, schemas.BatchListRequest, providerName, "")
+		return nil, false, 0, ParseOpenAIError(resp, schemas.BatchListRequest, providerName, "")
 	}
 
 	body, decodeErr := providerUtils.CheckAndDecodeBody(resp)
 	if decodeErr != nil {
-		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, decodeErr, providerName)
+		return nil, false, 0, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, decodeErr, providerName)
 	}
 
 	var openAIResp OpenAIBatchListResponse
-	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 	if bifrostErr != nil {
-		return nil, bifrostErr
+		return nil, false, 0, bifrostErr
 	}
 
 	// Convert batches to Bifrost format
 	batches := make([]schemas.BifrostBatchRetrieveResponse, 0, len(openAIResp.Data))
-	var lastBatchID string
 	for _, batch := range openAIResp.Data {
 		batches = append(batches, *batch.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse))
-		lastBatchID = batch.ID
 	}
-
-	// Build cursor for next request
-	// OpenAI uses LastID as the cursor for pagination
-	nextCursor, hasMore := helper.BuildNextCursor(openAIResp.HasMore, lastBatchID)
-
-	// Convert to Bifrost response
-	bifrostResp := &schemas.BifrostBatchListResponse{
-		Object:  "list",
-		Data:    batches,
-		HasMore: hasMore,
-		ExtraFields: schemas.BifrostResponseExtraFields{
-			RequestType: schemas.BatchListRequest,
-			Provider:    providerName,
-			Latency:     latency.Milliseconds(),
-		},
-	}
-	if nextCursor != "" {
-		bifrostResp.NextCursor = &nextCursor
-	}
-
-	return bifrostResp, nil
+	return batches, openAIResp.HasMore, latency, nil
 }
 
 // BatchRetrieve retrieves a specific batch job by trying each key until found.
 func (provider *OpenAIProvider) BatchRetrieve(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.BatchRetrieveRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.BatchRetrieveRequest); err != nil {
 		return nil, err
 	}
 
@@ -2966,8 +2996,8 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx context.Context, keys []schema
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -2976,8 +3006,8 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx context.Context, keys []schema
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/batches/" + request.BatchID)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/batches/" + request.BatchID)
 		req.Header.SetMethod(http.MethodGet)
 		req.Header.SetContentType("application/json")
 
@@ -3011,7 +3041,7 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx context.Context, keys []schema
 		}
 
 		var openAIResp OpenAIBatchResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -3032,7 +3062,7 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx context.Context, keys []schema
 
 // BatchCancel cancels a batch job by trying each key until successful.
 func (provider *OpenAIProvider) BatchCancel(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.BatchCancelRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.BatchCancelRequest); err != nil {
 		return nil, err
 	}
 
@@ -3041,8 +3071,8 @@ func (provider *OpenAIProvider) BatchCancel(ctx context.Context, keys []schemas.
 	}
 
 	providerName := provider.GetProviderKey()
-	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
-	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest())
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse())
 
 	var lastErr *schemas.BifrostError
 	for _, key := range keys {
@@ -3051,8 +3081,8 @@ func (provider *OpenAIProvider) BatchCancel(ctx context.Context, keys []schemas.
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/batches/" + request.BatchID + "/cancel")
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/batches/" + request.BatchID + "/cancel")
 		req.Header.SetMethod(http.MethodPost)
 		req.Header.SetContentType("application/json")
 
@@ -3086,7 +3116,7 @@ func (provider *OpenAIProvider) BatchCancel(ctx context.Context, keys []schemas.
 		}
 
 		var openAIResp OpenAIBatchResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
@@ -3136,7 +3166,7 @@ func (provider *OpenAIProvider) BatchCancel(ctx context.Context, keys []schemas.
 // Note: For OpenAI, batch results are obtained by downloading the output_file_id.
 // This method returns the file content parsed as batch results.
 func (provider *OpenAIProvider) BatchResults(ctx context.Context, keys []schemas.Key, request *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.BatchResultsRequest); err != nil {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.CustomProviderConfig(), schemas.BatchResultsRequest); err != nil {
 		return nil, err
 	}
 
@@ -3166,8 +3196,8 @@ func (provider *OpenAIProvider) BatchResults(ctx context.Context, keys []schemas
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/files/" + *batchResp.OutputFileID + "/content")
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
+		req.SetRequestURI(provider.NetworkConfig().BaseURL + "/v1/files/" + *batchResp.OutputFileID + "/content")
 		req.Header.SetMethod(http.MethodGet)
 
 		if key.Value != "" {
@@ -3205,15 +3235,18 @@ func (provider *OpenAIProvider) BatchResults(ctx context.Context, keys []schemas
 		// Parse JSONL content - each line is a separate result
 		var results []schemas.BatchResultItem
 
-		parseResult := providerUtils.ParseJSONL(body, func(line []byte) error {
+		parseResult, nextCursor, err := providerUtils.ParseJSONLPage(body, request.After, request.Limit, func(line []byte) error {
 			var resultItem schemas.BatchResultItem
-			if err := sonic.Unmarshal(line, &resultItem); err != nil {
-				provider.logger.Warn("failed to parse batch result line: %v", err)
+			if err := serialization.Unmarshal(line, &resultItem); err != nil {
+				provider.Logger().Warn("failed to parse batch result line: %v", err)
 				return err
 			}
 			results = append(results, resultItem)
 			return nil
 		})
+		if err != nil {
+			return nil, providerUtils.NewBifrostOperationError("invalid batch results cursor", err, providerName)
+		}
 
 		batchResultsResp := &schemas.BifrostBatchResultsResponse{
 			BatchID: request.BatchID,
@@ -3225,6 +3258,11 @@ func (provider *OpenAIProvider) BatchResults(ctx context.Context, keys []schemas
 			},
 		}
 
+		if nextCursor != nil {
+			batchResultsResp.HasMore = true
+			batchResultsResp.NextCursor = nextCursor
+		}
+
 		if len(parseResult.Errors) > 0 {
 			batchResultsResp.ExtraFields.ParseErrors = parseResult.Errors
 		}
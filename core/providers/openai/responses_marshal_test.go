@@ -5,8 +5,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 func TestOpenAIResponsesRequest_MarshalJSON_ReasoningMaxTokensAbsent(t *testing.T) {
@@ -90,7 +90,7 @@ func TestOpenAIResponsesRequest_MarshalJSON_ReasoningMaxTokensAbsent(t *testing.
 
 			// Parse the JSON to check structure
 			var jsonMap map[string]interface{}
-			if err := sonic.Unmarshal(jsonBytes, &jsonMap); err != nil {
+			if err := serialization.Unmarshal(jsonBytes, &jsonMap); err != nil {
 				t.Fatalf("Failed to unmarshal marshaled JSON: %v", err)
 			}
 
@@ -179,7 +179,7 @@ func TestOpenAIResponsesRequest_MarshalJSON_InputStringForm(t *testing.T) {
 
 			// Parse the JSON to check input field
 			var jsonMap map[string]interface{}
-			if err := sonic.Unmarshal(jsonBytes, &jsonMap); err != nil {
+			if err := serialization.Unmarshal(jsonBytes, &jsonMap); err != nil {
 				t.Fatalf("Failed to unmarshal marshaled JSON: %v", err)
 			}
 
@@ -296,7 +296,7 @@ func TestOpenAIResponsesRequest_MarshalJSON_InputArrayForm(t *testing.T) {
 
 			// Parse the JSON to check input field
 			var jsonMap map[string]interface{}
-			if err := sonic.Unmarshal(jsonBytes, &jsonMap); err != nil {
+			if err := serialization.Unmarshal(jsonBytes, &jsonMap); err != nil {
 				t.Fatalf("Failed to unmarshal marshaled JSON: %v", err)
 			}
 
@@ -340,7 +340,7 @@ func TestOpenAIResponsesRequest_MarshalJSON_FieldShadowingBehavior(t *testing.T)
 		}
 
 		var jsonMap map[string]interface{}
-		if err := sonic.Unmarshal(jsonBytes, &jsonMap); err != nil {
+		if err := serialization.Unmarshal(jsonBytes, &jsonMap); err != nil {
 			t.Fatalf("Failed to unmarshal marshaled JSON: %v", err)
 		}
 
@@ -441,7 +441,7 @@ func TestOpenAIResponsesRequest_MarshalJSON_RoundTrip(t *testing.T) {
 
 		// Unmarshal back
 		var unmarshaled OpenAIResponsesRequest
-		if err := sonic.Unmarshal(jsonBytes, &unmarshaled); err != nil {
+		if err := serialization.Unmarshal(jsonBytes, &unmarshaled); err != nil {
 			t.Fatalf("Failed to unmarshal: %v", err)
 		}
 
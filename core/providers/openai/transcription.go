@@ -59,8 +59,8 @@ func parseTranscriptionFormDataBodyFromRequest(writer *multipart.Writer, openaiR
 		return providerUtils.NewBifrostOperationError("failed to write model field", err, providerName)
 	}
 
-	// Add optional fields
-	if openaiReq.Language != nil {
+	// Add optional fields, the translations endpoint detects the audio language
+	if openaiReq.Language != nil && !openaiReq.IsTranslation() {
 		if err := writer.WriteField("language", *openaiReq.Language); err != nil {
 			return providerUtils.NewBifrostOperationError("failed to write language field", err, providerName)
 		}
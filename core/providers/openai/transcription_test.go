@@ -0,0 +1,60 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that translations are sent to the translations endpoint without the language hint transcriptions carry
+func TestTranscription_LanguageAndTranslation(t *testing.T) {
+	type received struct {
+		path     string
+		language string
+	}
+	requests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("failed to parse multipart form: %v", err)
+		}
+		requests <- received{path: r.URL.Path, language: r.FormValue("language")}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"text":"Hello there."}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, nil)
+
+	tests := []struct {
+		name     string
+		params   *schemas.TranscriptionParameters
+		expected received
+	}{
+		{"Transcription", &schemas.TranscriptionParameters{Language: schemas.Ptr("fr")}, received{path: "/v1/audio/transcriptions", language: "fr"}},
+		{"Translation", &schemas.TranscriptionParameters{Language: schemas.Ptr("fr"), Translate: schemas.Ptr(true)}, received{path: "/v1/audio/translations"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, bifrostErr := provider.Transcription(context.Background(), schemas.Key{Value: "sk-test"}, &schemas.BifrostTranscriptionRequest{
+				Provider: schemas.OpenAI,
+				Model:    "whisper-1",
+				Input:    &schemas.TranscriptionInput{File: []byte("audio")},
+				Params:   tt.params,
+			})
+			if bifrostErr != nil {
+				t.Fatalf("unexpected error: %v", bifrostErr.Error.Message)
+			}
+			if response.Text != "Hello there." {
+				t.Errorf("unexpected text %q", response.Text)
+			}
+			if got := <-requests; got != tt.expected {
+				t.Errorf("expected request %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 const (
@@ -186,7 +186,7 @@ func (r *OpenAIChatRequest) MarshalJSON() ([]byte, error) {
 		aux.ReasoningEffort = r.Reasoning.Effort
 	}
 
-	return sonic.Marshal(aux)
+	return serialization.Marshal(aux)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for OpenAIChatRequest.
@@ -203,7 +203,7 @@ func (r *OpenAIChatRequest) UnmarshalJSON(data []byte) error {
 		Fallbacks []string        `json:"fallbacks,omitempty"`
 	}
 	var base baseFields
-	if err := sonic.Unmarshal(data, &base); err != nil {
+	if err := serialization.Unmarshal(data, &base); err != nil {
 		return err
 	}
 	r.Model = base.Model
@@ -214,7 +214,7 @@ func (r *OpenAIChatRequest) UnmarshalJSON(data []byte) error {
 
 	// Unmarshal ChatParameters (which has its own custom unmarshaller)
 	var params schemas.ChatParameters
-	if err := sonic.Unmarshal(data, &params); err != nil {
+	if err := serialization.Unmarshal(data, &params); err != nil {
 		return err
 	}
 	r.ChatParameters = params
@@ -236,13 +236,13 @@ type OpenAIResponsesRequestInput struct {
 // UnmarshalJSON unmarshals the responses request input
 func (r *OpenAIResponsesRequestInput) UnmarshalJSON(data []byte) error {
 	var str string
-	if err := sonic.Unmarshal(data, &str); err == nil {
+	if err := serialization.Unmarshal(data, &str); err == nil {
 		r.OpenAIResponsesRequestInputStr = &str
 		r.OpenAIResponsesRequestInputArray = nil
 		return nil
 	}
 	var array []schemas.ResponsesMessage
-	if err := sonic.Unmarshal(data, &array); err == nil {
+	if err := serialization.Unmarshal(data, &array); err == nil {
 		r.OpenAIResponsesRequestInputStr = nil
 		r.OpenAIResponsesRequestInputArray = array
 		return nil
@@ -252,7 +252,7 @@ func (r *OpenAIResponsesRequestInput) UnmarshalJSON(data []byte) error {
 
 func (r *OpenAIResponsesRequestInput) MarshalJSON() ([]byte, error) {
 	if r.OpenAIResponsesRequestInputStr != nil {
-		return sonic.Marshal(*r.OpenAIResponsesRequestInputStr)
+		return serialization.Marshal(*r.OpenAIResponsesRequestInputStr)
 	}
 	if r.OpenAIResponsesRequestInputArray != nil {
 		// First pass: check if we need to modify anything
@@ -266,7 +266,7 @@ func (r *OpenAIResponsesRequestInput) MarshalJSON() ([]byte, error) {
 
 		// If no CacheControl found anywhere, marshal as-is
 		if !needsCopy {
-			return sonic.Marshal(r.OpenAIResponsesRequestInputArray)
+			return serialization.Marshal(r.OpenAIResponsesRequestInputArray)
 		}
 
 		// Only copy messages that have CacheControl
@@ -331,9 +331,9 @@ func (r *OpenAIResponsesRequestInput) MarshalJSON() ([]byte, error) {
 				}
 			}
 		}
-		return sonic.Marshal(messagesCopy)
+		return serialization.Marshal(messagesCopy)
 	}
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // Helper function to check if a chat message has any CacheControl fields
@@ -449,7 +449,7 @@ func (r *OpenAIResponsesRequest) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	return sonic.Marshal(aux)
+	return serialization.Marshal(aux)
 }
 
 // IsStreamingRequested implements the StreamingRequest interface
@@ -508,3 +508,26 @@ type OpenAIListModelsResponse struct {
 	Object string        `json:"object"`
 	Data   []OpenAIModel `json:"data"`
 }
+
+// ERROR TYPES
+
+// OpenAIStreamError is the error event sent on chat, text completion and audio streams (as `data: {"error": {...}}`)
+type OpenAIStreamError struct {
+	Error OpenAIStreamErrorField `json:"error"`
+}
+
+type OpenAIStreamErrorField struct {
+	Message string      `json:"message"`
+	Type    string      `json:"type"`
+	Param   interface{} `json:"param"`
+	Code    *string     `json:"code"`
+}
+
+// OpenAIResponsesStreamError is the `error` event sent on responses API streams
+type OpenAIResponsesStreamError struct {
+	Type           string      `json:"type"` // always "error"
+	Code           *string     `json:"code"`
+	Message        string      `json:"message"`
+	Param          interface{} `json:"param"`
+	SequenceNumber int         `json:"sequence_number"`
+}
@@ -3,8 +3,8 @@ package openai
 import (
 	"testing"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 func TestOpenAIChatRequest_UnmarshalJSON_BaseFieldsPreserved(t *testing.T) {
@@ -192,7 +192,7 @@ func TestOpenAIChatRequest_UnmarshalJSON_BaseFieldsPreserved(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var req OpenAIChatRequest
-			if err := sonic.Unmarshal([]byte(tt.jsonPayload), &req); err != nil {
+			if err := serialization.Unmarshal([]byte(tt.jsonPayload), &req); err != nil {
 				t.Fatalf("Failed to unmarshal JSON: %v", err)
 			}
 
@@ -312,7 +312,7 @@ func TestOpenAIChatRequest_UnmarshalJSON_ChatParametersCustomLogic(t *testing.T)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var req OpenAIChatRequest
-			err := sonic.Unmarshal([]byte(tt.jsonPayload), &req)
+			err := serialization.Unmarshal([]byte(tt.jsonPayload), &req)
 
 			if tt.expectError {
 				if err == nil {
@@ -349,7 +349,7 @@ func TestOpenAIChatRequest_UnmarshalJSON_PresenceAssertions(t *testing.T) {
 	}`
 
 	var req OpenAIChatRequest
-	if err := sonic.Unmarshal([]byte(jsonPayload), &req); err != nil {
+	if err := serialization.Unmarshal([]byte(jsonPayload), &req); err != nil {
 		t.Fatalf("Failed to unmarshal JSON: %v", err)
 	}
 
@@ -412,7 +412,7 @@ func TestOpenAIChatRequest_UnmarshalJSON_ValueAssertions(t *testing.T) {
 	}`
 
 	var req OpenAIChatRequest
-	if err := sonic.Unmarshal([]byte(jsonPayload), &req); err != nil {
+	if err := serialization.Unmarshal([]byte(jsonPayload), &req); err != nil {
 		t.Fatalf("Failed to unmarshal JSON: %v", err)
 	}
 
@@ -460,4 +460,3 @@ func TestOpenAIChatRequest_UnmarshalJSON_ValueAssertions(t *testing.T) {
 		t.Errorf("Expected Stop value ['END', 'STOP'], got %v", req.Stop)
 	}
 }
-
@@ -44,6 +44,42 @@ func ConvertBifrostMessagesToOpenAIMessages(messages []schemas.ChatMessage) []Op
 	return openaiMessages
 }
 
+// attachFileReferences adds the attached files as file content blocks referencing their file id to the last user message,
+// or to a new user message if there is none. The message content is copied, the original request is left untouched.
+func attachFileReferences(messages []OpenAIMessage, attachments []schemas.FileReference) []OpenAIMessage {
+	index := -1
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == schemas.ChatMessageRoleUser {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		messages = append(messages, OpenAIMessage{Role: schemas.ChatMessageRoleUser})
+		index = len(messages) - 1
+	}
+
+	var blocks []schemas.ChatContentBlock
+	if content := messages[index].Content; content != nil {
+		if content.ContentStr != nil {
+			blocks = append(blocks, schemas.ChatContentBlock{Type: schemas.ChatContentBlockTypeText, Text: content.ContentStr})
+		} else {
+			blocks = append(blocks, content.ContentBlocks...)
+		}
+	}
+	for _, attachment := range attachments {
+		blocks = append(blocks, schemas.ChatContentBlock{
+			Type: schemas.ChatContentBlockTypeFile,
+			File: &schemas.ChatInputFile{
+				FileID:   schemas.Ptr(attachment.FileID),
+				Filename: attachment.Filename,
+			},
+		})
+	}
+	messages[index].Content = &schemas.ChatMessageContent{ContentBlocks: blocks}
+	return messages
+}
+
 // OpenAI enforces a 64 character maximum on the user field
 const MaxUserFieldLength = 64
 
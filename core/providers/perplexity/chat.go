@@ -186,8 +186,10 @@ func (response *PerplexityChatResponse) ToBifrostChatResponse(model string) *sch
 			RequestType: schemas.ChatCompletionRequest,
 			Provider:    schemas.Perplexity,
 		},
-		SearchResults: response.SearchResults,
-		Videos:        response.Videos,
+		SearchResults:   response.SearchResults,
+		Videos:          response.Videos,
+		Citations:       response.Citations,
+		SourceCitations: convertPerplexityCitations(response),
 	}
 
 	// Map all response fields
@@ -235,3 +237,22 @@ func (response *PerplexityChatResponse) ToBifrostChatResponse(model string) *sch
 
 	return bifrostResponse
 }
+
+// convertPerplexityCitations converts the sources of a Perplexity response to Bifrost citations, from its search
+// results when present as they carry titles, otherwise from its citation URLs.
+func convertPerplexityCitations(response *PerplexityChatResponse) []schemas.Citation {
+	var citations []schemas.Citation
+	if len(response.SearchResults) > 0 {
+		for _, result := range response.SearchResults {
+			citations = append(citations, schemas.Citation{
+				URI:   result.URL,
+				Title: result.Title,
+			})
+		}
+		return citations
+	}
+	for _, uri := range response.Citations {
+		citations = append(citations, schemas.Citation{URI: uri})
+	}
+	return citations
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// DefaultAggregateListLimit is the page size of batch lists across all keys when the request sets no limit.
+const DefaultAggregateListLimit = 20
+
+// BatchListPageFunc lists a page of at most limit batches of a key, newest first, after the batch with ID after
+// (from the start if empty). It returns the batches, whether the key has more, and the request latency.
+type BatchListPageFunc func(ctx context.Context, key schemas.Key, after string, limit int) ([]schemas.BifrostBatchRetrieveResponse, bool, time.Duration, *schemas.BifrostError)
+
+// batchListKeyPage is the page of batches fetched for a key, and how far it was merged.
+type batchListKeyPage struct {
+	batches []schemas.BifrostBatchRetrieveResponse
+	pos     int
+	hasMore bool
+	latency time.Duration
+	err     *schemas.BifrostError
+}
+
+// head returns the next batch of the page to merge, or nil if the fetched batches are all merged.
+func (p *batchListKeyPage) head() *schemas.BifrostBatchRetrieveResponse {
+	if p == nil || p.pos >= len(p.batches) {
+		return nil
+	}
+	return &p.batches[p.pos]
+}
+
+// ListBatchesAcrossKeys lists a page of the batches of all keys at once. A page is fetched from every key not
+// exhausted yet, concurrently, and the pages are merged newest first, batches seen under several keys being
+// returned once. Merging stops before a key with more batches runs out of fetched batches, as its next ones
+// could be newer, so that pages are complete and in order; the batches fetched but not returned are fetched
+// again for the next page. The cursor tracks the last merged batch of each key, it is an AggregateCursor.
+// An error from any key fails the page, as a partial list would hide batches.
+func ListBatchesAcrossKeys(ctx context.Context, providerName schemas.ModelProvider, keys []schemas.Key, encodedCursor *string, limit int, listPage BatchListPageFunc) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
+	if limit <= 0 {
+		limit = DefaultAggregateListLimit
+	}
+	cursor := &schemas.AggregateCursor{Version: 1, Cursors: make([]string, len(keys)), Done: make([]bool, len(keys))}
+	if encodedCursor != nil && *encodedCursor != "" {
+		decoded, err := schemas.DecodeAggregateCursor(*encodedCursor)
+		if err != nil {
+			return nil, NewBifrostOperationError("invalid pagination cursor", err, providerName)
+		}
+		if len(decoded.Cursors) != len(keys) {
+			return nil, NewBifrostOperationError("invalid pagination cursor", fmt.Errorf("cursor covers %d keys, the provider has %d", len(decoded.Cursors), len(keys)), providerName)
+		}
+		cursor = decoded
+	}
+
+	pages := make([]*batchListKeyPage, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		if cursor.Done[i] {
+			continue
+		}
+		pages[i] = &batchListKeyPage{}
+		wg.Add(1)
+		go func(page *batchListKeyPage, key schemas.Key, after string) {
+			defer wg.Done()
+			page.batches, page.hasMore, page.latency, page.err = listPage(ctx, key, after, limit)
+			// A key returning no batches cannot make progress
+			page.hasMore = page.hasMore && len(page.batches) > 0
+		}(pages[i], key, cursor.Cursors[i])
+	}
+	wg.Wait()
+
+	var latency time.Duration
+	for _, page := range pages {
+		if page == nil {
+			continue
+		}
+		if page.err != nil {
+			return nil, page.err
+		}
+		latency = max(latency, page.latency)
+	}
+
+	batches := make([]schemas.BifrostBatchRetrieveResponse, 0, limit)
+	seen := make(map[string]struct{})
+merge:
+	for len(batches) < limit {
+		newest := -1
+		for i, page := range pages {
+			head := page.head()
+			if head == nil {
+				if page != nil && page.hasMore {
+					break merge
+				}
+				continue
+			}
+			if newest == -1 || head.CreatedAt > pages[newest].head().CreatedAt {
+				newest = i
+			}
+		}
+		if newest == -1 {
+			break
+		}
+		batch := *pages[newest].head()
+		// Merge the batch under every key whose next batch it is, so that it is not returned again later
+		for i, page := range pages {
+			if head := page.head(); head != nil && head.ID == batch.ID {
+				cursor.Cursors[i] = head.ID
+				page.pos++
+			}
+		}
+		if _, ok := seen[batch.ID]; ok {
+			continue
+		}
+		seen[batch.ID] = struct{}{}
+		batches = append(batches, batch)
+	}
+
+	hasMore := false
+	for i, page := range pages {
+		if page != nil {
+			cursor.Done[i] = page.head() == nil && !page.hasMore
+		}
+		hasMore = hasMore || !cursor.Done[i]
+	}
+
+	response := &schemas.BifrostBatchListResponse{
+		Object:  "list",
+		Data:    batches,
+		HasMore: hasMore,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.BatchListRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}
+	if hasMore {
+		nextCursor := schemas.EncodeAggregateCursor(cursor)
+		response.NextCursor = &nextCursor
+	}
+	return response, nil
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+func TestListBatchesAcrossKeys(t *testing.T) {
+	batch := func(id string, createdAt int64) schemas.BifrostBatchRetrieveResponse {
+		return schemas.BifrostBatchRetrieveResponse{ID: id, CreatedAt: createdAt}
+	}
+	// Both keys see batch_4 and batch_3, e.g. keys of the same account, on different pages
+	batchesByKey := map[string][]schemas.BifrostBatchRetrieveResponse{
+		"sk-a": {batch("batch_6", 600), batch("batch_4", 400), batch("batch_3", 300), batch("batch_1", 100)},
+		"sk-b": {batch("batch_5", 500), batch("batch_4", 400), batch("batch_3", 300), batch("batch_2", 200)},
+	}
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	listPage := func(ctx context.Context, key schemas.Key, after string, limit int) ([]schemas.BifrostBatchRetrieveResponse, bool, time.Duration, *schemas.BifrostError) {
+		mu.Lock()
+		calls = append(calls, key.Value+":"+after)
+		mu.Unlock()
+		batches := batchesByKey[key.Value]
+		start := 0
+		if after != "" {
+			start = slices.IndexFunc(batches, func(b schemas.BifrostBatchRetrieveResponse) bool { return b.ID == after }) + 1
+		}
+		end := min(start+limit, len(batches))
+		return batches[start:end], end < len(batches), time.Millisecond, nil
+	}
+	keys := []schemas.Key{{ID: "key-a", Value: "sk-a"}, {ID: "key-b", Value: "sk-b"}}
+
+	var ids []string
+	var cursor *string
+	pages := 0
+	for {
+		resp, err := ListBatchesAcrossKeys(context.Background(), schemas.OpenAI, keys, cursor, 2, listPage)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err.Error.Message)
+		}
+		pages++
+		if len(resp.Data) > 2 {
+			t.Errorf("Expected at most 2 batches per page, got %d", len(resp.Data))
+		}
+		for _, b := range resp.Data {
+			ids = append(ids, b.ID)
+		}
+		if !resp.HasMore {
+			if resp.NextCursor != nil {
+				t.Errorf("Expected no cursor on the last page, got %q", *resp.NextCursor)
+			}
+			break
+		}
+		if resp.NextCursor == nil || pages > 10 {
+			t.Fatalf("Expected a cursor while batches remain, got %v after %d pages", resp.NextCursor, pages)
+		}
+		cursor = resp.NextCursor
+	}
+
+	expected := []string{"batch_6", "batch_5", "batch_4", "batch_3", "batch_2", "batch_1"}
+	if !slices.Equal(ids, expected) {
+		t.Errorf("Expected every batch once, newest first, %v, got %v", expected, ids)
+	}
+	if pages != 3 {
+		t.Errorf("Expected 3 pages, got %d", pages)
+	}
+	// Each page queries every key not exhausted yet, from the last batch merged from it
+	slices.Sort(calls)
+	expectedCalls := []string{"sk-a:", "sk-a:batch_3", "sk-a:batch_6", "sk-b:", "sk-b:batch_3", "sk-b:batch_5"}
+	if !slices.Equal(calls, expectedCalls) {
+		t.Errorf("Expected key pages %v, got %v", expectedCalls, calls)
+	}
+
+	// A cursor for other keys is rejected
+	if _, err := ListBatchesAcrossKeys(context.Background(), schemas.OpenAI, keys[:1], cursor, 2, listPage); err == nil {
+		t.Error("Expected an error for a cursor of other keys")
+	}
+
+	// An error from any key fails the page
+	failing := func(ctx context.Context, key schemas.Key, after string, limit int) ([]schemas.BifrostBatchRetrieveResponse, bool, time.Duration, *schemas.BifrostError) {
+		if key.Value == "sk-b" {
+			return nil, false, 0, NewBifrostOperationError("unauthorized", nil, schemas.OpenAI)
+		}
+		return listPage(ctx, key, after, limit)
+	}
+	if _, err := ListBatchesAcrossKeys(context.Background(), schemas.OpenAI, keys, nil, 2, failing); err == nil {
+		t.Error("Expected the error of the failing key")
+	}
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"fmt"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// attachmentProviders are the providers that resolve the files attached to a chat request. Other providers would
+// silently answer without them.
+var attachmentProviders = map[schemas.ModelProvider]struct{}{
+	schemas.OpenAI: {},
+	schemas.Gemini: {},
+}
+
+// CheckChatAttachments returns an error if a chat request carries attachments that the provider cannot resolve.
+// Custom providers are checked against the provider they are based on.
+func CheckChatAttachments(provider schemas.ModelProvider, baseProvider schemas.ModelProvider, request *schemas.BifrostChatRequest) error {
+	if request == nil || len(request.Attachments) == 0 {
+		return nil
+	}
+	if _, ok := attachmentProviders[baseProvider]; ok {
+		return nil
+	}
+	return fmt.Errorf("attachments not supported by provider %s", provider)
+}
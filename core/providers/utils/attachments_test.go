@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+func TestCheckChatAttachments(t *testing.T) {
+	withAttachments := &schemas.BifrostChatRequest{Attachments: []schemas.FileReference{{FileID: "file-abc"}}}
+
+	for _, provider := range []schemas.ModelProvider{schemas.OpenAI, schemas.Gemini} {
+		if err := CheckChatAttachments(provider, provider, withAttachments); err != nil {
+			t.Errorf("Expected attachments to be accepted by %s, got %v", provider, err)
+		}
+	}
+	if err := CheckChatAttachments("my-openai", schemas.OpenAI, withAttachments); err != nil {
+		t.Errorf("Expected attachments to be accepted by a custom provider based on OpenAI, got %v", err)
+	}
+	for _, provider := range []schemas.ModelProvider{schemas.Anthropic, schemas.Bedrock, schemas.Vertex, schemas.Azure, schemas.Cohere} {
+		err := CheckChatAttachments(provider, provider, withAttachments)
+		if err == nil || err.Error() != "attachments not supported by provider "+string(provider) {
+			t.Errorf("Expected attachments to be rejected by %s, got %v", provider, err)
+		}
+	}
+	if err := CheckChatAttachments(schemas.Anthropic, schemas.Anthropic, &schemas.BifrostChatRequest{}); err != nil {
+		t.Errorf("Expected a request without attachments to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// BaseProvider holds the configuration shared by all providers and the methods derived from it.
+// Providers embed it, so that cross-cutting changes to how providers are configured are made in one place.
+// The HTTP client is not part of it, as providers use different clients (fasthttp, or net/http for Bedrock).
+type BaseProvider struct {
+	providerKey          schemas.ModelProvider         // Default provider key, overridden by custom providers
+	logger               schemas.Logger                // Logger for provider operations
+	networkConfig        schemas.NetworkConfig         // Network configuration including extra headers
+	sendBackRawRequest   bool                          // Whether to include raw request in BifrostResponse
+	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
+	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
+	batchNameTemplate    string                        // Naming template of batch jobs, empty for the provider's default names
+}
+
+// NewBaseProvider creates the base of a provider from its configuration.
+// The configuration is expected to have its defaults set and its base URL resolved.
+func NewBaseProvider(providerKey schemas.ModelProvider, config *schemas.ProviderConfig, logger schemas.Logger) BaseProvider {
+	return BaseProvider{
+		providerKey:          providerKey,
+		logger:               logger,
+		networkConfig:        config.NetworkConfig,
+		sendBackRawRequest:   config.SendBackRawRequest,
+		sendBackRawResponse:  config.SendBackRawResponse,
+		customProviderConfig: config.CustomProviderConfig,
+		batchNameTemplate:    config.BatchNameTemplate,
+	}
+}
+
+// GetProviderKey returns the provider identifier, the custom provider key for custom providers.
+func (provider *BaseProvider) GetProviderKey() schemas.ModelProvider {
+	return GetProviderName(provider.providerKey, provider.customProviderConfig)
+}
+
+// BuildRequestURL constructs the full request URL from the base URL and the request path,
+// honoring path overrides from the context and the custom provider config.
+func (provider *BaseProvider) BuildRequestURL(ctx context.Context, defaultPath string, requestType schemas.RequestType) string {
+	return JoinURL(provider.networkConfig.BaseURL, GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType))
+}
+
+// Logger returns the logger for provider operations.
+func (provider *BaseProvider) Logger() schemas.Logger {
+	return provider.logger
+}
+
+// NetworkConfig returns the network configuration of the provider.
+func (provider *BaseProvider) NetworkConfig() schemas.NetworkConfig {
+	return provider.networkConfig
+}
+
+// SendBackRawRequest returns whether the raw request is included in responses by default.
+func (provider *BaseProvider) SendBackRawRequest() bool {
+	return provider.sendBackRawRequest
+}
+
+// SendBackRawResponse returns whether the raw response is included in responses by default.
+func (provider *BaseProvider) SendBackRawResponse() bool {
+	return provider.sendBackRawResponse
+}
+
+// CustomProviderConfig returns the custom provider config, nil for standard providers.
+func (provider *BaseProvider) CustomProviderConfig() *schemas.CustomProviderConfig {
+	return provider.customProviderConfig
+}
+
+// ResponsePool is a pool of provider response objects, reset when acquired.
+// Its usage is counted and reported by GetResponsePoolStats, so that operators can tell whether
+// pooling is effective under their load.
+type ResponsePool[T any] struct {
+	name        string
+	pool        sync.Pool
+	gets        atomic.Uint64
+	puts        atomic.Uint64
+	allocations atomic.Uint64
+}
+
+// responsePoolStatsSource is implemented by every ResponsePool, whatever its response type.
+type responsePoolStatsSource interface {
+	poolName() string
+	stats() schemas.ResponsePoolStats
+}
+
+var (
+	responsePoolsMu sync.Mutex
+	responsePools   []responsePoolStatsSource
+)
+
+// NewResponsePool creates an empty response pool, named after its response type (e.g. "anthropic.AnthropicMessageResponse").
+func NewResponsePool[T any]() *ResponsePool[T] {
+	var zero T
+	p := &ResponsePool[T]{name: fmt.Sprintf("%T", zero)}
+	p.pool.New = func() any {
+		p.allocations.Add(1)
+		return new(T)
+	}
+
+	responsePoolsMu.Lock()
+	responsePools = append(responsePools, p)
+	responsePoolsMu.Unlock()
+	return p
+}
+
+// Acquire gets a response from the pool and resets it.
+func (p *ResponsePool[T]) Acquire() *T {
+	p.gets.Add(1)
+	resp := p.pool.Get().(*T)
+	var zero T
+	*resp = zero // Reset the struct
+	return resp
+}
+
+// Release returns a response to the pool.
+func (p *ResponsePool[T]) Release(resp *T) {
+	if resp != nil {
+		p.puts.Add(1)
+		p.pool.Put(resp)
+	}
+}
+
+// Prewarm fills the pool with the given number of responses, typically the provider concurrency.
+// Prewarmed responses are not counted as allocations or puts.
+func (p *ResponsePool[T]) Prewarm(count int) {
+	for i := 0; i < count; i++ {
+		p.pool.Put(new(T))
+	}
+}
+
+func (p *ResponsePool[T]) poolName() string {
+	return p.name
+}
+
+func (p *ResponsePool[T]) stats() schemas.ResponsePoolStats {
+	return schemas.ResponsePoolStats{
+		Gets:        p.gets.Load(),
+		Puts:        p.puts.Load(),
+		Allocations: p.allocations.Load(),
+	}
+}
+
+// GetResponsePoolStats returns the usage of every provider response pool, keyed by pool name.
+func GetResponsePoolStats() map[string]schemas.ResponsePoolStats {
+	responsePoolsMu.Lock()
+	defer responsePoolsMu.Unlock()
+
+	stats := make(map[string]schemas.ResponsePoolStats, len(responsePools))
+	for _, pool := range responsePools {
+		poolStats := pool.stats()
+		// Pools of the same response type are reported together
+		existing := stats[pool.poolName()]
+		stats[pool.poolName()] = schemas.ResponsePoolStats{
+			Gets:        existing.Gets + poolStats.Gets,
+			Puts:        existing.Puts + poolStats.Puts,
+			Allocations: existing.Allocations + poolStats.Allocations,
+		}
+	}
+	return stats
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+type pooledResponse struct {
+	ID      string
+	Choices []string
+}
+
+// Test that pooled responses are reset when acquired again
+func TestResponsePool_AcquireResets(t *testing.T) {
+	pool := NewResponsePool[pooledResponse]()
+	pool.Prewarm(2)
+
+	resp := pool.Acquire()
+	resp.ID = "resp-1"
+	resp.Choices = append(resp.Choices, "hello")
+	pool.Release(resp)
+	pool.Release(nil)
+
+	for i := 0; i < 3; i++ {
+		if got := pool.Acquire(); got.ID != "" || got.Choices != nil {
+			t.Fatalf("Expected an empty response, got %+v", got)
+		}
+	}
+}
+
+type countedResponse struct {
+	ID string
+}
+
+// Test that gets, puts and allocations of a pool are counted and reported under its name
+func TestResponsePool_Stats(t *testing.T) {
+	const name = "utils.countedResponse"
+	// Pools of the same type are reported together, so only count what this test does
+	before := GetResponsePoolStats()[name]
+	pool := NewResponsePool[countedResponse]()
+
+	// Without prewarming, the first acquisition allocates a response
+	first := pool.Acquire()
+	pool.Release(first)
+	// Responses still held are not available for reuse, so the pool allocates again
+	held := []*countedResponse{pool.Acquire(), pool.Acquire()}
+	for _, resp := range held {
+		pool.Release(resp)
+	}
+
+	after, ok := GetResponsePoolStats()[name]
+	if !ok {
+		t.Fatalf("Expected stats for pool %s, got %+v", name, GetResponsePoolStats())
+	}
+	stats := schemas.ResponsePoolStats{
+		Gets:        after.Gets - before.Gets,
+		Puts:        after.Puts - before.Puts,
+		Allocations: after.Allocations - before.Allocations,
+	}
+	if stats.Gets != 3 || stats.Puts != 3 {
+		t.Errorf("Expected 3 gets and 3 puts, got %+v", stats)
+	}
+	// sync.Pool may drop pooled objects at any time, so only bound the allocations
+	if stats.Allocations < 2 || stats.Allocations > stats.Gets {
+		t.Errorf("Expected between 2 and %d allocations, got %+v", stats.Gets, stats)
+	}
+}
+
+func BenchmarkResponsePool_AcquireRelease(b *testing.B) {
+	pool := NewResponsePool[countedResponse]()
+	pool.Prewarm(1)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			pool.Release(pool.Acquire())
+		}
+	})
+	stats := pool.stats()
+	b.ReportMetric(float64(stats.Allocations)/float64(stats.Gets), "allocations/get")
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"fmt"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// batchEndpointAliases maps the endpoints accepted in batch create requests to their canonical endpoint.
+// Provider-specific endpoints are accepted for compatibility with clients sending them.
+// The legacy completions endpoint has no canonical equivalent and maps to itself.
+var batchEndpointAliases = map[schemas.BatchEndpoint]schemas.BatchEndpoint{
+	schemas.BatchEndpointCanonicalChat:       schemas.BatchEndpointCanonicalChat,
+	schemas.BatchEndpointCanonicalEmbeddings: schemas.BatchEndpointCanonicalEmbeddings,
+	schemas.BatchEndpointCanonicalResponses:  schemas.BatchEndpointCanonicalResponses,
+	schemas.BatchEndpointChatCompletions:     schemas.BatchEndpointCanonicalChat,
+	schemas.BatchEndpointMessages:            schemas.BatchEndpointCanonicalChat,
+	schemas.BatchEndpointEmbeddings:          schemas.BatchEndpointCanonicalEmbeddings,
+	schemas.BatchEndpointResponses:           schemas.BatchEndpointCanonicalResponses,
+	schemas.BatchEndpointCompletions:         schemas.BatchEndpointCompletions,
+}
+
+// openAIBatchEndpoints are the endpoints of the OpenAI batch API, shared by Azure.
+var openAIBatchEndpoints = map[schemas.BatchEndpoint]schemas.BatchEndpoint{
+	schemas.BatchEndpointCanonicalChat:       schemas.BatchEndpointChatCompletions,
+	schemas.BatchEndpointCanonicalEmbeddings: schemas.BatchEndpointEmbeddings,
+	schemas.BatchEndpointCanonicalResponses:  schemas.BatchEndpointResponses,
+	schemas.BatchEndpointCompletions:         schemas.BatchEndpointCompletions,
+}
+
+// providerBatchEndpoints maps the canonical endpoints supported by each provider's batch API to the endpoint it requires.
+// Providers whose batch API takes no endpoint (Gemini, Bedrock) map to the canonical endpoint.
+var providerBatchEndpoints = map[schemas.ModelProvider]map[schemas.BatchEndpoint]schemas.BatchEndpoint{
+	schemas.OpenAI: openAIBatchEndpoints,
+	schemas.Azure:  openAIBatchEndpoints,
+	schemas.Anthropic: {
+		schemas.BatchEndpointCanonicalChat: schemas.BatchEndpointMessages,
+	},
+	schemas.Gemini: {
+		schemas.BatchEndpointCanonicalChat: schemas.BatchEndpointCanonicalChat,
+	},
+	schemas.Bedrock: {
+		schemas.BatchEndpointCanonicalChat: schemas.BatchEndpointCanonicalChat,
+	},
+}
+
+// NormalizeBatchEndpoint returns the endpoint the provider's batch API requires for a batch create request endpoint,
+// which is either a canonical endpoint (chat, embeddings, responses) or a provider-specific one.
+// An empty endpoint is returned as is, for providers to apply their own default or requirement.
+// An error is returned for unknown endpoints and endpoints the provider's batch API does not support.
+func NormalizeBatchEndpoint(provider schemas.ModelProvider, endpoint schemas.BatchEndpoint) (schemas.BatchEndpoint, error) {
+	if endpoint == "" {
+		return "", nil
+	}
+	canonical, ok := batchEndpointAliases[endpoint]
+	if !ok {
+		return "", fmt.Errorf("unknown batch endpoint %q, expected one of %q, %q or %q", endpoint,
+			schemas.BatchEndpointCanonicalChat, schemas.BatchEndpointCanonicalEmbeddings, schemas.BatchEndpointCanonicalResponses)
+	}
+	providerEndpoint, ok := providerBatchEndpoints[provider][canonical]
+	if !ok {
+		return "", fmt.Errorf("batch endpoint %q is not supported by the %s batch API", endpoint, provider)
+	}
+	return providerEndpoint, nil
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that canonical and provider-specific endpoints are normalized to the endpoint each provider requires
+func TestNormalizeBatchEndpoint(t *testing.T) {
+	tests := []struct {
+		provider schemas.ModelProvider
+		endpoint schemas.BatchEndpoint
+		expected schemas.BatchEndpoint
+	}{
+		{schemas.OpenAI, schemas.BatchEndpointCanonicalChat, schemas.BatchEndpointChatCompletions},
+		{schemas.OpenAI, schemas.BatchEndpointCanonicalEmbeddings, schemas.BatchEndpointEmbeddings},
+		{schemas.OpenAI, schemas.BatchEndpointCanonicalResponses, schemas.BatchEndpointResponses},
+		{schemas.OpenAI, schemas.BatchEndpointChatCompletions, schemas.BatchEndpointChatCompletions},
+		{schemas.OpenAI, schemas.BatchEndpointMessages, schemas.BatchEndpointChatCompletions},
+		{schemas.OpenAI, schemas.BatchEndpointCompletions, schemas.BatchEndpointCompletions},
+		{schemas.Azure, schemas.BatchEndpointCanonicalChat, schemas.BatchEndpointChatCompletions},
+		{schemas.Azure, schemas.BatchEndpointCanonicalEmbeddings, schemas.BatchEndpointEmbeddings},
+		{schemas.Azure, schemas.BatchEndpointCanonicalResponses, schemas.BatchEndpointResponses},
+		{schemas.Anthropic, schemas.BatchEndpointCanonicalChat, schemas.BatchEndpointMessages},
+		{schemas.Anthropic, schemas.BatchEndpointChatCompletions, schemas.BatchEndpointMessages},
+		{schemas.Gemini, schemas.BatchEndpointCanonicalChat, schemas.BatchEndpointCanonicalChat},
+		{schemas.Gemini, schemas.BatchEndpointChatCompletions, schemas.BatchEndpointCanonicalChat},
+		{schemas.Bedrock, schemas.BatchEndpointCanonicalChat, schemas.BatchEndpointCanonicalChat},
+		{schemas.Bedrock, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.provider)+"/"+string(tt.endpoint), func(t *testing.T) {
+			endpoint, err := NormalizeBatchEndpoint(tt.provider, tt.endpoint)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if endpoint != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, endpoint)
+			}
+		})
+	}
+}
+
+// Test that unknown endpoints and endpoints a provider's batch API does not run are rejected
+func TestNormalizeBatchEndpoint_Unsupported(t *testing.T) {
+	tests := []struct {
+		provider schemas.ModelProvider
+		endpoint schemas.BatchEndpoint
+	}{
+		{schemas.OpenAI, "/v1/images/generations"},
+		{schemas.OpenAI, "chat/completions"},
+		{schemas.Anthropic, schemas.BatchEndpointCanonicalEmbeddings},
+		{schemas.Anthropic, schemas.BatchEndpointCanonicalResponses},
+		{schemas.Gemini, schemas.BatchEndpointCanonicalEmbeddings},
+		{schemas.Bedrock, schemas.BatchEndpointCanonicalResponses},
+		{schemas.Bedrock, schemas.BatchEndpointCompletions},
+		{schemas.Cohere, schemas.BatchEndpointCanonicalChat},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.provider)+"/"+string(tt.endpoint), func(t *testing.T) {
+			if _, err := NormalizeBatchEndpoint(tt.provider, tt.endpoint); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Variables of a batch job naming template, written as "{name}" (e.g. "{vk}-{endpoint}-{timestamp}").
+const (
+	BatchNameVariableTimestamp = "timestamp" // Unix time in seconds of the batch creation
+	BatchNameVariableVK        = "vk"        // Name of the virtual key of the request, empty without governance
+	BatchNameVariableEndpoint  = "endpoint"  // Endpoint of the batch requests, with slashes replaced (e.g. "v1-chat-completions")
+	BatchNameVariableModel     = "model"     // Model of the batch, empty for file-based batches without model
+	BatchNameVariableRandom    = "random"    // 8 random hexadecimal characters
+)
+
+// virtualKeyNameContextKey is the context key the governance plugin stores the virtual key name under.
+const virtualKeyNameContextKey = schemas.BifrostContextKey("bf-governance-virtual-key-name")
+
+var batchNameVariablePattern = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// batchNameUnsafeChars matches the characters replaced in variable values, so that values such as model IDs or
+// virtual key names do not break the provider's name charset.
+var batchNameUnsafeChars = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// batchNameConstraint is the name constraint of a provider's batch jobs.
+type batchNameConstraint struct {
+	maxLength int
+	pattern   *regexp.Regexp // Allowed names, nil if any characters are allowed
+	charset   string         // Description of the allowed names, for errors
+}
+
+// batchNameConstraints are the name constraints of the providers supporting batch job names.
+var batchNameConstraints = map[schemas.ModelProvider]batchNameConstraint{
+	// CreateModelInvocationJob jobName
+	schemas.Bedrock: {
+		maxLength: 63,
+		pattern:   regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9+.-]*$`),
+		charset:   "letters, digits, '+', '-' and '.', starting with a letter or digit",
+	},
+	// batches displayName
+	schemas.Gemini: {maxLength: 128},
+}
+
+// ValidateBatchNameTemplate checks that a batch job naming template only uses known variables.
+func ValidateBatchNameTemplate(template string) error {
+	for _, match := range batchNameVariablePattern.FindAllStringSubmatch(template, -1) {
+		switch match[1] {
+		case BatchNameVariableTimestamp, BatchNameVariableVK, BatchNameVariableEndpoint, BatchNameVariableModel, BatchNameVariableRandom:
+		default:
+			return fmt.Errorf("unknown variable %q, expected one of {%s}, {%s}, {%s}, {%s} or {%s}", match[0],
+				BatchNameVariableTimestamp, BatchNameVariableVK, BatchNameVariableEndpoint, BatchNameVariableModel, BatchNameVariableRandom)
+		}
+	}
+	if stripped := batchNameVariablePattern.ReplaceAllString(template, ""); strings.ContainsAny(stripped, "{}") {
+		return fmt.Errorf("unbalanced braces in template %q", template)
+	}
+	return nil
+}
+
+// RenderBatchName renders a batch job naming template for a batch create request.
+// The separators around a variable rendering empty (e.g. {vk} without governance) are dropped with it, so that
+// "{vk}-{endpoint}" renders "v1-chat-completions" rather than "-v1-chat-completions".
+func RenderBatchName(ctx context.Context, template string, request *schemas.BifrostBatchCreateRequest) string {
+	var name strings.Builder
+	// Set after an empty variable with nothing before it, the separators that follow are dropped too
+	dropLeadingSeparators := false
+	appendLiteral := func(literal string) {
+		if dropLeadingSeparators {
+			literal = strings.TrimLeftFunc(literal, isBatchNameSeparator)
+			if literal == "" {
+				return
+			}
+			dropLeadingSeparators = false
+		}
+		name.WriteString(literal)
+	}
+
+	last := 0
+	for _, match := range batchNameVariablePattern.FindAllStringSubmatchIndex(template, -1) {
+		appendLiteral(template[last:match[0]])
+		last = match[1]
+
+		value, known := batchNameVariableValue(ctx, template[match[2]:match[3]], request)
+		if !known {
+			appendLiteral(template[match[0]:match[1]])
+			continue
+		}
+		value = strings.Trim(batchNameUnsafeChars.ReplaceAllString(value, "-"), "-")
+		if value != "" {
+			appendLiteral(value)
+			continue
+		}
+		// Drop the separators between the previous value and the empty variable
+		rendered := strings.TrimRightFunc(name.String(), isBatchNameSeparator)
+		name.Reset()
+		name.WriteString(rendered)
+		dropLeadingSeparators = rendered == ""
+	}
+	appendLiteral(template[last:])
+	return name.String()
+}
+
+// batchNameVariableValue returns the raw value of a naming template variable, and false for unknown variables.
+func batchNameVariableValue(ctx context.Context, variable string, request *schemas.BifrostBatchCreateRequest) (string, bool) {
+	switch variable {
+	case BatchNameVariableTimestamp:
+		return strconv.FormatInt(time.Now().Unix(), 10), true
+	case BatchNameVariableVK:
+		var value string
+		if ctx != nil {
+			value, _ = ctx.Value(virtualKeyNameContextKey).(string)
+		}
+		return value, true
+	case BatchNameVariableEndpoint:
+		return string(request.Endpoint), true
+	case BatchNameVariableModel:
+		if request.Model != nil {
+			return *request.Model, true
+		}
+		return "", true
+	case BatchNameVariableRandom:
+		random := make([]byte, 4)
+		rand.Read(random)
+		return hex.EncodeToString(random), true
+	}
+	return "", false
+}
+
+// isBatchNameSeparator reports whether a template character separates variables, i.e. is not a letter or digit.
+func isBatchNameSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// ValidateBatchName checks a batch job name against the provider's name constraints.
+func ValidateBatchName(provider schemas.ModelProvider, name string) error {
+	if name == "" {
+		return fmt.Errorf("batch name must not be empty")
+	}
+	constraint, ok := batchNameConstraints[provider]
+	if !ok {
+		return nil
+	}
+	if len(name) > constraint.maxLength {
+		return fmt.Errorf("batch name %q is %d characters long, %s allows at most %d", name, len(name), provider, constraint.maxLength)
+	}
+	if constraint.pattern != nil && !constraint.pattern.MatchString(name) {
+		return fmt.Errorf("batch name %q is not allowed by %s, expected %s", name, provider, constraint.charset)
+	}
+	return nil
+}
+
+// BatchName returns the name of a new batch job: the provider's naming template rendered for the request, or the
+// default name if no template is configured. An error is returned if the name breaks the provider's constraints.
+func (provider *BaseProvider) BatchName(ctx context.Context, request *schemas.BifrostBatchCreateRequest, defaultName string) (string, error) {
+	if provider.batchNameTemplate == "" {
+		return defaultName, nil
+	}
+	name := RenderBatchName(ctx, provider.batchNameTemplate, request)
+	if err := ValidateBatchName(provider.providerKey, name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
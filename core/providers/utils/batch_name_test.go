@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the variables of a batch naming template are substituted with values safe for the provider's charset
+func TestRenderBatchName(t *testing.T) {
+	ctx := context.WithValue(context.Background(), virtualKeyNameContextKey, "Team Search")
+	request := &schemas.BifrostBatchCreateRequest{
+		Provider: schemas.Bedrock,
+		Model:    schemas.Ptr("anthropic.claude-3-5-sonnet-20240620-v1:0"),
+		Endpoint: schemas.BatchEndpointChatCompletions,
+	}
+
+	name := RenderBatchName(ctx, "cost-{vk}-{endpoint}-{model}-{timestamp}-{random}", request)
+	expected := regexp.MustCompile(`^cost-Team-Search-v1-chat-completions-anthropic\.claude-3-5-sonnet-20240620-v1-0-\d+-[0-9a-f]{8}$`)
+	if !expected.MatchString(name) {
+		t.Errorf("unexpected batch name %q", name)
+	}
+	if err := ValidateBatchName(schemas.Bedrock, RenderBatchName(ctx, "{vk}-{timestamp}", request)); err != nil {
+		t.Errorf("expected rendered name to be valid for bedrock: %v", err)
+	}
+}
+
+// Test that the separators around variables rendering empty are dropped, so names stay valid for bedrock
+func TestRenderBatchName_EmptyVariables(t *testing.T) {
+	request := &schemas.BifrostBatchCreateRequest{Provider: schemas.Bedrock, Endpoint: schemas.BatchEndpointChatCompletions}
+
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{"{vk}-{endpoint}", "v1-chat-completions"},
+		{"{vk}-{model}_{endpoint}", "v1-chat-completions"},
+		{"{endpoint}-{vk}", "v1-chat-completions"},
+		{"nightly-{vk}-{endpoint}", "nightly-v1-chat-completions"},
+		{"nightly-{model}.{vk}", "nightly"},
+	}
+	for _, tt := range tests {
+		name := RenderBatchName(context.Background(), tt.template, request)
+		if name != tt.expected {
+			t.Errorf("template %q: expected %q, got %q", tt.template, tt.expected, name)
+		}
+		if err := ValidateBatchName(schemas.Bedrock, name); err != nil {
+			t.Errorf("template %q: expected %q to be valid for bedrock: %v", tt.template, name, err)
+		}
+	}
+}
+
+// Test that names breaking the provider's length or charset constraints are rejected
+func TestValidateBatchName(t *testing.T) {
+	if err := ValidateBatchName(schemas.Bedrock, strings.Repeat("a", 64)); err == nil {
+		t.Error("expected a 64 characters name to be rejected by bedrock")
+	}
+	if err := ValidateBatchName(schemas.Bedrock, "-batch"); err == nil {
+		t.Error("expected a name starting with '-' to be rejected by bedrock")
+	}
+	if err := ValidateBatchName(schemas.Gemini, "Nightly eval / team search"); err != nil {
+		t.Errorf("expected gemini display name to be valid: %v", err)
+	}
+	if err := ValidateBatchName(schemas.Gemini, strings.Repeat("a", 129)); err == nil {
+		t.Error("expected a 129 characters name to be rejected by gemini")
+	}
+}
+
+// Test that an over-length rendered name fails the batch name instead of being truncated
+func TestBaseProviderBatchName(t *testing.T) {
+	request := &schemas.BifrostBatchCreateRequest{Provider: schemas.Bedrock, Model: schemas.Ptr("amazon.nova-pro-v1:0")}
+
+	provider := NewBaseProvider(schemas.Bedrock, &schemas.ProviderConfig{}, nil)
+	if name, err := provider.BatchName(context.Background(), request, "bifrost-batch-1"); err != nil || name != "bifrost-batch-1" {
+		t.Errorf("expected the default name without template, got %q (%v)", name, err)
+	}
+
+	provider = NewBaseProvider(schemas.Bedrock, &schemas.ProviderConfig{BatchNameTemplate: strings.Repeat("nightly-", 7) + "{model}"}, nil)
+	if _, err := provider.BatchName(context.Background(), request, "bifrost-batch-1"); err == nil {
+		t.Error("expected an over-length name to be rejected")
+	}
+}
+
+// Test that templates with unknown variables or unbalanced braces are rejected
+func TestValidateBatchNameTemplate(t *testing.T) {
+	if err := ValidateBatchNameTemplate("{vk}-{endpoint}-{timestamp}"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, template := range []string{"{vk}-{date}", "batch-{timestamp", "batch}"} {
+		if err := ValidateBatchNameTemplate(template); err == nil {
+			t.Errorf("expected template %q to be rejected", template)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// RenderedInputFileID is the placeholder file ID of rendered batch create requests whose input is uploaded first.
+const RenderedInputFileID = "<uploaded-input-file>"
+
+// errInvalidBatchRequest is the line error of batch input lines that are not a JSON object.
+var errInvalidBatchRequest = errors.New("batch request is not a valid JSON object")
+
+// CountBatchRequests counts the requests of a JSONL batch input file, for limit and cost checks before the file
+// is submitted. Blank lines are skipped and lines are only checked to be valid JSON objects, request bodies are
+// not parsed. The count covers the valid lines, an error is also returned if the content could not be read or
+// some lines are not valid JSON objects.
+func CountBatchRequests(content io.Reader) (int, error) {
+	count := 0
+	result, err := ScanJSONL(content, func(line []byte) error {
+		trimmed := bytes.TrimSpace(line)
+		if len(trimmed) == 0 {
+			return nil
+		}
+		if trimmed[0] != '{' || !json.Valid(trimmed) {
+			return errInvalidBatchRequest
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, fmt.Errorf("failed to read batch requests: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		return count, fmt.Errorf("%d invalid batch requests, first on line %d: %s", len(result.Errors), *result.Errors[0].Line, result.Errors[0].Message)
+	}
+	return count, nil
+}
+
+// RenderBatchRequest renders a prepared batch HTTP request, for batch dry runs. Credentials are not redacted.
+func RenderBatchRequest(req *fasthttp.Request) *schemas.BatchRenderedRequest {
+	rendered := &schemas.BatchRenderedRequest{
+		Method:  string(req.Header.Method()),
+		URL:     string(req.RequestURI()),
+		Headers: make(map[string]string),
+	}
+	for key, value := range req.Header.All() {
+		rendered.Headers[string(key)] = string(value)
+	}
+	if body := req.Body(); len(body) > 0 {
+		rendered.Body = append([]byte(nil), body...)
+	}
+	return rendered
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test that batch requests are counted from non-blank lines, with Windows line endings, and invalid lines reported
+func TestCountBatchRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expected    int
+		expectedErr string
+	}{
+		{
+			name:     "blank lines and crlf",
+			content:  "{\"custom_id\":\"req-1\",\"body\":{}}\r\n\r\n{\"custom_id\":\"req-2\",\"body\":{}}\r\n   \n\n{\"custom_id\":\"req-3\",\"body\":{}}",
+			expected: 3,
+		},
+		{
+			name:    "empty",
+			content: "\n\r\n",
+		},
+		{
+			name:        "invalid lines",
+			content:     "{\"custom_id\":\"req-1\"}\n{\"custom_id\":\n[1,2]\n{\"custom_id\":\"req-2\"}\n",
+			expected:    2,
+			expectedErr: "2 invalid batch requests, first on line 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := CountBatchRequests(strings.NewReader(tt.content))
+			if count != tt.expected {
+				t.Errorf("Expected %d requests, got %d", tt.expected, count)
+			}
+			if tt.expectedErr == "" && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedErr)) {
+				t.Errorf("Expected an error containing %q, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// connectTimings holds how long new connections took to dial, until their first round trip claims it,
+// keyed by connectTimingKey.
+var connectTimings sync.Map
+
+// connectTimingKey identifies an open connection by its local and remote addresses.
+func connectTimingKey(local, remote net.Addr) string {
+	if local == nil || remote == nil {
+		return ""
+	}
+	return local.String() + "->" + remote.String()
+}
+
+// timedConn forgets the dial time of a connection when it is closed before its first round trip claimed it.
+type timedConn struct {
+	net.Conn
+	key string
+}
+
+func (c *timedConn) Close() error {
+	connectTimings.Delete(c.key)
+	return c.Conn.Close()
+}
+
+// ConfigureConnectTimings times the dialing (DNS resolution and TCP connect) of the connections of the client,
+// so that MakeRequestWithContext can report it apart from the upstream time in the latency breakdown of the
+// request. It wraps the dialer configured on the client, so it must be called after ConfigureProxy.
+func ConfigureConnectTimings(client *fasthttp.Client) *fasthttp.Client {
+	dial := client.DialTimeout
+	if dial == nil {
+		// Mirror fasthttp: a custom Dial ignores the timeout, the default dialer uses it if set
+		customDial := client.Dial
+		dualStack := client.DialDualStack
+		dial = func(addr string, timeout time.Duration) (net.Conn, error) {
+			switch {
+			case customDial != nil:
+				return customDial(addr)
+			case timeout > 0 && dualStack:
+				return fasthttp.DialDualStackTimeout(addr, timeout)
+			case timeout > 0:
+				return fasthttp.DialTimeout(addr, timeout)
+			case dualStack:
+				return fasthttp.DialDualStack(addr)
+			default:
+				return fasthttp.Dial(addr)
+			}
+		}
+	}
+	client.DialTimeout = func(addr string, timeout time.Duration) (net.Conn, error) {
+		startTime := time.Now()
+		conn, err := dial(addr, timeout)
+		if err != nil {
+			return nil, err
+		}
+		key := connectTimingKey(conn.LocalAddr(), conn.RemoteAddr())
+		if key == "" {
+			return conn, nil
+		}
+		connectTimings.Store(key, time.Since(startTime))
+		return &timedConn{Conn: conn, key: key}, nil
+	}
+	return client
+}
+
+// recordRoundTripLatency adds the latency of a round trip to the latency timings of the request, if any. The
+// dial time of the connection is reported as connect if the round trip opened it, the rest as upstream.
+func recordRoundTripLatency(ctx context.Context, resp *fasthttp.Response, latency time.Duration) {
+	var connect time.Duration
+	if key := connectTimingKey(resp.LocalAddr(), resp.RemoteAddr()); key != "" {
+		if value, ok := connectTimings.LoadAndDelete(key); ok {
+			connect = min(value.(time.Duration), latency)
+		}
+	}
+	timings, ok := ctx.Value(schemas.BifrostContextKeyLatencyTimings).(*schemas.LatencyTimings)
+	if !ok {
+		return
+	}
+	timings.Add(schemas.LatencyStageConnect, connect)
+	timings.Add(schemas.LatencyStageUpstream, latency-connect)
+}
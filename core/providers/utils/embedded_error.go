@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+	"github.com/valyala/fasthttp"
+)
+
+// errorEnvelopeFields are the top-level fields of the error bodies providers return, e.g.
+// OpenAI {"error":{"message","type","code"}}, Anthropic {"type":"error","error":{...},"request_id"},
+// Gemini {"error":{"code","message","status"}} and HuggingFace {"error":"...","type","estimated_time"}.
+// A body with any other field is a response that happens to carry an error field (e.g. a failed batch
+// or a failed Responses API response) and is left to the caller.
+var errorEnvelopeFields = map[string]bool{
+	"error":          true,
+	"type":           true,
+	"message":        true,
+	"code":           true,
+	"status":         true,
+	"request_id":     true,
+	"error_type":     true,
+	"estimated_time": true,
+	"warnings":       true,
+}
+
+// embeddedErrorObject is the error object nested under "error" in provider error bodies.
+type embeddedErrorObject struct {
+	Message string      `json:"message"`
+	Type    string      `json:"type"`
+	Code    interface{} `json:"code"`
+	Status  string      `json:"status"`
+}
+
+// errorTypeStatusCodes maps the error types used by OpenAI and Anthropic to HTTP status codes.
+var errorTypeStatusCodes = map[string]int{
+	"invalid_request_error": fasthttp.StatusBadRequest,
+	"authentication_error":  fasthttp.StatusUnauthorized,
+	"permission_error":      fasthttp.StatusForbidden,
+	"not_found_error":       fasthttp.StatusNotFound,
+	"rate_limit_error":      fasthttp.StatusTooManyRequests,
+	"rate_limit_exceeded":   fasthttp.StatusTooManyRequests,
+	"insufficient_quota":    fasthttp.StatusTooManyRequests,
+	"overloaded_error":      fasthttp.StatusServiceUnavailable,
+	"api_error":             fasthttp.StatusInternalServerError,
+	"server_error":          fasthttp.StatusInternalServerError,
+}
+
+// DetectEmbeddedError returns the error carried by a 200 response whose body is an error object instead
+// of the expected response, or nil if the body is not one. Bodies are only inspected when the embedded error
+// handling bifrost set in the context is convert.
+// The status code is derived from the error's code, status or type, and defaults to 500 so that
+// unclassified errors are retried like other provider server errors.
+func DetectEmbeddedError(ctx context.Context, responseBody []byte) *schemas.BifrostError {
+	if ctx == nil {
+		return nil
+	}
+	if handling, _ := ctx.Value(schemas.BifrostContextKeyEmbeddedErrorHandling).(schemas.EmbeddedErrorHandling); handling != schemas.EmbeddedErrorHandlingConvert {
+		return nil
+	}
+	trimmed := bytes.TrimSpace(responseBody)
+	if len(trimmed) == 0 || trimmed[0] != '{' || !bytes.Contains(trimmed, []byte(`"error"`)) {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := serialization.Unmarshal(trimmed, &fields); err != nil {
+		return nil
+	}
+	rawError, ok := fields["error"]
+	if !ok {
+		return nil
+	}
+	for field := range fields {
+		if !errorEnvelopeFields[field] {
+			return nil
+		}
+	}
+
+	var errorObject embeddedErrorObject
+	var errorMessage string
+	switch {
+	case serialization.Unmarshal(rawError, &errorMessage) == nil:
+		errorObject.Message = errorMessage
+	case serialization.Unmarshal(rawError, &errorObject) == nil:
+	default:
+		return nil
+	}
+	// Top-level fields complete what the nested error object leaves out (HuggingFace puts them at the top)
+	if errorObject.Type == "" {
+		if errorType, ok := fields["error_type"]; ok {
+			serialization.Unmarshal(errorType, &errorObject.Type)
+		} else if errorType, ok := fields["type"]; ok {
+			serialization.Unmarshal(errorType, &errorObject.Type)
+		}
+		if errorObject.Type == "error" {
+			errorObject.Type = ""
+		}
+	}
+	if errorObject.Message == "" {
+		if message, ok := fields["message"]; ok {
+			serialization.Unmarshal(message, &errorObject.Message)
+		}
+	}
+	if errorObject.Message == "" && errorObject.Type == "" && errorObject.Code == nil && errorObject.Status == "" {
+		return nil
+	}
+	if errorObject.Message == "" {
+		errorObject.Message = "provider returned an error with a 200 status"
+	}
+
+	statusCode := embeddedErrorStatusCode(&errorObject)
+	bifrostErr := &schemas.BifrostError{
+		IsBifrostError: false,
+		StatusCode:     &statusCode,
+		Error: &schemas.ErrorField{
+			Message: errorObject.Message,
+		},
+	}
+	if errorObject.Type != "" {
+		bifrostErr.Error.Type = schemas.Ptr(errorObject.Type)
+	}
+	if code := embeddedErrorCode(errorObject.Code); code != "" {
+		bifrostErr.Error.Code = schemas.Ptr(code)
+	}
+	ClassifyRateLimitError(bifrostErr)
+	return bifrostErr
+}
+
+// embeddedErrorStatusCode classifies an embedded error into the HTTP status code the provider would have used.
+func embeddedErrorStatusCode(errorObject *embeddedErrorObject) int {
+	if code, err := strconv.Atoi(embeddedErrorCode(errorObject.Code)); err == nil && code >= 400 && code < 600 {
+		return code
+	}
+	if statusCode, ok := GRPCStatusToHTTPStatus(errorObject.Status); ok {
+		return statusCode
+	}
+	if statusCode, ok := errorTypeStatusCodes[strings.ToLower(errorObject.Type)]; ok {
+		return statusCode
+	}
+	return fasthttp.StatusInternalServerError
+}
+
+// embeddedErrorCode returns the error code as a string, providers use both numbers and strings.
+func embeddedErrorCode(code interface{}) string {
+	switch code := code.(type) {
+	case string:
+		return code
+	case float64:
+		return strconv.FormatFloat(code, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+// convertEmbeddedErrorsContext is the context bifrost sets when embedded errors are converted into errors.
+var convertEmbeddedErrorsContext = context.WithValue(context.Background(), schemas.BifrostContextKeyEmbeddedErrorHandling, schemas.EmbeddedErrorHandlingConvert)
+
+func TestDetectEmbeddedError(t *testing.T) {
+	tests := []struct {
+		name               string
+		body               string
+		expectedError      bool
+		expectedStatusCode int
+		expectedMessage    string
+		expectedType       string
+	}{
+		{
+			name:               "OpenAI error body",
+			body:               `{"error":{"message":"Rate limit reached","type":"rate_limit_error","code":"rate_limit_exceeded"}}`,
+			expectedError:      true,
+			expectedStatusCode: 429,
+			expectedMessage:    "Rate limit reached",
+			expectedType:       "rate_limit_error",
+		},
+		{
+			name:               "Anthropic error body",
+			body:               `{"type":"error","error":{"type":"overloaded_error","message":"Overloaded"},"request_id":"req_1"}`,
+			expectedError:      true,
+			expectedStatusCode: 503,
+			expectedMessage:    "Overloaded",
+			expectedType:       "overloaded_error",
+		},
+		{
+			name:               "Gemini error body",
+			body:               `{"error":{"code":400,"message":"Invalid JSON payload","status":"INVALID_ARGUMENT"}}`,
+			expectedError:      true,
+			expectedStatusCode: 400,
+			expectedMessage:    "Invalid JSON payload",
+		},
+		{
+			name:               "HuggingFace error body",
+			body:               `{"error":"Model is currently loading","estimated_time":20.5}`,
+			expectedError:      true,
+			expectedStatusCode: 500,
+			expectedMessage:    "Model is currently loading",
+		},
+		{
+			name:          "Response with a null error field",
+			body:          `{"id":"resp_1","object":"response","status":"completed","error":null}`,
+			expectedError: false,
+		},
+		{
+			name:          "Failed response object is left to the caller",
+			body:          `{"id":"resp_1","object":"response","status":"failed","error":{"code":"server_error","message":"failed"}}`,
+			expectedError: false,
+		},
+		{
+			name:          "Null error envelope",
+			body:          `{"error":null}`,
+			expectedError: false,
+		},
+		{
+			name:          "Array body",
+			body:          `[{"error":"not an envelope"}]`,
+			expectedError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bifrostErr := DetectEmbeddedError(convertEmbeddedErrorsContext, []byte(tt.body))
+			if (bifrostErr != nil) != tt.expectedError {
+				t.Fatalf("DetectEmbeddedError() error = %v, expected error: %v", bifrostErr, tt.expectedError)
+			}
+			if bifrostErr == nil {
+				return
+			}
+			if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != tt.expectedStatusCode {
+				t.Errorf("expected status code %d, got %v", tt.expectedStatusCode, bifrostErr.StatusCode)
+			}
+			if bifrostErr.Error.Message != tt.expectedMessage {
+				t.Errorf("expected message %q, got %q", tt.expectedMessage, bifrostErr.Error.Message)
+			}
+			if tt.expectedType != "" && (bifrostErr.Error.Type == nil || *bifrostErr.Error.Type != tt.expectedType) {
+				t.Errorf("expected type %q, got %v", tt.expectedType, bifrostErr.Error.Type)
+			}
+		})
+	}
+}
+
+func TestHandleProviderResponse_EmbeddedError(t *testing.T) {
+	body := []byte(`{"error":{"message":"The model does not exist","type":"invalid_request_error","code":"model_not_found"}}`)
+
+	var response map[string]interface{}
+	_, _, bifrostErr := HandleProviderResponse(convertEmbeddedErrorsContext, body, &response, nil, false, false)
+	if bifrostErr == nil {
+		t.Fatal("expected 200 response with an error body to be returned as an error")
+	}
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != 400 {
+		t.Errorf("expected status code 400, got %v", bifrostErr.StatusCode)
+	}
+	if bifrostErr.Error.Code == nil || *bifrostErr.Error.Code != "model_not_found" {
+		t.Errorf("expected code model_not_found, got %v", bifrostErr.Error.Code)
+	}
+
+	// In passthrough mode, the default, the body is parsed as a response
+	if _, _, bifrostErr := HandleProviderResponse(context.Background(), body, &response, nil, false, false); bifrostErr != nil {
+		t.Fatalf("expected body to be parsed in passthrough mode, got error: %v", bifrostErr.Error.Message)
+	}
+	if _, ok := response["error"]; !ok {
+		t.Error("expected error body to be parsed as the response")
+	}
+}
@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+// maxErrorNesting bounds how deep error objects are searched for a message.
+const maxErrorNesting = 4
+
+// maxErrorDetails bounds the number of field-level details kept from an error body.
+const maxErrorDetails = 10
+
+// Keys under which providers put the error object, the message, the error type and the field-level details, in
+// order of preference, e.g. OpenAI {"error":{"message","type","code"}}, Gemini {"error":{"code","message","status",
+// "details"}}, Bedrock {"__type","Message"}, HuggingFace {"error","error_type"} and FastAPI servers {"detail":[...]}.
+var (
+	errorObjectKeys  = []string{"error", "Error", "errors"}
+	errorMessageKeys = []string{"message", "Message", "error_message", "errorMessage", "msg", "detail", "error_description", "description", "title"}
+	errorTypeKeys    = []string{"type", "__type", "error_type", "errorType", "status"}
+	errorCodeKeys    = []string{"code", "Code", "error_code", "errorCode"}
+	errorDetailKeys  = []string{"details", "detail", "errors", "fieldViolations", "field_errors"}
+	errorFieldKeys   = []string{"field", "loc", "param", "path", "property"}
+)
+
+// ProviderErrorInfo is the error information found in a provider error body by ExtractProviderError.
+type ProviderErrorInfo struct {
+	Message string
+	Type    string
+	Code    string
+	Details []string // Field-level details, as "field: description"
+}
+
+// ExtractProviderError finds the human-readable message, the type, the code and the field-level details of an
+// error in a provider error body, whatever its shape: flat or nested under an error object, in a list, with
+// capitalized keys. It is a best-effort fallback for the shapes the provider-specific parsers do not know, and
+// returns nil if the body is not JSON or carries none of them.
+func ExtractProviderError(body []byte) *ProviderErrorInfo {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+		return nil
+	}
+	var decoded any
+	if err := serialization.Unmarshal(trimmed, &decoded); err != nil {
+		return nil
+	}
+	var info ProviderErrorInfo
+	extractProviderError(decoded, &info, 0)
+	if info.Message == "" && info.Type == "" && info.Code == "" && len(info.Details) == 0 {
+		return nil
+	}
+	return &info
+}
+
+// extractProviderError fills the fields of info left empty with those of an error value. Nested error objects
+// come first: the outer ones often only carry a generic type (e.g. Anthropic's "type":"error").
+func extractProviderError(value any, info *ProviderErrorInfo, depth int) {
+	if depth > maxErrorNesting {
+		return
+	}
+	switch v := value.(type) {
+	case string:
+		if info.Message == "" {
+			info.Message = strings.TrimSpace(v)
+		}
+	case []any:
+		for _, item := range v {
+			extractProviderError(item, info, depth+1)
+			if info.Message != "" {
+				return
+			}
+		}
+	case map[string]any:
+		for _, key := range errorObjectKeys {
+			if nested, ok := v[key]; ok {
+				extractProviderError(nested, info, depth+1)
+			}
+		}
+		if info.Message == "" {
+			info.Message = firstErrorString(v, errorMessageKeys)
+		}
+		if info.Type == "" {
+			if errorType := firstErrorString(v, errorTypeKeys); errorType != "error" {
+				if _, name, found := strings.Cut(errorType, "#"); found {
+					errorType = name
+				}
+				info.Type = errorType
+			}
+		}
+		if info.Code == "" {
+			info.Code = firstErrorCode(v, errorCodeKeys)
+		}
+		if len(info.Details) == 0 {
+			info.Details = extractErrorDetails(v, depth)
+		}
+	}
+}
+
+// extractErrorDetails returns the field-level details of an error object, such as Google's field violations or
+// the validation errors of FastAPI servers.
+func extractErrorDetails(object map[string]any, depth int) []string {
+	var details []string
+	for _, key := range errorDetailKeys {
+		items, ok := object[key].([]any)
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			entry, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			if field := errorFieldName(entry); field != "" {
+				if description := firstErrorString(entry, errorMessageKeys); description != "" {
+					details = append(details, field+": "+description)
+				}
+			} else if depth < maxErrorNesting {
+				// Google nests the violations in typed detail objects
+				details = append(details, extractErrorDetails(entry, depth+1)...)
+			}
+			if len(details) >= maxErrorDetails {
+				return details[:maxErrorDetails]
+			}
+		}
+	}
+	return details
+}
+
+// errorFieldName returns the name of the field a detail is about, joining paths such as ["body","messages",0].
+func errorFieldName(entry map[string]any) string {
+	for _, key := range errorFieldKeys {
+		switch field := entry[key].(type) {
+		case string:
+			if field != "" {
+				return field
+			}
+		case []any:
+			parts := make([]string, 0, len(field))
+			for _, part := range field {
+				parts = append(parts, fmt.Sprint(part))
+			}
+			if len(parts) > 0 {
+				return strings.Join(parts, ".")
+			}
+		}
+	}
+	return ""
+}
+
+// firstErrorString returns the first non-empty string under the given keys of an object.
+func firstErrorString(object map[string]any, keys []string) string {
+	for _, key := range keys {
+		if value, ok := object[key].(string); ok {
+			if value = strings.TrimSpace(value); value != "" {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
+// firstErrorCode returns the first non-empty string or number under the given keys of an object.
+func firstErrorCode(object map[string]any, keys []string) string {
+	for _, key := range keys {
+		switch value := object[key].(type) {
+		case string:
+			if value = strings.TrimSpace(value); value != "" {
+				return value
+			}
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		case json.Number:
+			return value.String()
+		}
+	}
+	return ""
+}
+
+// ErrorField returns the error field of a BifrostError built from the error information, with the field-level
+// details appended to the message.
+func (info *ProviderErrorInfo) ErrorField() *schemas.ErrorField {
+	field := &schemas.ErrorField{Message: info.Message}
+	details := make([]string, 0, len(info.Details))
+	for _, detail := range info.Details {
+		// The message is often the description of the first detail
+		if field.Message == "" || !strings.HasSuffix(detail, ": "+field.Message) {
+			details = append(details, detail)
+		}
+	}
+	if len(details) > 0 {
+		if field.Message == "" {
+			field.Message = strings.Join(details, "; ")
+		} else {
+			field.Message += " (" + strings.Join(details, "; ") + ")"
+		}
+	}
+	if info.Type != "" {
+		field.Type = schemas.Ptr(info.Type)
+	}
+	if info.Code != "" {
+		field.Code = schemas.Ptr(info.Code)
+	}
+	return field
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestExtractProviderError(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		expectedMessage string
+		expectedType    string
+		expectedCode    string
+		expectedNil     bool
+	}{
+		{
+			name:            "OpenAI nested error",
+			body:            `{"error":{"message":"Invalid model","type":"invalid_request_error","code":"model_not_found","param":"model"}}`,
+			expectedMessage: "Invalid model",
+			expectedType:    "invalid_request_error",
+			expectedCode:    "model_not_found",
+		},
+		{
+			name:            "Anthropic envelope type is skipped",
+			body:            `{"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`,
+			expectedMessage: "Overloaded",
+			expectedType:    "overloaded_error",
+		},
+		{
+			name:            "Gemini nested error with field violations",
+			body:            `{"error":{"code":400,"message":"Invalid value","status":"INVALID_ARGUMENT","details":[{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"field":"contents[0].parts","description":"must not be empty"}]}]}}`,
+			expectedMessage: "Invalid value (contents[0].parts: must not be empty)",
+			expectedType:    "INVALID_ARGUMENT",
+			expectedCode:    "400",
+		},
+		{
+			name:            "Gemini error list",
+			body:            `[{"error":{"code":429,"message":"Resource exhausted","status":"RESOURCE_EXHAUSTED"}}]`,
+			expectedMessage: "Resource exhausted",
+			expectedType:    "RESOURCE_EXHAUSTED",
+			expectedCode:    "429",
+		},
+		{
+			name:            "Bedrock capitalized message",
+			body:            `{"__type":"com.amazon.coral.validate#ValidationException","Message":"Malformed input request"}`,
+			expectedMessage: "Malformed input request",
+			expectedType:    "ValidationException",
+		},
+		{
+			name:            "HuggingFace flattened error",
+			body:            `{"error":"Model is overloaded","error_type":"overloaded"}`,
+			expectedMessage: "Model is overloaded",
+			expectedType:    "overloaded",
+		},
+		{
+			name:            "FastAPI validation errors",
+			body:            `{"detail":[{"loc":["body","messages",0,"content"],"msg":"field required","type":"value_error.missing"},{"loc":["body","model"],"msg":"str type expected","type":"type_error.str"}]}`,
+			expectedMessage: "body.messages.0.content: field required; body.model: str type expected",
+		},
+		{
+			name:            "Error list with fields",
+			body:            `{"errors":[{"message":"is required","field":"prompt"}],"code":"validation_failed"}`,
+			expectedMessage: "is required",
+			expectedCode:    "validation_failed",
+		},
+		{
+			name:        "Not JSON",
+			body:        `upstream connect error`,
+			expectedNil: true,
+		},
+		{
+			name:        "JSON without error information",
+			body:        `{"id":"chatcmpl-1","choices":[]}`,
+			expectedNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := ExtractProviderError([]byte(tt.body))
+			if tt.expectedNil {
+				if info != nil {
+					t.Fatalf("Expected no error information, got %+v", info)
+				}
+				return
+			}
+			if info == nil {
+				t.Fatal("Expected error information, got nil")
+			}
+			field := info.ErrorField()
+			if field.Message != tt.expectedMessage {
+				t.Errorf("Expected message %q, got %q", tt.expectedMessage, field.Message)
+			}
+			if got := derefString(field.Type); got != tt.expectedType {
+				t.Errorf("Expected type %q, got %q", tt.expectedType, got)
+			}
+			if got := derefString(field.Code); got != tt.expectedCode {
+				t.Errorf("Expected code %q, got %q", tt.expectedCode, got)
+			}
+		})
+	}
+}
+
+// Test that provider errors of an unknown shape get their message instead of an empty one or the raw body
+func TestHandleProviderAPIError_ExtractsMessage(t *testing.T) {
+	var known struct {
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+	resp.SetStatusCode(fasthttp.StatusBadRequest)
+
+	resp.SetBodyString(`{"Message":"Malformed input request","__type":"ValidationException"}`)
+	bifrostErr := HandleProviderAPIError(resp, &known)
+	if bifrostErr.Error == nil || bifrostErr.Error.Message != "Malformed input request" {
+		t.Errorf("Expected the extracted message as fallback, got %+v", bifrostErr.Error)
+	}
+
+	var list []struct{}
+	resp.SetBodyString(`{"error":{"message":"Invalid value","status":"INVALID_ARGUMENT"}}`)
+	bifrostErr = HandleProviderAPIError(resp, &list)
+	if bifrostErr.Error == nil || bifrostErr.Error.Message != "Invalid value" {
+		t.Errorf("Expected the extracted message instead of the raw body, got %+v", bifrostErr.Error)
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// MultipartField is a form field written before the file in the multipart body of a file upload.
+type MultipartField struct {
+	Name  string
+	Value string
+}
+
+// HasFileUploadContent reports whether the file upload request has content, in memory or streamed.
+func HasFileUploadContent(request *schemas.BifrostFileUploadRequest) bool {
+	return len(request.File) > 0 || request.FileReader != nil
+}
+
+// SetMultipartFileUploadBody sets the multipart form body of a file upload request, made of the fields followed by
+// the "file" part, and its content type.
+// The file content is request.FileReader if set, which is then written as the request is sent, with chunked transfer
+// encoding, so that files larger than memory can be uploaded. Otherwise it is request.File.
+func SetMultipartFileUploadBody(req *fasthttp.Request, request *schemas.BifrostFileUploadRequest, filename string, fields ...MultipartField) error {
+	if request.FileReader == nil {
+		var buf bytes.Buffer
+		writer := multipart.NewWriter(&buf)
+		if err := writeMultipartFileUpload(writer, bytes.NewReader(request.File), filename, fields); err != nil {
+			return err
+		}
+		req.Header.SetContentType(writer.FormDataContentType())
+		req.SetBody(buf.Bytes())
+		return nil
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+	req.Header.SetContentType(writer.FormDataContentType())
+	go func() {
+		// Closing the pipe with the error fails the request if the file could not be read
+		pipeWriter.CloseWithError(writeMultipartFileUpload(writer, request.FileReader, filename, fields))
+	}()
+	// The request closes the pipe reader once sent or released, which stops the writer if the request failed early
+	req.SetBodyStream(pipeReader, -1)
+	return nil
+}
+
+// writeMultipartFileUpload writes the fields and the file part of a file upload and closes the writer.
+func writeMultipartFileUpload(writer *multipart.Writer, content io.Reader, filename string, fields []MultipartField) error {
+	for _, field := range fields {
+		if err := writer.WriteField(field.Name, field.Value); err != nil {
+			return fmt.Errorf("failed to write %s field: %w", field.Name, err)
+		}
+	}
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return fmt.Errorf("failed to write file content: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+	return nil
+}
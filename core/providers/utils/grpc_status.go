@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/valyala/fasthttp"
+)
+
+// grpcStatusCodes maps the gRPC-style status names used by Google APIs to HTTP status codes.
+var grpcStatusCodes = map[string]int{
+	"INVALID_ARGUMENT":    fasthttp.StatusBadRequest,
+	"FAILED_PRECONDITION": fasthttp.StatusBadRequest,
+	"OUT_OF_RANGE":        fasthttp.StatusBadRequest,
+	"UNAUTHENTICATED":     fasthttp.StatusUnauthorized,
+	"PERMISSION_DENIED":   fasthttp.StatusForbidden,
+	"NOT_FOUND":           fasthttp.StatusNotFound,
+	"ALREADY_EXISTS":      fasthttp.StatusConflict,
+	"ABORTED":             fasthttp.StatusConflict,
+	"RESOURCE_EXHAUSTED":  fasthttp.StatusTooManyRequests,
+	"CANCELLED":           499,
+	"INTERNAL":            fasthttp.StatusInternalServerError,
+	"UNKNOWN":             fasthttp.StatusInternalServerError,
+	"DATA_LOSS":           fasthttp.StatusInternalServerError,
+	"UNIMPLEMENTED":       fasthttp.StatusNotImplemented,
+	"NOT_IMPLEMENTED":     fasthttp.StatusNotImplemented,
+	"UNAVAILABLE":         fasthttp.StatusServiceUnavailable,
+	"DEADLINE_EXCEEDED":   fasthttp.StatusGatewayTimeout,
+}
+
+// grpcCodeNames are the names of the numeric gRPC status codes (google.rpc.Code), indexed by code.
+var grpcCodeNames = []string{
+	"OK",
+	"CANCELLED",
+	"UNKNOWN",
+	"INVALID_ARGUMENT",
+	"DEADLINE_EXCEEDED",
+	"NOT_FOUND",
+	"ALREADY_EXISTS",
+	"PERMISSION_DENIED",
+	"RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION",
+	"ABORTED",
+	"OUT_OF_RANGE",
+	"UNIMPLEMENTED",
+	"INTERNAL",
+	"UNAVAILABLE",
+	"DATA_LOSS",
+	"UNAUTHENTICATED",
+}
+
+// GRPCStatusToHTTPStatus returns the HTTP status code equivalent to a gRPC status name such as
+// "RESOURCE_EXHAUSTED", and false if the name is not a known gRPC status.
+func GRPCStatusToHTTPStatus(status string) (int, bool) {
+	statusCode, ok := grpcStatusCodes[strings.ToUpper(status)]
+	return statusCode, ok
+}
+
+// GoogleErrorHTTPStatus returns the HTTP status code of an error reported by a Google API as a google.rpc.Status,
+// whose code is either a gRPC code (e.g. 8 for RESOURCE_EXHAUSTED) or, in some responses, already an HTTP status.
+// The status name is used when the code is missing, and 500 is returned if neither is recognized.
+func GoogleErrorHTTPStatus(code int, status string) int {
+	if code >= 400 && code < 600 {
+		return code
+	}
+	if code > 0 && code < len(grpcCodeNames) {
+		statusCode, _ := GRPCStatusToHTTPStatus(grpcCodeNames[code])
+		return statusCode
+	}
+	if statusCode, ok := GRPCStatusToHTTPStatus(status); ok {
+		return statusCode
+	}
+	return fasthttp.StatusInternalServerError
+}
@@ -300,3 +300,18 @@ func BenchmarkExtractHTMLErrorMessage(b *testing.B) {
 		ExtractHTMLErrorMessage(body)
 	}
 }
+
+// Test that malformed response bodies are logged with their start and end only
+func TestRedactResponseBody(t *testing.T) {
+	if got := RedactResponseBody([]byte(`{"id":"chatcmpl-1"`)); got != `"{\"id\":\"chatcmpl-1\""` {
+		t.Errorf("Expected a short body to be kept, got %s", got)
+	}
+	body := "<html>" + strings.Repeat("secret ", 100) + "</html>"
+	got := RedactResponseBody([]byte(body))
+	if strings.Count(got, "secret") > 10 || !strings.HasPrefix(got, `"<html>`) || !strings.HasSuffix(got, `</html>"`) {
+		t.Errorf("Expected the middle of a long body to be redacted, got %s", got)
+	}
+	if !strings.Contains(got, "[REDACTED 633 bytes]") {
+		t.Errorf("Expected the redacted length, got %s", got)
+	}
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// quotaExhaustedSubtypes are the error types, codes and exception names (lowercased) providers return
+// when the quota or credit of the key is used up, as opposed to a temporary rate limit.
+var quotaExhaustedSubtypes = map[string]bool{
+	"insufficient_quota":            true, // OpenAI, Azure and OpenAI-compatible providers
+	"billing_hard_limit_reached":    true, // OpenAI
+	"servicequotaexceededexception": true, // Bedrock
+}
+
+// quotaExhaustedMessagePatterns are the (lowercased) messages of 429 errors returned for a used up quota by
+// providers that use the same error type for both kinds of 429, e.g. Anthropic.
+var quotaExhaustedMessagePatterns = []string{
+	"reached your specified api usage limits",
+	"credit balance is too low",
+	"insufficient_quota",
+}
+
+// IsQuotaExhaustedSubtype reports whether an error type, code or exception name returned with a 429 means
+// that the quota of the key is used up.
+func IsQuotaExhaustedSubtype(subtype string) bool {
+	return quotaExhaustedSubtypes[strings.ToLower(subtype)]
+}
+
+// SetRateLimitKind classifies a 429 error as a used up quota or as a temporary rate limit.
+// Errors with another status code are left unclassified.
+func SetRateLimitKind(bifrostErr *schemas.BifrostError, quotaExhausted bool) {
+	if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != fasthttp.StatusTooManyRequests {
+		return
+	}
+	if quotaExhausted {
+		bifrostErr.RateLimitKind = schemas.RateLimitKindQuotaExhausted
+	} else {
+		bifrostErr.RateLimitKind = schemas.RateLimitKindRateLimited
+	}
+}
+
+// ClassifyRateLimitError classifies a 429 error from its error type, code and message, and from the
+// provider-specific subtypes given (e.g. an exception name returned in a header).
+// A provider-specific subtype meaning a used up quota classifies the error whatever its status code,
+// as some providers return it with another status (e.g. Bedrock's ServiceQuotaExceededException is a 400).
+func ClassifyRateLimitError(bifrostErr *schemas.BifrostError, subtypes ...string) {
+	if bifrostErr == nil {
+		return
+	}
+	for _, subtype := range subtypes {
+		if IsQuotaExhaustedSubtype(subtype) {
+			bifrostErr.RateLimitKind = schemas.RateLimitKindQuotaExhausted
+			return
+		}
+	}
+	quotaExhausted := false
+	if errorField := bifrostErr.Error; errorField != nil {
+		if errorField.Type != nil && IsQuotaExhaustedSubtype(*errorField.Type) {
+			quotaExhausted = true
+		}
+		if errorField.Code != nil && IsQuotaExhaustedSubtype(*errorField.Code) {
+			quotaExhausted = true
+		}
+		message := strings.ToLower(errorField.Message)
+		for _, pattern := range quotaExhaustedMessagePatterns {
+			if strings.Contains(message, pattern) {
+				quotaExhausted = true
+				break
+			}
+		}
+	}
+	SetRateLimitKind(bifrostErr, quotaExhausted)
+}
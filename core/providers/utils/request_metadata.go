@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"fmt"
+	"maps"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// RequestMetadataUserID is the request metadata key mapped to the user fields of providers whose metadata only
+// holds a user (Anthropic metadata.user_id).
+const RequestMetadataUserID = "user_id"
+
+// requestMetadataTarget is the native metadata field of a provider and its constraints.
+type requestMetadataTarget struct {
+	field          string // Provider field, for errors
+	userOnly       bool   // Only the RequestMetadataUserID entry is mapped, other entries are dropped
+	maxKeys        int
+	maxKeyLength   int
+	maxValueLength int
+}
+
+// requestMetadataTargets are the native metadata fields request metadata is mapped to, providers without a target
+// do not receive request metadata.
+var requestMetadataTargets = map[schemas.ModelProvider]requestMetadataTarget{
+	schemas.OpenAI:    {field: "metadata", maxKeys: 16, maxKeyLength: 64, maxValueLength: 512},
+	schemas.Azure:     {field: "metadata", maxKeys: 16, maxKeyLength: 64, maxValueLength: 512},
+	schemas.Anthropic: {field: "metadata.user_id", userOnly: true, maxValueLength: 256},
+	schemas.Bedrock:   {field: "requestMetadata", maxKeys: 16, maxKeyLength: 256, maxValueLength: 256},
+}
+
+// getRequestMetadataTarget returns the native metadata field of a provider for a model. Anthropic models served by
+// Vertex take Anthropic request bodies.
+func getRequestMetadataTarget(provider schemas.ModelProvider, model string) (requestMetadataTarget, bool) {
+	if provider == schemas.Vertex && schemas.IsAnthropicModel(model) {
+		provider = schemas.Anthropic
+	}
+	target, ok := requestMetadataTargets[provider]
+	return target, ok
+}
+
+// merge adds the request metadata to the metadata already set on the request, whose entries take precedence, and
+// checks the result against the provider's constraints.
+func (target requestMetadataTarget) merge(provider schemas.ModelProvider, existing map[string]string, metadata map[string]string) (map[string]string, error) {
+	merged := make(map[string]string, len(existing)+len(metadata))
+	maps.Copy(merged, metadata)
+	maps.Copy(merged, existing)
+	if len(merged) > target.maxKeys {
+		return nil, fmt.Errorf("%s %s allows at most %d keys, got %d", provider, target.field, target.maxKeys, len(merged))
+	}
+	for key, value := range merged {
+		if key == "" || len(key) > target.maxKeyLength {
+			return nil, fmt.Errorf("%s %s keys must be 1 to %d characters long, got %q", provider, target.field, target.maxKeyLength, key)
+		}
+		if len(value) > target.maxValueLength {
+			return nil, fmt.Errorf("%s %s values must be at most %d characters long, the value of %q is %d characters long", provider, target.field, target.maxValueLength, key, len(value))
+		}
+	}
+	return merged, nil
+}
+
+// userID returns the user of the request metadata, checked against the provider's constraints.
+func (target requestMetadataTarget) userID(provider schemas.ModelProvider, metadata map[string]string) (*string, error) {
+	userID, ok := metadata[RequestMetadataUserID]
+	if !ok {
+		return nil, nil
+	}
+	if len(userID) > target.maxValueLength {
+		return nil, fmt.Errorf("%s %s must be at most %d characters long, got %d", provider, target.field, target.maxValueLength, len(userID))
+	}
+	return &userID, nil
+}
+
+// ApplyChatRequestMetadata maps request metadata to the native metadata field of the provider: OpenAI and Azure
+// metadata, Anthropic metadata.user_id (through the user parameter) and Bedrock requestMetadata. Metadata already
+// set on the request takes precedence. The caller's request is left untouched, a copy is returned if metadata is
+// mapped. An error is returned if the metadata breaks the provider's constraints (key count, key and value lengths).
+func ApplyChatRequestMetadata(provider schemas.ModelProvider, request *schemas.BifrostChatRequest, metadata map[string]string) (*schemas.BifrostChatRequest, error) {
+	target, ok := getRequestMetadataTarget(provider, request.Model)
+	if !ok || len(metadata) == 0 {
+		return request, nil
+	}
+	var params schemas.ChatParameters
+	if request.Params != nil {
+		params = *request.Params
+	}
+
+	switch {
+	case target.userOnly:
+		if params.User != nil {
+			return request, nil
+		}
+		userID, err := target.userID(provider, metadata)
+		if err != nil || userID == nil {
+			return request, err
+		}
+		params.User = userID
+	case provider == schemas.Bedrock:
+		extraParams, err := mergeBedrockRequestMetadata(target, provider, params.ExtraParams, metadata)
+		if err != nil {
+			return nil, err
+		}
+		params.ExtraParams = extraParams
+	default:
+		merged, err := mergeMetadataParam(target, provider, params.Metadata, metadata)
+		if err != nil {
+			return nil, err
+		}
+		params.Metadata = merged
+	}
+
+	mapped := *request
+	mapped.Params = &params
+	return &mapped, nil
+}
+
+// ApplyResponsesRequestMetadata is ApplyChatRequestMetadata for responses requests.
+func ApplyResponsesRequestMetadata(provider schemas.ModelProvider, request *schemas.BifrostResponsesRequest, metadata map[string]string) (*schemas.BifrostResponsesRequest, error) {
+	target, ok := getRequestMetadataTarget(provider, request.Model)
+	if !ok || len(metadata) == 0 {
+		return request, nil
+	}
+	var params schemas.ResponsesParameters
+	if request.Params != nil {
+		params = *request.Params
+	}
+
+	switch {
+	case target.userOnly:
+		if params.User != nil {
+			return request, nil
+		}
+		userID, err := target.userID(provider, metadata)
+		if err != nil || userID == nil {
+			return request, err
+		}
+		params.User = userID
+	case provider == schemas.Bedrock:
+		extraParams, err := mergeBedrockRequestMetadata(target, provider, params.ExtraParams, metadata)
+		if err != nil {
+			return nil, err
+		}
+		params.ExtraParams = extraParams
+	default:
+		merged, err := mergeMetadataParam(target, provider, params.Metadata, metadata)
+		if err != nil {
+			return nil, err
+		}
+		params.Metadata = merged
+	}
+
+	mapped := *request
+	mapped.Params = &params
+	return &mapped, nil
+}
+
+// mergeMetadataParam merges request metadata into a metadata parameter. Existing entries that are not strings are
+// kept as is and only count towards the key limit.
+func mergeMetadataParam(target requestMetadataTarget, provider schemas.ModelProvider, existing *map[string]any, metadata map[string]string) (*map[string]any, error) {
+	existingStrings := make(map[string]string)
+	result := make(map[string]any)
+	if existing != nil {
+		for key, value := range *existing {
+			result[key] = value
+			if s, ok := value.(string); ok {
+				existingStrings[key] = s
+			} else {
+				existingStrings[key] = ""
+			}
+		}
+	}
+	merged, err := target.merge(provider, existingStrings, metadata)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range merged {
+		if _, ok := result[key]; !ok {
+			result[key] = value
+		}
+	}
+	return &result, nil
+}
+
+// mergeBedrockRequestMetadata merges request metadata into the requestMetadata extra parameter of Bedrock requests.
+func mergeBedrockRequestMetadata(target requestMetadataTarget, provider schemas.ModelProvider, extraParams map[string]interface{}, metadata map[string]string) (map[string]interface{}, error) {
+	existing := make(map[string]string)
+	switch requestMetadata := extraParams["requestMetadata"].(type) {
+	case map[string]string:
+		existing = requestMetadata
+	case map[string]interface{}:
+		for key, value := range requestMetadata {
+			if s, ok := value.(string); ok {
+				existing[key] = s
+			}
+		}
+	}
+	merged, err := target.merge(provider, existing, metadata)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]interface{}, len(extraParams)+1)
+	maps.Copy(result, extraParams)
+	result["requestMetadata"] = merged
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that request metadata is mapped to each provider's native metadata field
+func TestApplyChatRequestMetadata(t *testing.T) {
+	metadata := map[string]string{"user_id": "user-123", "session": "s-1"}
+	request := func(provider schemas.ModelProvider, model string, params *schemas.ChatParameters) *schemas.BifrostChatRequest {
+		return &schemas.BifrostChatRequest{Provider: provider, Model: model, Params: params}
+	}
+
+	t.Run("OpenAI", func(t *testing.T) {
+		original := request(schemas.OpenAI, "gpt-4o-mini", &schemas.ChatParameters{Metadata: &map[string]any{"session": "explicit", "tier": 2}})
+		mapped, err := ApplyChatRequestMetadata(schemas.OpenAI, original, metadata)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := *mapped.Params.Metadata
+		if got["user_id"] != "user-123" || got["session"] != "explicit" || got["tier"] != 2 {
+			t.Errorf("expected the request metadata to be merged under the explicit metadata, got %v", got)
+		}
+		if len(*original.Params.Metadata) != 2 {
+			t.Error("expected the caller's request to be left untouched")
+		}
+	})
+
+	t.Run("Anthropic", func(t *testing.T) {
+		mapped, err := ApplyChatRequestMetadata(schemas.Anthropic, request(schemas.Anthropic, "claude-sonnet-4-5", nil), metadata)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if mapped.Params.User == nil || *mapped.Params.User != "user-123" {
+			t.Errorf("expected user user-123, got %v", mapped.Params.User)
+		}
+
+		explicit := request(schemas.Anthropic, "claude-sonnet-4-5", &schemas.ChatParameters{User: schemas.Ptr("explicit")})
+		mapped, err = ApplyChatRequestMetadata(schemas.Anthropic, explicit, metadata)
+		if err != nil || *mapped.Params.User != "explicit" {
+			t.Errorf("expected the explicit user to be kept, got %v (%v)", mapped.Params.User, err)
+		}
+	})
+
+	t.Run("VertexAnthropicModel", func(t *testing.T) {
+		mapped, err := ApplyChatRequestMetadata(schemas.Vertex, request(schemas.Vertex, "claude-sonnet-4-5", nil), metadata)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if mapped.Params.User == nil || *mapped.Params.User != "user-123" {
+			t.Errorf("expected user user-123, got %v", mapped.Params.User)
+		}
+	})
+
+	t.Run("Bedrock", func(t *testing.T) {
+		params := &schemas.ChatParameters{ExtraParams: map[string]interface{}{"requestMetadata": map[string]string{"team": "search"}}}
+		mapped, err := ApplyChatRequestMetadata(schemas.Bedrock, request(schemas.Bedrock, "anthropic.claude-3-haiku", params), metadata)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, ok := mapped.Params.ExtraParams["requestMetadata"].(map[string]string)
+		if !ok || len(got) != 3 || got["team"] != "search" || got["session"] != "s-1" {
+			t.Errorf("expected the request metadata to be merged into requestMetadata, got %v", mapped.Params.ExtraParams["requestMetadata"])
+		}
+	})
+
+	t.Run("UnsupportedProvider", func(t *testing.T) {
+		original := request(schemas.Groq, "llama-3.1-8b-instant", nil)
+		mapped, err := ApplyChatRequestMetadata(schemas.Groq, original, metadata)
+		if err != nil || mapped != original {
+			t.Errorf("expected the request to be sent as is, got %+v (%v)", mapped, err)
+		}
+	})
+}
+
+// Test that request metadata breaking a provider's constraints is rejected
+func TestApplyRequestMetadata_Constraints(t *testing.T) {
+	tooManyKeys := make(map[string]string)
+	for _, key := range strings.Split("a b c d e f g h i j k l m n o p q", " ") {
+		tooManyKeys[key] = "v"
+	}
+	tests := []struct {
+		name     string
+		provider schemas.ModelProvider
+		metadata map[string]string
+		expected string
+	}{
+		{"OpenAIKeyCount", schemas.OpenAI, tooManyKeys, "at most 16 keys"},
+		{"OpenAIKeyLength", schemas.OpenAI, map[string]string{strings.Repeat("k", 65): "v"}, "keys must be 1 to 64 characters long"},
+		{"OpenAIValueLength", schemas.OpenAI, map[string]string{"session": strings.Repeat("v", 513)}, "values must be at most 512 characters long"},
+		{"AnthropicUserIDLength", schemas.Anthropic, map[string]string{"user_id": strings.Repeat("u", 257)}, "must be at most 256 characters long"},
+		{"BedrockValueLength", schemas.Bedrock, map[string]string{"session": strings.Repeat("v", 257)}, "values must be at most 256 characters long"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ApplyResponsesRequestMetadata(tt.provider, &schemas.BifrostResponsesRequest{Provider: tt.provider, Model: "model"}, tt.metadata)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected an error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
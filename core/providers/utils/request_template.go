@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+// ApplyRequestBodyTemplate reshapes a JSON request body according to a custom provider's request body template:
+// each target path of the template fields is set to the value at its source path, unset target paths with a default
+// are set to it, and the top-level fields not used as a source are kept only if the template keeps unmapped fields.
+// Fields whose source path is not set in the body are skipped. Target paths are set in sorted order, so that
+// conflicting paths (e.g. "parameters" and "parameters.temperature") fail consistently.
+func ApplyRequestBodyTemplate(body []byte, template *schemas.RequestBodyTemplate) ([]byte, error) {
+	if template == nil {
+		return body, nil
+	}
+	var source map[string]any
+	if err := serialization.Unmarshal(body, &source); err != nil {
+		return nil, fmt.Errorf("request body is not a JSON object: %w", err)
+	}
+
+	target := make(map[string]any)
+	mappedSources := make(map[string]bool)
+	for _, targetPath := range slices.Sorted(maps.Keys(template.Fields)) {
+		sourcePath := template.Fields[targetPath]
+		mappedSources[strings.SplitN(sourcePath, ".", 2)[0]] = true
+		if value, ok := getJSONPath(source, sourcePath); ok {
+			if err := setJSONPath(target, targetPath, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if template.KeepUnmapped {
+		for key, value := range source {
+			if mappedSources[key] {
+				continue
+			}
+			if _, exists := target[key]; !exists {
+				target[key] = value
+			}
+		}
+	}
+	for _, targetPath := range slices.Sorted(maps.Keys(template.Defaults)) {
+		value := template.Defaults[targetPath]
+		if _, exists := getJSONPath(target, targetPath); exists {
+			continue
+		}
+		if err := setJSONPath(target, targetPath, value); err != nil {
+			return nil, err
+		}
+	}
+	return serialization.MarshalIndent(target, "", "  ")
+}
+
+// getJSONPath returns the value at a dot-separated path of a decoded JSON object.
+func getJSONPath(object map[string]any, path string) (any, bool) {
+	segments := strings.Split(path, ".")
+	var value any = object
+	for _, segment := range segments {
+		current, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = current[segment]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// setJSONPath sets the value at a dot-separated path of a decoded JSON object, creating the intermediate objects.
+func setJSONPath(object map[string]any, path string, value any) error {
+	segments := strings.Split(path, ".")
+	current := object
+	for i, segment := range segments[:len(segments)-1] {
+		next, exists := current[segment]
+		if !exists {
+			child := make(map[string]any)
+			current[segment] = child
+			current = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot set %q: %q is not an object", path, strings.Join(segments[:i+1], "."))
+		}
+		current = child
+	}
+	current[segments[len(segments)-1]] = value
+	return nil
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+// Test that a chat request body is reshaped with field renames, nesting and defaults
+func TestApplyRequestBodyTemplate(t *testing.T) {
+	body := []byte(`{"model":"llama-3","messages":[{"role":"user","content":"hello"}],"temperature":0.2,"max_tokens":64,"user":"u-1"}`)
+
+	tests := []struct {
+		name     string
+		template *schemas.RequestBodyTemplate
+		expected map[string]any
+	}{
+		{
+			name: "renames, nesting and defaults",
+			template: &schemas.RequestBodyTemplate{
+				Fields: map[string]string{
+					"model_id":                  "model",
+					"inputs.conversation":       "messages",
+					"parameters.temperature":    "temperature",
+					"parameters.max_new_tokens": "max_tokens",
+					"parameters.top_p":          "top_p", // not set in the request
+				},
+				Defaults: map[string]any{
+					"parameters.temperature": 1.0, // set by a field, not overridden
+					"parameters.do_sample":   true,
+					"stream":                 false,
+				},
+			},
+			expected: map[string]any{
+				"model_id": "llama-3",
+				"inputs": map[string]any{
+					"conversation": []any{map[string]any{"role": "user", "content": "hello"}},
+				},
+				"parameters": map[string]any{
+					"temperature":    0.2,
+					"max_new_tokens": float64(64),
+					"do_sample":      true,
+				},
+				"stream": false,
+			},
+		},
+		{
+			name: "keep unmapped fields",
+			template: &schemas.RequestBodyTemplate{
+				Fields:       map[string]string{"prompt": "messages", "options.temperature": "temperature"},
+				KeepUnmapped: true,
+			},
+			expected: map[string]any{
+				"model":      "llama-3",
+				"prompt":     []any{map[string]any{"role": "user", "content": "hello"}},
+				"options":    map[string]any{"temperature": 0.2},
+				"max_tokens": float64(64),
+				"user":       "u-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reshaped, err := ApplyRequestBodyTemplate(body, tt.template)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var actual map[string]any
+			if err := serialization.Unmarshal(reshaped, &actual); err != nil {
+				t.Fatalf("reshaped body is not JSON: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected body %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+// Test that a template setting a path under a non-object value is rejected
+func TestApplyRequestBodyTemplate_Conflict(t *testing.T) {
+	template := &schemas.RequestBodyTemplate{
+		Fields: map[string]string{"parameters": "model", "parameters.temperature": "temperature"},
+	}
+	if _, err := ApplyRequestBodyTemplate([]byte(`{"model":"m","temperature":0.2}`), template); err == nil {
+		t.Error("expected an error for conflicting target paths")
+	}
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Unified speech output formats, as named by OpenAI.
+const (
+	SpeechFormatMP3  = "mp3"
+	SpeechFormatOpus = "opus"
+	SpeechFormatAAC  = "aac"
+	SpeechFormatFLAC = "flac"
+	SpeechFormatWAV  = "wav"
+	SpeechFormatPCM  = "pcm"
+)
+
+// speechCatalog describes the voices and output formats a speech provider accepts.
+type speechCatalog struct {
+	voices        map[string]string // Unified voice (OpenAI voice name) to provider voice
+	formats       []string          // Unified formats the provider returns, natively or transcoded
+	nativeFormats *regexp.Regexp    // Provider-specific formats sent as is (optional)
+}
+
+// speechCatalogs are the speech catalogs of the providers, providers without a catalog are not normalized.
+// Voices without a mapping are sent as is, so that provider-specific voices keep working.
+var speechCatalogs = map[schemas.ModelProvider]speechCatalog{
+	schemas.OpenAI: {
+		formats: []string{SpeechFormatMP3, SpeechFormatOpus, SpeechFormatAAC, SpeechFormatFLAC, SpeechFormatWAV, SpeechFormatPCM},
+	},
+	schemas.Azure: {
+		formats: []string{SpeechFormatMP3, SpeechFormatOpus, SpeechFormatAAC, SpeechFormatFLAC, SpeechFormatWAV, SpeechFormatPCM},
+	},
+	// Prebuilt voices, the PCM output is transcoded to WAV
+	schemas.Gemini: {
+		voices: map[string]string{
+			"alloy":   "Zephyr",
+			"ash":     "Orus",
+			"ballad":  "Enceladus",
+			"coral":   "Aoede",
+			"echo":    "Puck",
+			"fable":   "Fenrir",
+			"onyx":    "Charon",
+			"nova":    "Leda",
+			"sage":    "Kore",
+			"shimmer": "Callirrhoe",
+			"verse":   "Iapetus",
+		},
+		formats: []string{SpeechFormatWAV, SpeechFormatPCM},
+	},
+	// Premade voice IDs
+	schemas.Elevenlabs: {
+		voices: map[string]string{
+			"alloy":   "21m00Tcm4TlvDq8ikWAM", // Rachel
+			"ash":     "TxGEqnHWrfWFTfGW9XjX", // Josh
+			"ballad":  "VR6AewLTigWG4xSOukaG", // Arnold
+			"coral":   "EXAVITQu4vr4xnSDxMaL", // Bella
+			"echo":    "ErXwobaYiN019PkySvjV", // Antoni
+			"fable":   "yoZ06aMxZJJ28mfd3POQ", // Sam
+			"onyx":    "pNInz6obpgDQGcFmaJgB", // Adam
+			"nova":    "MF3mGyEYCl7XYWbV9V6O", // Elli
+			"sage":    "AZnzlk1XvdvUeBnXmlld", // Domi
+			"shimmer": "EXAVITQu4vr4xnSDxMaL", // Bella
+			"verse":   "ErXwobaYiN019PkySvjV", // Antoni
+		},
+		formats:       []string{SpeechFormatMP3, SpeechFormatOpus, SpeechFormatWAV, SpeechFormatPCM},
+		nativeFormats: regexp.MustCompile(`^((mp3|pcm|opus)_\d+(_\d+)?|(ulaw|alaw)_8000)$`),
+	},
+}
+
+// NormalizeSpeechRequest maps the unified voice of a speech request to the provider's voice, and checks that the
+// provider can return the requested output format. The caller's request is left untouched, a copy is returned if
+// a voice is mapped. An error is returned for output formats the provider does not support.
+func NormalizeSpeechRequest(provider schemas.ModelProvider, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechRequest, error) {
+	catalog, ok := speechCatalogs[provider]
+	if !ok || request == nil || request.Params == nil {
+		return request, nil
+	}
+	params := request.Params
+
+	if format := params.ResponseFormat; format != "" && !slices.Contains(catalog.formats, format) &&
+		(catalog.nativeFormats == nil || !catalog.nativeFormats.MatchString(format)) {
+		return nil, fmt.Errorf("response_format %q is not supported by %s, supported formats are: %s", format, provider, strings.Join(catalog.formats, ", "))
+	}
+
+	voiceConfig := params.VoiceConfig
+	if voiceConfig == nil || len(catalog.voices) == 0 {
+		return request, nil
+	}
+	normalizedVoiceConfig := &schemas.SpeechVoiceInput{}
+	mapped := false
+	if voiceConfig.Voice != nil {
+		voice := *voiceConfig.Voice
+		if providerVoice, ok := catalog.voices[strings.ToLower(voice)]; ok {
+			voice, mapped = providerVoice, true
+		}
+		normalizedVoiceConfig.Voice = &voice
+	}
+	if len(voiceConfig.MultiVoiceConfig) > 0 {
+		normalizedVoiceConfig.MultiVoiceConfig = make([]schemas.VoiceConfig, len(voiceConfig.MultiVoiceConfig))
+		for i, speaker := range voiceConfig.MultiVoiceConfig {
+			if providerVoice, ok := catalog.voices[strings.ToLower(speaker.Voice)]; ok {
+				speaker.Voice, mapped = providerVoice, true
+			}
+			normalizedVoiceConfig.MultiVoiceConfig[i] = speaker
+		}
+	}
+	if !mapped {
+		return request, nil
+	}
+
+	normalizedParams := *params
+	normalizedParams.VoiceConfig = normalizedVoiceConfig
+	normalized := *request
+	normalized.Params = &normalizedParams
+	return &normalized, nil
+}
+
+// DetectSpeechFormat returns the unified format of audio data, from its headers, or "" if it is not recognized.
+func DetectSpeechFormat(audio []byte) string {
+	switch DetectAudioMimeType(audio) {
+	case "audio/wav":
+		return SpeechFormatWAV
+	case "audio/mp3":
+		return SpeechFormatMP3
+	case "audio/aac":
+		return SpeechFormatAAC
+	case "audio/flac":
+		return SpeechFormatFLAC
+	case "audio/ogg":
+		return SpeechFormatOpus
+	}
+	return ""
+}
+
+// CheckSpeechFormat checks that audio returned by a provider whose output format cannot be selected is in the
+// requested format. Raw PCM can not be detected, only container formats are checked.
+func CheckSpeechFormat(provider schemas.ModelProvider, requested string, audio []byte) error {
+	if requested == "" || requested == SpeechFormatPCM {
+		return nil
+	}
+	if detected := DetectSpeechFormat(audio); detected != "" && detected != requested {
+		return fmt.Errorf("%s returned %s audio but response_format %q was requested, the model's output format cannot be selected", provider, detected, requested)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that unified voices are mapped to each provider's voices, and that other voices are sent as is
+func TestNormalizeSpeechRequest_Voices(t *testing.T) {
+	request := func(voice string) *schemas.BifrostSpeechRequest {
+		return &schemas.BifrostSpeechRequest{
+			Input:  &schemas.SpeechInput{Input: "Hello"},
+			Params: &schemas.SpeechParameters{VoiceConfig: &schemas.SpeechVoiceInput{Voice: schemas.Ptr(voice)}},
+		}
+	}
+	tests := []struct {
+		provider schemas.ModelProvider
+		voice    string
+		expected string
+	}{
+		{schemas.OpenAI, "alloy", "alloy"},
+		{schemas.Gemini, "alloy", "Zephyr"},
+		{schemas.Gemini, "Nova", "Leda"},
+		{schemas.Gemini, "Kore", "Kore"},
+		{schemas.Elevenlabs, "onyx", "pNInz6obpgDQGcFmaJgB"},
+		{schemas.Elevenlabs, "custom-voice-id", "custom-voice-id"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.provider)+"/"+tt.voice, func(t *testing.T) {
+			original := request(tt.voice)
+			normalized, err := NormalizeSpeechRequest(tt.provider, original)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := *normalized.Params.VoiceConfig.Voice; got != tt.expected {
+				t.Errorf("expected voice %q, got %q", tt.expected, got)
+			}
+			if *original.Params.VoiceConfig.Voice != tt.voice {
+				t.Error("expected the caller's request to be left untouched")
+			}
+		})
+	}
+
+	multiSpeaker := &schemas.BifrostSpeechRequest{
+		Params: &schemas.SpeechParameters{VoiceConfig: &schemas.SpeechVoiceInput{MultiVoiceConfig: []schemas.VoiceConfig{
+			{Speaker: "Joe", Voice: "echo"},
+			{Speaker: "Jane", Voice: "Kore"},
+		}}},
+	}
+	normalized, err := NormalizeSpeechRequest(schemas.Gemini, multiSpeaker)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	speakers := normalized.Params.VoiceConfig.MultiVoiceConfig
+	if speakers[0].Voice != "Puck" || speakers[1].Voice != "Kore" || speakers[0].Speaker != "Joe" {
+		t.Errorf("unexpected speaker voices %+v", speakers)
+	}
+}
+
+// Test that output formats a provider cannot return are rejected with the supported formats
+func TestNormalizeSpeechRequest_Formats(t *testing.T) {
+	tests := []struct {
+		provider  schemas.ModelProvider
+		format    string
+		supported bool
+	}{
+		{schemas.OpenAI, "flac", true},
+		{schemas.OpenAI, "ogg", false},
+		{schemas.Gemini, "wav", true},
+		{schemas.Gemini, "mp3", false},
+		{schemas.Elevenlabs, "opus", true},
+		{schemas.Elevenlabs, "mp3_22050_32", true},
+		{schemas.Elevenlabs, "aac", false},
+		{schemas.HuggingFace, "aac", true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.provider)+"/"+tt.format, func(t *testing.T) {
+			_, err := NormalizeSpeechRequest(tt.provider, &schemas.BifrostSpeechRequest{
+				Params: &schemas.SpeechParameters{ResponseFormat: tt.format},
+			})
+			if tt.supported && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.supported && err == nil {
+				t.Error("expected the format to be rejected")
+			}
+		})
+	}
+}
+
+// Test that audio in another format than requested is detected
+func TestCheckSpeechFormat(t *testing.T) {
+	wav := []byte("RIFF\x24\x00\x00\x00WAVEfmt ")
+	if err := CheckSpeechFormat(schemas.HuggingFace, SpeechFormatWAV, wav); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := CheckSpeechFormat(schemas.HuggingFace, SpeechFormatFLAC, wav); err == nil {
+		t.Error("expected wav audio to be rejected when flac is requested")
+	}
+	if err := CheckSpeechFormat(schemas.HuggingFace, "", wav); err != nil {
+		t.Errorf("unexpected error without requested format: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// StreamFormat is the framing of a streaming response body.
+type StreamFormat string
+
+const (
+	StreamFormatSSE    StreamFormat = "sse"    // Server-sent events, each payload in a "data:" field
+	StreamFormatNDJSON StreamFormat = "ndjson" // Newline-delimited JSON, each line a payload
+)
+
+// ndjsonContentTypes are the content types of newline-delimited JSON streams.
+var ndjsonContentTypes = []string{"application/x-ndjson", "application/ndjson", "application/jsonl", "application/x-jsonlines", "application/json-seq"}
+
+// StreamReader reads the payloads of a streaming response body, whether the provider frames them as SSE events
+// or as newline-delimited JSON. The format is detected from the content type or, failing that, from the first
+// line of the body: a line that is not an SSE field or comment means NDJSON.
+//
+// In SSE streams, lines that are not SSE fields are returned as payloads, as providers send JSON errors without
+// a "data:" prefix. A "[DONE]" payload ends the stream in both formats.
+type StreamReader struct {
+	scanner  *bufio.Scanner
+	format   StreamFormat
+	detected bool
+	event    string
+	data     string
+}
+
+// NewStreamReader returns a reader of the payloads of a streaming response body with the given content type.
+func NewStreamReader(body io.Reader, contentType string) *StreamReader {
+	scanner := bufio.NewScanner(body)
+	buf := make([]byte, 0, 1024*1024)
+	scanner.Buffer(buf, 10*1024*1024)
+
+	reader := &StreamReader{scanner: scanner, format: StreamFormatSSE}
+	contentType = strings.ToLower(contentType)
+	for _, ndjsonContentType := range ndjsonContentTypes {
+		if strings.HasPrefix(contentType, ndjsonContentType) {
+			reader.format = StreamFormatNDJSON
+			reader.detected = true
+			break
+		}
+	}
+	if strings.HasPrefix(contentType, "text/event-stream") {
+		reader.detected = true
+	}
+	return reader
+}
+
+// Next advances to the next payload, returning false at the end of the stream or on a read error (see Err).
+func (r *StreamReader) Next() bool {
+	for r.scanner.Scan() {
+		line := strings.TrimRight(r.scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if !r.detected {
+			r.detected = true
+			if !isSSELine(line) {
+				r.format = StreamFormatNDJSON
+			}
+		}
+
+		data := line
+		if r.format == StreamFormatSSE {
+			field, value, _ := strings.Cut(line, ":")
+			switch {
+			case field == "":
+				// Comment
+				continue
+			case field == "event":
+				r.event = strings.TrimPrefix(value, " ")
+				continue
+			case field == "id" || field == "retry":
+				continue
+			case field == "data":
+				data = strings.TrimPrefix(value, " ")
+			}
+		}
+		if strings.TrimSpace(data) == "" {
+			continue
+		}
+		if data == "[DONE]" {
+			return false
+		}
+		r.data = data
+		return true
+	}
+	return false
+}
+
+// Data returns the current payload.
+func (r *StreamReader) Data() string {
+	return r.data
+}
+
+// Event returns the name of the last SSE event received, empty for NDJSON streams and unnamed events.
+func (r *StreamReader) Event() string {
+	return r.event
+}
+
+// Format returns the detected format of the stream.
+func (r *StreamReader) Format() StreamFormat {
+	return r.format
+}
+
+// Err returns the error that stopped the reader, nil at the end of the stream.
+func (r *StreamReader) Err() error {
+	return r.scanner.Err()
+}
+
+// isSSELine reports whether a line is an SSE comment or field.
+func isSSELine(line string) bool {
+	if strings.HasPrefix(line, ":") {
+		return true
+	}
+	field, _, found := strings.Cut(line, ":")
+	return found && (field == "data" || field == "event" || field == "id" || field == "retry")
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+// readStream returns the payloads and the detected format of a streaming body
+func readStream(body string, contentType string) ([]string, StreamFormat) {
+	reader := NewStreamReader(strings.NewReader(body), contentType)
+	var payloads []string
+	for reader.Next() {
+		payloads = append(payloads, reader.Data())
+	}
+	return payloads, reader.Format()
+}
+
+// Test that SSE and NDJSON streams are read to the same payloads, detected from the content type or the body
+func TestStreamReader(t *testing.T) {
+	expected := []string{`{"id":"1"}`, `{"id":"2"}`}
+	tests := []struct {
+		name           string
+		contentType    string
+		body           string
+		expectedFormat StreamFormat
+	}{
+		{
+			name:           "sse",
+			contentType:    "text/event-stream; charset=utf-8",
+			body:           ": keep-alive\n\nevent: chunk\ndata: {\"id\":\"1\"}\n\ndata:{\"id\":\"2\"}\r\n\r\ndata: [DONE]\n\ndata: {\"id\":\"3\"}\n",
+			expectedFormat: StreamFormatSSE,
+		},
+		{
+			name:           "ndjson content type",
+			contentType:    "application/x-ndjson",
+			body:           "{\"id\":\"1\"}\n\n{\"id\":\"2\"}\n",
+			expectedFormat: StreamFormatNDJSON,
+		},
+		{
+			name:           "ndjson without content type",
+			contentType:    "application/json",
+			body:           "{\"id\":\"1\"}\n{\"id\":\"2\"}",
+			expectedFormat: StreamFormatNDJSON,
+		},
+		{
+			name:           "sse without content type",
+			body:           "data: {\"id\":\"1\"}\n\ndata: {\"id\":\"2\"}\n\n",
+			expectedFormat: StreamFormatSSE,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payloads, format := readStream(tt.body, tt.contentType)
+			if format != tt.expectedFormat {
+				t.Errorf("expected format %s, got %s", tt.expectedFormat, format)
+			}
+			if strings.Join(payloads, ",") != strings.Join(expected, ",") {
+				t.Errorf("expected payloads %v, got %v", expected, payloads)
+			}
+		})
+	}
+}
+
+// Test that JSON errors sent without a data prefix in an SSE stream are returned as payloads
+func TestStreamReader_SSERawJSONError(t *testing.T) {
+	payloads, format := readStream("data: {\"id\":\"1\"}\n\n{\"error\":{\"message\":\"overloaded\"}}\n", "text/event-stream")
+	if format != StreamFormatSSE {
+		t.Errorf("expected format %s, got %s", StreamFormatSSE, format)
+	}
+	if len(payloads) != 2 || payloads[1] != `{"error":{"message":"overloaded"}}` {
+		t.Errorf("expected the raw JSON error as payload, got %v", payloads)
+	}
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"slices"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// tlsVersions maps the supported TLS minimum versions to their crypto/tls values.
+// Versions older than TLS 1.2 are deliberately not supported.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// BuildTLSConfig returns the TLS configuration for provider connections, applying the given TLS config
+// on top of base (e.g. a custom CA set up for a proxy), which is cloned and may be nil.
+// Connections require TLS 1.2 or later when no minimum version is configured.
+// An error is returned for unsupported versions and unknown, insecure or TLS 1.3 only cipher suites.
+func BuildTLSConfig(base *tls.Config, config *schemas.TLSConfig) (*tls.Config, error) {
+	var tlsConfig *tls.Config
+	if base != nil {
+		tlsConfig = base.Clone()
+	} else {
+		tlsConfig = &tls.Config{}
+	}
+	if tlsConfig.MinVersion < tls.VersionTLS12 {
+		tlsConfig.MinVersion = tls.VersionTLS12
+	}
+	if config == nil {
+		return tlsConfig, nil
+	}
+
+	if config.MinVersion != "" {
+		version, ok := tlsVersions[config.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unsupported TLS min version %q, expected \"1.2\" or \"1.3\"", config.MinVersion)
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if len(config.CipherSuites) > 0 {
+		cipherSuites := make([]uint16, 0, len(config.CipherSuites))
+		for _, name := range config.CipherSuites {
+			id, err := tls12CipherSuiteID(name)
+			if err != nil {
+				return nil, err
+			}
+			cipherSuites = append(cipherSuites, id)
+		}
+		tlsConfig.CipherSuites = cipherSuites
+	}
+
+	tlsConfig.InsecureSkipVerify = config.InsecureSkipVerify
+	return tlsConfig, nil
+}
+
+// tls12CipherSuiteID returns the ID of a secure TLS 1.2 cipher suite from its IANA name.
+func tls12CipherSuiteID(name string) (uint16, error) {
+	for _, suite := range tls.CipherSuites() {
+		if suite.Name != name {
+			continue
+		}
+		if !slices.Contains(suite.SupportedVersions, tls.VersionTLS12) {
+			return 0, fmt.Errorf("cipher suite %s is TLS 1.3 only, TLS 1.3 cipher suites are not configurable", name)
+		}
+		return suite.ID, nil
+	}
+	for _, suite := range tls.InsecureCipherSuites() {
+		if suite.Name == name {
+			return 0, fmt.Errorf("cipher suite %s is insecure", name)
+		}
+	}
+	return 0, fmt.Errorf("unknown cipher suite %q", name)
+}
+
+// ConfigureTLS applies the TLS configuration to the fasthttp client. It must be called after ConfigureProxy,
+// as it keeps the custom CA certificate configured for the proxy.
+// An invalid configuration is rejected like in config validation and provider creation: it is never replaced by
+// other settings, every connection of the client fails with the configuration error instead.
+func ConfigureTLS(client *fasthttp.Client, config *schemas.TLSConfig, logger schemas.Logger) *fasthttp.Client {
+	tlsConfig, err := BuildTLSConfig(client.TLSConfig, config)
+	if err != nil {
+		err = fmt.Errorf("invalid TLS configuration: %w", err)
+		if logger != nil {
+			logger.Error(err.Error())
+		}
+		client.Dial = func(addr string) (net.Conn, error) {
+			return nil, err
+		}
+		return client
+	}
+	client.TLSConfig = tlsConfig
+	return client
+}
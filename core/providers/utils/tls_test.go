@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// newTLS12OnlyServer returns a TLS server that offers TLS 1.2 at most
+func newTLS12OnlyServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doTLSTestRequest(client *fasthttp.Client, url string) error {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+	req.SetRequestURI(url)
+	return client.Do(req, resp)
+}
+
+// Test that a connection is refused when the server only offers a TLS version below the configured minimum
+func TestConfigureTLS_RefusesDisallowedVersion(t *testing.T) {
+	server := newTLS12OnlyServer(t)
+
+	client := ConfigureTLS(&fasthttp.Client{}, &schemas.TLSConfig{MinVersion: "1.3", InsecureSkipVerify: true}, nil)
+	if err := doTLSTestRequest(client, server.URL); err == nil {
+		t.Fatal("expected the TLS 1.2 connection to be refused with a TLS 1.3 minimum")
+	}
+
+	client = ConfigureTLS(&fasthttp.Client{}, &schemas.TLSConfig{MinVersion: "1.2", InsecureSkipVerify: true}, nil)
+	if err := doTLSTestRequest(client, server.URL); err != nil {
+		t.Fatalf("expected the TLS 1.2 connection to succeed with a TLS 1.2 minimum, got %v", err)
+	}
+}
+
+// Test that an invalid TLS config is never replaced by the defaults, the client refuses every connection instead
+func TestConfigureTLS_InvalidConfigRefusesConnections(t *testing.T) {
+	server := newTLS12OnlyServer(t)
+
+	client := ConfigureTLS(&fasthttp.Client{}, &schemas.TLSConfig{MinVersion: "1.0", InsecureSkipVerify: true}, nil)
+	if err := doTLSTestRequest(client, server.URL); err == nil || !strings.Contains(err.Error(), "invalid TLS configuration") {
+		t.Fatalf("expected the connection to be refused with the configuration error, got %v", err)
+	}
+}
+
+// Test that the secure defaults apply without a TLS config and that a custom CA set up for a proxy is kept
+func TestBuildTLSConfig_Defaults(t *testing.T) {
+	tlsConfig, err := BuildTLSConfig(nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig.MinVersion != tls.VersionTLS12 || tlsConfig.InsecureSkipVerify {
+		t.Errorf("expected TLS 1.2 minimum with certificate verification, got min version %x, insecure %v", tlsConfig.MinVersion, tlsConfig.InsecureSkipVerify)
+	}
+
+	base := &tls.Config{MinVersion: tls.VersionTLS10, ServerName: "proxy.internal"}
+	tlsConfig, err = BuildTLSConfig(base, &schemas.TLSConfig{CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig.ServerName != "proxy.internal" || tlsConfig.MinVersion != tls.VersionTLS12 {
+		t.Errorf("expected the base config to be kept with a TLS 1.2 minimum, got %+v", tlsConfig)
+	}
+	if len(tlsConfig.CipherSuites) != 1 || tlsConfig.CipherSuites[0] != tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 {
+		t.Errorf("unexpected cipher suites %v", tlsConfig.CipherSuites)
+	}
+	if base.MinVersion != tls.VersionTLS10 {
+		t.Error("expected the base config not to be modified")
+	}
+}
+
+func TestBuildTLSConfig_Invalid(t *testing.T) {
+	tests := map[string]*schemas.TLSConfig{
+		"UnsupportedVersion":  {MinVersion: "1.1"},
+		"UnknownCipherSuite":  {CipherSuites: []string{"TLS_NOT_A_SUITE"}},
+		"InsecureCipherSuite": {CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}},
+		"TLS13CipherSuite":    {CipherSuites: []string{"TLS_AES_128_GCM_SHA256"}},
+	}
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := BuildTLSConfig(nil, config); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
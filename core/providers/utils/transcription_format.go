@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// IsTextTranscriptionResponseFormat reports whether a transcription response format is returned as plain text
+// (text, srt or vtt) instead of JSON.
+func IsTextTranscriptionResponseFormat(format *string) bool {
+	if format == nil {
+		return false
+	}
+	switch *format {
+	case schemas.TranscriptionResponseFormatText, schemas.TranscriptionResponseFormatSRT, schemas.TranscriptionResponseFormatVTT:
+		return true
+	}
+	return false
+}
+
+// IsTimestampedTranscriptionResponseFormat reports whether a transcription response format needs segment timestamps.
+func IsTimestampedTranscriptionResponseFormat(format *string) bool {
+	if format == nil {
+		return false
+	}
+	switch *format {
+	case schemas.TranscriptionResponseFormatSRT, schemas.TranscriptionResponseFormatVTT, schemas.TranscriptionResponseFormatVerboseJSON:
+		return true
+	}
+	return false
+}
+
+// ApplyTranscriptionResponseFormat renders the transcription in the requested text, srt or vtt format, from its
+// text and segments, unless the provider already returned it in that format.
+func ApplyTranscriptionResponseFormat(response *schemas.BifrostTranscriptionResponse, format *string) {
+	if response == nil || response.Formatted != nil || !IsTextTranscriptionResponseFormat(format) {
+		return
+	}
+	var formatted string
+	switch *format {
+	case schemas.TranscriptionResponseFormatText:
+		formatted = response.Text
+	case schemas.TranscriptionResponseFormatSRT:
+		formatted = FormatTranscriptionSRT(transcriptionCues(response))
+	case schemas.TranscriptionResponseFormatVTT:
+		formatted = FormatTranscriptionVTT(transcriptionCues(response))
+	}
+	response.Formatted = &formatted
+}
+
+// transcriptionCues returns the segments of a transcription, or a single segment spanning the whole audio for
+// transcriptions without segments.
+func transcriptionCues(response *schemas.BifrostTranscriptionResponse) []schemas.TranscriptionSegment {
+	if len(response.Segments) > 0 {
+		return response.Segments
+	}
+	if strings.TrimSpace(response.Text) == "" {
+		return nil
+	}
+	segment := schemas.TranscriptionSegment{Text: response.Text}
+	if response.Duration != nil {
+		segment.End = *response.Duration
+	}
+	return []schemas.TranscriptionSegment{segment}
+}
+
+// FormatTranscriptionSRT renders transcription segments as SubRip subtitles.
+func FormatTranscriptionSRT(segments []schemas.TranscriptionSegment) string {
+	var b strings.Builder
+	cue := 0
+	for _, segment := range segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		cue++
+		start, end := cueTimes(segment)
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", cue, formatCueTimestamp(start, ","), formatCueTimestamp(end, ","), text)
+	}
+	return b.String()
+}
+
+// FormatTranscriptionVTT renders transcription segments as WebVTT subtitles.
+func FormatTranscriptionVTT(segments []schemas.TranscriptionSegment) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+	for _, segment := range segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		start, end := cueTimes(segment)
+		fmt.Fprintf(&b, "%s --> %s\n%s\n\n", formatCueTimestamp(start, "."), formatCueTimestamp(end, "."), text)
+	}
+	return b.String()
+}
+
+// cueTimes returns the start and end of a segment, the end of the last chunk may be missing (e.g. HuggingFace).
+func cueTimes(segment schemas.TranscriptionSegment) (float64, float64) {
+	start := max(segment.Start, 0)
+	return start, max(segment.End, start)
+}
+
+// formatCueTimestamp formats seconds as HH:MM:SS<sep>mmm, the separator being "," for SRT and "." for WebVTT.
+func formatCueTimestamp(seconds float64, millisecondSeparator string) string {
+	total := int64(seconds*1000 + 0.5)
+	hours := total / 3_600_000
+	minutes := total / 60_000 % 60
+	secs := total / 1000 % 60
+	millis := total % 1000
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", hours, minutes, secs, millisecondSeparator, millis)
+}
+
+// SubtitleText returns the text of SRT or WebVTT subtitles, the cue texts joined by spaces.
+func SubtitleText(subtitles string) string {
+	lines := strings.Split(strings.ReplaceAll(subtitles, "\r\n", "\n"), "\n")
+	var texts []string
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || line == "WEBVTT" || strings.Contains(line, "-->") {
+			continue
+		}
+		// Cue identifiers (SRT cue numbers) precede the timing line
+		if i+1 < len(lines) && strings.Contains(lines[i+1], "-->") {
+			continue
+		}
+		texts = append(texts, line)
+	}
+	return strings.Join(texts, " ")
+}
+
+// CheckTranslationSupported returns an unsupported operation error for a translation requested from a provider
+// (or operation) without translation support, instead of silently transcribing the audio in its own language.
+func CheckTranslationSupported(provider schemas.ModelProvider, requestType schemas.RequestType, request *schemas.BifrostTranscriptionRequest) *schemas.BifrostError {
+	if request == nil || !request.Params.IsTranslation() {
+		return nil
+	}
+	bifrostErr := NewUnsupportedOperationError(requestType, provider)
+	bifrostErr.Error.Message = fmt.Sprintf("audio translation is not supported by %s provider for %s requests", provider, requestType)
+	return bifrostErr
+}
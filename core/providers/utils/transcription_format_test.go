@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+var testTranscriptionSegments = []schemas.TranscriptionSegment{
+	{ID: 0, Start: 0, End: 2.5, Text: " Hello there."},
+	{ID: 1, Start: 2.5, End: 3661.042, Text: " General Kenobi."},
+}
+
+// Test that segments are rendered as SubRip cues numbered from 1, with comma separated milliseconds
+func TestFormatTranscriptionSRT(t *testing.T) {
+	expected := "1\n00:00:00,000 --> 00:00:02,500\nHello there.\n\n" +
+		"2\n00:00:02,500 --> 01:01:01,042\nGeneral Kenobi.\n\n"
+	if got := FormatTranscriptionSRT(testTranscriptionSegments); got != expected {
+		t.Errorf("unexpected srt:\n%q\nexpected:\n%q", got, expected)
+	}
+}
+
+// Test that segments are rendered as WebVTT cues, and that a missing end timestamp does not precede the start
+func TestFormatTranscriptionVTT(t *testing.T) {
+	segments := append(testTranscriptionSegments, schemas.TranscriptionSegment{ID: 2, Start: 3662, Text: " You are a bold one."})
+	expected := "WEBVTT\n\n" +
+		"00:00:00.000 --> 00:00:02.500\nHello there.\n\n" +
+		"00:00:02.500 --> 01:01:01.042\nGeneral Kenobi.\n\n" +
+		"01:01:02.000 --> 01:01:02.000\nYou are a bold one.\n\n"
+	if got := FormatTranscriptionVTT(segments); got != expected {
+		t.Errorf("unexpected vtt:\n%q\nexpected:\n%q", got, expected)
+	}
+}
+
+// Test that the requested format is rendered once, and that the text of subtitles can be recovered
+func TestApplyTranscriptionResponseFormat(t *testing.T) {
+	response := &schemas.BifrostTranscriptionResponse{Text: "Hello there. General Kenobi.", Segments: testTranscriptionSegments}
+	ApplyTranscriptionResponseFormat(response, schemas.Ptr(schemas.TranscriptionResponseFormatSRT))
+	if response.Formatted == nil || *response.Formatted != FormatTranscriptionSRT(testTranscriptionSegments) {
+		t.Fatalf("expected srt output, got %v", response.Formatted)
+	}
+	if text := SubtitleText(*response.Formatted); text != response.Text {
+		t.Errorf("expected subtitle text %q, got %q", response.Text, text)
+	}
+
+	// The provider's own output is kept
+	ApplyTranscriptionResponseFormat(response, schemas.Ptr(schemas.TranscriptionResponseFormatVTT))
+	if *response.Formatted != FormatTranscriptionSRT(testTranscriptionSegments) {
+		t.Errorf("expected formatted output to be kept, got %q", *response.Formatted)
+	}
+
+	response = &schemas.BifrostTranscriptionResponse{Text: "Hello there."}
+	ApplyTranscriptionResponseFormat(response, schemas.Ptr(schemas.TranscriptionResponseFormatJSON))
+	if response.Formatted != nil {
+		t.Errorf("expected no formatted output for json, got %q", *response.Formatted)
+	}
+}
+
+// Test that a translation requested from a provider without translation support is rejected, not dropped
+func TestCheckTranslationSupported(t *testing.T) {
+	transcription := &schemas.BifrostTranscriptionRequest{
+		Model:  "voxtral-mini-latest",
+		Params: &schemas.TranscriptionParameters{Language: schemas.Ptr("fr")},
+	}
+	if err := CheckTranslationSupported(schemas.Mistral, schemas.TranscriptionRequest, transcription); err != nil {
+		t.Errorf("expected transcriptions to be allowed, got %v", err.Error.Message)
+	}
+
+	translation := &schemas.BifrostTranscriptionRequest{
+		Model:  "voxtral-mini-latest",
+		Params: &schemas.TranscriptionParameters{Language: schemas.Ptr("fr"), Translate: schemas.Ptr(true)},
+	}
+	err := CheckTranslationSupported(schemas.Mistral, schemas.TranscriptionRequest, translation)
+	if err == nil {
+		t.Fatal("expected an unsupported operation error")
+	}
+	if err.Error.Code == nil || *err.Error.Code != "unsupported_operation" {
+		t.Errorf("expected unsupported_operation code, got %v", err.Error.Code)
+	}
+	if err.ExtraFields.Provider != schemas.Mistral || err.ExtraFields.RequestType != schemas.TranscriptionRequest {
+		t.Errorf("unexpected extra fields %+v", err.ExtraFields)
+	}
+}
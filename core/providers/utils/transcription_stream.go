@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"context"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// TranscriptionStreamResponses splits a complete transcription into the frames of a transcription stream: one delta
+// per segment, in order, or a single delta with the whole text if the transcription has no segments, followed by
+// the done frame with the complete transcript, its log probabilities and its usage. It lets providers whose APIs only
+// return complete transcripts serve streaming requests. The frames keep the provider and model of the response.
+func TranscriptionStreamResponses(response *schemas.BifrostTranscriptionResponse) []*schemas.BifrostTranscriptionStreamResponse {
+	if response == nil {
+		return nil
+	}
+	var deltas []string
+	for _, segment := range response.Segments {
+		if segment.Text != "" {
+			deltas = append(deltas, segment.Text)
+		}
+	}
+	if len(deltas) == 0 && response.Text != "" {
+		deltas = []string{response.Text}
+	}
+
+	frames := make([]*schemas.BifrostTranscriptionStreamResponse, 0, len(deltas)+1)
+	for _, delta := range deltas {
+		frames = append(frames, &schemas.BifrostTranscriptionStreamResponse{
+			Type:  schemas.TranscriptionStreamResponseTypeDelta,
+			Delta: schemas.Ptr(delta),
+		})
+	}
+	frames = append(frames, &schemas.BifrostTranscriptionStreamResponse{
+		Type:     schemas.TranscriptionStreamResponseTypeDone,
+		Text:     response.Text,
+		LogProbs: response.LogProbs,
+		Usage:    response.Usage,
+	})
+	for i, frame := range frames {
+		frame.ExtraFields = schemas.BifrostResponseExtraFields{
+			RequestType:    schemas.TranscriptionStreamRequest,
+			Provider:       response.ExtraFields.Provider,
+			ModelRequested: response.ExtraFields.ModelRequested,
+			ChunkIndex:     i,
+		}
+	}
+	// The whole transcript arrived at once: the first frame waited for it, and the done frame reports it as the
+	// total latency like the done frames of streaming providers
+	frames[0].ExtraFields.Latency = response.ExtraFields.Latency
+	done := frames[len(frames)-1]
+	done.ExtraFields.Latency = response.ExtraFields.Latency
+	done.ExtraFields.RawRequest = response.ExtraFields.RawRequest
+	done.ExtraFields.RawResponse = response.ExtraFields.RawResponse
+	return frames
+}
+
+// StreamTranscriptionResponse serves a streaming transcription request from a complete transcription, sending the
+// frames of TranscriptionStreamResponses through the post hooks to a new stream channel.
+func StreamTranscriptionResponse(ctx context.Context, postHookRunner schemas.PostHookRunner, response *schemas.BifrostTranscriptionResponse) chan *schemas.BifrostStream {
+	responseChan := make(chan *schemas.BifrostStream, schemas.DefaultStreamBufferSize)
+	go func() {
+		defer close(responseChan)
+		frames := TranscriptionStreamResponses(response)
+		for i, frame := range frames {
+			frameCtx := ctx
+			if i == len(frames)-1 {
+				frameCtx = context.WithValue(ctx, schemas.BifrostContextKeyStreamEndIndicator, true)
+			}
+			ProcessAndSendResponse(frameCtx, postHookRunner, GetBifrostResponseForStreamResponse(nil, nil, nil, nil, frame), responseChan)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+	return responseChan
+}
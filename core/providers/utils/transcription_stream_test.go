@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a transcription without segments is streamed as a single delta, then the done frame with the usage
+func TestTranscriptionStreamResponses_WithoutSegments(t *testing.T) {
+	frames := TranscriptionStreamResponses(&schemas.BifrostTranscriptionResponse{
+		Text:  "Hello there.",
+		Usage: &schemas.TranscriptionUsage{Type: "duration", Seconds: schemas.Ptr(2)},
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			Provider:       schemas.HuggingFace,
+			ModelRequested: "hf-inference/openai/whisper-large-v3",
+			Latency:        420,
+		},
+	})
+	if len(frames) != 2 {
+		t.Fatalf("expected a delta and a done frame, got %d frames", len(frames))
+	}
+	delta, done := frames[0], frames[1]
+	if delta.Type != schemas.TranscriptionStreamResponseTypeDelta || delta.Delta == nil || *delta.Delta != "Hello there." {
+		t.Errorf("expected the whole text as a delta, got %+v", delta)
+	}
+	if done.Type != schemas.TranscriptionStreamResponseTypeDone || done.Text != "Hello there." || done.Usage == nil {
+		t.Errorf("expected the complete transcript and the usage in the done frame, got %+v", done)
+	}
+	for i, frame := range frames {
+		if frame.ExtraFields.ChunkIndex != i || frame.ExtraFields.RequestType != schemas.TranscriptionStreamRequest || frame.ExtraFields.Provider != schemas.HuggingFace || frame.ExtraFields.Latency != 420 {
+			t.Errorf("unexpected extra fields for frame %d: %+v", i, frame.ExtraFields)
+		}
+	}
+
+	if frames := TranscriptionStreamResponses(&schemas.BifrostTranscriptionResponse{}); len(frames) != 1 || frames[0].Type != schemas.TranscriptionStreamResponseTypeDone {
+		t.Errorf("expected an empty transcription to be streamed as a done frame only, got %+v", frames)
+	}
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+// apiVersionSegment matches the API version segments of URL paths, e.g. v1, v2 or v1beta.
+var apiVersionSegment = regexp.MustCompile(`^v\d+((alpha|beta)\d*)?$`)
+
+// NormalizeBaseURL trims the spaces and the trailing slashes of a base URL, so that paths can be appended to it.
+func NormalizeBaseURL(baseURL string) string {
+	return strings.TrimRight(strings.TrimSpace(baseURL), "/")
+}
+
+// JoinURL joins a base URL and a request path with a single slash, whatever slashes the base URL ends with and
+// the path starts with. The query of the path is kept, a path made of a query only is appended as is. A path starting with the API version the base URL ends
+// with (e.g. /v1/chat/completions on https://api.openai.com/v1) does not repeat it, so that base URLs can be
+// configured with or without the API version.
+func JoinURL(baseURL, path string) string {
+	baseURL = NormalizeBaseURL(baseURL)
+	path = strings.TrimLeft(path, "/")
+	if path == "" || path[0] == '?' {
+		return baseURL + path
+	}
+	if version := lastPathSegment(baseURL); apiVersionSegment.MatchString(version) {
+		if rest, ok := strings.CutPrefix(path, version); ok && (rest == "" || rest[0] == '/') {
+			path = strings.TrimLeft(rest, "/")
+			if path == "" {
+				return baseURL
+			}
+		}
+	}
+	return baseURL + "/" + path
+}
+
+// lastPathSegment returns the last segment of the path of a URL without trailing slashes, or "" if it has no
+// path.
+func lastPathSegment(rawURL string) string {
+	hostStart := 0
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		hostStart = i + len("://")
+	}
+	pathStart := strings.IndexByte(rawURL[hostStart:], '/')
+	if pathStart < 0 {
+		return ""
+	}
+	return rawURL[strings.LastIndexByte(rawURL, '/')+1:]
+}
+
+// URLPathRewrite derives an alternative base URL of a provider API by inserting a path segment before the API
+// version, e.g. the upload endpoints of Gemini live under /upload/v1beta rather than /v1beta. Providers declare
+// them once rather than rewriting their base URLs inline.
+type URLPathRewrite struct {
+	Before string // path segment the prefix is inserted before, e.g. "v1beta"
+	Prefix string // path segment inserted, e.g. "upload"
+}
+
+// Apply returns the base URL with the prefix inserted before the first path segment equal to Before. The base
+// URL is returned normalized but otherwise unchanged if its path has no such segment, e.g. for proxies not
+// exposing the API version, or if the prefix is already there.
+func (r URLPathRewrite) Apply(baseURL string) string {
+	baseURL = NormalizeBaseURL(baseURL)
+	hostStart := 0
+	if i := strings.Index(baseURL, "://"); i >= 0 {
+		hostStart = i + len("://")
+	}
+	pathStart := strings.IndexByte(baseURL[hostStart:], '/')
+	if pathStart < 0 {
+		return baseURL
+	}
+	pathStart += hostStart
+	segments := strings.Split(baseURL[pathStart+1:], "/")
+	for i, segment := range segments {
+		if segment != r.Before {
+			continue
+		}
+		if i > 0 && segments[i-1] == r.Prefix {
+			return baseURL
+		}
+		rewritten := append(append(append([]string{}, segments[:i]...), r.Prefix), segments[i:]...)
+		return baseURL[:pathStart+1] + strings.Join(rewritten, "/")
+	}
+	return baseURL
+}
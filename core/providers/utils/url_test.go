@@ -0,0 +1,62 @@
+package utils
+
+import "testing"
+
+// Test that base URLs and paths are joined with a single slash, without repeating the API version
+func TestJoinURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		path    string
+		want    string
+	}{
+		{"plain", "https://api.openai.com", "/v1/chat/completions", "https://api.openai.com/v1/chat/completions"},
+		{"trailing slash", "https://api.openai.com/", "/v1/chat/completions", "https://api.openai.com/v1/chat/completions"},
+		{"double slashes", "https://api.openai.com//", "//v1/chat/completions", "https://api.openai.com/v1/chat/completions"},
+		{"missing leading slash", "https://api.openai.com", "v1/chat/completions", "https://api.openai.com/v1/chat/completions"},
+		{"spaces", " https://api.openai.com/ ", "/v1/models", "https://api.openai.com/v1/models"},
+		{"version in base URL", "https://api.openai.com/v1", "/v1/chat/completions", "https://api.openai.com/v1/chat/completions"},
+		{"version in base URL only", "https://generativelanguage.googleapis.com/v1beta", "/models/gemini-2.0-flash:generateContent", "https://generativelanguage.googleapis.com/v1beta/models/gemini-2.0-flash:generateContent"},
+		{"other version", "https://proxy.example.com/v1", "/v1beta/models", "https://proxy.example.com/v1/v1beta/models"},
+		{"version prefix of a segment", "https://proxy.example.com/v1", "/v1chat", "https://proxy.example.com/v1/v1chat"},
+		{"non-version prefix kept", "https://proxy.example.com/openai", "/openai/deployments", "https://proxy.example.com/openai/openai/deployments"},
+		{"version host", "https://v1", "/v1/models", "https://v1/v1/models"},
+		{"query", "https://generativelanguage.googleapis.com/v1beta/", "/models/gemini-2.0-flash:streamGenerateContent?alt=sse", "https://generativelanguage.googleapis.com/v1beta/models/gemini-2.0-flash:streamGenerateContent?alt=sse"},
+		{"query only", "https://api.example.com/v1/", "?api-version=1", "https://api.example.com/v1?api-version=1"},
+		{"empty path", "https://api.openai.com/", "", "https://api.openai.com"},
+		{"version path only", "https://api.openai.com/v1", "/v1/", "https://api.openai.com/v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinURL(tt.baseURL, tt.path); got != tt.want {
+				t.Errorf("JoinURL(%q, %q) = %q, want %q", tt.baseURL, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test that path rewrites insert their prefix before the API version only, once
+func TestURLPathRewrite(t *testing.T) {
+	upload := URLPathRewrite{Before: "v1beta", Prefix: "upload"}
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+	}{
+		{"default base URL", "https://generativelanguage.googleapis.com/v1beta", "https://generativelanguage.googleapis.com/upload/v1beta"},
+		{"trailing slash", "https://generativelanguage.googleapis.com/v1beta/", "https://generativelanguage.googleapis.com/upload/v1beta"},
+		{"prefix already there", "https://generativelanguage.googleapis.com/upload/v1beta", "https://generativelanguage.googleapis.com/upload/v1beta"},
+		{"proxy path", "https://proxy.example.com/gemini/v1beta", "https://proxy.example.com/gemini/upload/v1beta"},
+		{"missing version", "https://proxy.example.com/gemini", "https://proxy.example.com/gemini"},
+		{"no path", "https://proxy.example.com", "https://proxy.example.com"},
+		{"version in host", "https://v1beta.example.com/v1beta", "https://v1beta.example.com/upload/v1beta"},
+		{"similar segment", "https://proxy.example.com/v1beta2", "https://proxy.example.com/v1beta2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := upload.Apply(tt.baseURL); got != tt.want {
+				t.Errorf("Apply(%q) = %q, want %q", tt.baseURL, got, tt.want)
+			}
+		})
+	}
+}
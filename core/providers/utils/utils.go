@@ -3,6 +3,8 @@
 package utils
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -20,8 +22,8 @@ import (
 	"sync"
 	"time"
 
-	"github.com/bytedance/sonic"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/fasthttpproxy"
 )
@@ -67,6 +69,7 @@ func MakeRequestWithContext(ctx context.Context, client *fasthttp.Client, req *f
 		// The fasthttp.Do call completed.
 		// Calculate latency for both successful and failed requests
 		latency := time.Since(startTime)
+		recordRoundTripLatency(ctx, resp, latency)
 		if err != nil {
 			if errors.Is(err, context.Canceled) {
 				return latency, &schemas.BifrostError{
@@ -308,10 +311,16 @@ func CheckContextAndGetRequestBody(ctx context.Context, request RequestBodyGette
 		if convertedBody == nil {
 			return nil, NewBifrostOperationError("request body is not provided", nil, providerType)
 		}
-		jsonBody, err := sonic.MarshalIndent(convertedBody, "", "  ")
+		jsonBody, err := serialization.MarshalIndent(convertedBody, "", "  ")
 		if err != nil {
 			return nil, NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerType)
 		}
+		// Reshape the body for custom providers expecting a different body (raw request bodies are sent as is)
+		if template, ok := ctx.Value(schemas.BifrostContextKeyRequestBodyTemplate).(*schemas.RequestBodyTemplate); ok && template != nil {
+			if jsonBody, err = ApplyRequestBodyTemplate(jsonBody, template); err != nil {
+				return nil, NewBifrostOperationError(schemas.ErrRequestBodyConversion, err, providerType)
+			}
+		}
 		return jsonBody, nil
 	} else {
 		return rawBody, nil
@@ -389,12 +398,25 @@ func HandleProviderAPIError(resp *fasthttp.Response, errorResp any) *schemas.Bif
 	}
 
 	// Try JSON parsing first
-	if err := sonic.Unmarshal(decodedBody, errorResp); err == nil {
-		// JSON parsing succeeded, return success
+	if err := serialization.Unmarshal(decodedBody, errorResp); err == nil {
+		// JSON parsing succeeded, the error field is a fallback for the fields the caller does not find in errorResp
+		errorField := &schemas.ErrorField{}
+		if info := ExtractProviderError(decodedBody); info != nil {
+			errorField = info.ErrorField()
+		}
+		return &schemas.BifrostError{
+			IsBifrostError: false,
+			StatusCode:     &statusCode,
+			Error:          errorField,
+		}
+	}
+
+	// JSON of another shape than errorResp still carries a readable message most of the time
+	if info := ExtractProviderError(decodedBody); info != nil && info.Message != "" {
 		return &schemas.BifrostError{
 			IsBifrostError: false,
 			StatusCode:     &statusCode,
-			Error:          &schemas.ErrorField{},
+			Error:          info.ErrorField(),
 		}
 	}
 
@@ -421,13 +443,28 @@ func HandleProviderAPIError(resp *fasthttp.Response, errorResp any) *schemas.Bif
 	}
 }
 
+// Length of the start and end of response bodies kept by RedactResponseBody.
+const (
+	redactedBodyHead = 64
+	redactedBodyTail = 16
+)
+
+// RedactResponseBody shortens a response body for logging, keeping its start and end only: enough to recognise
+// a truncated JSON document or an error page, without logging the content it carries.
+func RedactResponseBody(body []byte) string {
+	if len(body) <= redactedBodyHead+redactedBodyTail {
+		return fmt.Sprintf("%q", body)
+	}
+	return fmt.Sprintf("%q[REDACTED %d bytes]%q", body[:redactedBodyHead], len(body)-redactedBodyHead-redactedBodyTail, body[len(body)-redactedBodyTail:])
+}
+
 // HandleProviderResponse handles common response parsing logic for provider responses.
 // It attempts to parse the response body into the provided response type
 // and returns either the parsed response or a BifrostError if parsing fails.
 // If sendBackRawResponse is true, it returns the raw response interface, otherwise nil.
 // HTML detection only runs if JSON parsing fails to avoid expensive regex operations
 // on responses that are almost certainly valid JSON.
-func HandleProviderResponse[T any](responseBody []byte, response *T, requestBody []byte, sendBackRawRequest bool, sendBackRawResponse bool) (rawRequest interface{}, rawResponse interface{}, bifrostErr *schemas.BifrostError) {
+func HandleProviderResponse[T any](ctx context.Context, responseBody []byte, response *T, requestBody []byte, sendBackRawRequest bool, sendBackRawResponse bool) (rawRequest interface{}, rawResponse interface{}, bifrostErr *schemas.BifrostError) {
 	// Check for empty response
 	trimmed := strings.TrimSpace(string(responseBody))
 	if len(trimmed) == 0 {
@@ -439,6 +476,11 @@ func HandleProviderResponse[T any](responseBody []byte, response *T, requestBody
 		}
 	}
 
+	// Some providers report failures with a 200 status and an error object as body
+	if bifrostErr := DetectEmbeddedError(ctx, responseBody); bifrostErr != nil {
+		return nil, nil, bifrostErr
+	}
+
 	var wg sync.WaitGroup
 	var structuredErr, rawRequestErr, rawResponseErr error
 
@@ -457,25 +499,28 @@ func HandleProviderResponse[T any](responseBody []byte, response *T, requestBody
 	wg.Add(numGoroutines)
 	go func() {
 		defer wg.Done()
-		structuredErr = sonic.Unmarshal(responseBody, response)
+		structuredErr = serialization.Unmarshal(responseBody, response)
 	}()
 
 	if shouldCaptureRawRequest {
 		go func() {
 			defer wg.Done()
-			rawRequestErr = sonic.Unmarshal(requestBody, &rawRequest)
+			rawRequestErr = serialization.Unmarshal(requestBody, &rawRequest)
 		}()
 	}
 
 	if sendBackRawResponse {
 		go func() {
 			defer wg.Done()
-			rawResponseErr = sonic.Unmarshal(responseBody, &rawResponse)
+			rawResponseErr = serialization.Unmarshal(responseBody, &rawResponse)
 		}()
 	}
 	wg.Wait()
 
 	if structuredErr != nil {
+		if logger != nil {
+			logger.Debug(fmt.Sprintf("malformed response body from provider (%v): %s", structuredErr, RedactResponseBody(responseBody)))
+		}
 		// JSON parsing failed - check if it's an HTML response (expensive operation)
 		if IsHTMLResponse(nil, responseBody) {
 			return nil, nil, &schemas.BifrostError{
@@ -537,7 +582,7 @@ func HandleProviderResponse[T any](responseBody []byte, response *T, requestBody
 // ParseAndSetRawRequest parses the raw request body and sets it in the extra fields.
 func ParseAndSetRawRequest(extraFields *schemas.BifrostResponseExtraFields, jsonBody []byte) {
 	var rawRequest interface{}
-	if err := sonic.Unmarshal(jsonBody, &rawRequest); err != nil {
+	if err := serialization.Unmarshal(jsonBody, &rawRequest); err != nil {
 		logger.Warn(fmt.Sprintf("Failed to parse raw request: %v", err))
 	} else {
 		extraFields.RawRequest = rawRequest
@@ -551,7 +596,7 @@ func NewUnsupportedOperationError(requestType schemas.RequestType, providerName
 		IsBifrostError: false,
 		Error: &schemas.ErrorField{
 			Message: fmt.Sprintf("%s is not supported by %s provider", requestType, providerName),
-			Code:    schemas.Ptr("unsupported_operation"),
+			Code:    schemas.Ptr(schemas.UnsupportedOperationErrorCode),
 		},
 		ExtraFields: schemas.BifrostErrorExtraFields{
 			Provider:    providerName,
@@ -717,12 +762,76 @@ type JSONLParseResult struct {
 // The callback receives the line bytes and returns an error if parsing fails.
 // This function operates directly on byte slices to avoid unnecessary string conversions.
 func ParseJSONL(data []byte, parseLine func(line []byte) error) JSONLParseResult {
+	result, _, _ := parseJSONLLines(data, 0, 0, 0, parseLine)
+	return result
+}
+
+// ParseJSONLPage parses a page of at most limit records of JSONL data, starting at the position of the
+// after cursor (from the start if nil), and returns the cursor of the next page, or nil if no records remain.
+// A limit of 0 parses all the remaining records. Line numbers in parse errors are relative to the whole data,
+// and records that fail to parse count towards the limit so that every page advances.
+func ParseJSONLPage(data []byte, after *string, limit int, parseLine func(line []byte) error) (JSONLParseResult, *string, error) {
+	start, lineNum := 0, 0
+	if after != nil {
+		cursor, err := schemas.DecodeBatchResultsCursor(*after)
+		if err != nil {
+			return JSONLParseResult{}, nil, err
+		}
+		if cursor != nil {
+			if cursor.Offset > len(data) {
+				return JSONLParseResult{}, nil, fmt.Errorf("cursor offset %d is past the end of the results", cursor.Offset)
+			}
+			start, lineNum = cursor.Offset, cursor.Line
+		}
+	}
+
+	result, next, lineNum := parseJSONLLines(data, start, lineNum, limit, parseLine)
+	if len(bytes.TrimSpace(data[next:])) == 0 {
+		return result, nil, nil
+	}
+	nextCursor := schemas.EncodeBatchResultsCursor(&schemas.BatchResultsCursor{Version: 1, Offset: next, Line: lineNum})
+	return result, &nextCursor, nil
+}
+
+// maxJSONLLineSize bounds the length of a line read by ScanJSONL, batch request lines embed whole inputs (e.g. base64 images).
+const maxJSONLLineSize = 64 * 1024 * 1024
+
+// ScanJSONL is ParseJSONL for JSONL content read from a stream, line by line, without holding the whole content
+// in memory. The returned error is a read error (including lines longer than 64MB), line-level errors are
+// collected in the result.
+func ScanJSONL(content io.Reader, parseLine func(line []byte) error) (JSONLParseResult, error) {
 	result := JSONLParseResult{}
+	scanner := bufio.NewScanner(content)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxJSONLLineSize)
 
 	lineNum := 0
-	start := 0
+	for scanner.Scan() {
+		lineNum++
+		// Trim trailing carriage return for Windows-style line endings, and skip empty lines
+		line := bytes.TrimSuffix(scanner.Bytes(), []byte{'\r'})
+		if len(line) == 0 {
+			continue
+		}
+		if err := parseLine(line); err != nil {
+			lineNumCopy := lineNum
+			result.Errors = append(result.Errors, schemas.BatchError{
+				Code:    "parse_error",
+				Message: err.Error(),
+				Line:    &lineNumCopy,
+			})
+		}
+	}
+	return result, scanner.Err()
+}
+
+// parseJSONLLines parses the JSONL lines of data from the start offset until limit records were parsed
+// (or the end of data if limit is 0). lineNum is the number of lines before start.
+// Returns the offset and line number the parsing stopped at.
+func parseJSONLLines(data []byte, start, lineNum, limit int, parseLine func(line []byte) error) (JSONLParseResult, int, int) {
+	result := JSONLParseResult{}
+	records := 0
 
-	for i := 0; i <= len(data); i++ {
+	for i := start; i <= len(data); i++ {
 		// Check for newline or end of data
 		if i == len(data) || data[i] == '\n' {
 			lineNum++
@@ -747,14 +856,18 @@ func ParseJSONL(data []byte, parseLine func(line []byte) error) JSONLParseResult
 							Line:    &lineNumCopy,
 						})
 					}
+					records++
 				}
 			}
 
 			start = i + 1
+			if limit > 0 && records == limit {
+				break
+			}
 		}
 	}
 
-	return result
+	return result, min(start, len(data)), lineNum
 }
 
 // NewConfigurationError creates a standardized error for configuration errors.
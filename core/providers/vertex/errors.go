@@ -4,9 +4,9 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/bytedance/sonic"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
@@ -84,15 +84,15 @@ func parseVertexError(resp *fasthttp.Response, meta *providerUtils.RequestMetada
 		return bifrostErr
 	}
 
-	if err := sonic.Unmarshal(decodedBody, &openAIErr); err != nil || openAIErr.Error == nil {
+	if err := serialization.Unmarshal(decodedBody, &openAIErr); err != nil || openAIErr.Error == nil {
 		// Try Vertex error format if OpenAI format fails or is incomplete
-		if err := sonic.Unmarshal(decodedBody, &vertexErr); err != nil {
+		if err := serialization.Unmarshal(decodedBody, &vertexErr); err != nil {
 			//try with single Vertex error format
 			var vertexErr VertexError
-			if err := sonic.Unmarshal(decodedBody, &vertexErr); err != nil {
+			if err := serialization.Unmarshal(decodedBody, &vertexErr); err != nil {
 				// Try VertexValidationError format (validation errors from Mistral endpoint)
 				var validationErr VertexValidationError
-				if err := sonic.Unmarshal(decodedBody, &validationErr); err != nil {
+				if err := serialization.Unmarshal(decodedBody, &validationErr); err != nil {
 					bifrostErr := providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 					if meta != nil {
 						bifrostErr.ExtraFields = schemas.BifrostErrorExtraFields{
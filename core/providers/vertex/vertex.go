@@ -15,12 +15,12 @@ import (
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	"github.com/maximhq/bifrost/core/providers/gemini"
 	"github.com/maximhq/bifrost/core/providers/openai"
 	providerUtils "github.com/maximhq/bifrost/core/providers/utils"
 	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 type VertexError struct {
@@ -56,11 +56,8 @@ func removeVertexClient(authCredentials string) {
 
 // VertexProvider implements the Provider interface for Google's Vertex AI API.
 type VertexProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	providerUtils.BaseProvider
+	client *fasthttp.Client // HTTP client for API requests
 }
 
 // NewVertexProvider creates a new Vertex provider instance.
@@ -76,12 +73,11 @@ func NewVertexProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*
 		MaxConnWaitTimeout:  10 * time.Second,
 	}
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureTLS(client, config.NetworkConfig.TLS, logger)
+	client = providerUtils.ConfigureConnectTimings(client)
 	return &VertexProvider{
-		logger:              logger,
-		client:              client,
-		networkConfig:       config.NetworkConfig,
-		sendBackRawRequest:  config.SendBackRawRequest,
-		sendBackRawResponse: config.SendBackRawResponse,
+		BaseProvider: providerUtils.NewBaseProvider(schemas.Vertex, config, logger),
+		client:       client,
 	}, nil
 }
 
@@ -113,11 +109,6 @@ func getAuthTokenSource(key schemas.Key) (oauth2.TokenSource, error) {
 	return tokenSource, nil
 }
 
-// GetProviderKey returns the provider identifier for Vertex.
-func (provider *VertexProvider) GetProviderKey() schemas.ModelProvider {
-	return schemas.Vertex
-}
-
 // listModelsByKey performs a list models request for a single key.
 // Returns the response and latency, or an error if the request fails.
 // Handles pagination automatically by following nextPageToken until all models are retrieved.
@@ -178,7 +169,7 @@ func (provider *VertexProvider) listModelsByKey(ctx context.Context, key schemas
 		req.Header.SetMethod(http.MethodGet)
 		req.SetRequestURI(requestURL)
 		req.Header.SetContentType("application/json")
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
 
 		_, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
@@ -193,7 +184,7 @@ func (provider *VertexProvider) listModelsByKey(ctx context.Context, key schemas
 			}
 
 			var errorResp VertexError
-			if err := sonic.Unmarshal(resp.Body(), &errorResp); err != nil {
+			if err := serialization.Unmarshal(resp.Body(), &errorResp); err != nil {
 				return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, schemas.Vertex)
 			}
 			return nil, providerUtils.NewProviderAPIError(errorResp.Error.Message, nil, resp.StatusCode(), schemas.Vertex, nil, nil)
@@ -201,14 +192,14 @@ func (provider *VertexProvider) listModelsByKey(ctx context.Context, key schemas
 
 		// Parse Vertex's response
 		var vertexResponse VertexListModelsResponse
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), &vertexResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), &vertexResponse, nil, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			rawRequests = append(rawRequests, rawRequest)
 		}
-		if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+		if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 			rawResponses = append(rawResponses, rawResponse)
 		}
 
@@ -228,11 +219,11 @@ func (provider *VertexProvider) listModelsByKey(ctx context.Context, key schemas
 	}
 	response := aggregatedResponse.ToBifrostListModelsResponse(key.Models, key.VertexKeyConfig.Deployments)
 
-	if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+	if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 		response.ExtraFields.RawRequest = rawRequests
 	}
 
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		response.ExtraFields.RawResponse = rawResponses
 	}
 
@@ -247,7 +238,7 @@ func (provider *VertexProvider) ListModels(ctx context.Context, keys []schemas.K
 		keys,
 		request,
 		provider.listModelsByKey,
-		provider.logger,
+		provider.Logger(),
 	)
 	if bifrostErr != nil {
 		return nil, bifrostErr
@@ -304,11 +295,11 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 				}
 				reqBody.Model = deployment
 				// Convert struct to map for Vertex API
-				reqBytes, err := sonic.Marshal(reqBody)
+				reqBytes, err := serialization.Marshal(reqBody)
 				if err != nil {
 					return nil, fmt.Errorf("failed to marshal request body: %w", err)
 				}
-				if err := sonic.Unmarshal(reqBytes, &requestBody); err != nil {
+				if err := serialization.Unmarshal(reqBytes, &requestBody); err != nil {
 					return nil, fmt.Errorf("failed to unmarshal request body: %w", err)
 				}
 			} else if schemas.IsGeminiModel(deployment) {
@@ -320,11 +311,11 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 				// Strip unsupported fields for Vertex Gemini
 				stripVertexGeminiUnsupportedFields(reqBody)
 				// Convert struct to map for Vertex API
-				reqBytes, err := sonic.Marshal(reqBody)
+				reqBytes, err := serialization.Marshal(reqBody)
 				if err != nil {
 					return nil, fmt.Errorf("failed to marshal request body: %w", err)
 				}
-				if err := sonic.Unmarshal(reqBytes, &requestBody); err != nil {
+				if err := serialization.Unmarshal(reqBytes, &requestBody); err != nil {
 					return nil, fmt.Errorf("failed to unmarshal request body: %w", err)
 				}
 			} else {
@@ -335,11 +326,11 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 				}
 				reqBody.Model = deployment
 				// Convert struct to map for Vertex API
-				reqBytes, err := sonic.Marshal(reqBody)
+				reqBytes, err := serialization.Marshal(reqBody)
 				if err != nil {
 					return nil, fmt.Errorf("failed to marshal request body: %w", err)
 				}
-				if err := sonic.Unmarshal(reqBytes, &requestBody); err != nil {
+				if err := serialization.Unmarshal(reqBytes, &requestBody); err != nil {
 					return nil, fmt.Errorf("failed to unmarshal request body: %w", err)
 				}
 			}
@@ -426,7 +417,7 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType("application/json")
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// If auth query is set, add it to the URL
 	// Otherwise, get the oauth2 token and set the Authorization header
@@ -471,7 +462,7 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 		anthropicResponse := anthropic.AcquireAnthropicMessageResponse()
 		defer anthropic.ReleaseAnthropicMessageResponse(anthropicResponse)
 
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), anthropicResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), anthropicResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
@@ -492,12 +483,12 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 		}
 
 		// Set raw request if enabled
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			response.ExtraFields.RawRequest = rawRequest
 		}
 
 		// Set raw response if enabled
-		if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+		if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 			response.ExtraFields.RawResponse = rawResponse
 		}
 
@@ -505,7 +496,7 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 	} else if schemas.IsGeminiModel(deployment) {
 		geminiResponse := gemini.GenerateContentResponse{}
 
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), &geminiResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), &geminiResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
@@ -519,11 +510,11 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 		}
 		response.ExtraFields.Latency = latency.Milliseconds()
 
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			response.ExtraFields.RawRequest = rawRequest
 		}
 
-		if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+		if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 			response.ExtraFields.RawResponse = rawResponse
 		}
 
@@ -532,7 +523,7 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 		response := &schemas.BifrostChatResponse{}
 
 		// Use enhanced response handler with pre-allocated response
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), response, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
@@ -546,12 +537,12 @@ func (provider *VertexProvider) ChatCompletion(ctx context.Context, key schemas.
 		response.ExtraFields.Latency = latency.Milliseconds()
 
 		// Set raw request if enabled
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			response.ExtraFields.RawRequest = rawRequest
 		}
 
 		// Set raw response if enabled
-		if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+		if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 			response.ExtraFields.RawResponse = rawResponse
 		}
 
@@ -608,12 +599,12 @@ func (provider *VertexProvider) ChatCompletionStream(ctx context.Context, postHo
 				}
 
 				// Convert struct to map for Vertex API
-				reqBytes, err := sonic.Marshal(reqBody)
+				reqBytes, err := serialization.Marshal(reqBody)
 				if err != nil {
 					return nil, fmt.Errorf("failed to marshal request body: %w", err)
 				}
 				var requestBody map[string]interface{}
-				if err := sonic.Unmarshal(reqBytes, &requestBody); err != nil {
+				if err := serialization.Unmarshal(reqBytes, &requestBody); err != nil {
 					return nil, fmt.Errorf("failed to unmarshal request body: %w", err)
 				}
 
@@ -662,13 +653,13 @@ func (provider *VertexProvider) ChatCompletionStream(ctx context.Context, postHo
 			completeURL,
 			jsonData,
 			headers,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			providerName,
 			postHookRunner,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 			&providerUtils.RequestMetadata{
 				Provider:    providerName,
 				Model:       request.Model,
@@ -742,14 +733,14 @@ func (provider *VertexProvider) ChatCompletionStream(ctx context.Context, postHo
 			completeURL,
 			jsonData,
 			headers,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			provider.GetProviderKey(),
 			request.Model,
 			postHookRunner,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 		)
 	} else {
 		var authHeader map[string]string
@@ -819,15 +810,15 @@ func (provider *VertexProvider) ChatCompletionStream(ctx context.Context, postHo
 			completeURL,
 			request,
 			authHeader,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			providerName,
 			postHookRunner,
 			nil,
 			postRequestConverter,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 		)
 	}
 }
@@ -878,7 +869,7 @@ func (provider *VertexProvider) Responses(ctx context.Context, key schemas.Key,
 
 		req.Header.SetMethod(http.MethodPost)
 		req.Header.SetContentType("application/json")
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 		// Getting oauth2 token
 		tokenSource, err := getAuthTokenSource(key)
@@ -916,7 +907,7 @@ func (provider *VertexProvider) Responses(ctx context.Context, key schemas.Key,
 		anthropicResponse := anthropic.AcquireAnthropicMessageResponse()
 		defer anthropic.ReleaseAnthropicMessageResponse(anthropicResponse)
 
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), anthropicResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), anthropicResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
@@ -934,12 +925,12 @@ func (provider *VertexProvider) Responses(ctx context.Context, key schemas.Key,
 		response.ExtraFields.ModelRequested = request.Model
 
 		// Set raw request if enabled
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			response.ExtraFields.RawRequest = rawRequest
 		}
 
 		// Set raw response if enabled
-		if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+		if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 			response.ExtraFields.RawResponse = rawResponse
 		}
 		if request.Model != deployment {
@@ -996,7 +987,7 @@ func (provider *VertexProvider) Responses(ctx context.Context, key schemas.Key,
 
 		req.Header.SetMethod(http.MethodPost)
 		req.Header.SetContentType("application/json")
-		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+		providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 		// If auth query is set, add it to the URL
 		// Otherwise, get the oauth2 token and set the Authorization header
@@ -1038,7 +1029,7 @@ func (provider *VertexProvider) Responses(ctx context.Context, key schemas.Key,
 
 		geminiResponse := &gemini.GenerateContentResponse{}
 
-		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(resp.Body(), geminiResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest), providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse))
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(ctx, resp.Body(), geminiResponse, jsonBody, providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()), providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()))
 		if bifrostErr != nil {
 			return nil, bifrostErr
 		}
@@ -1054,14 +1045,14 @@ func (provider *VertexProvider) Responses(ctx context.Context, key schemas.Key,
 		}
 
 		// Set raw response if enabled
-		if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+		if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 			response.ExtraFields.RawResponse = rawResponse
 		}
 		if request.Model != deployment {
 			response.ExtraFields.ModelDeployment = deployment
 		}
 
-		if providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest) {
+		if providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()) {
 			response.ExtraFields.RawRequest = rawRequest
 		}
 
@@ -1156,13 +1147,13 @@ func (provider *VertexProvider) ResponsesStream(ctx context.Context, postHookRun
 			url,
 			jsonBody,
 			headers,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			provider.GetProviderKey(),
 			postHookRunner,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 			&providerUtils.RequestMetadata{
 				Provider:    provider.GetProviderKey(),
 				Model:       request.Model,
@@ -1254,14 +1245,14 @@ func (provider *VertexProvider) ResponsesStream(ctx context.Context, postHookRun
 			completeURL,
 			jsonData,
 			headers,
-			provider.networkConfig.ExtraHeaders,
-			providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-			providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+			provider.NetworkConfig().ExtraHeaders,
+			providerUtils.ShouldSendBackRawRequest(ctx, provider.SendBackRawRequest()),
+			providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()),
 			provider.GetProviderKey(),
 			request.Model,
 			postHookRunner,
 			postResponseConverter,
-			provider.logger,
+			provider.Logger(),
 		)
 	} else {
 		ctx = context.WithValue(ctx, schemas.BifrostContextKeyIsResponsesToChatCompletionFallback, true)
@@ -1323,7 +1314,7 @@ func (provider *VertexProvider) Embedding(ctx context.Context, key schemas.Key,
 	req.Header.SetContentType("application/json")
 
 	// Set any extra headers from network config
-	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	providerUtils.SetExtraHeaders(ctx, req, provider.NetworkConfig().ExtraHeaders, nil)
 
 	// Getting oauth2 token
 	tokenSource, err := getAuthTokenSource(key)
@@ -1359,7 +1350,7 @@ func (provider *VertexProvider) Embedding(ctx context.Context, key schemas.Key,
 		if len(responseBody) > 0 {
 			// Try to parse Vertex's error format
 			var vertexError map[string]interface{}
-			if err := sonic.Unmarshal(resp.Body(), &vertexError); err != nil {
+			if err := serialization.Unmarshal(resp.Body(), &vertexError); err != nil {
 				return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, schemas.Vertex)
 			}
 
@@ -1379,7 +1370,7 @@ func (provider *VertexProvider) Embedding(ctx context.Context, key schemas.Key,
 
 	// Parse Vertex's native embedding response using typed response
 	var vertexResponse VertexEmbeddingResponse
-	if err := sonic.Unmarshal(resp.Body(), &vertexResponse); err != nil {
+	if err := serialization.Unmarshal(resp.Body(), &vertexResponse); err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, schemas.Vertex)
 	}
 
@@ -1397,9 +1388,9 @@ func (provider *VertexProvider) Embedding(ctx context.Context, key schemas.Key,
 	}
 
 	// Set raw response if enabled
-	if providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse) {
+	if providerUtils.ShouldSendBackRawResponse(ctx, provider.SendBackRawResponse()) {
 		var rawResponseMap map[string]interface{}
-		if err := sonic.Unmarshal(resp.Body(), &rawResponseMap); err != nil {
+		if err := serialization.Unmarshal(resp.Body(), &rawResponseMap); err != nil {
 			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRawResponseUnmarshal, err, providerName)
 		}
 		bifrostResponse.ExtraFields.RawResponse = rawResponseMap
@@ -0,0 +1,86 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a 429 for a used up quota fails over to the fallbacks without retrying, while a rate limit is retried
+func TestExecuteRequestWithRetries_QuotaExhaustedNotRetried(t *testing.T) {
+	tests := []struct {
+		name             string
+		body             string
+		expectedAttempts int32
+		expectedKind     schemas.RateLimitKind
+	}{
+		{
+			name:             "rate limited",
+			body:             `{"error":{"message":"Rate limit reached for requests","type":"requests","code":"rate_limit_exceeded"}}`,
+			expectedAttempts: 3,
+			expectedKind:     schemas.RateLimitKindRateLimited,
+		},
+		{
+			name:             "quota exhausted",
+			body:             `{"error":{"message":"You exceeded your current quota, please check your plan and billing details.","type":"insufficient_quota","code":"insufficient_quota"}}`,
+			expectedAttempts: 1,
+			expectedKind:     schemas.RateLimitKindQuotaExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			primaryServer := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(tt.body))
+			})
+
+			var fallbackCalls atomic.Int32
+			fallbackServer := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+				fallbackCalls.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(mockChatCompletionBody))
+			})
+
+			const fallbackProvider = schemas.ModelProvider("fallback-openai")
+			account := NewMockAccount()
+			account.addOpenAICompatibleProvider(schemas.OpenAI, primaryServer.URL, nil)
+			account.addOpenAICompatibleProvider(fallbackProvider, fallbackServer.URL, nil)
+			account.configs[schemas.OpenAI].NetworkConfig.MaxRetries = 2
+			account.configs[schemas.OpenAI].NetworkConfig.RetryBackoffInitial = 1
+			account.configs[schemas.OpenAI].NetworkConfig.RetryBackoffMax = 1
+			client := initTestClient(t, account, schemas.BifrostConfig{})
+
+			// Without fallbacks the classified error is returned
+			_, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+			if bifrostErr == nil {
+				t.Fatal("Expected the request to fail")
+			}
+			if bifrostErr.RateLimitKind != tt.expectedKind {
+				t.Errorf("Expected rate limit kind %q, got %q", tt.expectedKind, bifrostErr.RateLimitKind)
+			}
+			if got := attempts.Load(); got != tt.expectedAttempts {
+				t.Errorf("Expected %d attempts, got %d", tt.expectedAttempts, got)
+			}
+
+			// With fallbacks the request fails over once the primary provider gives up
+			attempts.Store(0)
+			request := newTestChatRequest(schemas.OpenAI)
+			request.Fallbacks = []schemas.Fallback{{Provider: fallbackProvider, Model: "gpt-4o-mini"}}
+			if _, bifrostErr := client.ChatCompletionRequest(context.Background(), request); bifrostErr != nil {
+				t.Fatalf("Expected the request to fail over, got error: %v", GetErrorMessage(bifrostErr))
+			}
+			if got := attempts.Load(); got != tt.expectedAttempts {
+				t.Errorf("Expected %d attempts before failing over, got %d", tt.expectedAttempts, got)
+			}
+			if fallbackCalls.Load() != 1 {
+				t.Errorf("Expected the fallback provider to serve the request, got %d calls", fallbackCalls.Load())
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package bifrost
+
+import (
+	"strings"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+)
+
+// stripRawResponseFields removes the denylisted JSON paths from the raw response of a response, so that
+// provider-internal fields are not returned to clients when raw responses are sent back.
+func stripRawResponseFields(response *schemas.BifrostResponse, denylist []string) {
+	if response == nil || len(denylist) == 0 {
+		return
+	}
+	extraFields := response.GetExtraFields()
+	if extraFields == nil || extraFields.RawResponse == nil {
+		return
+	}
+	extraFields.RawResponse = stripJSONPaths(extraFields.RawResponse, denylist)
+}
+
+// stripJSONPaths returns the raw value without the given paths. Decoded JSON objects and arrays are modified in place,
+// raw JSON strings and bytes are decoded and re-encoded when a path is removed, other values are returned as is
+// if they cannot be decoded.
+func stripJSONPaths(raw any, paths []string) any {
+	switch v := raw.(type) {
+	case map[string]any, []any:
+		for _, path := range paths {
+			removeJSONPath(v, strings.Split(path, "."))
+		}
+		return v
+	case string:
+		if stripped, ok := stripEncodedJSONPaths([]byte(v), paths); ok {
+			return string(stripped)
+		}
+		return v
+	case []byte:
+		if stripped, ok := stripEncodedJSONPaths(v, paths); ok {
+			return stripped
+		}
+		return v
+	default:
+		data, err := serialization.Marshal(v)
+		if err != nil {
+			return v
+		}
+		var decoded any
+		if err := serialization.Unmarshal(data, &decoded); err != nil {
+			return v
+		}
+		return stripJSONPaths(decoded, paths)
+	}
+}
+
+// stripEncodedJSONPaths removes the given paths from encoded JSON. Returns false if the data is not JSON
+// or none of the paths were found.
+func stripEncodedJSONPaths(data []byte, paths []string) ([]byte, bool) {
+	var decoded any
+	if err := serialization.Unmarshal(data, &decoded); err != nil {
+		return nil, false
+	}
+	removed := false
+	for _, path := range paths {
+		removed = removeJSONPath(decoded, strings.Split(path, ".")) || removed
+	}
+	if !removed {
+		return nil, false
+	}
+	stripped, err := serialization.Marshal(decoded)
+	if err != nil {
+		return nil, false
+	}
+	return stripped, true
+}
+
+// removeJSONPath removes a dot-separated path from a decoded JSON value in place, where "*" matches every key
+// of an object or every element of an array. Returns whether anything was removed.
+func removeJSONPath(value any, segments []string) bool {
+	if len(segments) == 0 {
+		return false
+	}
+	segment, rest := segments[0], segments[1:]
+	removed := false
+	switch v := value.(type) {
+	case map[string]any:
+		if segment == "*" {
+			for key, field := range v {
+				if len(rest) == 0 {
+					delete(v, key)
+					removed = true
+				} else {
+					removed = removeJSONPath(field, rest) || removed
+				}
+			}
+			return removed
+		}
+		field, ok := v[segment]
+		if !ok {
+			return false
+		}
+		if len(rest) == 0 {
+			delete(v, segment)
+			return true
+		}
+		return removeJSONPath(field, rest)
+	case []any:
+		// Array elements cannot be removed without shifting the others, so "*" only descends into them
+		if segment != "*" || len(rest) == 0 {
+			return false
+		}
+		for _, item := range v {
+			removed = removeJSONPath(item, rest) || removed
+		}
+	}
+	return removed
+}
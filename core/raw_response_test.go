@@ -0,0 +1,61 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the denylisted paths are removed from the raw response returned to clients
+func TestRawResponseDenylist(t *testing.T) {
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"gpt-4o-mini","organization":"org-internal",` +
+			`"choices":[{"index":0,"message":{"role":"assistant","content":"ok"},"finish_reason":"stop","internal_id":"trace-1"}],` +
+			`"usage":{"prompt_tokens":1,"completion_tokens":1,"total_tokens":2}}`))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.configs[schemas.OpenAI].SendBackRawResponse = true
+	account.configs[schemas.OpenAI].RawResponseDenylist = []string{"organization", "choices.*.internal_id", "missing.path"}
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	response, bifrostErr := client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+	if bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	rawResponse, ok := response.ExtraFields.RawResponse.(map[string]any)
+	if !ok {
+		t.Fatalf("Expected the raw response to be sent back, got %T", response.ExtraFields.RawResponse)
+	}
+	if _, ok := rawResponse["organization"]; ok {
+		t.Error("Expected organization to be removed from the raw response")
+	}
+	choice := rawResponse["choices"].([]any)[0].(map[string]any)
+	if _, ok := choice["internal_id"]; ok {
+		t.Error("Expected choices.*.internal_id to be removed from the raw response")
+	}
+	if rawResponse["id"] != "chatcmpl-1" || choice["finish_reason"] != "stop" {
+		t.Errorf("Expected the other fields to be kept, got %v", rawResponse)
+	}
+}
+
+// Test that denylisted paths are removed from raw responses sent as encoded JSON, such as stream chunks
+func TestStripJSONPaths_EncodedJSON(t *testing.T) {
+	paths := []string{"organization", "data.*.internal"}
+	stripped := stripJSONPaths(`{"organization":"org-internal","data":[{"internal":1,"value":2}]}`, paths)
+	if stripped != `{"data":[{"value":2}]}` {
+		t.Errorf("Expected the paths to be removed from the raw JSON, got %v", stripped)
+	}
+
+	// Values without denylisted paths are returned as is
+	if unchanged := stripJSONPaths(`{"id":"1"}`, paths); unchanged != `{"id":"1"}` {
+		t.Errorf("Expected the raw JSON to be unchanged, got %v", unchanged)
+	}
+	if unchanged := stripJSONPaths("data: [DONE]", paths); unchanged != "data: [DONE]" {
+		t.Errorf("Expected non-JSON raw responses to be unchanged, got %v", unchanged)
+	}
+}
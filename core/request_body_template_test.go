@@ -0,0 +1,59 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the chat request body sent to a custom provider is reshaped by its request body template
+func TestCustomProviderRequestBodyTemplate(t *testing.T) {
+	bodies := make(chan map[string]any, 1)
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies <- body
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	const customProvider = schemas.ModelProvider("self-hosted")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(customProvider, server.URL, nil)
+	account.configs[customProvider].CustomProviderConfig.RequestBodyTemplates = map[schemas.RequestType]*schemas.RequestBodyTemplate{
+		schemas.ChatCompletionRequest: {
+			Fields: map[string]string{
+				"model_name":             "model",
+				"input.messages":         "messages",
+				"parameters.temperature": "temperature",
+			},
+			Defaults: map[string]any{"parameters.return_full_text": false},
+		},
+	}
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	request := newTestChatRequest(customProvider)
+	request.Params = &schemas.ChatParameters{Temperature: schemas.Ptr(0.5)}
+	if _, bifrostErr := client.ChatCompletionRequest(context.Background(), request); bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+
+	body := <-bodies
+	if body["model_name"] != "gpt-4o-mini" {
+		t.Errorf("Expected the model to be renamed to model_name, got %v", body)
+	}
+	if _, ok := body["messages"]; ok {
+		t.Errorf("Expected unmapped fields to be dropped, got %v", body)
+	}
+	input, _ := body["input"].(map[string]any)
+	if messages, _ := input["messages"].([]any); len(messages) != 1 {
+		t.Errorf("Expected the messages to be nested under input, got %v", body["input"])
+	}
+	parameters, _ := body["parameters"].(map[string]any)
+	if parameters["temperature"] != 0.5 || parameters["return_full_text"] != false {
+		t.Errorf("Expected the temperature and the default under parameters, got %v", body["parameters"])
+	}
+}
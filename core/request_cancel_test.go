@@ -0,0 +1,42 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a caller stops waiting for a queued request once its context is done, even though no worker has
+// picked the request up yet
+func TestTryRequest_ContextDoneWhileQueued(t *testing.T) {
+	release := make(chan struct{})
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.configs[schemas.OpenAI].ConcurrencyAndBufferSize.Concurrency = 1
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+	defer close(release)
+
+	// The only worker is held by a request the server does not answer
+	go client.ChatCompletionRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, bifrostErr := client.ChatCompletionRequest(ctx, newTestChatRequest(schemas.OpenAI))
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the caller to stop waiting at its deadline, took %v", elapsed)
+	}
+	if bifrostErr == nil || bifrostErr.Error == nil || bifrostErr.Error.Type == nil || *bifrostErr.Error.Type != schemas.RequestCancelled {
+		t.Fatalf("Expected a cancelled request error, got %v", GetErrorMessage(bifrostErr))
+	}
+}
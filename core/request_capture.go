@@ -0,0 +1,370 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+	"github.com/valyala/fasthttp"
+)
+
+// capturedRequestSensitiveSuffixes are the (normalized) field name suffixes whose values are redacted from
+// captured requests.
+var capturedRequestSensitiveSuffixes = []string{
+	"api_key", "apikey", "secret", "password", "authorization",
+	"access_key", "access_token", "refresh_token", "session_token", "credentials",
+}
+
+// failedRequestCaptures keeps the captures of failed requests, bounded in count and age.
+type failedRequestCaptures struct {
+	mu              sync.Mutex
+	captures        []schemas.CapturedRequest // Oldest first
+	enabled         bool
+	maxEntries      int
+	ttl             time.Duration
+	maxPayloadBytes int
+}
+
+// configure applies the capture configuration, a nil configuration disables capturing and drops the captures.
+func (c *failedRequestCaptures) configure(config *schemas.FailedRequestCaptureConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if config == nil {
+		c.enabled = false
+		c.captures = nil
+		return
+	}
+	c.enabled = true
+	c.maxEntries = config.MaxEntries
+	if c.maxEntries <= 0 {
+		c.maxEntries = schemas.DefaultFailedRequestCaptureMaxEntries
+	}
+	c.ttl = config.TTL
+	if c.ttl <= 0 {
+		c.ttl = schemas.DefaultFailedRequestCaptureTTL
+	}
+	c.maxPayloadBytes = config.MaxPayloadBytes
+	if c.maxPayloadBytes <= 0 {
+		c.maxPayloadBytes = schemas.DefaultFailedRequestCaptureMaxPayloadBytes
+	}
+	c.evict(time.Now())
+}
+
+// isEnabled reports whether failed requests are captured.
+func (c *failedRequestCaptures) isEnabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.enabled
+}
+
+// fits reports whether a redacted payload of the given size is small enough to be captured.
+func (c *failedRequestCaptures) fits(payloadBytes int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return payloadBytes <= c.maxPayloadBytes
+}
+
+// add stores a capture, evicting expired captures and the oldest ones beyond the bound.
+func (c *failedRequestCaptures) add(capture schemas.CapturedRequest) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.enabled {
+		return
+	}
+	c.captures = append(c.captures, capture)
+	c.evict(capture.CapturedAt)
+}
+
+// evict drops expired captures and the oldest ones beyond the bound, the lock must be held.
+func (c *failedRequestCaptures) evict(now time.Time) {
+	start := 0
+	for start < len(c.captures) && now.Sub(c.captures[start].CapturedAt) > c.ttl {
+		start++
+	}
+	start = max(start, len(c.captures)-c.maxEntries)
+	if start > 0 {
+		c.captures = append([]schemas.CapturedRequest(nil), c.captures[start:]...)
+	}
+}
+
+// list returns the captures that did not expire, most recent first.
+func (c *failedRequestCaptures) list() []schemas.CapturedRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.enabled {
+		return nil
+	}
+	c.evict(time.Now())
+	captures := make([]schemas.CapturedRequest, 0, len(c.captures))
+	for i := len(c.captures) - 1; i >= 0; i-- {
+		captures = append(captures, c.captures[i])
+	}
+	return captures
+}
+
+// FailedRequestCaptures returns the captured failed requests, most recent first.
+// Requests are only captured when BifrostConfig.FailedRequestCapture is set.
+func (bifrost *Bifrost) FailedRequestCaptures() []schemas.CapturedRequest {
+	return bifrost.failedRequests.list()
+}
+
+// GetFailedRequestCapture returns the captured failed request with the given capture ID, if it is still kept.
+func (bifrost *Bifrost) GetFailedRequestCapture(id string) (*schemas.CapturedRequest, bool) {
+	for _, capture := range bifrost.failedRequests.list() {
+		if capture.ID == id {
+			return &capture, true
+		}
+	}
+	return nil, false
+}
+
+// captureFailedRequest stores a redacted copy of a failed request, if capturing is enabled and the request type
+// can be replayed. It must be called before the request is released to the pool.
+func (bifrost *Bifrost) captureFailedRequest(ctx context.Context, req *schemas.BifrostRequest, bifrostErr *schemas.BifrostError) {
+	if bifrostErr == nil || !bifrost.failedRequests.isEnabled() {
+		return
+	}
+	var request any
+	var extraParams map[string]any
+	switch req.RequestType {
+	case schemas.TextCompletionRequest, schemas.TextCompletionStreamRequest:
+		request = req.TextCompletionRequest
+		if req.TextCompletionRequest.Params != nil {
+			extraParams = req.TextCompletionRequest.Params.ExtraParams
+		}
+	case schemas.ChatCompletionRequest, schemas.ChatCompletionStreamRequest:
+		request = req.ChatRequest
+		if req.ChatRequest.Params != nil {
+			extraParams = req.ChatRequest.Params.ExtraParams
+		}
+	case schemas.ResponsesRequest, schemas.ResponsesStreamRequest:
+		request = req.ResponsesRequest
+		if req.ResponsesRequest.Params != nil {
+			extraParams = req.ResponsesRequest.Params.ExtraParams
+		}
+	case schemas.EmbeddingRequest:
+		request = req.EmbeddingRequest
+		if req.EmbeddingRequest.Params != nil {
+			extraParams = req.EmbeddingRequest.Params.ExtraParams
+		}
+	case schemas.SpeechRequest, schemas.SpeechStreamRequest:
+		request = req.SpeechRequest
+		if req.SpeechRequest.Params != nil {
+			extraParams = req.SpeechRequest.Params.ExtraParams
+		}
+	case schemas.TranscriptionRequest, schemas.TranscriptionStreamRequest:
+		request = req.TranscriptionRequest
+		if req.TranscriptionRequest.Params != nil {
+			extraParams = req.TranscriptionRequest.Params.ExtraParams
+		}
+	default:
+		return
+	}
+
+	redacted, err := redactCapturedValue(request)
+	if err != nil {
+		bifrost.logger.Warn(fmt.Sprintf("failed to capture failed %s request: %v", req.RequestType, err))
+		return
+	}
+	var redactedExtraParams []byte
+	if len(extraParams) > 0 {
+		if redactedExtraParams, err = redactCapturedValue(extraParams); err != nil {
+			bifrost.logger.Warn(fmt.Sprintf("failed to capture failed %s request: %v", req.RequestType, err))
+			return
+		}
+	}
+	if size := len(redacted) + len(redactedExtraParams); !bifrost.failedRequests.fits(size) {
+		bifrost.logger.Debug(fmt.Sprintf("not capturing failed %s request: its %d byte payload exceeds the capture limit", req.RequestType, size))
+		return
+	}
+	provider, model, _ := req.GetRequestFields()
+	capture := schemas.CapturedRequest{
+		ID:          uuid.New().String(),
+		RequestType: req.RequestType,
+		Provider:    provider,
+		Model:       model,
+		Request:     redacted,
+		ExtraParams: redactedExtraParams,
+		Error:       GetErrorMessage(bifrostErr),
+		StatusCode:  bifrostErr.StatusCode,
+		CapturedAt:  time.Now(),
+	}
+	if ctx != nil {
+		if requestID, ok := ctx.Value(schemas.BifrostContextKeyRequestID).(string); ok {
+			capture.RequestID = requestID
+		}
+	}
+	bifrost.failedRequests.add(capture)
+}
+
+// redactCapturedValue serializes a value, replacing the values of credential fields. The value is serialized
+// first, so that it is not modified.
+func redactCapturedValue(value any) ([]byte, error) {
+	data, err := serialization.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	if err := serialization.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	redactCapturedFields(decoded)
+	return serialization.Marshal(decoded)
+}
+
+// redactCapturedFields walks a decoded JSON value, redacting the values of credential fields in place.
+func redactCapturedFields(value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveCapturedField(key) {
+				v[key] = schemas.CapturedRequestRedacted
+				continue
+			}
+			redactCapturedFields(field)
+		}
+	case []any:
+		for _, item := range v {
+			redactCapturedFields(item)
+		}
+	}
+}
+
+func isSensitiveCapturedField(name string) bool {
+	normalized := strings.ReplaceAll(strings.ToLower(name), "-", "_")
+	for _, suffix := range capturedRequestSensitiveSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplayCapturedRequest re-submits a captured request through the regular request path, including plugins,
+// to reproduce its failure. Streaming requests are replayed as streams and return a stream channel, other
+// requests return a response.
+func (bifrost *Bifrost) ReplayCapturedRequest(ctx context.Context, capture *schemas.CapturedRequest) (*schemas.BifrostResponse, chan *schemas.BifrostStream, *schemas.BifrostError) {
+	if capture == nil || len(capture.Request) == 0 {
+		return nil, nil, newBifrostErrorFromMsg("captured request is required")
+	}
+	invalid := func(err error) *schemas.BifrostError {
+		bifrostErr := newBifrostErrorFromMsg(fmt.Sprintf("invalid captured %s request: %v", capture.RequestType, err))
+		bifrostErr.StatusCode = schemas.Ptr(fasthttp.StatusBadRequest)
+		return bifrostErr
+	}
+
+	var extraParams map[string]any
+	if len(capture.ExtraParams) > 0 {
+		if err := serialization.Unmarshal(capture.ExtraParams, &extraParams); err != nil {
+			return nil, nil, invalid(err)
+		}
+	}
+
+	response := &schemas.BifrostResponse{}
+	var bifrostErr *schemas.BifrostError
+	switch capture.RequestType {
+	case schemas.TextCompletionRequest, schemas.TextCompletionStreamRequest:
+		var req schemas.BifrostTextCompletionRequest
+		if err := serialization.Unmarshal(capture.Request, &req); err != nil {
+			return nil, nil, invalid(err)
+		}
+		if extraParams != nil {
+			if req.Params == nil {
+				req.Params = &schemas.TextCompletionParameters{}
+			}
+			req.Params.ExtraParams = extraParams
+		}
+		if capture.RequestType == schemas.TextCompletionStreamRequest {
+			stream, bifrostErr := bifrost.TextCompletionStreamRequest(ctx, &req)
+			return nil, stream, bifrostErr
+		}
+		response.TextCompletionResponse, bifrostErr = bifrost.TextCompletionRequest(ctx, &req)
+	case schemas.ChatCompletionRequest, schemas.ChatCompletionStreamRequest:
+		var req schemas.BifrostChatRequest
+		if err := serialization.Unmarshal(capture.Request, &req); err != nil {
+			return nil, nil, invalid(err)
+		}
+		if extraParams != nil {
+			if req.Params == nil {
+				req.Params = &schemas.ChatParameters{}
+			}
+			req.Params.ExtraParams = extraParams
+		}
+		if capture.RequestType == schemas.ChatCompletionStreamRequest {
+			stream, bifrostErr := bifrost.ChatCompletionStreamRequest(ctx, &req)
+			return nil, stream, bifrostErr
+		}
+		response.ChatResponse, bifrostErr = bifrost.ChatCompletionRequest(ctx, &req)
+	case schemas.ResponsesRequest, schemas.ResponsesStreamRequest:
+		var req schemas.BifrostResponsesRequest
+		if err := serialization.Unmarshal(capture.Request, &req); err != nil {
+			return nil, nil, invalid(err)
+		}
+		if extraParams != nil {
+			if req.Params == nil {
+				req.Params = &schemas.ResponsesParameters{}
+			}
+			req.Params.ExtraParams = extraParams
+		}
+		if capture.RequestType == schemas.ResponsesStreamRequest {
+			stream, bifrostErr := bifrost.ResponsesStreamRequest(ctx, &req)
+			return nil, stream, bifrostErr
+		}
+		response.ResponsesResponse, bifrostErr = bifrost.ResponsesRequest(ctx, &req)
+	case schemas.EmbeddingRequest:
+		var req schemas.BifrostEmbeddingRequest
+		if err := serialization.Unmarshal(capture.Request, &req); err != nil {
+			return nil, nil, invalid(err)
+		}
+		if extraParams != nil {
+			if req.Params == nil {
+				req.Params = &schemas.EmbeddingParameters{}
+			}
+			req.Params.ExtraParams = extraParams
+		}
+		response.EmbeddingResponse, bifrostErr = bifrost.EmbeddingRequest(ctx, &req)
+	case schemas.SpeechRequest, schemas.SpeechStreamRequest:
+		var req schemas.BifrostSpeechRequest
+		if err := serialization.Unmarshal(capture.Request, &req); err != nil {
+			return nil, nil, invalid(err)
+		}
+		if extraParams != nil {
+			if req.Params == nil {
+				req.Params = &schemas.SpeechParameters{}
+			}
+			req.Params.ExtraParams = extraParams
+		}
+		if capture.RequestType == schemas.SpeechStreamRequest {
+			stream, bifrostErr := bifrost.SpeechStreamRequest(ctx, &req)
+			return nil, stream, bifrostErr
+		}
+		response.SpeechResponse, bifrostErr = bifrost.SpeechRequest(ctx, &req)
+	case schemas.TranscriptionRequest, schemas.TranscriptionStreamRequest:
+		var req schemas.BifrostTranscriptionRequest
+		if err := serialization.Unmarshal(capture.Request, &req); err != nil {
+			return nil, nil, invalid(err)
+		}
+		if extraParams != nil {
+			if req.Params == nil {
+				req.Params = &schemas.TranscriptionParameters{}
+			}
+			req.Params.ExtraParams = extraParams
+		}
+		if capture.RequestType == schemas.TranscriptionStreamRequest {
+			stream, bifrostErr := bifrost.TranscriptionStreamRequest(ctx, &req)
+			return nil, stream, bifrostErr
+		}
+		response.TranscriptionResponse, bifrostErr = bifrost.TranscriptionRequest(ctx, &req)
+	default:
+		return nil, nil, invalid(fmt.Errorf("request type %q cannot be replayed", capture.RequestType))
+	}
+	if bifrostErr != nil {
+		return nil, nil, bifrostErr
+	}
+	return response, nil, nil
+}
@@ -0,0 +1,158 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// extraParamsRecorder is a plugin recording the extra parameters of the chat requests it sees
+type extraParamsRecorder struct {
+	extraParams atomic.Value
+}
+
+func (p *extraParamsRecorder) GetName() string { return "extra-params-recorder" }
+
+func (p *extraParamsRecorder) TransportInterceptor(ctx *schemas.BifrostContext, url string, headers map[string]string, body map[string]any) (map[string]string, map[string]any, error) {
+	return headers, body, nil
+}
+
+func (p *extraParamsRecorder) PreHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error) {
+	if req.ChatRequest != nil && req.ChatRequest.Params != nil {
+		p.extraParams.Store(req.ChatRequest.Params.ExtraParams)
+	}
+	return req, nil, nil
+}
+
+func (p *extraParamsRecorder) PostHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	return result, err, nil
+}
+
+func (p *extraParamsRecorder) Cleanup() error { return nil }
+
+// Test that a failed request is captured with its credentials redacted, and that re-submitting its export
+// replays the same request
+func TestFailedRequestCapture_CaptureAndReplay(t *testing.T) {
+	var calls atomic.Int32
+	var replayedBody atomic.Value
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"unsupported parameter: reasoning_mode","type":"invalid_request_error"}}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		replayedBody.Store(string(body))
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	recorder := &extraParamsRecorder{}
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		Plugins:              []schemas.Plugin{recorder},
+		FailedRequestCapture: &schemas.FailedRequestCaptureConfig{},
+	})
+
+	req := newTestChatRequest(schemas.OpenAI)
+	req.Params = &schemas.ChatParameters{
+		Temperature: schemas.Ptr(0.2),
+		ExtraParams: map[string]any{
+			"reasoning_mode": "deep",
+			"plugin_config":  map[string]any{"x-api-key": "secret-value"},
+		},
+	}
+	ctx := context.WithValue(context.Background(), schemas.BifrostContextKeyRequestID, "req-capture-1")
+	if _, bifrostErr := client.ChatCompletionRequest(ctx, req); bifrostErr == nil {
+		t.Fatal("Expected the first request to fail")
+	}
+	if req.Params.ExtraParams["plugin_config"].(map[string]any)["x-api-key"] != "secret-value" {
+		t.Error("Expected capturing not to modify the request")
+	}
+
+	captures := client.FailedRequestCaptures()
+	if len(captures) != 1 {
+		t.Fatalf("Expected 1 captured request, got %d", len(captures))
+	}
+	capture := captures[0]
+	if capture.RequestID != "req-capture-1" || capture.RequestType != schemas.ChatCompletionRequest || capture.Provider != schemas.OpenAI || capture.Model != "gpt-4o-mini" {
+		t.Errorf("Unexpected capture: %+v", capture)
+	}
+	if capture.StatusCode == nil || *capture.StatusCode != http.StatusBadRequest || !strings.Contains(capture.Error, "reasoning_mode") {
+		t.Errorf("Expected the capture to record the error, got status %v and error %q", capture.StatusCode, capture.Error)
+	}
+	if strings.Contains(string(capture.ExtraParams), "secret-value") || !strings.Contains(string(capture.ExtraParams), schemas.CapturedRequestRedacted) {
+		t.Errorf("Expected credentials to be redacted, got extra params %s", capture.ExtraParams)
+	}
+	if got, ok := client.GetFailedRequestCapture(capture.ID); !ok || got.ID != capture.ID {
+		t.Errorf("Expected the capture to be found by ID")
+	}
+
+	// Re-submit the export as it would be received by the replay endpoint
+	export, err := json.Marshal(capture)
+	if err != nil {
+		t.Fatalf("Failed to export capture: %v", err)
+	}
+	var imported schemas.CapturedRequest
+	if err := json.Unmarshal(export, &imported); err != nil {
+		t.Fatalf("Failed to import capture: %v", err)
+	}
+	response, stream, bifrostErr := client.ReplayCapturedRequest(context.Background(), &imported)
+	if bifrostErr != nil {
+		t.Fatalf("Expected the replay to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if stream != nil || response == nil || response.ChatResponse == nil {
+		t.Fatalf("Expected a chat response, got %+v", response)
+	}
+	body, _ := replayedBody.Load().(string)
+	if !strings.Contains(body, `"temperature": 0.2`) || !strings.Contains(body, `"hello"`) {
+		t.Errorf("Expected the replayed request to match the failed one, got %s", body)
+	}
+	extraParams, _ := recorder.extraParams.Load().(map[string]any)
+	pluginConfig, _ := extraParams["plugin_config"].(map[string]any)
+	if extraParams["reasoning_mode"] != "deep" || pluginConfig["x-api-key"] != schemas.CapturedRequestRedacted {
+		t.Errorf("Expected the replayed request to have the redacted extra params, got %+v", extraParams)
+	}
+	if len(client.FailedRequestCaptures()) != 1 {
+		t.Errorf("Expected the successful replay not to be captured")
+	}
+}
+
+// Test that captures are bounded in count, age and payload size
+func TestFailedRequestCapture_Bounds(t *testing.T) {
+	captures := &failedRequestCaptures{}
+	captures.configure(&schemas.FailedRequestCaptureConfig{MaxEntries: 2, TTL: time.Minute})
+
+	now := time.Now()
+	captures.add(schemas.CapturedRequest{ID: "expired", CapturedAt: now.Add(-2 * time.Minute)})
+	captures.add(schemas.CapturedRequest{ID: "first", CapturedAt: now.Add(-3 * time.Second)})
+	captures.add(schemas.CapturedRequest{ID: "second", CapturedAt: now.Add(-2 * time.Second)})
+	captures.add(schemas.CapturedRequest{ID: "third", CapturedAt: now.Add(-time.Second)})
+
+	list := captures.list()
+	if len(list) != 2 || list[0].ID != "third" || list[1].ID != "second" {
+		t.Errorf("Expected the 2 most recent captures, got %+v", list)
+	}
+
+	if captures.fits(schemas.DefaultFailedRequestCaptureMaxPayloadBytes + 1) {
+		t.Error("Expected payloads above the default limit not to be captured")
+	}
+	captures.configure(&schemas.FailedRequestCaptureConfig{MaxPayloadBytes: 10})
+	if !captures.fits(10) || captures.fits(11) {
+		t.Error("Expected payloads to be capped at the configured limit")
+	}
+
+	captures.configure(nil)
+	captures.add(schemas.CapturedRequest{ID: "disabled", CapturedAt: now})
+	if list := captures.list(); len(list) != 0 {
+		t.Errorf("Expected no captures once disabled, got %+v", list)
+	}
+}
@@ -0,0 +1,227 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// requestHookQueueSize bounds the hook events waiting to be delivered, events are dropped when it is full.
+const requestHookQueueSize = 4096
+
+// RequestHookEvent describes a request attempt sent to a provider.
+type RequestHookEvent struct {
+	RequestID   string
+	Provider    schemas.ModelProvider
+	Model       string
+	RequestType schemas.RequestType
+	KeyID       string
+	Attempt     int       // 1 for the first attempt, incremented on every retry
+	StartTime   time.Time // When the request was sent to the provider
+}
+
+// RequestEndEvent describes the outcome of a request attempt sent to a provider.
+type RequestEndEvent struct {
+	RequestHookEvent
+	EndTime time.Time
+	Latency time.Duration         // Until the response, or until the end of the stream for streaming requests
+	Chunks  int                   // Number of chunks received, for streaming requests
+	Error   *schemas.BifrostError // Error of the attempt, if it failed
+}
+
+// StreamChunkEvent describes a chunk received from a provider stream.
+type StreamChunkEvent struct {
+	RequestHookEvent
+	ChunkIndex     int // 0 for the first chunk
+	ReceivedAt     time.Time
+	SinceStart     time.Duration // Time since the request was sent, the time to first token for the first chunk
+	SinceLastChunk time.Duration // Time since the previous chunk, or since the request was sent for the first chunk
+}
+
+// requestHooks holds the telemetry callbacks registered with OnRequestStart, OnRequestEnd and OnStreamChunk.
+// Callbacks are called from a single dispatcher goroutine, in the order events happened, so that a slow
+// callback never delays requests. Events are dropped if callbacks cannot keep up.
+type requestHooks struct {
+	mu      sync.RWMutex
+	onStart []func(RequestHookEvent)
+	onEnd   []func(RequestEndEvent)
+	onChunk []func(StreamChunkEvent)
+
+	active     atomic.Bool // Whether any callback is registered
+	events     chan func()
+	dispatcher sync.Once
+	dropped    atomic.Int64
+	logger     schemas.Logger
+}
+
+// OnRequestStart registers a callback called when a request attempt is sent to a provider.
+// Callbacks are lightweight telemetry hooks for library embedders, they cannot modify requests (use plugins for that).
+// They are called asynchronously, one at a time, and must not block for long or events will be dropped.
+func (bifrost *Bifrost) OnRequestStart(callback func(RequestHookEvent)) {
+	hooks := bifrost.startRequestHooks()
+	hooks.mu.Lock()
+	hooks.onStart = append(hooks.onStart, callback)
+	hooks.mu.Unlock()
+}
+
+// OnRequestEnd registers a callback called when a request attempt to a provider completes, or when its
+// stream ends for streaming requests. See OnRequestStart for how callbacks are called.
+func (bifrost *Bifrost) OnRequestEnd(callback func(RequestEndEvent)) {
+	hooks := bifrost.startRequestHooks()
+	hooks.mu.Lock()
+	hooks.onEnd = append(hooks.onEnd, callback)
+	hooks.mu.Unlock()
+}
+
+// OnStreamChunk registers a callback called for each chunk received from a provider stream.
+// See OnRequestStart for how callbacks are called.
+func (bifrost *Bifrost) OnStreamChunk(callback func(StreamChunkEvent)) {
+	hooks := bifrost.startRequestHooks()
+	hooks.mu.Lock()
+	hooks.onChunk = append(hooks.onChunk, callback)
+	hooks.mu.Unlock()
+}
+
+// startRequestHooks activates the request hooks, starting the dispatcher goroutine on first use.
+// The dispatcher stops when Bifrost shuts down.
+func (bifrost *Bifrost) startRequestHooks() *requestHooks {
+	hooks := bifrost.requestHooks
+	hooks.dispatcher.Do(func() {
+		go func() {
+			for {
+				select {
+				case <-bifrost.ctx.Done():
+					return
+				case deliver := <-hooks.events:
+					hooks.deliver(deliver)
+				}
+			}
+		}()
+		hooks.active.Store(true)
+	})
+	return hooks
+}
+
+// newRequestHooks creates the request hooks of a Bifrost instance, inactive until a callback is registered.
+func newRequestHooks(logger schemas.Logger) *requestHooks {
+	return &requestHooks{
+		events: make(chan func(), requestHookQueueSize),
+		logger: logger,
+	}
+}
+
+// deliver calls a callback, recovering from panics so that a faulty callback does not stop the dispatcher.
+func (hooks *requestHooks) deliver(deliver func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			hooks.logger.Warn(fmt.Sprintf("request hook callback panicked: %v", r))
+		}
+	}()
+	deliver()
+}
+
+// enqueue queues a callback invocation without blocking, dropping it if the queue is full.
+func (hooks *requestHooks) enqueue(deliver func()) {
+	select {
+	case hooks.events <- deliver:
+	default:
+		if dropped := hooks.dropped.Add(1); dropped == 1 || dropped%1000 == 0 {
+			hooks.logger.Warn(fmt.Sprintf("request hook callbacks are not keeping up, %d events dropped so far", dropped))
+		}
+	}
+}
+
+// requestStarted emits the start event of a request attempt and returns it, or nil if no callback is registered.
+func (hooks *requestHooks) requestStarted(ctx context.Context, provider schemas.ModelProvider, model string, requestType schemas.RequestType, keyID string) *RequestHookEvent {
+	if hooks == nil || !hooks.active.Load() {
+		return nil
+	}
+	event := &RequestHookEvent{
+		Provider:    provider,
+		Model:       model,
+		RequestType: requestType,
+		KeyID:       keyID,
+		Attempt:     1,
+		StartTime:   time.Now(),
+	}
+	if requestID, ok := ctx.Value(schemas.BifrostContextKeyRequestID).(string); ok {
+		event.RequestID = requestID
+	}
+	if retries, ok := ctx.Value(schemas.BifrostContextKeyNumberOfRetries).(int); ok {
+		event.Attempt = retries + 1
+	}
+
+	hooks.mu.RLock()
+	for _, callback := range hooks.onStart {
+		hooks.enqueue(func() { callback(*event) })
+	}
+	hooks.mu.RUnlock()
+	return event
+}
+
+// requestEnded emits the end event of a request attempt started with requestStarted.
+func (hooks *requestHooks) requestEnded(event *RequestHookEvent, chunks int, bifrostErr *schemas.BifrostError) {
+	if event == nil {
+		return
+	}
+	endTime := time.Now()
+	endEvent := RequestEndEvent{
+		RequestHookEvent: *event,
+		EndTime:          endTime,
+		Latency:          endTime.Sub(event.StartTime),
+		Chunks:           chunks,
+		Error:            bifrostErr,
+	}
+	hooks.mu.RLock()
+	for _, callback := range hooks.onEnd {
+		hooks.enqueue(func() { callback(endEvent) })
+	}
+	hooks.mu.RUnlock()
+}
+
+// observeStream emits a chunk event for every chunk of a provider stream, and the end event once it closes.
+// The stream is returned as is if no callback is registered.
+func (hooks *requestHooks) observeStream(event *RequestHookEvent, stream chan *schemas.BifrostStream, bifrostErr *schemas.BifrostError) (chan *schemas.BifrostStream, *schemas.BifrostError) {
+	if event == nil {
+		return stream, bifrostErr
+	}
+	if bifrostErr != nil || stream == nil {
+		hooks.requestEnded(event, 0, bifrostErr)
+		return stream, bifrostErr
+	}
+
+	observed := make(chan *schemas.BifrostStream, cap(stream))
+	go func() {
+		defer close(observed)
+		chunks := 0
+		lastChunkAt := event.StartTime
+		var streamErr *schemas.BifrostError
+		for chunk := range stream {
+			receivedAt := time.Now()
+			chunkEvent := StreamChunkEvent{
+				RequestHookEvent: *event,
+				ChunkIndex:       chunks,
+				ReceivedAt:       receivedAt,
+				SinceStart:       receivedAt.Sub(event.StartTime),
+				SinceLastChunk:   receivedAt.Sub(lastChunkAt),
+			}
+			hooks.mu.RLock()
+			for _, callback := range hooks.onChunk {
+				hooks.enqueue(func() { callback(chunkEvent) })
+			}
+			hooks.mu.RUnlock()
+			chunks++
+			lastChunkAt = receivedAt
+			if chunk != nil && chunk.BifrostError != nil {
+				streamErr = chunk.BifrostError
+			}
+			observed <- chunk
+		}
+		hooks.requestEnded(event, chunks, streamErr)
+	}()
+	return observed, nil
+}
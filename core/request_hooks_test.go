@@ -0,0 +1,169 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// hookRecorder collects the events delivered to request hook callbacks
+type hookRecorder struct {
+	mu     sync.Mutex
+	starts []RequestHookEvent
+	ends   []RequestEndEvent
+	chunks []StreamChunkEvent
+}
+
+func (r *hookRecorder) register(client *Bifrost) {
+	client.OnRequestStart(func(event RequestHookEvent) {
+		r.mu.Lock()
+		r.starts = append(r.starts, event)
+		r.mu.Unlock()
+	})
+	client.OnRequestEnd(func(event RequestEndEvent) {
+		r.mu.Lock()
+		r.ends = append(r.ends, event)
+		r.mu.Unlock()
+	})
+	client.OnStreamChunk(func(event StreamChunkEvent) {
+		r.mu.Lock()
+		r.chunks = append(r.chunks, event)
+		r.mu.Unlock()
+	})
+}
+
+// waitForEnds waits until the given number of end events were delivered, as callbacks are called asynchronously
+func (r *hookRecorder) waitForEnds(t *testing.T, count int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		r.mu.Lock()
+		ends := len(r.ends)
+		r.mu.Unlock()
+		if ends >= count {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %d request end events", count)
+}
+
+func initRequestHooksTestClient(t *testing.T, handler http.HandlerFunc) *Bifrost {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	return initTestClient(t, account, schemas.BifrostConfig{})
+}
+
+// Test that start and end callbacks fire around a provider request with its latency
+func TestRequestHooks_Request(t *testing.T) {
+	const providerDelay = 50 * time.Millisecond
+	client := initRequestHooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(providerDelay)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+	recorder := &hookRecorder{}
+	recorder.register(client)
+
+	ctx := context.WithValue(context.Background(), schemas.BifrostContextKeyRequestID, "req-hooks-1")
+	before := time.Now()
+	if _, bifrostErr := client.ChatCompletionRequest(ctx, newTestChatRequest(schemas.OpenAI)); bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	after := time.Now()
+	recorder.waitForEnds(t, 1)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.starts) != 1 || len(recorder.ends) != 1 || len(recorder.chunks) != 0 {
+		t.Fatalf("Expected 1 start and 1 end event, got %d starts, %d ends and %d chunks", len(recorder.starts), len(recorder.ends), len(recorder.chunks))
+	}
+	start, end := recorder.starts[0], recorder.ends[0]
+	if start.RequestID != "req-hooks-1" || start.Provider != schemas.OpenAI || start.RequestType != schemas.ChatCompletionRequest || start.Attempt != 1 || start.KeyID == "" {
+		t.Errorf("Unexpected start event: %+v", start)
+	}
+	if start.StartTime.Before(before) || end.EndTime.After(after) || end.StartTime != start.StartTime {
+		t.Errorf("Expected the request to be timed within the call, got start %v and end %v", start.StartTime, end.EndTime)
+	}
+	if end.Latency < providerDelay || end.Latency != end.EndTime.Sub(end.StartTime) {
+		t.Errorf("Expected latency of at least %v, got %v", providerDelay, end.Latency)
+	}
+	if end.Error != nil {
+		t.Errorf("Expected no error in end event, got %v", GetErrorMessage(end.Error))
+	}
+}
+
+// Test that chunk callbacks fire for each stream chunk with their timing, and that the end callback fires
+// once the stream is over
+func TestRequestHooks_Stream(t *testing.T) {
+	const chunkDelay = 20 * time.Millisecond
+	client := initRequestHooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+		for i, content := range []string{"Hello", " world", "!"} {
+			time.Sleep(chunkDelay)
+			fmt.Fprintf(w, "data: {\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"created\":1,\"model\":\"gpt-4o-mini\",\"choices\":[{\"index\":0,\"delta\":{\"content\":%q}}]}\n\n", content)
+			if i == 2 {
+				fmt.Fprint(w, "data: {\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"created\":1,\"model\":\"gpt-4o-mini\",\"choices\":[{\"index\":0,\"delta\":{},\"finish_reason\":\"stop\"}],\"usage\":{\"prompt_tokens\":1,\"completion_tokens\":3,\"total_tokens\":4}}\n\n")
+			}
+			flusher.Flush()
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	})
+	recorder := &hookRecorder{}
+	recorder.register(client)
+
+	stream, bifrostErr := client.ChatCompletionStreamRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+	if bifrostErr != nil {
+		t.Fatalf("Expected stream to start, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	var content strings.Builder
+	received := 0
+	for chunk := range stream {
+		received++
+		if chunk.BifrostChatResponse != nil && len(chunk.BifrostChatResponse.Choices) > 0 && chunk.BifrostChatResponse.Choices[0].ChatStreamResponseChoice != nil {
+			if delta := chunk.BifrostChatResponse.Choices[0].ChatStreamResponseChoice.Delta; delta != nil && delta.Content != nil {
+				content.WriteString(*delta.Content)
+			}
+		}
+	}
+	if content.String() != "Hello world!" {
+		t.Errorf("Expected the stream to be forwarded unchanged, got %q", content.String())
+	}
+	recorder.waitForEnds(t, 1)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.chunks) != received {
+		t.Fatalf("Expected a chunk event for each of the %d chunks, got %d", received, len(recorder.chunks))
+	}
+	for i, chunk := range recorder.chunks {
+		if chunk.ChunkIndex != i || chunk.RequestType != schemas.ChatCompletionStreamRequest {
+			t.Errorf("Unexpected chunk event %d: %+v", i, chunk)
+		}
+		if i > 0 && chunk.SinceStart < recorder.chunks[i-1].SinceStart {
+			t.Errorf("Expected chunk timings to increase, chunk %d at %v after chunk %d at %v", i, chunk.SinceStart, i-1, recorder.chunks[i-1].SinceStart)
+		}
+	}
+	if first := recorder.chunks[0]; first.SinceStart < chunkDelay || first.SinceLastChunk != first.SinceStart {
+		t.Errorf("Expected the first chunk after at least %v, got %+v", chunkDelay, first)
+	}
+	end := recorder.ends[0]
+	if end.Chunks != received || end.Error != nil {
+		t.Errorf("Expected end event with %d chunks and no error, got %+v", received, end)
+	}
+	if last := recorder.chunks[len(recorder.chunks)-1]; end.EndTime.Before(last.ReceivedAt) || end.Latency < 3*chunkDelay {
+		t.Errorf("Expected the end event after the last chunk, got latency %v", end.Latency)
+	}
+}
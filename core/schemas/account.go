@@ -49,7 +49,8 @@ type S3BucketConfig struct {
 // BatchS3Config holds S3 bucket configurations for Bedrock batch operations.
 // Supports multiple buckets to allow flexible batch job routing.
 type BatchS3Config struct {
-	Buckets []S3BucketConfig `json:"buckets,omitempty"` // List of S3 bucket configurations
+	Buckets    []S3BucketConfig `json:"buckets,omitempty"`      // List of S3 bucket configurations
+	InputS3URI string           `json:"input_s3_uri,omitempty"` // S3 bucket or prefix inline batch requests are uploaded to (defaults to the output bucket)
 }
 
 // BedrockKeyConfig represents the AWS Bedrock-specific configuration.
@@ -69,6 +70,9 @@ type BedrockKeyConfig struct {
 
 type HuggingFaceKeyConfig struct {
 	Deployments map[string]string `json:"deployments,omitempty"` // Mapping of model identifiers to deployment names
+	// When the inference provider mapping cannot be fetched because the Hub is down (network error or 5xx), send the
+	// model name as is to the selected inference provider instead of failing the request (default: false)
+	ModelMappingFallback bool `json:"model_mapping_fallback,omitempty"`
 }
 
 // Account defines the interface for managing provider accounts and their configurations.
@@ -1,6 +1,11 @@
 // Package schemas defines the core schemas and types used by the Bifrost system.
 package schemas
 
+import (
+	"encoding/json"
+	"io"
+)
+
 // BatchStatus represents the status of a batch job.
 type BatchStatus string
 
@@ -14,8 +19,22 @@ const (
 	BatchStatusCancelling BatchStatus = "cancelling"
 	BatchStatusCancelled  BatchStatus = "cancelled"
 	BatchStatusEnded      BatchStatus = "ended" // Anthropic-specific
+
+	// BatchStatusPartiallyCompleted is a batch that ended with some of its requests failed (Bedrock-specific).
+	// Results are available for both the succeeded and the failed requests.
+	BatchStatusPartiallyCompleted BatchStatus = "partially_completed"
 )
 
+// IsTerminal reports whether the batch status is final, i.e. the batch will not make further progress.
+func (s BatchStatus) IsTerminal() bool {
+	switch s {
+	case BatchStatusCompleted, BatchStatusPartiallyCompleted, BatchStatusFailed, BatchStatusExpired, BatchStatusCancelled, BatchStatusEnded:
+		return true
+	default:
+		return false
+	}
+}
+
 // BatchEndpoint represents supported batch API endpoints.
 type BatchEndpoint string
 
@@ -27,6 +46,14 @@ const (
 	BatchEndpointMessages        BatchEndpoint = "/v1/messages" // Anthropic
 )
 
+// Canonical batch endpoints, normalized to the endpoint of the provider the batch is submitted to,
+// so that clients do not depend on provider-specific endpoint strings.
+const (
+	BatchEndpointCanonicalChat       BatchEndpoint = "chat"
+	BatchEndpointCanonicalEmbeddings BatchEndpoint = "embeddings"
+	BatchEndpointCanonicalResponses  BatchEndpoint = "responses"
+)
+
 // BatchRequestItem represents a single request in a batch (for inline requests).
 type BatchRequestItem struct {
 	CustomID string                 `json:"custom_id"`        // User-provided unique ID for this request
@@ -70,6 +97,11 @@ type BifrostBatchCreateRequest struct {
 	// OpenAI-style: file-based batching
 	InputFileID string `json:"input_file_id,omitempty"` // ID of uploaded JSONL file
 
+	// Streamed file-based batching: the JSONL input is uploaded as a batch file as it is read, and the batch is
+	// created from it. It is an alternative to InputFileID for inputs generated on the fly or larger than memory.
+	InputReader   io.Reader `json:"-"`
+	InputFilename string    `json:"-"` // Filename of the uploaded input (optional)
+
 	// Anthropic-style: inline requests
 	Requests []BatchRequestItem `json:"requests,omitempty"` // Inline request items
 
@@ -128,6 +160,12 @@ type BifrostBatchListRequest struct {
 	PageSize   int     `json:"page_size,omitempty"`   // For Gemini pagination
 	NextCursor *string `json:"next_cursor,omitempty"` // For Gemini pagination
 
+	// AllKeys lists the batches of all the provider's batch keys at once, merged newest first and de-duplicated
+	// by batch ID, instead of listing the keys one after the other (OpenAI and Anthropic). Keys sharing an
+	// account see the same batches, which are then returned once. The cursor is the NextCursor of the previous
+	// page, passed in After (OpenAI) or AfterID (Anthropic). BeforeID is not supported.
+	AllKeys bool `json:"all_keys,omitempty"`
+
 	// Extra parameters for provider-specific features
 	ExtraParams map[string]interface{} `json:"-"`
 }
@@ -230,12 +268,80 @@ type BifrostBatchCancelResponse struct {
 	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
 }
 
+// BifrostBatchCancelAllRequest represents a request to cancel every running batch job of a provider.
+type BifrostBatchCancelAllRequest struct {
+	Provider ModelProvider `json:"provider"`
+	Model    *string       `json:"model"`
+
+	// Extra parameters for provider-specific features, passed to every list and cancel request
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BatchCancelFailure represents a batch job that could not be cancelled.
+type BatchCancelFailure struct {
+	BatchID string `json:"batch_id"`
+	Error   string `json:"error"`
+}
+
+// BifrostBatchCancelAllResponse summarizes the cancellation of a provider's running batch jobs.
+type BifrostBatchCancelAllResponse struct {
+	Provider  ModelProvider                `json:"provider"`
+	Cancelled []BifrostBatchCancelResponse `json:"cancelled"` // Batch jobs a cancellation was requested for
+	Failed    []BatchCancelFailure         `json:"failed"`    // Batch jobs that could not be cancelled
+	Skipped   int                          `json:"skipped"`   // Batch jobs already terminal or being cancelled
+}
+
+// BifrostBatchDryRunResponse is the result of a batch dry run: the batch create request is validated, estimated
+// and rendered as it would be sent to the provider, but not submitted.
+type BifrostBatchDryRunResponse struct {
+	Provider     ModelProvider         `json:"provider"`
+	Valid        bool                  `json:"valid"`                      // Whether the batch passed validation
+	Errors       []BatchError          `json:"errors,omitempty"`           // Validation errors, with the line (1-based) of the offending request if any
+	RequestCount int                   `json:"request_count"`              // Input requests, 0 for batches created from an already uploaded input file
+	Estimate     *BatchEstimate        `json:"estimate,omitempty"`         // Token estimate of the input requests, nil if they could not be read
+	Rendered     *BatchRenderedRequest `json:"rendered_request,omitempty"` // Nil if the provider does not support rendering or the batch is invalid
+}
+
+// BatchEstimate is the approximate token usage of a batch's input requests.
+// Input tokens are estimated from the length of the request bodies, output tokens from their output token limits.
+type BatchEstimate struct {
+	InputTokens  int                            `json:"input_tokens"`
+	OutputTokens int                            `json:"output_tokens"` // Upper bound, from the output token limits of the requests that set one
+	Models       map[string]*BatchModelEstimate `json:"models,omitempty"`
+	Cost         *float64                       `json:"cost,omitempty"` // Estimated cost in dollars, only set when pricing is available (e.g. by the HTTP transport)
+}
+
+// BatchModelEstimate is the approximate token usage of a batch's input requests for one model.
+type BatchModelEstimate struct {
+	Requests                   int `json:"requests"`
+	InputTokens                int `json:"input_tokens"`
+	OutputTokens               int `json:"output_tokens"`
+	RequestsWithoutOutputLimit int `json:"requests_without_output_limit,omitempty"` // Requests whose output tokens are not counted
+}
+
+// BatchRenderedRequest is a provider-native HTTP request rendered without being sent, credentials are redacted.
+type BatchRenderedRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
 // BifrostBatchResultsRequest represents a request to retrieve batch results.
 type BifrostBatchResultsRequest struct {
 	Provider ModelProvider `json:"provider"`
 	Model    *string       `json:"model"`
 	BatchID  string        `json:"batch_id"` // ID of the batch to get results for
 
+	// Pagination (optional, all results are returned at once if neither is set)
+	Limit int     `json:"limit,omitempty"` // Max results to return
+	After *string `json:"after,omitempty"` // Cursor from the NextCursor of the previous page
+
+	// Submission order of the batch's requests (optional, cannot be combined with pagination).
+	// When set, results are returned in this order instead of the provider's file order, and requests
+	// without a result get an error placeholder. Results for unlisted custom IDs are appended at the end.
+	CustomIDOrder []string `json:"custom_id_order,omitempty"`
+
 	RawRequestBody []byte `json:"-"` // Raw request body (not serialized)
 
 	// For OpenAI, results are retrieved via output_file_id (file download)
@@ -286,7 +392,7 @@ type BifrostBatchResultsResponse struct {
 	BatchID string            `json:"batch_id"`
 	Results []BatchResultItem `json:"results"`
 
-	// For streaming results (Anthropic)
+	// Pagination, set when the request was paginated and more results remain
 	HasMore    bool    `json:"has_more,omitempty"`
 	NextCursor *string `json:"next_cursor,omitempty"`
 
@@ -5,12 +5,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 const (
-	DefaultInitialPoolSize = 5000
+	DefaultInitialPoolSize    = 5000
+	DefaultPluginFlushTimeout = 10 * time.Second
 )
 
 type KeySelector func(ctx *context.Context, keys []Key, providerKey ModelProvider, model string) (Key, error)
@@ -26,8 +28,126 @@ type BifrostConfig struct {
 	DropExcessRequests bool        // If true, in cases where the queue is full, requests will not wait for the queue to be empty and will be dropped instead.
 	MCPConfig          *MCPConfig  // MCP (Model Context Protocol) configuration for tool integration
 	KeySelector        KeySelector // Custom key selector function
+
+	// EmptyContentHandling controls what happens to chat messages with empty content before they are dispatched.
+	// Defaults to EmptyContentHandlingPassthrough, which forwards them to the provider as is.
+	EmptyContentHandling EmptyContentHandling
+
+	// MaxTokensDerivation, when set, fills in the output token limit of chat requests that omit it (opt-in).
+	MaxTokensDerivation *MaxTokensDerivationConfig
+
+	// LengthContinuation, when set, continues chat responses truncated by the output token limit (finish reason
+	// "length") with follow-up requests and stitches the results into one response (opt-in).
+	LengthContinuation *LengthContinuationConfig
+
+	// EmbeddedErrorHandling controls how HTTP 200 provider responses whose body is an error object are treated.
+	// Defaults to EmbeddedErrorHandlingPassthrough, which parses them as responses like any 200 response.
+	EmbeddedErrorHandling EmbeddedErrorHandling
+
+	// FailedRequestCapture, when set, keeps a redacted copy of failed requests in memory so that they can be
+	// exported and replayed (opt-in).
+	FailedRequestCapture *FailedRequestCaptureConfig
+
+	// ModelNameNormalization configures how model strings are normalized by Bifrost.ParseModelString, in addition to
+	// the default trimming and case-folding of known provider names.
+	ModelNameNormalization *ModelNameNormalization
+
+	// PluginFlushTimeout bounds how long Bifrost waits for plugins implementing FlushablePlugin to flush their
+	// pending asynchronous work on shutdown. Defaults to DefaultPluginFlushTimeout.
+	PluginFlushTimeout time.Duration
+
+	// PluginOrder lists the names of the plugins whose hooks run first, in that order. PreHooks run in plugin
+	// order and PostHooks in reverse order, so the first plugin sees the request first and the response last.
+	// Plugins not listed keep the order in which they were added and run after the listed ones.
+	PluginOrder []string
+
+	// MaxConcurrentStreams caps the number of streaming requests open at once across all providers, new streams
+	// over the cap are rejected with a StreamCapacityExceeded error. 0 means unbounded. Providers can set a lower
+	// cap of their own with ProviderConfig.MaxConcurrentStreams.
+	MaxConcurrentStreams int
+
+	// Hedging, when set, sends latency-sensitive requests opted in with BifrostContextKeyHedge to their first
+	// fallback as well when the primary provider is slow to respond, and uses the first response (opt-in).
+	Hedging *HedgingConfig
+}
+
+// HedgingConfig configures request hedging, which cuts the tail latency of interactive requests at the cost of
+// the requests sent twice. A request opted in that the primary provider has not answered within Delay is sent
+// to its first fallback too, the first successful response is used and the other request is cancelled. Only
+// idempotent requests are hedged (text completion, chat, responses and embedding requests, not streamed).
+type HedgingConfig struct {
+	Delay time.Duration `json:"delay"` // How long the primary provider is given before the hedge is sent (0 disables hedging)
+}
+
+// HedgeWinner tells which request of a hedged request returned the response.
+type HedgeWinner string
+
+const (
+	HedgeWinnerPrimary HedgeWinner = "primary" // the primary provider answered first
+	HedgeWinnerHedge   HedgeWinner = "hedge"   // the hedge, sent to the first fallback, answered first
+)
+
+// ModelNameNormalization configures the normalization of model strings such as "OpenAI/GPT-4o".
+type ModelNameNormalization struct {
+	CaseFoldModels bool              `json:"case_fold_models,omitempty"` // Lowercase model names (known provider names are always case-folded)
+	Aliases        map[string]string `json:"aliases,omitempty"`          // Model strings keyed by alias, e.g. "gpt4o" -> "openai/gpt-4o", matched case-insensitively
+}
+
+// FailedRequestCaptureConfig bounds the failed requests kept for replay.
+type FailedRequestCaptureConfig struct {
+	MaxEntries      int           `json:"max_entries,omitempty"`       // Maximum captures kept, the oldest are evicted first (default 100)
+	TTL             time.Duration `json:"ttl,omitempty"`               // How long a capture is kept (default 1 hour)
+	MaxPayloadBytes int           `json:"max_payload_bytes,omitempty"` // Requests whose redacted payload is larger are not captured (default 1 MiB)
+}
+
+// MaxTokensDerivationConfig configures the derivation of max_tokens for chat requests that do not set it.
+// Providers default the output limit differently (some to a small value, some to the whole remaining window),
+// so the limit is set to the remaining context window after the (estimated) input tokens, capped by the
+// model's maximum output tokens.
+type MaxTokensDerivationConfig struct {
+	ModelLimits   map[string]ModelTokenLimits `json:"model_limits"`             // Token limits keyed by model name
+	DefaultLimits *ModelTokenLimits           `json:"default_limits,omitempty"` // Limits used for models missing from ModelLimits (nil skips them)
+}
+
+// PinnedProvider pins a request to one provider, and optionally one of its keys, for reproducibility or debugging.
+// Pinned requests bypass fallbacks and weighted key selection, plugins (governance, logging) still run.
+type PinnedProvider struct {
+	Provider ModelProvider `json:"provider"`
+	Key      string        `json:"key,omitempty"` // ID or name of the key to use, the provider's first key supporting the model if empty
+}
+
+// LengthContinuationConfig configures the continuation of chat responses truncated by the output token limit.
+// The truncated text is sent back as a trailing assistant message (a prefill) for the model to continue from,
+// providers without native assistant prefill support need AssistantPrefillModeEmulate to continue it.
+type LengthContinuationConfig struct {
+	MaxContinuations int `json:"max_continuations"` // Maximum follow-up requests issued for one response (0 disables continuation)
 }
 
+// ModelTokenLimits describes the token limits of a model.
+type ModelTokenLimits struct {
+	ContextWindow   int `json:"context_window"`    // Total tokens (input + output) the model accepts
+	MaxOutputTokens int `json:"max_output_tokens"` // Maximum tokens the model generates in one response (0 means bounded by the context window only)
+}
+
+// EmptyContentHandling defines how chat messages with null or empty content are handled before dispatch.
+// Tool messages and assistant messages carrying tool calls are never considered empty.
+type EmptyContentHandling string
+
+const (
+	EmptyContentHandlingPassthrough EmptyContentHandling = ""       // Forward empty messages to the provider unchanged
+	EmptyContentHandlingDrop        EmptyContentHandling = "drop"   // Drop empty messages, rejecting the request if no message is left
+	EmptyContentHandlingReject      EmptyContentHandling = "reject" // Reject requests containing any empty message
+)
+
+// EmbeddedErrorHandling defines how provider responses with a 200 status and an error object as body are treated.
+// Some providers (e.g. some HuggingFace inference providers) report failures this way instead of with an error status.
+type EmbeddedErrorHandling string
+
+const (
+	EmbeddedErrorHandlingPassthrough EmbeddedErrorHandling = ""        // Trust the 200 status and parse the body as a response
+	EmbeddedErrorHandlingConvert     EmbeddedErrorHandling = "convert" // Convert the error body into an error, classified from its code, status or type
+)
+
 // ModelProvider represents the different AI model providers supported by Bifrost.
 type ModelProvider string
 
@@ -110,6 +230,7 @@ const (
 	FileRetrieveRequest         RequestType = "file_retrieve"
 	FileDeleteRequest           RequestType = "file_delete"
 	FileContentRequest          RequestType = "file_content"
+	FileDownloadURLRequest      RequestType = "file_download_url"
 	UnknownRequest              RequestType = "unknown"
 )
 
@@ -134,10 +255,19 @@ const (
 	BifrostContextKeyUseRawRequestBody                   BifrostContextKey = "bifrost-use-raw-request-body"
 	BifrostContextKeySendBackRawRequest                  BifrostContextKey = "bifrost-send-back-raw-request"                    // bool
 	BifrostContextKeySendBackRawResponse                 BifrostContextKey = "bifrost-send-back-raw-response"                   // bool
+	BifrostContextKeyRequestBodyTemplate                 BifrostContextKey = "bifrost-request-body-template"                    // *RequestBodyTemplate (set by bifrost for custom providers with a template for the request type)
 	BifrostContextKeyIntegrationType                     BifrostContextKey = "bifrost-integration-type"                         // integration used in gateway (e.g. openai, anthropic, bedrock, etc.)
 	BifrostContextKeyIsResponsesToChatCompletionFallback BifrostContextKey = "bifrost-is-responses-to-chat-completion-fallback" // bool (set by bifrost)
 	BifrostContextKeyStructuredOutputToolName            BifrostContextKey = "bifrost-structured-output-tool-name"              // string (to store the name of the structured output tool (set by bifrost))
 	BifrostContextKeyUserAgent                           BifrostContextKey = "bifrost-user-agent"                               // string (set by bifrost)
+	BifrostContextKeyDerivedMaxTokens                    BifrostContextKey = "bifrost-derived-max-tokens"                       // int (to store the max tokens derived for the request (set by bifrost))
+	BifrostContextKeyModelRequested                      BifrostContextKey = "bifrost-model-requested"                          // string (the model as sent by the client, before normalization, reported as ModelRequested)
+	BifrostContextKeyRequestMetadata                     BifrostContextKey = "bifrost-request-metadata"                         // map[string]string (metadata mirrored in the provider's native metadata fields, e.g. OpenAI metadata or Anthropic metadata.user_id)
+	BifrostContextKeyMaxContinuations                    BifrostContextKey = "bifrost-max-continuations"                        // int (overrides LengthContinuationConfig.MaxContinuations for the request, 0 disables continuation)
+	BifrostContextKeyPinnedProvider                      BifrostContextKey = "bifrost-pinned-provider"                          // PinnedProvider (sends the request to this provider and key only, bypassing fallbacks and key load balancing)
+	BifrostContextKeyLatencyTimings                      BifrostContextKey = "bifrost-latency-timings"                          // *LatencyTimings (set by bifrost for non-streaming requests, timings of the stages of the request)
+	BifrostContextKeyHedge                               BifrostContextKey = "bifrost-hedge"                                    // bool (opts the request in to hedging, see HedgingConfig)
+	BifrostContextKeyEmbeddedErrorHandling               BifrostContextKey = "bifrost-embedded-error-handling"                  // EmbeddedErrorHandling (set by bifrost, how 200 responses with an error body are treated)
 )
 
 // NOTE: for custom plugin implementation dealing with streaming short circuit,
@@ -164,23 +294,24 @@ type Fallback struct {
 type BifrostRequest struct {
 	RequestType RequestType
 
-	ListModelsRequest     *BifrostListModelsRequest
-	TextCompletionRequest *BifrostTextCompletionRequest
-	ChatRequest           *BifrostChatRequest
-	ResponsesRequest      *BifrostResponsesRequest
-	EmbeddingRequest      *BifrostEmbeddingRequest
-	SpeechRequest         *BifrostSpeechRequest
-	TranscriptionRequest  *BifrostTranscriptionRequest
-	FileUploadRequest     *BifrostFileUploadRequest
-	FileListRequest       *BifrostFileListRequest
-	FileRetrieveRequest   *BifrostFileRetrieveRequest
-	FileDeleteRequest     *BifrostFileDeleteRequest
-	FileContentRequest    *BifrostFileContentRequest
-	BatchCreateRequest    *BifrostBatchCreateRequest
-	BatchListRequest      *BifrostBatchListRequest
-	BatchRetrieveRequest  *BifrostBatchRetrieveRequest
-	BatchCancelRequest    *BifrostBatchCancelRequest
-	BatchResultsRequest   *BifrostBatchResultsRequest
+	ListModelsRequest      *BifrostListModelsRequest
+	TextCompletionRequest  *BifrostTextCompletionRequest
+	ChatRequest            *BifrostChatRequest
+	ResponsesRequest       *BifrostResponsesRequest
+	EmbeddingRequest       *BifrostEmbeddingRequest
+	SpeechRequest          *BifrostSpeechRequest
+	TranscriptionRequest   *BifrostTranscriptionRequest
+	FileUploadRequest      *BifrostFileUploadRequest
+	FileListRequest        *BifrostFileListRequest
+	FileRetrieveRequest    *BifrostFileRetrieveRequest
+	FileDeleteRequest      *BifrostFileDeleteRequest
+	FileContentRequest     *BifrostFileContentRequest
+	FileDownloadURLRequest *BifrostFileDownloadURLRequest
+	BatchCreateRequest     *BifrostBatchCreateRequest
+	BatchListRequest       *BifrostBatchListRequest
+	BatchRetrieveRequest   *BifrostBatchRetrieveRequest
+	BatchCancelRequest     *BifrostBatchCancelRequest
+	BatchResultsRequest    *BifrostBatchResultsRequest
 }
 
 // GetRequestFields returns the provider, model, and fallbacks from the request.
@@ -223,6 +354,8 @@ func (br *BifrostRequest) GetRequestFields() (provider ModelProvider, model stri
 			return br.FileContentRequest.Provider, *br.FileContentRequest.Model, nil
 		}
 		return br.FileContentRequest.Provider, "", nil
+	case br.FileDownloadURLRequest != nil:
+		return br.FileDownloadURLRequest.Provider, "", nil
 	case br.BatchCreateRequest != nil:
 		if br.BatchCreateRequest.Model != nil {
 			return br.BatchCreateRequest.Provider, *br.BatchCreateRequest.Model, nil
@@ -338,6 +471,7 @@ type BifrostResponse struct {
 	FileRetrieveResponse        *BifrostFileRetrieveResponse
 	FileDeleteResponse          *BifrostFileDeleteResponse
 	FileContentResponse         *BifrostFileContentResponse
+	FileDownloadURLResponse     *BifrostFileDownloadURLResponse
 	BatchCreateResponse         *BifrostBatchCreateResponse
 	BatchListResponse           *BifrostBatchListResponse
 	BatchRetrieveResponse       *BifrostBatchRetrieveResponse
@@ -375,6 +509,8 @@ func (r *BifrostResponse) GetExtraFields() *BifrostResponseExtraFields {
 		return &r.FileDeleteResponse.ExtraFields
 	case r.FileContentResponse != nil:
 		return &r.FileContentResponse.ExtraFields
+	case r.FileDownloadURLResponse != nil:
+		return &r.FileDownloadURLResponse.ExtraFields
 	case r.BatchCreateResponse != nil:
 		return &r.BatchCreateResponse.ExtraFields
 	case r.BatchListResponse != nil:
@@ -392,16 +528,24 @@ func (r *BifrostResponse) GetExtraFields() *BifrostResponseExtraFields {
 
 // BifrostResponseExtraFields contains additional fields in a response.
 type BifrostResponseExtraFields struct {
-	RequestType     RequestType        `json:"request_type"`
-	Provider        ModelProvider      `json:"provider,omitempty"`
-	ModelRequested  string             `json:"model_requested,omitempty"`
-	ModelDeployment string             `json:"model_deployment,omitempty"` // only present for providers which use model deployments (e.g. Azure, Bedrock)
-	Latency         int64              `json:"latency"`                    // in milliseconds (for streaming responses this will be each chunk latency, and the last chunk latency will be the total latency)
-	ChunkIndex      int                `json:"chunk_index"`                // used for streaming responses to identify the chunk index, will be 0 for non-streaming responses
-	RawRequest      interface{}        `json:"raw_request,omitempty"`
-	RawResponse     interface{}        `json:"raw_response,omitempty"`
-	CacheDebug      *BifrostCacheDebug `json:"cache_debug,omitempty"`
-	ParseErrors     []BatchError       `json:"parse_errors,omitempty"` // errors encountered while parsing JSONL batch results
+	RequestType      RequestType        `json:"request_type"`
+	Provider         ModelProvider      `json:"provider,omitempty"`
+	ModelRequested   string             `json:"model_requested,omitempty"`
+	ModelDeployment  string             `json:"model_deployment,omitempty"` // only present for providers which use model deployments (e.g. Azure, Bedrock)
+	ModelNormalized  string             `json:"model_normalized,omitempty"` // model the request was sent with, only set when it differs from the model sent by the client (see ModelNameNormalization)
+	Latency          int64              `json:"latency"`                    // in milliseconds (for streaming responses this will be each chunk latency, and the last chunk latency will be the total latency)
+	ChunkIndex       int                `json:"chunk_index"`                // used for streaming responses to identify the chunk index, will be 0 for non-streaming responses
+	StreamSegment    int                `json:"stream_segment,omitempty"`   // used for streaming responses resumed on a new connection, incremented for each reconnect (0 for the first connection)
+	RawRequest       interface{}        `json:"raw_request,omitempty"`
+	RawResponse      interface{}        `json:"raw_response,omitempty"`
+	CacheDebug       *BifrostCacheDebug `json:"cache_debug,omitempty"`
+	ParseErrors      []BatchError       `json:"parse_errors,omitempty"`       // errors encountered while parsing JSONL batch results
+	DerivedMaxTokens *int               `json:"derived_max_tokens,omitempty"` // max tokens set by bifrost when the request did not set it (see MaxTokensDerivationConfig)
+	Continuations    int                `json:"continuations,omitempty"`      // follow-up requests issued to continue a response truncated by the output token limit (see LengthContinuationConfig)
+	SelectedKeyID    string             `json:"selected_key_id,omitempty"`    // key the request was sent with, only set for requests pinned with BifrostContextKeyPinnedProvider
+	SelectedKeyName  string             `json:"selected_key_name,omitempty"`  // name of the key the request was sent with, only set for pinned requests
+	LatencyBreakdown *LatencyBreakdown  `json:"latency_breakdown,omitempty"`  // time spent in each stage of the request, only set for non-streaming requests
+	HedgeWinner      HedgeWinner        `json:"hedge_winner,omitempty"`       // request which returned the response, only set for requests hedged (see HedgingConfig)
 }
 
 // BifrostCacheDebug represents debug information about the cache.
@@ -422,7 +566,8 @@ type BifrostCacheDebug struct {
 }
 
 const (
-	RequestCancelled = "request_cancelled"
+	RequestCancelled       = "request_cancelled"
+	StreamCapacityExceeded = "stream_capacity_exceeded"
 )
 
 // BifrostStream represents a stream of responses from the Bifrost system.
@@ -440,17 +585,17 @@ type BifrostStream struct {
 // This ensures that only the non-nil embedded struct is marshaled,
 func (bs BifrostStream) MarshalJSON() ([]byte, error) {
 	if bs.BifrostTextCompletionResponse != nil {
-		return sonic.Marshal(bs.BifrostTextCompletionResponse)
+		return serialization.Marshal(bs.BifrostTextCompletionResponse)
 	} else if bs.BifrostChatResponse != nil {
-		return sonic.Marshal(bs.BifrostChatResponse)
+		return serialization.Marshal(bs.BifrostChatResponse)
 	} else if bs.BifrostResponsesStreamResponse != nil {
-		return sonic.Marshal(bs.BifrostResponsesStreamResponse)
+		return serialization.Marshal(bs.BifrostResponsesStreamResponse)
 	} else if bs.BifrostSpeechStreamResponse != nil {
-		return sonic.Marshal(bs.BifrostSpeechStreamResponse)
+		return serialization.Marshal(bs.BifrostSpeechStreamResponse)
 	} else if bs.BifrostTranscriptionStreamResponse != nil {
-		return sonic.Marshal(bs.BifrostTranscriptionStreamResponse)
+		return serialization.Marshal(bs.BifrostTranscriptionStreamResponse)
 	} else if bs.BifrostError != nil {
-		return sonic.Marshal(bs.BifrostError)
+		return serialization.Marshal(bs.BifrostError)
 	}
 	// Return empty object if both are nil (shouldn't happen in practice)
 	return []byte("{}"), nil
@@ -468,11 +613,24 @@ type BifrostError struct {
 	IsBifrostError bool                    `json:"is_bifrost_error"`
 	StatusCode     *int                    `json:"status_code,omitempty"`
 	Error          *ErrorField             `json:"error"`
-	AllowFallbacks *bool                   `json:"-"` // Optional: Controls fallback behavior (nil = true by default)
-	StreamControl  *StreamControl          `json:"-"` // Optional: Controls stream behavior
+	AllowFallbacks *bool                   `json:"-"`                         // Optional: Controls fallback behavior (nil = true by default)
+	StreamControl  *StreamControl          `json:"-"`                         // Optional: Controls stream behavior
+	RateLimitKind  RateLimitKind           `json:"rate_limit_kind,omitempty"` // Set on 429 and quota errors, tells a temporary rate limit from a used up quota
 	ExtraFields    BifrostErrorExtraFields `json:"extra_fields,omitempty"`
 }
 
+// RateLimitKind distinguishes the two meanings of a 429 error from a provider.
+// Errors naming a used up quota with another status (e.g. a Bedrock 400) are also quota exhausted.
+type RateLimitKind string
+
+const (
+	RateLimitKindRateLimited    RateLimitKind = "rate_limited"    // Too many requests for now, retrying after a backoff can succeed
+	RateLimitKindQuotaExhausted RateLimitKind = "quota_exhausted" // The quota or credit of the key is used up, retrying cannot succeed
+)
+
+// UnsupportedOperationErrorCode is the error code of requests a provider does not support.
+const UnsupportedOperationErrorCode = "unsupported_operation"
+
 // StreamControl represents stream control options.
 type StreamControl struct {
 	LogError   *bool `json:"log_error,omitempty"`   // Optional: Controls logging of error
@@ -0,0 +1,30 @@
+package schemas
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	DefaultFailedRequestCaptureMaxEntries      = 100
+	DefaultFailedRequestCaptureTTL             = time.Hour
+	DefaultFailedRequestCaptureMaxPayloadBytes = 1 << 20
+)
+
+// CapturedRequestRedacted replaces the values of credential fields in captured requests.
+const CapturedRequestRedacted = "[REDACTED]"
+
+// CapturedRequest is the export of a failed request, which can be re-submitted to reproduce the failure
+// (e.g. against the mocker plugin). Credentials in the request are redacted.
+type CapturedRequest struct {
+	ID          string          `json:"id"`
+	RequestID   string          `json:"request_id,omitempty"` // Request ID of the failed request, if it had one
+	RequestType RequestType     `json:"request_type"`
+	Provider    ModelProvider   `json:"provider"`
+	Model       string          `json:"model"`
+	Request     json.RawMessage `json:"request"`                // The request as Bifrost received it, e.g. a BifrostChatRequest for chat completions
+	ExtraParams json.RawMessage `json:"extra_params,omitempty"` // The request's extra parameters, which are not part of its serialization
+	Error       string          `json:"error"`
+	StatusCode  *int            `json:"status_code,omitempty"`
+	CapturedAt  time.Time       `json:"captured_at"`
+}
@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strings"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // BifrostChatRequest is the request struct for chat completion requests
@@ -15,7 +16,8 @@ type BifrostChatRequest struct {
 	Input          []ChatMessage   `json:"input,omitempty"`
 	Params         *ChatParameters `json:"params,omitempty"`
 	Fallbacks      []Fallback      `json:"fallbacks,omitempty"`
-	RawRequestBody []byte          `json:"-"` // set bifrost-use-raw-request-body to true in ctx to use the raw request body. Bifrost will directly send this to the downstream provider.
+	Attachments    []FileReference `json:"attachments,omitempty"` // Previously uploaded files attached to the last user message
+	RawRequestBody []byte          `json:"-"`                     // set bifrost-use-raw-request-body to true in ctx to use the raw request body. Bifrost will directly send this to the downstream provider.
 }
 
 // GetRawRequestBody returns the raw request body
@@ -39,6 +41,9 @@ type BifrostChatResponse struct {
 	SearchResults []SearchResult `json:"search_results,omitempty"`
 	Videos        []VideoResult  `json:"videos,omitempty"`
 	Citations     []string       `json:"citations,omitempty"`
+
+	// Sources the provider attributed the generated content to (grounding or citation metadata)
+	SourceCitations []Citation `json:"source_citations,omitempty"`
 }
 
 // ToTextCompletionResponse converts a BifrostChatResponse to a BifrostTextCompletionResponse
@@ -199,7 +204,7 @@ func (cp *ChatParameters) UnmarshalJSON(data []byte) error {
 	aux.Alias = (*Alias)(cp)
 
 	// Single unmarshal
-	if err := sonic.Unmarshal(data, &aux); err != nil {
+	if err := serialization.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 
@@ -335,7 +340,7 @@ func (om OrderedMap) MarshalJSON() ([]byte, error) {
 		}
 
 		// key
-		keyBytes, err := sonic.Marshal(k)
+		keyBytes, err := serialization.Marshal(k)
 		if err != nil {
 			return nil, err
 		}
@@ -343,7 +348,7 @@ func (om OrderedMap) MarshalJSON() ([]byte, error) {
 		buf.WriteByte(':')
 
 		// value
-		valBytes, err := sonic.Marshal(norm[k])
+		valBytes, err := serialization.Marshal(norm[k])
 		if err != nil {
 			return nil, err
 		}
@@ -408,13 +413,13 @@ func (ctc ChatToolChoice) MarshalJSON() ([]byte, error) {
 	}
 
 	if ctc.ChatToolChoiceStr != nil {
-		return sonic.Marshal(ctc.ChatToolChoiceStr)
+		return serialization.Marshal(ctc.ChatToolChoiceStr)
 	}
 	if ctc.ChatToolChoiceStruct != nil {
-		return sonic.Marshal(ctc.ChatToolChoiceStruct)
+		return serialization.Marshal(ctc.ChatToolChoiceStruct)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ChatMessageContent.
@@ -423,7 +428,7 @@ func (ctc ChatToolChoice) MarshalJSON() ([]byte, error) {
 func (ctc *ChatToolChoice) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var toolChoiceStr string
-	if err := sonic.Unmarshal(data, &toolChoiceStr); err == nil {
+	if err := serialization.Unmarshal(data, &toolChoiceStr); err == nil {
 		ctc.ChatToolChoiceStr = &toolChoiceStr
 		ctc.ChatToolChoiceStruct = nil
 		return nil
@@ -431,7 +436,7 @@ func (ctc *ChatToolChoice) UnmarshalJSON(data []byte) error {
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var chatToolChoice ChatToolChoiceStruct
-	if err := sonic.Unmarshal(data, &chatToolChoice); err == nil {
+	if err := serialization.Unmarshal(data, &chatToolChoice); err == nil {
 		ctc.ChatToolChoiceStr = nil
 		ctc.ChatToolChoiceStruct = &chatToolChoice
 		return nil
@@ -497,7 +502,7 @@ func (cm *ChatMessage) UnmarshalJSON(data []byte) error {
 		Content *ChatMessageContent `json:"content,omitempty"`
 	}
 	var base baseFields
-	if err := sonic.Unmarshal(data, &base); err != nil {
+	if err := serialization.Unmarshal(data, &base); err != nil {
 		return err
 	}
 	cm.Name = base.Name
@@ -507,7 +512,7 @@ func (cm *ChatMessage) UnmarshalJSON(data []byte) error {
 	// Unmarshal ChatToolMessage fields
 	type toolMsgAlias ChatToolMessage
 	var toolMsg toolMsgAlias
-	if err := sonic.Unmarshal(data, &toolMsg); err != nil {
+	if err := serialization.Unmarshal(data, &toolMsg); err != nil {
 		return err
 	}
 	if toolMsg.ToolCallID != nil {
@@ -516,7 +521,7 @@ func (cm *ChatMessage) UnmarshalJSON(data []byte) error {
 
 	// Unmarshal ChatAssistantMessage (which has its own custom unmarshaller)
 	var assistantMsg ChatAssistantMessage
-	if err := sonic.Unmarshal(data, &assistantMsg); err != nil {
+	if err := serialization.Unmarshal(data, &assistantMsg); err != nil {
 		return err
 	}
 	// Only set if any field is populated
@@ -544,13 +549,13 @@ func (mc ChatMessageContent) MarshalJSON() ([]byte, error) {
 	}
 
 	if mc.ContentStr != nil {
-		return sonic.Marshal(*mc.ContentStr)
+		return serialization.Marshal(*mc.ContentStr)
 	}
 	if mc.ContentBlocks != nil {
-		return sonic.Marshal(mc.ContentBlocks)
+		return serialization.Marshal(mc.ContentBlocks)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ChatMessageContent.
@@ -566,7 +571,7 @@ func (mc *ChatMessageContent) UnmarshalJSON(data []byte) error {
 
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		mc.ContentStr = &stringContent
 		mc.ContentBlocks = nil
 		return nil
@@ -574,7 +579,7 @@ func (mc *ChatMessageContent) UnmarshalJSON(data []byte) error {
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var arrayContent []ChatContentBlock
-	if err := sonic.Unmarshal(data, &arrayContent); err == nil {
+	if err := serialization.Unmarshal(data, &arrayContent); err == nil {
 		mc.ContentBlocks = arrayContent
 		mc.ContentStr = nil
 		return nil
@@ -640,6 +645,17 @@ type ChatInputFile struct {
 	Filename *string `json:"filename,omitempty"`  // Name of the file
 }
 
+// FileReference points at a file previously uploaded to the provider (e.g. an OpenAI file id or a Gemini file),
+// so that it can be attached to a chat request without sending its content again.
+// OpenAI and Gemini resolve it into their native form (a file content block, a fileData part), requests with
+// attachments are rejected by other providers.
+type FileReference struct {
+	FileID   string  `json:"file_id"`             // Provider file id, e.g. "file-abc123" (OpenAI) or "files/abc123" (Gemini)
+	URI      *string `json:"uri,omitempty"`       // File URI, for providers addressing files by URI (resolved from FileID if not set)
+	MimeType *string `json:"mime_type,omitempty"` // MIME type of the file (resolved from FileID if not set)
+	Filename *string `json:"filename,omitempty"`  // Name of the file
+}
+
 // ChatToolMessage represents a tool message in a chat conversation.
 type ChatToolMessage struct {
 	ToolCallID *string `json:"tool_call_id,omitempty"`
@@ -655,6 +671,32 @@ type ChatAssistantMessage struct {
 	ToolCalls        []ChatAssistantMessageToolCall   `json:"tool_calls,omitempty"`
 }
 
+// SetRefusal marks an assistant message as a refusal, for providers reporting refusals through a stop reason
+// rather than a refusal field: its text content becomes the refusal, or the fallback if it has no text, and the
+// content is cleared so that clients can tell refusals apart from regular answers.
+func (cm *ChatMessage) SetRefusal(fallback string) {
+	var refusal strings.Builder
+	if cm.Content != nil {
+		if cm.Content.ContentStr != nil {
+			refusal.WriteString(*cm.Content.ContentStr)
+		}
+		for _, block := range cm.Content.ContentBlocks {
+			if block.Type == ChatContentBlockTypeText && block.Text != nil {
+				refusal.WriteString(*block.Text)
+			}
+		}
+	}
+	if strings.TrimSpace(refusal.String()) == "" {
+		refusal.Reset()
+		refusal.WriteString(fallback)
+	}
+	if cm.ChatAssistantMessage == nil {
+		cm.ChatAssistantMessage = &ChatAssistantMessage{}
+	}
+	cm.ChatAssistantMessage.Refusal = Ptr(refusal.String())
+	cm.Content = nil
+}
+
 // UnmarshalJSON implements custom unmarshalling for ChatAssistantMessage.
 // If Reasoning is non-nil and ReasoningDetails is nil/empty, it adds a single
 // ChatReasoningDetails entry of type "reasoning.text" with the text set to Reasoning.
@@ -667,7 +709,7 @@ func (cm *ChatAssistantMessage) UnmarshalJSON(data []byte) error {
 	type Alias ChatAssistantMessage
 
 	var aux Alias
-	if err := sonic.Unmarshal(data, &aux); err != nil {
+	if err := serialization.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 
@@ -803,7 +845,7 @@ func (d *ChatStreamResponseChoiceDelta) UnmarshalJSON(data []byte) error {
 	type Alias ChatStreamResponseChoiceDelta
 
 	var aux Alias
-	if err := sonic.Unmarshal(data, &aux); err != nil {
+	if err := serialization.Unmarshal(data, &aux); err != nil {
 		return err
 	}
 
@@ -886,7 +928,7 @@ type BifrostCost struct {
 func (bc *BifrostCost) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct float
 	var costFloat float64
-	if err := sonic.Unmarshal(data, &costFloat); err == nil {
+	if err := serialization.Unmarshal(data, &costFloat); err == nil {
 		bc.TotalCost = costFloat
 		return nil
 	}
@@ -895,7 +937,7 @@ func (bc *BifrostCost) UnmarshalJSON(data []byte) error {
 	// Use a type alias to avoid infinite recursion
 	type Alias BifrostCost
 	var costStruct Alias
-	if err := sonic.Unmarshal(data, &costStruct); err == nil {
+	if err := serialization.Unmarshal(data, &costStruct); err == nil {
 		*bc = BifrostCost(costStruct)
 		return nil
 	}
@@ -912,6 +954,18 @@ type SearchResult struct {
 	Source      *string `json:"source,omitempty"`
 }
 
+// Citation is a source the provider attributed part of the generated content to, such as a web page found by
+// search grounding or a retrieved document.
+type Citation struct {
+	URI        string   `json:"uri,omitempty"`
+	Title      string   `json:"title,omitempty"`
+	Text       string   `json:"text,omitempty"`        // The generated text attributed to the source
+	StartIndex *int     `json:"start_index,omitempty"` // Start of the attributed text in the generated content, as reported by the provider
+	EndIndex   *int     `json:"end_index,omitempty"`   // End (exclusive) of the attributed text in the generated content
+	Confidence *float64 `json:"confidence,omitempty"`  // Provider confidence that the source supports the text, from 0 to 1
+	License    string   `json:"license,omitempty"`
+}
+
 type VideoResult struct {
 	URL             string   `json:"url"`
 	ThumbnailURL    *string  `json:"thumbnail_url,omitempty"`
@@ -3,7 +3,7 @@ package schemas
 import (
 	"fmt"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 type BifrostEmbeddingRequest struct {
@@ -58,16 +58,16 @@ func (e *EmbeddingInput) MarshalJSON() ([]byte, error) {
 	}
 
 	if e.Text != nil {
-		return sonic.Marshal(*e.Text)
+		return serialization.Marshal(*e.Text)
 	}
 	if e.Texts != nil {
-		return sonic.Marshal(e.Texts)
+		return serialization.Marshal(e.Texts)
 	}
 	if e.Embedding != nil {
-		return sonic.Marshal(e.Embedding)
+		return serialization.Marshal(e.Embedding)
 	}
 	if e.Embeddings != nil {
-		return sonic.Marshal(e.Embeddings)
+		return serialization.Marshal(e.Embeddings)
 	}
 
 	return nil, fmt.Errorf("invalid embedding input")
@@ -80,25 +80,25 @@ func (e *EmbeddingInput) UnmarshalJSON(data []byte) error {
 	e.Embeddings = nil
 	// Try string
 	var s string
-	if err := sonic.Unmarshal(data, &s); err == nil {
+	if err := serialization.Unmarshal(data, &s); err == nil {
 		e.Text = &s
 		return nil
 	}
 	// Try []string
 	var ss []string
-	if err := sonic.Unmarshal(data, &ss); err == nil {
+	if err := serialization.Unmarshal(data, &ss); err == nil {
 		e.Texts = ss
 		return nil
 	}
 	// Try []int
 	var i []int
-	if err := sonic.Unmarshal(data, &i); err == nil {
+	if err := serialization.Unmarshal(data, &i); err == nil {
 		e.Embedding = i
 		return nil
 	}
 	// Try [][]int
 	var i2 [][]int
-	if err := sonic.Unmarshal(data, &i2); err == nil {
+	if err := serialization.Unmarshal(data, &i2); err == nil {
 		e.Embeddings = i2
 		return nil
 	}
@@ -129,13 +129,13 @@ type EmbeddingStruct struct {
 
 func (be EmbeddingStruct) MarshalJSON() ([]byte, error) {
 	if be.EmbeddingStr != nil {
-		return sonic.Marshal(be.EmbeddingStr)
+		return serialization.Marshal(be.EmbeddingStr)
 	}
 	if be.EmbeddingArray != nil {
-		return sonic.Marshal(be.EmbeddingArray)
+		return serialization.Marshal(be.EmbeddingArray)
 	}
 	if be.Embedding2DArray != nil {
-		return sonic.Marshal(be.Embedding2DArray)
+		return serialization.Marshal(be.Embedding2DArray)
 	}
 	return nil, fmt.Errorf("no embedding found")
 }
@@ -143,24 +143,33 @@ func (be EmbeddingStruct) MarshalJSON() ([]byte, error) {
 func (be *EmbeddingStruct) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		be.EmbeddingStr = &stringContent
 		return nil
 	}
 
 	// Try to unmarshal as a direct array of float32
 	var arrayContent []float32
-	if err := sonic.Unmarshal(data, &arrayContent); err == nil {
+	if err := serialization.Unmarshal(data, &arrayContent); err == nil {
 		be.EmbeddingArray = arrayContent
 		return nil
 	}
 
 	// Try to unmarshal as a direct 2D array of float32
 	var arrayContent2D [][]float32
-	if err := sonic.Unmarshal(data, &arrayContent2D); err == nil {
+	if err := serialization.Unmarshal(data, &arrayContent2D); err == nil {
 		be.Embedding2DArray = arrayContent2D
 		return nil
 	}
 
 	return fmt.Errorf("embedding field is neither a string nor an array of float32 nor a 2D array of float32")
 }
+
+// BifrostEmbeddingProgress is emitted by EmbeddingRequestWithProgress each time a sub-batch of inputs is embedded.
+// The last event carries the final assembled response (or the error that aborted the request).
+type BifrostEmbeddingProgress struct {
+	Completed int                       `json:"completed"`          // Number of inputs embedded so far
+	Total     int                       `json:"total"`              // Total number of inputs in the request
+	Response  *BifrostEmbeddingResponse `json:"response,omitempty"` // Final response, only set on the last event
+	Error     *BifrostError             `json:"error,omitempty"`    // Set if a sub-batch failed, no further events are sent
+}
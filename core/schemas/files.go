@@ -1,6 +1,11 @@
 // Package schemas defines the core schemas and types used by the Bifrost system.
 package schemas
 
+import (
+	"io"
+	"time"
+)
+
 // FilePurpose represents the purpose of an uploaded file.
 type FilePurpose string
 
@@ -59,6 +64,12 @@ type BifrostFileUploadRequest struct {
 	Filename string      `json:"filename"` // Original filename
 	Purpose  FilePurpose `json:"purpose"`  // Purpose of the file (e.g., "batch")
 
+	// FileReader streams the file content instead of File, for files generated on the fly or larger than memory.
+	// It is read once as the file is uploaded, so streamed uploads are not retried.
+	// OpenAI, Azure, Anthropic and Gemini uploads are sent with chunked transfer encoding, Bedrock uploads use
+	// an S3 multipart upload.
+	FileReader io.Reader `json:"-"`
+
 	// Storage configuration (for S3/GCS backends)
 	StorageConfig *FileStorageConfig `json:"storage_config,omitempty"`
 
@@ -231,6 +242,25 @@ func (request *BifrostFileContentRequest) GetRawRequestBody() []byte {
 	return request.RawRequestBody
 }
 
+// DefaultFileDownloadURLTTL is how long file download URLs are valid when the request does not say.
+const DefaultFileDownloadURLTTL = 15 * time.Minute
+
+// BifrostFileDownloadURLRequest represents a request for a direct, expiring URL to download a file.
+type BifrostFileDownloadURLRequest struct {
+	Provider ModelProvider `json:"provider"`
+	FileID   string        `json:"file_id"`       // ID of the file to download
+	TTL      time.Duration `json:"ttl,omitempty"` // How long the URL is valid (DefaultFileDownloadURLTTL if 0)
+}
+
+// BifrostFileDownloadURLResponse represents a direct, expiring URL to download a file.
+type BifrostFileDownloadURLResponse struct {
+	FileID    string `json:"file_id"`
+	URL       string `json:"url"`
+	ExpiresAt int64  `json:"expires_at"` // Unix timestamp (seconds) after which the URL no longer works
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
 // BifrostFileContentResponse represents the response from downloading file content.
 type BifrostFileContentResponse struct {
 	FileID      string `json:"file_id"`
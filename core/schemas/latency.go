@@ -0,0 +1,76 @@
+package schemas
+
+import (
+	"sync"
+	"time"
+)
+
+// LatencyBreakdown splits the latency of a request into the stages of the pipeline, in milliseconds.
+// The stages add up to roughly the total: retry backoff, outbound rate limit pacing and the handoffs between
+// stages are not attributed to any of them.
+type LatencyBreakdown struct {
+	PreHooks     int64 `json:"pre_hooks"`     // plugin pre-hooks
+	Queue        int64 `json:"queue"`         // waiting in the provider queue for a worker
+	KeySelection int64 `json:"key_selection"` // key selection and request preparation by the worker (routing)
+	Connect      int64 `json:"connect"`       // DNS resolution and TCP connect, only for requests opening a new connection
+	Upstream     int64 `json:"upstream"`      // sending the request and receiving the response, TLS handshake included
+	Decode       int64 `json:"decode"`        // building the provider request and decoding its response
+	PostHooks    int64 `json:"post_hooks"`    // plugin post-hooks
+	Total        int64 `json:"total"`         // end to end, from the pre-hooks to the post-hooks
+}
+
+// LatencyStage is a stage of the pipeline timed by LatencyTimings.
+type LatencyStage int
+
+const (
+	LatencyStagePreHooks     LatencyStage = iota
+	LatencyStageQueue                     // waiting in the provider queue
+	LatencyStageKeySelection              // key selection and request preparation by the worker
+	LatencyStageProvider                  // the provider call, connect and upstream included
+	LatencyStageConnect                   // dialing new connections, within the provider call
+	LatencyStageUpstream                  // HTTP round trips minus dialing, within the provider call
+	LatencyStagePostHooks
+	latencyStageCount
+)
+
+// LatencyTimings accumulates the time spent in each stage of a request. Bifrost stores one in the context
+// under BifrostContextKeyLatencyTimings for non-streaming requests, and reports it as the LatencyBreakdown of
+// the response. It is safe for concurrent use, as batch and file requests call several keys at once.
+type LatencyTimings struct {
+	mu        sync.Mutex
+	durations [latencyStageCount]time.Duration
+}
+
+// Add adds d to the time spent in stage.
+func (t *LatencyTimings) Add(stage LatencyStage, d time.Duration) {
+	if t == nil || stage < 0 || stage >= latencyStageCount {
+		return
+	}
+	t.mu.Lock()
+	t.durations[stage] += d
+	t.mu.Unlock()
+}
+
+// Breakdown returns the breakdown of a request which took total. The time of the provider call not spent
+// connecting or waiting on the upstream is reported as decode. Providers not timing their round trips (e.g.
+// those not using fasthttp) report their whole call as upstream.
+func (t *LatencyTimings) Breakdown(total time.Duration) *LatencyBreakdown {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	connect := t.durations[LatencyStageConnect]
+	upstream := t.durations[LatencyStageUpstream]
+	decode := t.durations[LatencyStageProvider] - connect - upstream
+	if connect+upstream == 0 {
+		upstream, decode = t.durations[LatencyStageProvider], 0
+	}
+	return &LatencyBreakdown{
+		PreHooks:     t.durations[LatencyStagePreHooks].Milliseconds(),
+		Queue:        t.durations[LatencyStageQueue].Milliseconds(),
+		KeySelection: t.durations[LatencyStageKeySelection].Milliseconds(),
+		Connect:      connect.Milliseconds(),
+		Upstream:     upstream.Milliseconds(),
+		Decode:       max(decode, 0).Milliseconds(),
+		PostHooks:    t.durations[LatencyStagePostHooks].Milliseconds(),
+		Total:        total.Milliseconds(),
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // DefaultPageSize is the default page size for listing models
@@ -182,7 +182,7 @@ func encodePaginationCursor(offset int, lastID string) (string, error) {
 		LastID: lastID,
 	}
 
-	jsonData, err := sonic.Marshal(cursor)
+	jsonData, err := serialization.Marshal(cursor)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal pagination cursor: %w", err)
 	}
@@ -206,7 +206,7 @@ func decodePaginationCursor(token string) paginationCursor {
 	}
 
 	var cursor paginationCursor
-	if err := sonic.Unmarshal(decoded, &cursor); err != nil {
+	if err := serialization.Unmarshal(decoded, &cursor); err != nil {
 		return paginationCursor{}
 	}
 
@@ -271,6 +271,15 @@ func (cm *ChatMessage) ToResponsesMessages() []ResponsesMessage {
 
 	// Check if this is an assistant message with multiple tool calls that need expansion
 	if cm.ChatAssistantMessage != nil && cm.ChatAssistantMessage.ToolCalls != nil && len(cm.ChatAssistantMessage.ToolCalls) > 0 {
+		// Text sent along the tool calls becomes a message item ahead of the function_call items
+		if cm.Content != nil && ((cm.Content.ContentStr != nil && *cm.Content.ContentStr != "") || len(cm.Content.ContentBlocks) > 0) {
+			assistantMessage := *cm.ChatAssistantMessage
+			assistantMessage.ToolCalls = nil
+			textMessage := *cm
+			textMessage.ChatAssistantMessage = &assistantMessage
+			messages = append(messages, textMessage.ToResponsesMessages()...)
+		}
+
 		// Expand multiple tool calls into separate function_call items
 		for _, tc := range cm.ChatAssistantMessage.ToolCalls {
 			messageType := ResponsesMessageTypeFunctionCall
@@ -426,6 +435,10 @@ func (cm *ChatMessage) ToResponsesMessages() []ResponsesMessage {
 			rm.ResponsesToolMessage.Output = &ResponsesToolMessageOutputStruct{
 				ResponsesToolCallOutputStr: rm.Content.ContentStr,
 			}
+		} else if rm.Content != nil && len(rm.Content.ContentBlocks) > 0 {
+			rm.ResponsesToolMessage.Output = &ResponsesToolMessageOutputStruct{
+				ResponsesFunctionToolCallOutputBlocks: rm.Content.ContentBlocks,
+			}
 		}
 	}
 
@@ -474,14 +487,7 @@ func ToChatMessages(rms []ResponsesMessage) []ChatMessage {
 
 		// If we have collected tool calls, create an assistant message with them
 		if len(currentToolCalls) > 0 {
-			// Create a copy of the slice to avoid shared slice header issues
-			toolCallsCopy := append([]ChatAssistantMessageToolCall(nil), currentToolCalls...)
-			chatMessages = append(chatMessages, ChatMessage{
-				Role: ChatMessageRoleAssistant,
-				ChatAssistantMessage: &ChatAssistantMessage{
-					ToolCalls: toolCallsCopy,
-				},
-			})
+			chatMessages = appendChatToolCalls(chatMessages, currentToolCalls)
 			currentToolCalls = nil // Reset for next batch
 		}
 
@@ -515,17 +521,15 @@ func ToChatMessages(rms []ResponsesMessage) []ChatMessage {
 					// Extract content from ResponsesFunctionToolCallOutput if present
 					// This is needed because OpenAI Responses API uses an "output" field
 					// which is stored in ResponsesFunctionToolCallOutput
-					if rm.ResponsesToolMessage.Output != nil {
-						if rm.Content == nil {
-							rm.Content = &ResponsesMessageContent{}
+					// If Content is not already set, extract from ResponsesFunctionToolCallOutput
+					// A new content is set so that the caller's message is left untouched
+					if rm.ResponsesToolMessage.Output != nil && (rm.Content == nil || (rm.Content.ContentStr == nil && rm.Content.ContentBlocks == nil)) {
+						rm.Content = &ResponsesMessageContent{
+							ContentStr:    rm.ResponsesToolMessage.Output.ResponsesToolCallOutputStr,
+							ContentBlocks: rm.ResponsesToolMessage.Output.ResponsesFunctionToolCallOutputBlocks,
 						}
-						// If Content is not already set, extract from ResponsesFunctionToolCallOutput
-						if rm.Content.ContentStr == nil && rm.Content.ContentBlocks == nil {
-							if rm.ResponsesToolMessage.Output.ResponsesToolCallOutputStr != nil {
-								rm.Content.ContentStr = rm.ResponsesToolMessage.Output.ResponsesToolCallOutputStr
-							} else if rm.ResponsesToolMessage.Output.ResponsesFunctionToolCallOutputBlocks != nil {
-								rm.Content.ContentBlocks = rm.ResponsesToolMessage.Output.ResponsesFunctionToolCallOutputBlocks
-							}
+						if rm.Content.ContentStr != nil {
+							rm.Content.ContentBlocks = nil
 						}
 					}
 				}
@@ -570,8 +574,8 @@ func ToChatMessages(rms []ResponsesMessage) []ChatMessage {
 					// Map ResponsesMessageContentBlockType to ChatContentBlockType
 					var chatBlockType ChatContentBlockType
 					switch block.Type {
-					case ResponsesInputMessageContentBlockTypeText:
-						chatBlockType = ChatContentBlockTypeText // "input_text" -> "text"
+					case ResponsesInputMessageContentBlockTypeText, ResponsesOutputMessageContentTypeText:
+						chatBlockType = ChatContentBlockTypeText // "input_text" / "output_text" -> "text"
 					case ResponsesInputMessageContentBlockTypeImage:
 						chatBlockType = ChatContentBlockTypeImage // "input_image" -> "image_url"
 					case ResponsesInputMessageContentBlockTypeFile:
@@ -624,19 +628,35 @@ func ToChatMessages(rms []ResponsesMessage) []ChatMessage {
 
 	// Handle any remaining tool calls at the end
 	if len(currentToolCalls) > 0 {
-		// Create a copy of the slice to avoid shared slice header issues
-		toolCallsCopy := append([]ChatAssistantMessageToolCall(nil), currentToolCalls...)
-		chatMessages = append(chatMessages, ChatMessage{
-			Role: ChatMessageRoleAssistant,
-			ChatAssistantMessage: &ChatAssistantMessage{
-				ToolCalls: toolCallsCopy,
-			},
-		})
+		chatMessages = appendChatToolCalls(chatMessages, currentToolCalls)
 	}
 
 	return chatMessages
 }
 
+// appendChatToolCalls adds the tool calls of consecutive function_call items to the chat messages. They belong to
+// the assistant message item just before them, if any, as chat assistant messages hold both text and tool calls.
+func appendChatToolCalls(chatMessages []ChatMessage, toolCalls []ChatAssistantMessageToolCall) []ChatMessage {
+	// Create a copy of the slice to avoid shared slice header issues
+	toolCallsCopy := append([]ChatAssistantMessageToolCall(nil), toolCalls...)
+	if n := len(chatMessages); n > 0 && chatMessages[n-1].Role == ChatMessageRoleAssistant {
+		last := &chatMessages[n-1]
+		if last.ChatAssistantMessage == nil {
+			last.ChatAssistantMessage = &ChatAssistantMessage{}
+		}
+		if len(last.ChatAssistantMessage.ToolCalls) == 0 && last.ChatAssistantMessage.Refusal == nil {
+			last.ChatAssistantMessage.ToolCalls = toolCallsCopy
+			return chatMessages
+		}
+	}
+	return append(chatMessages, ChatMessage{
+		Role: ChatMessageRoleAssistant,
+		ChatAssistantMessage: &ChatAssistantMessage{
+			ToolCalls: toolCallsCopy,
+		},
+	})
+}
+
 func (cu *BifrostLLMUsage) ToResponsesResponseUsage() *ResponsesResponseUsage {
 	if cu == nil {
 		return nil
@@ -809,8 +829,15 @@ func (brr *BifrostResponsesRequest) ToChatRequest() *BifrostChatRequest {
 		Fallbacks: brr.Fallbacks, // Copy fallbacks as-is
 	}
 
-	// Convert Input messages using existing ToChatMessages()
+	// Convert Input messages using existing ToChatMessages(), instructions become a leading system message
 	bcr.Input = ToChatMessages(brr.Input)
+	if brr.Params != nil && brr.Params.Instructions != nil && *brr.Params.Instructions != "" {
+		instructions := ChatMessage{
+			Role:    ChatMessageRoleSystem,
+			Content: &ChatMessageContent{ContentStr: brr.Params.Instructions},
+		}
+		bcr.Input = append([]ChatMessage{instructions}, bcr.Input...)
+	}
 
 	// Convert Parameters
 	if brr.Params != nil {
@@ -887,11 +914,12 @@ func (cr *BifrostChatResponse) ToBifrostResponsesResponse() *BifrostResponsesRes
 
 	// Create new BifrostResponsesResponse from Chat fields
 	responsesResp := &BifrostResponsesResponse{
-		CreatedAt:     cr.Created,
-		Model:         cr.Model,
-		Citations:     cr.Citations,
-		SearchResults: cr.SearchResults,
-		Videos:        cr.Videos,
+		CreatedAt:       cr.Created,
+		Model:           cr.Model,
+		Citations:       cr.Citations,
+		SearchResults:   cr.SearchResults,
+		Videos:          cr.Videos,
+		SourceCitations: cr.SourceCitations,
 	}
 
 	// Convert Choices to Output messages
@@ -929,12 +957,13 @@ func (responsesResp *BifrostResponsesResponse) ToBifrostChatResponse() *BifrostC
 
 	// Create new BifrostChatResponse from Responses fields
 	chatResp := &BifrostChatResponse{
-		Created:       responsesResp.CreatedAt,
-		Object:        "chat.completion",
-		Model:         responsesResp.Model,
-		Citations:     responsesResp.Citations,
-		SearchResults: responsesResp.SearchResults,
-		Videos:        responsesResp.Videos,
+		Created:         responsesResp.CreatedAt,
+		Object:          "chat.completion",
+		Model:           responsesResp.Model,
+		Citations:       responsesResp.Citations,
+		SearchResults:   responsesResp.SearchResults,
+		Videos:          responsesResp.Videos,
+		SourceCitations: responsesResp.SourceCitations,
 	}
 
 	// Create Choices from ResponsesResponse
@@ -985,6 +1014,11 @@ type ChatToResponsesStreamState struct {
 	TextItemAdded         bool              // Whether text item has been added
 	TextItemClosed        bool              // Whether text item has been closed
 	TextItemHasContent    bool              // Whether text item has received any content deltas
+	TextOutputIndex       int               // Output index of the text item
+	ReasoningItemAdded    bool              // Whether the reasoning item has been added
+	ReasoningItemClosed   bool              // Whether the reasoning item has been closed
+	ReasoningOutputIndex  int               // Output index of the reasoning item
+	ReasoningText         string            // Accumulated reasoning summary text
 	CurrentOutputIndex    int               // Current output index counter
 	ToolCallOutputIndices map[string]int    // Maps tool call ID to output index
 	SequenceNumber        int               // Monotonic sequence number across all chunks
@@ -1007,6 +1041,8 @@ var chatToResponsesStreamStatePool = sync.Pool{
 			TextItemAdded:         false,
 			TextItemClosed:        false,
 			TextItemHasContent:    false,
+			ReasoningItemAdded:    false,
+			ReasoningItemClosed:   false,
 		}
 	},
 }
@@ -1051,6 +1087,11 @@ func AcquireChatToResponsesStreamState() *ChatToResponsesStreamState {
 	state.TextItemAdded = false
 	state.TextItemClosed = false
 	state.TextItemHasContent = false
+	state.TextOutputIndex = 0
+	state.ReasoningItemAdded = false
+	state.ReasoningItemClosed = false
+	state.ReasoningOutputIndex = 0
+	state.ReasoningText = ""
 	state.SequenceNumber = 0
 	return state
 }
@@ -1084,11 +1125,254 @@ func ReleaseChatToResponsesStreamState(state *ChatToResponsesStreamState) {
 		state.TextItemAdded = false
 		state.TextItemClosed = false
 		state.TextItemHasContent = false
+		state.TextOutputIndex = 0
+		state.ReasoningItemAdded = false
+		state.ReasoningItemClosed = false
+		state.ReasoningOutputIndex = 0
+		state.ReasoningText = ""
 		state.SequenceNumber = 0
 		chatToResponsesStreamStatePool.Put(state)
 	}
 }
 
+// nextOutputIndex returns the output index of a new output item.
+func (state *ChatToResponsesStreamState) nextOutputIndex() int {
+	outputIndex := state.CurrentOutputIndex
+	state.CurrentOutputIndex++
+	return outputIndex
+}
+
+// outputItemID returns a stable ID for the output item at the given index.
+func (state *ChatToResponsesStreamState) outputItemID(prefix string, outputIndex int) string {
+	if state.MessageID == nil {
+		return fmt.Sprintf("item_%d", outputIndex)
+	}
+	return fmt.Sprintf("%s_%s_item_%d", prefix, *state.MessageID, outputIndex)
+}
+
+// reasoningDelta returns the events streaming a reasoning delta as a reasoning summary of the reasoning item,
+// adding the item and its summary part on the first delta. Reasoning resuming after the item was closed
+// (e.g. by content) opens a new reasoning item.
+func (state *ChatToResponsesStreamState) reasoningDelta(reasoning string, extraFields BifrostResponseExtraFields) []*BifrostResponsesStreamResponse {
+	var responses []*BifrostResponsesStreamResponse
+	if !state.ReasoningItemAdded || state.ReasoningItemClosed {
+		outputIndex := state.nextOutputIndex()
+		itemID := state.outputItemID("rs", outputIndex)
+		state.ItemIDs["reasoning"] = itemID
+		state.ReasoningOutputIndex = outputIndex
+		state.ReasoningItemAdded = true
+		state.ReasoningItemClosed = false
+		state.ReasoningText = ""
+
+		responses = append(responses, &BifrostResponsesStreamResponse{
+			Type:           ResponsesStreamResponseTypeOutputItemAdded,
+			SequenceNumber: state.SequenceNumber,
+			OutputIndex:    Ptr(outputIndex),
+			ItemID:         &itemID,
+			Item: &ResponsesMessage{
+				ID:   &itemID,
+				Type: Ptr(ResponsesMessageTypeReasoning),
+				ResponsesReasoning: &ResponsesReasoning{
+					Summary: []ResponsesReasoningSummary{},
+				},
+			},
+			ExtraFields: extraFields,
+		})
+		state.SequenceNumber++
+
+		// Emit reasoning_summary_part.added for the single summary part
+		responses = append(responses, &BifrostResponsesStreamResponse{
+			Type:           ResponsesStreamResponseTypeReasoningSummaryPartAdded,
+			SequenceNumber: state.SequenceNumber,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			ItemID:         &itemID,
+			ExtraFields:    extraFields,
+		})
+		state.SequenceNumber++
+	}
+
+	itemID := state.ItemIDs["reasoning"]
+	state.ReasoningText += reasoning
+	responses = append(responses, &BifrostResponsesStreamResponse{
+		Type:           ResponsesStreamResponseTypeReasoningSummaryTextDelta,
+		SequenceNumber: state.SequenceNumber,
+		OutputIndex:    Ptr(state.ReasoningOutputIndex),
+		ContentIndex:   Ptr(0),
+		ItemID:         &itemID,
+		Delta:          &reasoning,
+		ExtraFields:    extraFields,
+	})
+	state.SequenceNumber++
+	return responses
+}
+
+// closeReasoningItem returns the events closing the reasoning item, if it is open.
+// The item is closed as soon as content, a tool call or the end of the stream arrives.
+func (state *ChatToResponsesStreamState) closeReasoningItem(extraFields BifrostResponseExtraFields) []*BifrostResponsesStreamResponse {
+	if !state.ReasoningItemAdded || state.ReasoningItemClosed {
+		return nil
+	}
+	outputIndex := state.ReasoningOutputIndex
+	itemID := state.ItemIDs["reasoning"]
+	text := state.ReasoningText
+	state.ReasoningItemClosed = true
+
+	responses := []*BifrostResponsesStreamResponse{
+		{
+			Type:           ResponsesStreamResponseTypeReasoningSummaryTextDone,
+			SequenceNumber: state.SequenceNumber,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			ItemID:         &itemID,
+			Text:           &text,
+			ExtraFields:    extraFields,
+		},
+		{
+			Type:           ResponsesStreamResponseTypeReasoningSummaryPartDone,
+			SequenceNumber: state.SequenceNumber + 1,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			ItemID:         &itemID,
+			ExtraFields:    extraFields,
+		},
+		{
+			Type:           ResponsesStreamResponseTypeOutputItemDone,
+			SequenceNumber: state.SequenceNumber + 2,
+			OutputIndex:    Ptr(outputIndex),
+			ItemID:         &itemID,
+			Item: &ResponsesMessage{
+				ID:     &itemID,
+				Type:   Ptr(ResponsesMessageTypeReasoning),
+				Status: Ptr("completed"),
+				ResponsesReasoning: &ResponsesReasoning{
+					Summary: []ResponsesReasoningSummary{{Type: ResponsesReasoningContentBlockTypeSummaryText, Text: text}},
+				},
+			},
+			ExtraFields: extraFields,
+		},
+	}
+	state.SequenceNumber += len(responses)
+	return responses
+}
+
+// openTextItem returns the events adding the message item and its output_text part, if it is not added yet.
+func (state *ChatToResponsesStreamState) openTextItem(extraFields BifrostResponseExtraFields) []*BifrostResponsesStreamResponse {
+	if state.TextItemAdded {
+		return nil
+	}
+	outputIndex := state.nextOutputIndex()
+	state.TextOutputIndex = outputIndex
+	// Generate stable ID for text item
+	itemID := state.outputItemID("msg", outputIndex)
+	state.ItemIDs["text"] = itemID
+	state.TextItemAdded = true
+
+	messageType := ResponsesMessageTypeMessage
+	role := ResponsesInputMessageRoleAssistant
+	emptyText := ""
+	responses := []*BifrostResponsesStreamResponse{
+		{
+			Type:           ResponsesStreamResponseTypeOutputItemAdded,
+			SequenceNumber: state.SequenceNumber,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			Item: &ResponsesMessage{
+				ID:   &itemID,
+				Type: &messageType,
+				Role: &role,
+				Content: &ResponsesMessageContent{
+					ContentBlocks: []ResponsesMessageContentBlock{},
+				},
+			},
+			ExtraFields: extraFields,
+		},
+		// content_part.added with an empty output_text part
+		{
+			Type:           ResponsesStreamResponseTypeContentPartAdded,
+			SequenceNumber: state.SequenceNumber + 1,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			ItemID:         &itemID,
+			Part: &ResponsesMessageContentBlock{
+				Type: ResponsesOutputMessageContentTypeText,
+				Text: &emptyText,
+			},
+			ExtraFields: extraFields,
+		},
+	}
+	state.SequenceNumber += len(responses)
+	return responses
+}
+
+// textDelta returns the event streaming a content delta as output text of the message item.
+func (state *ChatToResponsesStreamState) textDelta(content string, extraFields BifrostResponseExtraFields) *BifrostResponsesStreamResponse {
+	itemID := state.ItemIDs["text"]
+	response := &BifrostResponsesStreamResponse{
+		Type:           ResponsesStreamResponseTypeOutputTextDelta,
+		SequenceNumber: state.SequenceNumber,
+		OutputIndex:    Ptr(state.TextOutputIndex),
+		ContentIndex:   Ptr(0),
+		Delta:          &content,
+		ExtraFields:    extraFields,
+	}
+	if itemID != "" {
+		response.ItemID = &itemID
+	}
+	state.SequenceNumber++
+	state.TextItemHasContent = true
+	return response
+}
+
+// closeTextItem returns the events closing the message item, if it is open.
+func (state *ChatToResponsesStreamState) closeTextItem(extraFields BifrostResponseExtraFields) []*BifrostResponsesStreamResponse {
+	if !state.TextItemAdded || state.TextItemClosed {
+		return nil
+	}
+	outputIndex := state.TextOutputIndex
+	itemID := state.ItemIDs["text"]
+	state.TextItemClosed = true
+
+	// output_text.done is emitted without the accumulated text, just the event
+	emptyText := ""
+	statusCompleted := "completed"
+	doneItem := &ResponsesMessage{
+		Status: &statusCompleted,
+	}
+	if itemID != "" {
+		doneItem.ID = &itemID
+	}
+	responses := []*BifrostResponsesStreamResponse{
+		{
+			Type:           ResponsesStreamResponseTypeOutputTextDone,
+			SequenceNumber: state.SequenceNumber,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			ItemID:         &itemID,
+			Text:           &emptyText,
+			ExtraFields:    extraFields,
+		},
+		{
+			Type:           ResponsesStreamResponseTypeContentPartDone,
+			SequenceNumber: state.SequenceNumber + 1,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			ItemID:         &itemID,
+			ExtraFields:    extraFields,
+		},
+		{
+			Type:           ResponsesStreamResponseTypeOutputItemDone,
+			SequenceNumber: state.SequenceNumber + 2,
+			OutputIndex:    Ptr(outputIndex),
+			ContentIndex:   Ptr(0),
+			Item:           doneItem,
+			ExtraFields:    extraFields,
+		},
+	}
+	state.SequenceNumber += len(responses)
+	return responses
+}
+
 // ToBifrostResponsesStreamResponse converts the BifrostChatResponse from Chat streaming format to Responses streaming format
 // This converts Chat stream chunks (Choices with Deltas) to BifrostResponsesStreamResponse format
 // Returns a slice of responses to support cases where a single event produces multiple responses
@@ -1155,90 +1439,16 @@ func (cr *BifrostChatResponse) ToBifrostResponsesStreamResponse(state *ChatToRes
 	hasContent := delta.Content != nil && *delta.Content != ""
 	hasReasoning := delta.Reasoning != nil && *delta.Reasoning != ""
 
-	// Create output items if we have content OR reasoning (for reasoning-only models)
-	if hasContent || (hasReasoning && !state.TextItemAdded) {
-		// Text content delta (or reasoning-only response)
-		if !state.TextItemAdded {
-			// Add text item if not already added
-			outputIndex := 0
-			// Generate stable ID for text item
-			var itemID string
-			if state.MessageID == nil {
-				itemID = fmt.Sprintf("item_%d", outputIndex)
-			} else {
-				itemID = fmt.Sprintf("msg_%s_item_%d", *state.MessageID, outputIndex)
-			}
-			state.ItemIDs["text"] = itemID
-
-			messageType := ResponsesMessageTypeMessage
-			role := ResponsesInputMessageRoleAssistant
-
-			item := &ResponsesMessage{
-				ID:   &itemID,
-				Type: &messageType,
-				Role: &role,
-				Content: &ResponsesMessageContent{
-					ContentBlocks: []ResponsesMessageContentBlock{},
-				},
-			}
-
-			responses = append(responses, &BifrostResponsesStreamResponse{
-				Type:           ResponsesStreamResponseTypeOutputItemAdded,
-				SequenceNumber: state.SequenceNumber,
-				OutputIndex:    Ptr(outputIndex),
-				ContentIndex:   Ptr(0),
-				Item:           item,
-				ExtraFields:    cr.ExtraFields,
-			})
-			state.SequenceNumber++
-			state.TextItemAdded = true
-
-			// Emit content_part.added with empty output_text part
-			emptyText := ""
-			part := &ResponsesMessageContentBlock{
-				Type: ResponsesOutputMessageContentTypeText,
-				Text: &emptyText,
-			}
-			responses = append(responses, &BifrostResponsesStreamResponse{
-				Type:           ResponsesStreamResponseTypeContentPartAdded,
-				SequenceNumber: state.SequenceNumber,
-				OutputIndex:    Ptr(outputIndex),
-				ContentIndex:   Ptr(0),
-				ItemID:         &itemID,
-				Part:           part,
-				ExtraFields:    cr.ExtraFields,
-			})
-			state.SequenceNumber++
-		}
-
-		// Emit text delta - at least one is required for lifecycle validation
-		// Even for reasoning-only responses, we emit an empty delta on the first chunk
-		if hasContent || (!state.TextItemHasContent && (hasReasoning || hasContent)) {
-			itemID := state.ItemIDs["text"]
-
-			var contentDelta string
-			if hasContent {
-				contentDelta = *delta.Content
-			} else {
-				// For reasoning-only responses, emit empty delta on first chunk
-				contentDelta = ""
-			}
+	// Reasoning/thought content delta (for models that support reasoning), streamed as a reasoning item
+	// of its own rather than as output text, so that clients render it apart from the answer
+	if hasReasoning {
+		responses = append(responses, state.reasoningDelta(*delta.Reasoning, cr.ExtraFields)...)
+	}
 
-			response := &BifrostResponsesStreamResponse{
-				Type:           ResponsesStreamResponseTypeOutputTextDelta,
-				SequenceNumber: state.SequenceNumber,
-				OutputIndex:    Ptr(0),
-				ContentIndex:   Ptr(0),
-				Delta:          &contentDelta,
-				ExtraFields:    cr.ExtraFields,
-			}
-			if itemID != "" {
-				response.ItemID = &itemID
-			}
-			responses = append(responses, response)
-			state.SequenceNumber++
-			state.TextItemHasContent = true
-		}
+	if hasContent {
+		responses = append(responses, state.closeReasoningItem(cr.ExtraFields)...)
+		responses = append(responses, state.openTextItem(cr.ExtraFields)...)
+		responses = append(responses, state.textDelta(*delta.Content, cr.ExtraFields))
 	}
 
 	if len(delta.ToolCalls) > 0 {
@@ -1264,53 +1474,11 @@ func (cr *BifrostChatResponse) ToBifrostResponsesStreamResponse(state *ChatToRes
 		// Check if this is a new tool call (only when ID is present)
 		if toolCall.ID != nil && *toolCall.ID != "" {
 			if _, exists := state.ToolCallOutputIndices[toolCallID]; !exists {
+				responses = append(responses, state.closeReasoningItem(cr.ExtraFields)...)
+
 				// Close text item if still open and has content
-				if state.TextItemAdded && !state.TextItemClosed && state.TextItemHasContent {
-					outputIndex := 0
-					itemID := state.ItemIDs["text"]
-
-					// Emit output_text.done (without accumulated text, just the event)
-					emptyText := ""
-					responses = append(responses, &BifrostResponsesStreamResponse{
-						Type:           ResponsesStreamResponseTypeOutputTextDone,
-						SequenceNumber: state.SequenceNumber,
-						OutputIndex:    Ptr(outputIndex),
-						ContentIndex:   Ptr(0),
-						ItemID:         &itemID,
-						Text:           &emptyText,
-						ExtraFields:    cr.ExtraFields,
-					})
-					state.SequenceNumber++
-
-					// Emit content_part.done
-					responses = append(responses, &BifrostResponsesStreamResponse{
-						Type:           ResponsesStreamResponseTypeContentPartDone,
-						SequenceNumber: state.SequenceNumber,
-						OutputIndex:    Ptr(outputIndex),
-						ContentIndex:   Ptr(0),
-						ItemID:         &itemID,
-						ExtraFields:    cr.ExtraFields,
-					})
-					state.SequenceNumber++
-
-					// Emit output_item.done
-					statusCompleted := "completed"
-					doneItem := &ResponsesMessage{
-						Status: &statusCompleted,
-					}
-					if itemID != "" {
-						doneItem.ID = &itemID
-					}
-					responses = append(responses, &BifrostResponsesStreamResponse{
-						Type:           ResponsesStreamResponseTypeOutputItemDone,
-						SequenceNumber: state.SequenceNumber,
-						OutputIndex:    Ptr(outputIndex),
-						ContentIndex:   Ptr(0),
-						Item:           doneItem,
-						ExtraFields:    cr.ExtraFields,
-					})
-					state.SequenceNumber++
-					state.TextItemClosed = true
+				if state.TextItemHasContent {
+					responses = append(responses, state.closeTextItem(cr.ExtraFields)...)
 				}
 
 				// Assign new output index for tool call
@@ -1379,25 +1547,12 @@ func (cr *BifrostChatResponse) ToBifrostResponsesStreamResponse(state *ChatToRes
 		}
 	}
 
-	if delta.Reasoning != nil && *delta.Reasoning != "" {
-		// Reasoning/thought content delta (for models that support reasoning)
-		response := &BifrostResponsesStreamResponse{
-			Type:           ResponsesStreamResponseTypeReasoningSummaryTextDelta,
-			SequenceNumber: state.SequenceNumber,
-			OutputIndex:    Ptr(0),
-			Delta:          delta.Reasoning,
-			ExtraFields:    cr.ExtraFields,
-		}
-		responses = append(responses, response)
-		state.SequenceNumber++
-	}
-
 	if delta.Refusal != nil && *delta.Refusal != "" {
 		// Refusal delta
 		response := &BifrostResponsesStreamResponse{
 			Type:           ResponsesStreamResponseTypeRefusalDelta,
 			SequenceNumber: state.SequenceNumber,
-			OutputIndex:    Ptr(0),
+			OutputIndex:    Ptr(state.TextOutputIndex),
 			Refusal:        delta.Refusal,
 			ExtraFields:    cr.ExtraFields,
 		}
@@ -1407,53 +1562,12 @@ func (cr *BifrostChatResponse) ToBifrostResponsesStreamResponse(state *ChatToRes
 
 	// Check if this is a completion chunk with finish_reason
 	if choice.FinishReason != nil {
-		// Close text item if still open (regardless of whether it has content, to support reasoning-only responses)
-		if state.TextItemAdded && !state.TextItemClosed {
-			outputIndex := 0
-			itemID := state.ItemIDs["text"]
-
-			// Emit output_text.done (without accumulated text, just the event)
-			emptyText := ""
-			responses = append(responses, &BifrostResponsesStreamResponse{
-				Type:           ResponsesStreamResponseTypeOutputTextDone,
-				SequenceNumber: state.SequenceNumber,
-				OutputIndex:    Ptr(outputIndex),
-				ContentIndex:   Ptr(0),
-				ItemID:         &itemID,
-				Text:           &emptyText,
-				ExtraFields:    cr.ExtraFields,
-			})
-			state.SequenceNumber++
-
-			// Emit content_part.done
-			responses = append(responses, &BifrostResponsesStreamResponse{
-				Type:           ResponsesStreamResponseTypeContentPartDone,
-				SequenceNumber: state.SequenceNumber,
-				OutputIndex:    Ptr(outputIndex),
-				ContentIndex:   Ptr(0),
-				ItemID:         &itemID,
-				ExtraFields:    cr.ExtraFields,
-			})
-			state.SequenceNumber++
+		responses = append(responses, state.closeReasoningItem(cr.ExtraFields)...)
 
-			// Emit output_item.done
-			statusCompleted := "completed"
-			doneItem := &ResponsesMessage{
-				Status: &statusCompleted,
-			}
-			if itemID != "" {
-				doneItem.ID = &itemID
-			}
-			responses = append(responses, &BifrostResponsesStreamResponse{
-				Type:           ResponsesStreamResponseTypeOutputItemDone,
-				SequenceNumber: state.SequenceNumber,
-				OutputIndex:    Ptr(outputIndex),
-				ContentIndex:   Ptr(0),
-				Item:           doneItem,
-				ExtraFields:    cr.ExtraFields,
-			})
-			state.SequenceNumber++
-			state.TextItemClosed = true
+		// Reasoning-only responses still carry a message item, with an empty output text
+		if !state.TextItemAdded && len(state.ToolCallOutputIndices) == 0 {
+			responses = append(responses, state.openTextItem(cr.ExtraFields)...)
+			responses = append(responses, state.textDelta("", cr.ExtraFields))
 		}
 
 		// Close any open tool call items and emit function_call_arguments.done
@@ -1498,6 +1612,9 @@ func (cr *BifrostChatResponse) ToBifrostResponsesStreamResponse(state *ChatToRes
 			}
 		}
 
+		// The message item is always closed last
+		responses = append(responses, state.closeTextItem(cr.ExtraFields)...)
+
 		// Emit response.completed
 		var usage *ResponsesResponseUsage
 		if cr.Usage != nil {
@@ -1531,6 +1648,7 @@ func (cr *BifrostChatResponse) ToBifrostResponsesStreamResponse(state *ChatToRes
 			resp.SearchResults = cr.SearchResults
 			resp.Videos = cr.Videos
 			resp.Citations = cr.Citations
+			resp.SourceCitations = cr.SourceCitations
 		}
 	}
 
@@ -0,0 +1,203 @@
+package schemas
+
+import "testing"
+
+// streamChatDeltas converts Chat stream deltas to Responses stream events, finishing the stream after the last delta
+func streamChatDeltas(deltas ...*ChatStreamResponseChoiceDelta) []*BifrostResponsesStreamResponse {
+	state := AcquireChatToResponsesStreamState()
+	defer ReleaseChatToResponsesStreamState(state)
+
+	var events []*BifrostResponsesStreamResponse
+	for i, delta := range deltas {
+		choice := BifrostResponseChoice{ChatStreamResponseChoice: &ChatStreamResponseChoice{Delta: delta}}
+		if i == len(deltas)-1 {
+			choice.FinishReason = Ptr("stop")
+		}
+		chunk := &BifrostChatResponse{ID: "chatcmpl-1", Model: "gpt-4o", Choices: []BifrostResponseChoice{choice}}
+		events = append(events, chunk.ToBifrostResponsesStreamResponse(state)...)
+	}
+	return events
+}
+
+// Test that reasoning deltas are streamed as reasoning summary events of a reasoning item, apart from the output text
+func TestToBifrostResponsesStreamResponse_ReasoningSummary(t *testing.T) {
+	events := streamChatDeltas(
+		&ChatStreamResponseChoiceDelta{Role: Ptr("assistant")},
+		&ChatStreamResponseChoiceDelta{Reasoning: Ptr("Let me ")},
+		&ChatStreamResponseChoiceDelta{Reasoning: Ptr("think.")},
+		&ChatStreamResponseChoiceDelta{Content: Ptr("Hello")},
+		&ChatStreamResponseChoiceDelta{},
+	)
+
+	var types []ResponsesStreamResponseType
+	var reasoningDeltas, textDeltas []string
+	var reasoningItemID, reasoningDone string
+	for i, event := range events {
+		if event.SequenceNumber != i {
+			t.Errorf("expected sequence number %d, got %d", i, event.SequenceNumber)
+		}
+		types = append(types, event.Type)
+		switch event.Type {
+		case ResponsesStreamResponseTypeReasoningSummaryTextDelta:
+			reasoningDeltas = append(reasoningDeltas, *event.Delta)
+			if event.ItemID == nil || *event.OutputIndex != 0 {
+				t.Errorf("expected reasoning deltas on the reasoning item at output index 0, got %+v", event)
+			} else {
+				reasoningItemID = *event.ItemID
+			}
+		case ResponsesStreamResponseTypeReasoningSummaryTextDone:
+			reasoningDone = *event.Text
+		case ResponsesStreamResponseTypeOutputTextDelta:
+			textDeltas = append(textDeltas, *event.Delta)
+			if *event.OutputIndex != 1 || (event.ItemID != nil && *event.ItemID == reasoningItemID) {
+				t.Errorf("expected text deltas on the message item at output index 1, got %+v", event)
+			}
+		}
+	}
+
+	expectedTypes := []ResponsesStreamResponseType{
+		ResponsesStreamResponseTypeCreated,
+		ResponsesStreamResponseTypeInProgress,
+		ResponsesStreamResponseTypeOutputItemAdded,
+		ResponsesStreamResponseTypeReasoningSummaryPartAdded,
+		ResponsesStreamResponseTypeReasoningSummaryTextDelta,
+		ResponsesStreamResponseTypeReasoningSummaryTextDelta,
+		ResponsesStreamResponseTypeReasoningSummaryTextDone,
+		ResponsesStreamResponseTypeReasoningSummaryPartDone,
+		ResponsesStreamResponseTypeOutputItemDone,
+		ResponsesStreamResponseTypeOutputItemAdded,
+		ResponsesStreamResponseTypeContentPartAdded,
+		ResponsesStreamResponseTypeOutputTextDelta,
+		ResponsesStreamResponseTypeOutputTextDone,
+		ResponsesStreamResponseTypeContentPartDone,
+		ResponsesStreamResponseTypeOutputItemDone,
+		ResponsesStreamResponseTypeCompleted,
+	}
+	if len(types) != len(expectedTypes) {
+		t.Fatalf("expected events %v, got %v", expectedTypes, types)
+	}
+	for i := range expectedTypes {
+		if types[i] != expectedTypes[i] {
+			t.Fatalf("expected events %v, got %v", expectedTypes, types)
+		}
+	}
+	if len(reasoningDeltas) != 2 || reasoningDeltas[0] != "Let me " || reasoningDeltas[1] != "think." {
+		t.Errorf("expected the reasoning deltas as reasoning summary deltas, got %q", reasoningDeltas)
+	}
+	if reasoningDone != "Let me think." {
+		t.Errorf("expected the full reasoning summary on done, got %q", reasoningDone)
+	}
+	if len(textDeltas) != 1 || textDeltas[0] != "Hello" {
+		t.Errorf("expected only the content as text deltas, got %q", textDeltas)
+	}
+
+	addedItem := events[2].Item
+	if addedItem == nil || addedItem.Type == nil || *addedItem.Type != ResponsesMessageTypeReasoning {
+		t.Errorf("expected a reasoning item to be added, got %+v", addedItem)
+	}
+	doneItem := events[8].Item
+	if doneItem == nil || doneItem.ResponsesReasoning == nil || len(doneItem.ResponsesReasoning.Summary) != 1 ||
+		doneItem.ResponsesReasoning.Summary[0].Text != "Let me think." {
+		t.Errorf("expected the done reasoning item to carry the summary, got %+v", doneItem)
+	}
+}
+
+// Test that a reasoning-only stream still carries the message item with its output text, closed last
+func TestToBifrostResponsesStreamResponse_ReasoningOnly(t *testing.T) {
+	events := streamChatDeltas(
+		&ChatStreamResponseChoiceDelta{Role: Ptr("assistant"), Reasoning: Ptr("Thinking")},
+		&ChatStreamResponseChoiceDelta{},
+	)
+
+	expectedTypes := []ResponsesStreamResponseType{
+		ResponsesStreamResponseTypeCreated,
+		ResponsesStreamResponseTypeInProgress,
+		ResponsesStreamResponseTypeOutputItemAdded,
+		ResponsesStreamResponseTypeReasoningSummaryPartAdded,
+		ResponsesStreamResponseTypeReasoningSummaryTextDelta,
+		ResponsesStreamResponseTypeReasoningSummaryTextDone,
+		ResponsesStreamResponseTypeReasoningSummaryPartDone,
+		ResponsesStreamResponseTypeOutputItemDone,
+		ResponsesStreamResponseTypeOutputItemAdded,
+		ResponsesStreamResponseTypeContentPartAdded,
+		ResponsesStreamResponseTypeOutputTextDelta,
+		ResponsesStreamResponseTypeOutputTextDone,
+		ResponsesStreamResponseTypeContentPartDone,
+		ResponsesStreamResponseTypeOutputItemDone,
+		ResponsesStreamResponseTypeCompleted,
+	}
+	assertStreamEventTypes(t, events, expectedTypes)
+
+	if item := events[8].Item; item == nil || item.Type == nil || *item.Type != ResponsesMessageTypeMessage {
+		t.Errorf("expected a message item to be added after the reasoning, got %+v", item)
+	}
+	if delta := events[10].Delta; delta == nil || *delta != "" {
+		t.Errorf("expected an empty output text delta, got %v", delta)
+	}
+}
+
+// Test that reasoning resuming after content opens a new reasoning item and that the message item is closed last
+func TestToBifrostResponsesStreamResponse_ReasoningAfterContent(t *testing.T) {
+	events := streamChatDeltas(
+		&ChatStreamResponseChoiceDelta{Role: Ptr("assistant"), Reasoning: Ptr("First thought.")},
+		&ChatStreamResponseChoiceDelta{Content: Ptr("Hello")},
+		&ChatStreamResponseChoiceDelta{Reasoning: Ptr("Second thought.")},
+		&ChatStreamResponseChoiceDelta{},
+	)
+
+	expectedTypes := []ResponsesStreamResponseType{
+		ResponsesStreamResponseTypeCreated,
+		ResponsesStreamResponseTypeInProgress,
+		ResponsesStreamResponseTypeOutputItemAdded,
+		ResponsesStreamResponseTypeReasoningSummaryPartAdded,
+		ResponsesStreamResponseTypeReasoningSummaryTextDelta,
+		ResponsesStreamResponseTypeReasoningSummaryTextDone,
+		ResponsesStreamResponseTypeReasoningSummaryPartDone,
+		ResponsesStreamResponseTypeOutputItemDone,
+		ResponsesStreamResponseTypeOutputItemAdded,
+		ResponsesStreamResponseTypeContentPartAdded,
+		ResponsesStreamResponseTypeOutputTextDelta,
+		ResponsesStreamResponseTypeOutputItemAdded,
+		ResponsesStreamResponseTypeReasoningSummaryPartAdded,
+		ResponsesStreamResponseTypeReasoningSummaryTextDelta,
+		ResponsesStreamResponseTypeReasoningSummaryTextDone,
+		ResponsesStreamResponseTypeReasoningSummaryPartDone,
+		ResponsesStreamResponseTypeOutputItemDone,
+		ResponsesStreamResponseTypeOutputTextDone,
+		ResponsesStreamResponseTypeContentPartDone,
+		ResponsesStreamResponseTypeOutputItemDone,
+		ResponsesStreamResponseTypeCompleted,
+	}
+	assertStreamEventTypes(t, events, expectedTypes)
+
+	first, second := events[2], events[11]
+	if first.ItemID == nil || second.ItemID == nil || *first.ItemID == *second.ItemID || *second.OutputIndex != 2 {
+		t.Errorf("expected the resumed reasoning on a new item at output index 2, got %+v and %+v", first, second)
+	}
+	if text := events[14].Text; text == nil || *text != "Second thought." {
+		t.Errorf("expected the new reasoning item to carry only its own summary, got %v", text)
+	}
+	if *events[19].OutputIndex != 1 {
+		t.Errorf("expected the message item at output index 1 to be closed last, got output index %d", *events[19].OutputIndex)
+	}
+}
+
+// assertStreamEventTypes checks the types and sequence numbers of the Responses stream events
+func assertStreamEventTypes(t *testing.T, events []*BifrostResponsesStreamResponse, expectedTypes []ResponsesStreamResponseType) {
+	t.Helper()
+	types := make([]ResponsesStreamResponseType, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+		if event.SequenceNumber != i {
+			t.Errorf("expected sequence number %d, got %d", i, event.SequenceNumber)
+		}
+	}
+	if len(types) != len(expectedTypes) {
+		t.Fatalf("expected events %v, got %v", expectedTypes, types)
+	}
+	for i := range expectedTypes {
+		if types[i] != expectedTypes[i] {
+			t.Fatalf("expected events %v, got %v", expectedTypes, types)
+		}
+	}
+}
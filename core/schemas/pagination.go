@@ -61,3 +61,98 @@ func NewSerialCursor(keyIndex int, cursor string) *SerialCursor {
 	}
 }
 
+// AggregateCursor tracks pagination state when listing across all keys at once.
+// Each key is paged independently from its own native cursor, and the pages of all keys are merged.
+type AggregateCursor struct {
+	Version int      `json:"v"` // Version for compatibility
+	Cursors []string `json:"c"` // Native cursor per key index in sorted keys array (empty = start fresh)
+	Done    []bool   `json:"d"` // Per key index, whether the key's pages are exhausted
+}
+
+// EncodeAggregateCursor encodes an AggregateCursor to a base64 string for transport.
+func EncodeAggregateCursor(cursor *AggregateCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// DecodeAggregateCursor decodes a base64 string back to an AggregateCursor.
+// Returns (nil, nil) if the encoded string is empty; returns an error for invalid data.
+func DecodeAggregateCursor(encoded string) (*AggregateCursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	var cursor AggregateCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cursor: %w", err)
+	}
+
+	// Validate version
+	if cursor.Version != 1 {
+		return nil, fmt.Errorf("unsupported cursor version: %d", cursor.Version)
+	}
+	if len(cursor.Cursors) != len(cursor.Done) {
+		return nil, fmt.Errorf("invalid cursor: %d key cursors for %d keys", len(cursor.Cursors), len(cursor.Done))
+	}
+
+	return &cursor, nil
+}
+
+// BatchResultsCursor tracks the position in a batch results file when paging through its results.
+// The file is JSONL, so the position is the byte offset of the next record, which lets the next page
+// skip the records already returned without parsing them.
+type BatchResultsCursor struct {
+	Version int `json:"v"` // Version for compatibility
+	Offset  int `json:"o"` // Byte offset of the next record in the results file
+	Line    int `json:"l"` // Lines before Offset, so that parse error line numbers stay absolute
+}
+
+// EncodeBatchResultsCursor encodes a BatchResultsCursor to a base64 string for transport.
+func EncodeBatchResultsCursor(cursor *BatchResultsCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// DecodeBatchResultsCursor decodes a base64 string back to a BatchResultsCursor.
+// Returns (nil, nil) if the encoded string is empty; returns an error for invalid data.
+func DecodeBatchResultsCursor(encoded string) (*BatchResultsCursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	var cursor BatchResultsCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cursor: %w", err)
+	}
+
+	if cursor.Version != 1 {
+		return nil, fmt.Errorf("unsupported cursor version: %d", cursor.Version)
+	}
+	if cursor.Offset < 0 || cursor.Line < 0 {
+		return nil, fmt.Errorf("invalid cursor position")
+	}
+
+	return &cursor, nil
+}
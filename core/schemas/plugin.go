@@ -1,6 +1,8 @@
 // Package schemas defines the core schemas and types used by the Bifrost system.
 package schemas
 
+import "context"
+
 // PluginShortCircuit represents a plugin's decision to short-circuit the normal flow.
 // It can contain either a response (success short-circuit), a stream (streaming short-circuit), or an error (error short-circuit).
 type PluginShortCircuit struct {
@@ -86,6 +88,16 @@ type Plugin interface {
 	Cleanup() error
 }
 
+// FlushablePlugin is implemented by plugins doing work asynchronously from their hooks, such as writing logs
+// or recording metrics in background goroutines.
+// Flush is called before Cleanup, on shutdown once no more requests are processed, and when the plugin is removed
+// or replaced. It should wait for the pending work to complete, or return when the context is done, in which case
+// the remaining work may be lost. The context deadline is set by BifrostConfig.PluginFlushTimeout.
+type FlushablePlugin interface {
+	Plugin
+	Flush(ctx context.Context) error
+}
+
 // PluginConfig is the configuration for a plugin.
 // It contains the name of the plugin, whether it is enabled, and the configuration for the plugin.
 type PluginConfig struct {
@@ -12,6 +12,7 @@ const (
 	DefaultMaxRetries              = 0
 	DefaultRetryBackoffInitial     = 500 * time.Millisecond
 	DefaultRetryBackoffMax         = 5 * time.Second
+	DefaultConnectionRetries       = 2
 	DefaultRequestTimeoutInSeconds = 30
 	DefaultBufferSize              = 5000
 	DefaultConcurrency             = 1000
@@ -53,6 +54,23 @@ type NetworkConfig struct {
 	MaxRetries                     int               `json:"max_retries"`                        // Maximum number of retries
 	RetryBackoffInitial            time.Duration     `json:"retry_backoff_initial"`              // Initial backoff duration (stored as nanoseconds, JSON as milliseconds)
 	RetryBackoffMax                time.Duration     `json:"retry_backoff_max"`                  // Maximum backoff duration (stored as nanoseconds, JSON as milliseconds)
+	// ConnectionRetries is supported for Bedrock, whose requests go through net/http. Only idempotent requests
+	// (GET, HEAD) failing with a connection error are retried, with the retry backoff. A negative value disables them.
+	ConnectionRetries int `json:"connection_retries,omitempty"` // Maximum number of connection-level retries (optional)
+	// RetryOnMalformedResponse sends read-only requests (list, retrieve and file content) again once when the
+	// provider's response cannot be decoded (empty, truncated or HTML body), which is often a transient proxy or
+	// network artifact. Billed requests such as chat completions or embeddings are never sent again.
+	RetryOnMalformedResponse bool `json:"retry_on_malformed_response,omitempty"` // Re-request once on malformed responses (optional)
+	// TLS restricts the TLS connections to the provider. Connections require TLS 1.2 or later even if it is not set.
+	TLS *TLSConfig `json:"tls,omitempty"` // TLS configuration (optional)
+}
+
+// TLSConfig restricts the TLS versions and cipher suites used to connect to a provider.
+type TLSConfig struct {
+	MinVersion string `json:"min_version,omitempty"` // Minimum TLS version, "1.2" (default) or "1.3"
+	// CipherSuites only applies to TLS 1.2 connections, TLS 1.3 cipher suites are not configurable.
+	CipherSuites       []string `json:"cipher_suites,omitempty"`        // Allowed cipher suites by IANA name, e.g. "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256" (optional, Go's secure defaults if empty)
+	InsecureSkipVerify bool     `json:"insecure_skip_verify,omitempty"` // Skip the verification of the provider certificate, for testing only
 }
 
 // UnmarshalJSON customizes JSON unmarshaling for NetworkConfig.
@@ -67,6 +85,9 @@ func (nc *NetworkConfig) UnmarshalJSON(data []byte) error {
 		MaxRetries                     int               `json:"max_retries"`
 		RetryBackoffInitial            int64             `json:"retry_backoff_initial"` // milliseconds in JSON
 		RetryBackoffMax                int64             `json:"retry_backoff_max"`     // milliseconds in JSON
+		ConnectionRetries              int               `json:"connection_retries,omitempty"`
+		RetryOnMalformedResponse       bool              `json:"retry_on_malformed_response,omitempty"`
+		TLS                            *TLSConfig        `json:"tls,omitempty"`
 	}
 
 	var alias NetworkConfigAlias
@@ -79,6 +100,9 @@ func (nc *NetworkConfig) UnmarshalJSON(data []byte) error {
 	nc.ExtraHeaders = alias.ExtraHeaders
 	nc.DefaultRequestTimeoutInSeconds = alias.DefaultRequestTimeoutInSeconds
 	nc.MaxRetries = alias.MaxRetries
+	nc.ConnectionRetries = alias.ConnectionRetries
+	nc.RetryOnMalformedResponse = alias.RetryOnMalformedResponse
+	nc.TLS = alias.TLS
 
 	// Convert milliseconds to time.Duration (nanoseconds)
 	// Only convert if value is greater than 0
@@ -104,6 +128,9 @@ func (nc NetworkConfig) MarshalJSON() ([]byte, error) {
 		MaxRetries                     int               `json:"max_retries"`
 		RetryBackoffInitial            int64             `json:"retry_backoff_initial"` // milliseconds in JSON
 		RetryBackoffMax                int64             `json:"retry_backoff_max"`     // milliseconds in JSON
+		ConnectionRetries              int               `json:"connection_retries,omitempty"`
+		RetryOnMalformedResponse       bool              `json:"retry_on_malformed_response,omitempty"`
+		TLS                            *TLSConfig        `json:"tls,omitempty"`
 	}
 
 	alias := NetworkConfigAlias{
@@ -111,6 +138,9 @@ func (nc NetworkConfig) MarshalJSON() ([]byte, error) {
 		ExtraHeaders:                   nc.ExtraHeaders,
 		DefaultRequestTimeoutInSeconds: nc.DefaultRequestTimeoutInSeconds,
 		MaxRetries:                     nc.MaxRetries,
+		ConnectionRetries:              nc.ConnectionRetries,
+		RetryOnMalformedResponse:       nc.RetryOnMalformedResponse,
+		TLS:                            nc.TLS,
 		// Convert time.Duration (nanoseconds) to milliseconds
 		RetryBackoffInitial: int64(nc.RetryBackoffInitial / time.Millisecond),
 		RetryBackoffMax:     int64(nc.RetryBackoffMax / time.Millisecond),
@@ -125,6 +155,7 @@ var DefaultNetworkConfig = NetworkConfig{
 	MaxRetries:                     DefaultMaxRetries,
 	RetryBackoffInitial:            DefaultRetryBackoffInitial,
 	RetryBackoffMax:                DefaultRetryBackoffMax,
+	ConnectionRetries:              DefaultConnectionRetries,
 }
 
 // ConcurrencyAndBufferSize represents configuration for concurrent operations and buffer sizes.
@@ -139,6 +170,94 @@ var DefaultConcurrencyAndBufferSize = ConcurrencyAndBufferSize{
 	BufferSize:  DefaultBufferSize,
 }
 
+// BulkheadConfig bounds the number of requests a single provider may hold at once,
+// so that a slow or degraded provider cannot tie up callers (goroutines, connections)
+// that are shared with healthy providers.
+// A nil *BulkheadConfig or a MaxInFlight of 0 disables the bulkhead.
+type BulkheadConfig struct {
+	MaxInFlight int                        `json:"max_in_flight"`      // Maximum number of admitted requests (queued + executing + open streams) for the provider
+	MaxWaitInMs int                        `json:"max_wait_ms"`        // Maximum time a caller waits for a free slot before being rejected (0 means reject immediately)
+	Adaptive    *AdaptiveConcurrencyConfig `json:"adaptive,omitempty"` // Lowers the limit below MaxInFlight while the provider is overloaded (optional)
+}
+
+// AdaptiveConcurrencyConfig makes the limit of a bulkhead adaptive (additive increase, multiplicative decrease):
+// the limit shrinks when provider calls show overload (429, 502, 503 and 504 errors, timeouts, or calls slower
+// than the latency target) and grows back one slot per successful call, up to the bulkhead's MaxInFlight.
+type AdaptiveConcurrencyConfig struct {
+	MinInFlight       int `json:"min_in_flight,omitempty"`     // Lowest limit the bulkhead shrinks to (default: 1)
+	LatencyTargetInMs int `json:"latency_target_ms,omitempty"` // Provider calls slower than this count as overload, 0 only counts errors (optional)
+}
+
+// BulkheadStats is a point-in-time snapshot of a provider's bulkhead usage.
+type BulkheadStats struct {
+	MaxInFlight int    `json:"max_in_flight"` // Configured slot count
+	Limit       int    `json:"limit"`         // Slots currently admitted, below MaxInFlight while an adaptive limit backs off
+	InFlight    int64  `json:"in_flight"`     // Requests currently holding a slot
+	Waiting     int64  `json:"waiting"`       // Callers currently waiting for a slot
+	Rejected    uint64 `json:"rejected"`      // Total requests rejected because no slot became free in time
+}
+
+// StreamStats is a point-in-time snapshot of the streams open at once, for the whole instance or a single provider.
+type StreamStats struct {
+	MaxConcurrent int64  `json:"max_concurrent"` // Configured cap, 0 if unbounded
+	Active        int64  `json:"active"`         // Streams currently open, only counted while a stream cap or a bulkhead applies
+	Rejected      uint64 `json:"rejected"`       // Total streams rejected because the cap was reached
+}
+
+// ResponsePoolStats is a point-in-time snapshot of the usage of a pool of provider response objects.
+// Allocations close to Gets mean that the pool rarely has a response to reuse under the current load.
+type ResponsePoolStats struct {
+	Gets        uint64 `json:"gets"`        // Total responses acquired from the pool
+	Puts        uint64 `json:"puts"`        // Total responses returned to the pool
+	Allocations uint64 `json:"allocations"` // Total responses allocated because the pool was empty
+}
+
+// S3OperationStats is a point-in-time snapshot of the S3 operations of one kind (e.g. PutObject) sent by a provider.
+type S3OperationStats struct {
+	Count           uint64  `json:"count"`            // Total operations sent, including failed ones
+	Errors          uint64  `json:"errors"`           // Total operations that failed or got an error response
+	DurationSeconds float64 `json:"duration_seconds"` // Total time spent on the operations
+}
+
+// OutboundRateLimitConfig paces the requests Bifrost itself sends to a provider with a token bucket,
+// so that bursts of traffic are smoothed to the provider's per-second limits instead of being rejected by it.
+// This is independent of governance rate limits, which cap the usage of Bifrost's own callers.
+// A nil *OutboundRateLimitConfig or a RequestsPerSecond of 0 disables pacing.
+type OutboundRateLimitConfig struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`   // Sustained dispatch rate
+	Burst             int     `json:"burst,omitempty"`       // Requests that may be dispatched at once after an idle period (default: 1)
+	PerKey            bool    `json:"per_key,omitempty"`     // Pace each key separately instead of the provider as a whole
+	MaxWaitInMs       int     `json:"max_wait_ms,omitempty"` // Maximum time a request is held back before being rejected (0 means wait as long as needed)
+}
+
+// ModelParameterAction defines what happens to a request carrying parameters a model does not support.
+type ModelParameterAction string
+
+const (
+	ModelParameterActionStrip  ModelParameterAction = "strip"  // Remove the incompatible parameters and send the request (default)
+	ModelParameterActionReject ModelParameterAction = "reject" // Reject the request with a 400 without sending it
+)
+
+// ModelParameterRule declares the request parameters a set of models accepts, so that parameters the provider
+// would reject (e.g. temperature on reasoning models) are handled by Bifrost instead of failing with a provider 400.
+// Parameters are matched by their JSON name (e.g. "temperature", "top_p"), including extra params.
+// If AllowedParams is set, any other parameter is incompatible, otherwise only DeniedParams are.
+type ModelParameterRule struct {
+	Models        []string             `json:"models"`                   // Model names, a trailing "*" matches by prefix (e.g. "o1*")
+	AllowedParams []string             `json:"allowed_params,omitempty"` // Only these parameters are sent
+	DeniedParams  []string             `json:"denied_params,omitempty"`  // These parameters are never sent
+	Action        ModelParameterAction `json:"action,omitempty"`         // What to do with incompatible parameters (default: strip)
+}
+
+// AssistantPrefillMode defines how a trailing assistant message, a prefill the model is asked to continue from,
+// is handled for providers that do not support assistant prefill natively (Anthropic does).
+type AssistantPrefillMode string
+
+const (
+	AssistantPrefillModeEmulate AssistantPrefillMode = "emulate" // Ask the model to begin its response with the prefill, which is removed from the response
+	AssistantPrefillModeWarn    AssistantPrefillMode = "warn"    // Send the messages unchanged and log a warning
+)
+
 // ProxyType defines the type of proxy to use for connections.
 type ProxyType string
 
@@ -239,7 +358,7 @@ func (ar *AllowedRequests) IsOperationAllowed(operation RequestType) bool {
 		return ar.FileRetrieve
 	case FileDeleteRequest:
 		return ar.FileDelete
-	case FileContentRequest:
+	case FileContentRequest, FileDownloadURLRequest:
 		return ar.FileContent
 	default:
 		return false // Default to not allowed for unknown operations
@@ -247,11 +366,28 @@ func (ar *AllowedRequests) IsOperationAllowed(operation RequestType) bool {
 }
 
 type CustomProviderConfig struct {
-	CustomProviderKey    string                 `json:"-"`                                // Custom provider key, internally set by Bifrost
-	IsKeyLess            bool                   `json:"is_key_less"`                      // Whether the custom provider requires a key (not allowed for Bedrock)
-	BaseProviderType     ModelProvider          `json:"base_provider_type"`               // Base provider type
-	AllowedRequests      *AllowedRequests       `json:"allowed_requests,omitempty"`       // Allowed requests for the custom provider
-	RequestPathOverrides map[RequestType]string `json:"request_path_overrides,omitempty"` // Mapping of request type to its custom path which will override the default path of the provider (not allowed for Bedrock)
+	CustomProviderKey    string                               `json:"-"`                                // Custom provider key, internally set by Bifrost
+	IsKeyLess            bool                                 `json:"is_key_less"`                      // Whether the custom provider requires a key (not allowed for Bedrock)
+	BaseProviderType     ModelProvider                        `json:"base_provider_type"`               // Base provider type
+	AllowedRequests      *AllowedRequests                     `json:"allowed_requests,omitempty"`       // Allowed requests for the custom provider
+	RequestPathOverrides map[RequestType]string               `json:"request_path_overrides,omitempty"` // Mapping of request type to its custom path which will override the default path of the provider (not allowed for Bedrock)
+	RequestBodyTemplates map[RequestType]*RequestBodyTemplate `json:"request_body_templates,omitempty"` // Mapping of request type to the template reshaping its request body (optional)
+}
+
+// RequestBodyTemplate reshapes the JSON request body Bifrost builds for the base provider into the body a custom
+// provider expects. Paths are dot-separated, e.g. "parameters.temperature".
+type RequestBodyTemplate struct {
+	Fields       map[string]string `json:"fields,omitempty"`        // Target path -> source path, renaming and (un)nesting fields
+	Defaults     map[string]any    `json:"defaults,omitempty"`      // Target path -> value, set when the target path is not set by a field
+	KeepUnmapped bool              `json:"keep_unmapped,omitempty"` // Keep the top-level fields not used as source of a field, dropped by default
+}
+
+// GetRequestBodyTemplate returns the request body template of a request type, or nil if there is none.
+func (cpc *CustomProviderConfig) GetRequestBodyTemplate(requestType RequestType) *RequestBodyTemplate {
+	if cpc == nil {
+		return nil
+	}
+	return cpc.RequestBodyTemplates[requestType]
 }
 
 // IsOperationAllowed checks if a specific operation is allowed for this custom provider
@@ -269,11 +405,19 @@ type ProviderConfig struct {
 	NetworkConfig            NetworkConfig            `json:"network_config"`              // Network configuration
 	ConcurrencyAndBufferSize ConcurrencyAndBufferSize `json:"concurrency_and_buffer_size"` // Concurrency settings
 	// Logger instance, can be provided by the user or bifrost default logger is used if not provided
-	Logger               Logger                `json:"-"`
-	ProxyConfig          *ProxyConfig          `json:"proxy_config,omitempty"` // Proxy configuration
-	SendBackRawRequest   bool                  `json:"send_back_raw_request"`  // Send raw request back in the bifrost response (default: false)
-	SendBackRawResponse  bool                  `json:"send_back_raw_response"` // Send raw response back in the bifrost response (default: false)
-	CustomProviderConfig *CustomProviderConfig `json:"custom_provider_config,omitempty"`
+	Logger                 Logger                   `json:"-"`
+	ProxyConfig            *ProxyConfig             `json:"proxy_config,omitempty"`              // Proxy configuration
+	Bulkhead               *BulkheadConfig          `json:"bulkhead,omitempty"`                  // Per-provider resource isolation (optional)
+	OutboundRateLimit      *OutboundRateLimitConfig `json:"outbound_rate_limit,omitempty"`       // Pacing of requests sent to the provider (optional)
+	ParameterRules         []ModelParameterRule     `json:"parameter_rules,omitempty"`           // Parameters each model accepts, incompatible ones are stripped or rejected before dispatch (optional)
+	SendBackRawRequest     bool                     `json:"send_back_raw_request"`               // Send raw request back in the bifrost response (default: false)
+	SendBackRawResponse    bool                     `json:"send_back_raw_response"`              // Send raw response back in the bifrost response (default: false)
+	RawResponseDenylist    []string                 `json:"raw_response_denylist,omitempty"`     // JSON paths removed from raw responses, e.g. "organization" or "choices.*.internal_id" (optional)
+	AssistantPrefill       AssistantPrefillMode     `json:"assistant_prefill,omitempty"`         // Handling of assistant prefills if the provider does not support them (default: sent unchanged)
+	BatchNameTemplate      string                   `json:"batch_name_template,omitempty"`       // Naming template of batch jobs, e.g. "{vk}-{endpoint}-{timestamp}" (optional)
+	MaxConcurrentStreams   int                      `json:"max_concurrent_streams,omitempty"`    // Maximum number of streams open at once for the provider, 0 means unbounded (optional)
+	MaxInlineBatchRequests int                      `json:"max_inline_batch_requests,omitempty"` // Maximum number of inline requests of a batch, checked before submission, 0 means unchecked (optional)
+	CustomProviderConfig   *CustomProviderConfig    `json:"custom_provider_config,omitempty"`
 }
 
 func (config *ProviderConfig) CheckAndSetDefaults() {
@@ -301,6 +445,10 @@ func (config *ProviderConfig) CheckAndSetDefaults() {
 		config.NetworkConfig.RetryBackoffMax = DefaultRetryBackoffMax
 	}
 
+	if config.NetworkConfig.ConnectionRetries == 0 {
+		config.NetworkConfig.ConnectionRetries = DefaultConnectionRetries
+	}
+
 	// Create a defensive copy of ExtraHeaders to prevent data races
 	if config.NetworkConfig.ExtraHeaders != nil {
 		headersCopy := make(map[string]string, len(config.NetworkConfig.ExtraHeaders))
@@ -360,3 +508,17 @@ type Provider interface {
 	// FileContent downloads file content from the provider
 	FileContent(ctx context.Context, keys []Key, request *BifrostFileContentRequest) (*BifrostFileContentResponse, *BifrostError)
 }
+
+// BatchCreateRenderer is implemented by providers that can render the native request BatchCreate would send
+// without sending it, for batch dry runs. Inputs BatchCreate uploads first are not uploaded, the rendered request
+// refers to them with a placeholder file ID.
+type BatchCreateRenderer interface {
+	RenderBatchCreate(ctx context.Context, key Key, request *BifrostBatchCreateRequest) (*BatchRenderedRequest, *BifrostError)
+}
+
+// FileDownloader is implemented by providers that can hand out direct, expiring URLs to download files from their
+// storage (e.g. presigned S3 URLs for Bedrock), so that large downloads do not go through Bifrost. It returns the
+// URL and when it expires, in Unix seconds.
+type FileDownloader interface {
+	FileDownloadURL(ctx context.Context, key Key, fileID string, ttl time.Duration) (string, int64, *BifrostError)
+}
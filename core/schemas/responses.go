@@ -2,8 +2,10 @@ package schemas
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // =============================================================================
@@ -82,6 +84,9 @@ type BifrostResponsesResponse struct {
 	SearchResults []SearchResult `json:"search_results,omitempty"`
 	Videos        []VideoResult  `json:"videos,omitempty"`
 	Citations     []string       `json:"citations,omitempty"`
+
+	// Sources the provider attributed the generated content to (grounding or citation metadata)
+	SourceCitations []Citation `json:"source_citations,omitempty"`
 }
 
 type ResponsesParameters struct {
@@ -155,13 +160,13 @@ func (rc ResponsesResponseConversation) MarshalJSON() ([]byte, error) {
 	}
 
 	if rc.ResponsesResponseConversationStr != nil {
-		return sonic.Marshal(*rc.ResponsesResponseConversationStr)
+		return serialization.Marshal(*rc.ResponsesResponseConversationStr)
 	}
 	if rc.ResponsesResponseConversationStruct != nil {
-		return sonic.Marshal(rc.ResponsesResponseConversationStruct)
+		return serialization.Marshal(rc.ResponsesResponseConversationStruct)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ResponsesMessageContent.
@@ -170,14 +175,14 @@ func (rc ResponsesResponseConversation) MarshalJSON() ([]byte, error) {
 func (rc *ResponsesResponseConversation) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		rc.ResponsesResponseConversationStr = &stringContent
 		return nil
 	}
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var structContent ResponsesResponseConversationStruct
-	if err := sonic.Unmarshal(data, &structContent); err == nil {
+	if err := serialization.Unmarshal(data, &structContent); err == nil {
 		rc.ResponsesResponseConversationStruct = &structContent
 		return nil
 	}
@@ -199,13 +204,13 @@ func (rc ResponsesResponseInstructions) MarshalJSON() ([]byte, error) {
 	}
 
 	if rc.ResponsesResponseInstructionsStr != nil {
-		return sonic.Marshal(*rc.ResponsesResponseInstructionsStr)
+		return serialization.Marshal(*rc.ResponsesResponseInstructionsStr)
 	}
 	if rc.ResponsesResponseInstructionsArray != nil {
-		return sonic.Marshal(rc.ResponsesResponseInstructionsArray)
+		return serialization.Marshal(rc.ResponsesResponseInstructionsArray)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ResponsesMessageContent.
@@ -214,14 +219,14 @@ func (rc ResponsesResponseInstructions) MarshalJSON() ([]byte, error) {
 func (rc *ResponsesResponseInstructions) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		rc.ResponsesResponseInstructionsStr = &stringContent
 		return nil
 	}
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var arrayContent []ResponsesMessage
-	if err := sonic.Unmarshal(data, &arrayContent); err == nil {
+	if err := serialization.Unmarshal(data, &arrayContent); err == nil {
 		rc.ResponsesResponseInstructionsArray = arrayContent
 		return nil
 	}
@@ -359,13 +364,13 @@ func (rc ResponsesMessageContent) MarshalJSON() ([]byte, error) {
 	}
 
 	if rc.ContentStr != nil {
-		return sonic.Marshal(*rc.ContentStr)
+		return serialization.Marshal(*rc.ContentStr)
 	}
 	if rc.ContentBlocks != nil {
-		return sonic.Marshal(rc.ContentBlocks)
+		return serialization.Marshal(rc.ContentBlocks)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ResponsesMessageContent.
@@ -374,14 +379,14 @@ func (rc ResponsesMessageContent) MarshalJSON() ([]byte, error) {
 func (rc *ResponsesMessageContent) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		rc.ContentStr = &stringContent
 		return nil
 	}
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var arrayContent []ResponsesMessageContentBlock
-	if err := sonic.Unmarshal(data, &arrayContent); err == nil {
+	if err := serialization.Unmarshal(data, &arrayContent); err == nil {
 		rc.ContentBlocks = arrayContent
 		return nil
 	}
@@ -469,6 +474,55 @@ type ResponsesOutputMessageContentRefusal struct {
 	Refusal string `json:"refusal"`
 }
 
+// SetResponsesRefusal marks the output of a response as a refusal, for providers reporting refusals through a
+// stop reason rather than a refusal field: the text of the assistant message items becomes a single refusal item
+// (as chat refusals are converted), or the fallback if there is no text. Other output items are kept.
+func SetResponsesRefusal(output []ResponsesMessage, fallback string) []ResponsesMessage {
+	var refusal strings.Builder
+	refusalIndex := -1
+	result := make([]ResponsesMessage, 0, len(output)+1)
+	for _, item := range output {
+		isAssistantMessage := item.Type != nil && *item.Type == ResponsesMessageTypeMessage &&
+			(item.Role == nil || *item.Role == ResponsesInputMessageRoleAssistant)
+		if !isAssistantMessage {
+			result = append(result, item)
+			continue
+		}
+		if refusalIndex == -1 {
+			refusalIndex = len(result)
+		}
+		if item.Content == nil {
+			continue
+		}
+		if item.Content.ContentStr != nil {
+			refusal.WriteString(*item.Content.ContentStr)
+		}
+		for _, block := range item.Content.ContentBlocks {
+			if block.Text != nil {
+				refusal.WriteString(*block.Text)
+			}
+		}
+	}
+	if strings.TrimSpace(refusal.String()) == "" {
+		refusal.Reset()
+		refusal.WriteString(fallback)
+	}
+
+	refusalItem := ResponsesMessage{
+		Type:   Ptr(ResponsesMessageTypeRefusal),
+		Role:   Ptr(ResponsesInputMessageRoleAssistant),
+		Status: Ptr("completed"),
+		Content: &ResponsesMessageContent{ContentBlocks: []ResponsesMessageContentBlock{{
+			Type:                                 ResponsesOutputMessageContentTypeRefusal,
+			ResponsesOutputMessageContentRefusal: &ResponsesOutputMessageContentRefusal{Refusal: refusal.String()},
+		}}},
+	}
+	if refusalIndex == -1 {
+		return append(result, refusalItem)
+	}
+	return slices.Insert(result, refusalIndex, refusalItem)
+}
+
 type ResponsesToolMessage struct {
 	CallID    *string                           `json:"call_id,omitempty"` // Common call ID for tool calls and outputs
 	Name      *string                           `json:"name,omitempty"`    // Common name field for tool calls
@@ -500,38 +554,38 @@ type ResponsesToolMessageActionStruct struct {
 
 func (action ResponsesToolMessageActionStruct) MarshalJSON() ([]byte, error) {
 	if action.ResponsesComputerToolCallAction != nil {
-		return sonic.Marshal(action.ResponsesComputerToolCallAction)
+		return serialization.Marshal(action.ResponsesComputerToolCallAction)
 	}
 	if action.ResponsesWebSearchToolCallAction != nil {
-		return sonic.Marshal(action.ResponsesWebSearchToolCallAction)
+		return serialization.Marshal(action.ResponsesWebSearchToolCallAction)
 	}
 	if action.ResponsesLocalShellToolCallAction != nil {
-		return sonic.Marshal(action.ResponsesLocalShellToolCallAction)
+		return serialization.Marshal(action.ResponsesLocalShellToolCallAction)
 	}
 	if action.ResponsesMCPApprovalRequestAction != nil {
-		return sonic.Marshal(action.ResponsesMCPApprovalRequestAction)
+		return serialization.Marshal(action.ResponsesMCPApprovalRequestAction)
 	}
 	return nil, fmt.Errorf("responses tool message action struct is neither a computer tool call action nor a web search tool call action nor a local shell tool call action nor a mcp approval request action")
 }
 
 func (action *ResponsesToolMessageActionStruct) UnmarshalJSON(data []byte) error {
 	var computerToolCallAction ResponsesComputerToolCallAction
-	if err := sonic.Unmarshal(data, &computerToolCallAction); err == nil {
+	if err := serialization.Unmarshal(data, &computerToolCallAction); err == nil {
 		action.ResponsesComputerToolCallAction = &computerToolCallAction
 		return nil
 	}
 	var webSearchToolCallAction ResponsesWebSearchToolCallAction
-	if err := sonic.Unmarshal(data, &webSearchToolCallAction); err == nil {
+	if err := serialization.Unmarshal(data, &webSearchToolCallAction); err == nil {
 		action.ResponsesWebSearchToolCallAction = &webSearchToolCallAction
 		return nil
 	}
 	var localShellToolCallAction ResponsesLocalShellToolCallAction
-	if err := sonic.Unmarshal(data, &localShellToolCallAction); err == nil {
+	if err := serialization.Unmarshal(data, &localShellToolCallAction); err == nil {
 		action.ResponsesLocalShellToolCallAction = &localShellToolCallAction
 		return nil
 	}
 	var mcpApprovalRequestAction ResponsesMCPApprovalRequestAction
-	if err := sonic.Unmarshal(data, &mcpApprovalRequestAction); err == nil {
+	if err := serialization.Unmarshal(data, &mcpApprovalRequestAction); err == nil {
 		action.ResponsesMCPApprovalRequestAction = &mcpApprovalRequestAction
 		return nil
 	}
@@ -546,29 +600,29 @@ type ResponsesToolMessageOutputStruct struct {
 
 func (output ResponsesToolMessageOutputStruct) MarshalJSON() ([]byte, error) {
 	if output.ResponsesToolCallOutputStr != nil {
-		return sonic.Marshal(*output.ResponsesToolCallOutputStr)
+		return serialization.Marshal(*output.ResponsesToolCallOutputStr)
 	}
 	if output.ResponsesFunctionToolCallOutputBlocks != nil {
-		return sonic.Marshal(output.ResponsesFunctionToolCallOutputBlocks)
+		return serialization.Marshal(output.ResponsesFunctionToolCallOutputBlocks)
 	}
 	if output.ResponsesComputerToolCallOutput != nil {
-		return sonic.Marshal(output.ResponsesComputerToolCallOutput)
+		return serialization.Marshal(output.ResponsesComputerToolCallOutput)
 	}
 	return nil, fmt.Errorf("responses tool message output struct is neither a string nor an array of responses message content blocks nor a computer tool call output data")
 }
 func (output *ResponsesToolMessageOutputStruct) UnmarshalJSON(data []byte) error {
 	var str string
-	if err := sonic.Unmarshal(data, &str); err == nil {
+	if err := serialization.Unmarshal(data, &str); err == nil {
 		output.ResponsesToolCallOutputStr = &str
 		return nil
 	}
 	var array []ResponsesMessageContentBlock
-	if err := sonic.Unmarshal(data, &array); err == nil {
+	if err := serialization.Unmarshal(data, &array); err == nil {
 		output.ResponsesFunctionToolCallOutputBlocks = array
 		return nil
 	}
 	var computerToolCallOutput ResponsesComputerToolCallOutputData
-	if err := sonic.Unmarshal(data, &computerToolCallOutput); err == nil {
+	if err := serialization.Unmarshal(data, &computerToolCallOutput); err == nil {
 		output.ResponsesComputerToolCallOutput = &computerToolCallOutput
 		return nil
 	}
@@ -683,13 +737,13 @@ func (rf ResponsesFunctionToolCallOutput) MarshalJSON() ([]byte, error) {
 	}
 
 	if rf.ResponsesFunctionToolCallOutputStr != nil {
-		return sonic.Marshal(*rf.ResponsesFunctionToolCallOutputStr)
+		return serialization.Marshal(*rf.ResponsesFunctionToolCallOutputStr)
 	}
 	if rf.ResponsesFunctionToolCallOutputBlocks != nil {
-		return sonic.Marshal(rf.ResponsesFunctionToolCallOutputBlocks)
+		return serialization.Marshal(rf.ResponsesFunctionToolCallOutputBlocks)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ResponsesFunctionToolCallOutput.
@@ -698,7 +752,7 @@ func (rf ResponsesFunctionToolCallOutput) MarshalJSON() ([]byte, error) {
 func (rf *ResponsesFunctionToolCallOutput) UnmarshalJSON(data []byte) error {
 	// Parse as generic object to check if it contains content-like fields
 	var genericObj map[string]interface{}
-	if err := sonic.Unmarshal(data, &genericObj); err != nil {
+	if err := serialization.Unmarshal(data, &genericObj); err != nil {
 		return err
 	}
 
@@ -718,14 +772,14 @@ func (rf *ResponsesFunctionToolCallOutput) UnmarshalJSON(data []byte) error {
 
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		rf.ResponsesFunctionToolCallOutputStr = &stringContent
 		return nil
 	}
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var arrayContent []ResponsesMessageContentBlock
-	if err := sonic.Unmarshal(data, &arrayContent); err == nil {
+	if err := serialization.Unmarshal(data, &arrayContent); err == nil {
 		rf.ResponsesFunctionToolCallOutputBlocks = arrayContent
 		return nil
 	}
@@ -792,10 +846,10 @@ func (o ResponsesCodeInterpreterOutput) MarshalJSON() ([]byte, error) {
 
 	// Marshal whichever one is present
 	if o.ResponsesCodeInterpreterOutputLogs != nil {
-		return sonic.Marshal(o.ResponsesCodeInterpreterOutputLogs)
+		return serialization.Marshal(o.ResponsesCodeInterpreterOutputLogs)
 	}
 	if o.ResponsesCodeInterpreterOutputImage != nil {
-		return sonic.Marshal(o.ResponsesCodeInterpreterOutputImage)
+		return serialization.Marshal(o.ResponsesCodeInterpreterOutputImage)
 	}
 
 	// Return null if neither is set
@@ -813,7 +867,7 @@ func (o *ResponsesCodeInterpreterOutput) UnmarshalJSON(data []byte) error {
 	var typeStruct struct {
 		Type string `json:"type"`
 	}
-	if err := sonic.Unmarshal(data, &typeStruct); err != nil {
+	if err := serialization.Unmarshal(data, &typeStruct); err != nil {
 		return fmt.Errorf("failed to read type field: %w", err)
 	}
 
@@ -821,7 +875,7 @@ func (o *ResponsesCodeInterpreterOutput) UnmarshalJSON(data []byte) error {
 	switch typeStruct.Type {
 	case "logs":
 		var logs ResponsesCodeInterpreterOutputLogs
-		if err := sonic.Unmarshal(data, &logs); err != nil {
+		if err := serialization.Unmarshal(data, &logs); err != nil {
 			return fmt.Errorf("failed to unmarshal logs output: %w", err)
 		}
 		o.ResponsesCodeInterpreterOutputLogs = &logs
@@ -830,7 +884,7 @@ func (o *ResponsesCodeInterpreterOutput) UnmarshalJSON(data []byte) error {
 
 	case "image":
 		var image ResponsesCodeInterpreterOutputImage
-		if err := sonic.Unmarshal(data, &image); err != nil {
+		if err := serialization.Unmarshal(data, &image); err != nil {
 			return fmt.Errorf("failed to unmarshal image output: %w", err)
 		}
 		o.ResponsesCodeInterpreterOutputImage = &image
@@ -980,13 +1034,13 @@ func (tc ResponsesToolChoice) MarshalJSON() ([]byte, error) {
 	}
 
 	if tc.ResponsesToolChoiceStr != nil {
-		return sonic.Marshal(tc.ResponsesToolChoiceStr)
+		return serialization.Marshal(tc.ResponsesToolChoiceStr)
 	}
 	if tc.ResponsesToolChoiceStruct != nil {
-		return sonic.Marshal(tc.ResponsesToolChoiceStruct)
+		return serialization.Marshal(tc.ResponsesToolChoiceStruct)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ChatMessageContent.
@@ -995,14 +1049,14 @@ func (tc ResponsesToolChoice) MarshalJSON() ([]byte, error) {
 func (tc *ResponsesToolChoice) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var toolChoiceStr string
-	if err := sonic.Unmarshal(data, &toolChoiceStr); err == nil {
+	if err := serialization.Unmarshal(data, &toolChoiceStr); err == nil {
 		tc.ResponsesToolChoiceStr = &toolChoiceStr
 		return nil
 	}
 
 	// Try to unmarshal as a direct array of ContentBlock
 	var responsesToolChoiceStruct ResponsesToolChoiceStruct
-	if err := sonic.Unmarshal(data, &responsesToolChoiceStruct); err == nil {
+	if err := serialization.Unmarshal(data, &responsesToolChoiceStruct); err == nil {
 		tc.ResponsesToolChoiceStruct = &responsesToolChoiceStruct
 		return nil
 	}
@@ -1113,14 +1167,14 @@ func (f *ResponsesToolFileSearchFilter) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("unknown filter type: %s", f.Type)
 	}
 
-	return sonic.Marshal(result)
+	return serialization.Marshal(result)
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for ResponsesToolFileSearchFilter
 func (f *ResponsesToolFileSearchFilter) UnmarshalJSON(data []byte) error {
 	// First, unmarshal into a map to inspect the type field
 	var raw map[string]interface{}
-	if err := sonic.Unmarshal(data, &raw); err != nil {
+	if err := serialization.Unmarshal(data, &raw); err != nil {
 		return fmt.Errorf("failed to unmarshal filter JSON: %w", err)
 	}
 
@@ -1145,7 +1199,7 @@ func (f *ResponsesToolFileSearchFilter) UnmarshalJSON(data []byte) error {
 		f.ResponsesToolFileSearchCompoundFilter = nil
 
 		// Unmarshal into the comparison filter
-		if err := sonic.Unmarshal(data, f.ResponsesToolFileSearchComparisonFilter); err != nil {
+		if err := serialization.Unmarshal(data, f.ResponsesToolFileSearchComparisonFilter); err != nil {
 			return fmt.Errorf("failed to unmarshal comparison filter: %w", err)
 		}
 
@@ -1163,7 +1217,7 @@ func (f *ResponsesToolFileSearchFilter) UnmarshalJSON(data []byte) error {
 		f.ResponsesToolFileSearchComparisonFilter = nil
 
 		// Unmarshal into the compound filter
-		if err := sonic.Unmarshal(data, f.ResponsesToolFileSearchCompoundFilter); err != nil {
+		if err := serialization.Unmarshal(data, f.ResponsesToolFileSearchCompoundFilter); err != nil {
 			return fmt.Errorf("failed to unmarshal compound filter: %w", err)
 		}
 
@@ -1269,7 +1323,7 @@ func (as ResponsesToolMCPAllowedToolsApprovalSetting) MarshalJSON() ([]byte, err
 	}
 
 	if as.Setting != nil {
-		return sonic.Marshal(*as.Setting)
+		return serialization.Marshal(*as.Setting)
 	}
 	if as.Always != nil || as.Never != nil {
 		// Marshal as an object with always/never fields
@@ -1280,17 +1334,17 @@ func (as ResponsesToolMCPAllowedToolsApprovalSetting) MarshalJSON() ([]byte, err
 		if as.Never != nil {
 			obj["never"] = as.Never
 		}
-		return sonic.Marshal(obj)
+		return serialization.Marshal(obj)
 	}
 	// If all are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for ResponsesToolMCPAllowedToolsApprovalSetting
 func (as *ResponsesToolMCPAllowedToolsApprovalSetting) UnmarshalJSON(data []byte) error {
 	// First, try to unmarshal as a direct string
 	var settingStr string
-	if err := sonic.Unmarshal(data, &settingStr); err == nil {
+	if err := serialization.Unmarshal(data, &settingStr); err == nil {
 		as.Setting = &settingStr
 		return nil
 	}
@@ -1300,7 +1354,7 @@ func (as *ResponsesToolMCPAllowedToolsApprovalSetting) UnmarshalJSON(data []byte
 		Always *ResponsesToolMCPAllowedToolsApprovalFilter `json:"always,omitempty"`
 		Never  *ResponsesToolMCPAllowedToolsApprovalFilter `json:"never,omitempty"`
 	}
-	if err := sonic.Unmarshal(data, &obj); err == nil {
+	if err := serialization.Unmarshal(data, &obj); err == nil {
 		as.Always = obj.Always
 		as.Never = obj.Never
 		return nil
@@ -1476,4 +1530,7 @@ type BifrostResponsesStreamResponse struct {
 	SearchResults []SearchResult `json:"search_results,omitempty"`
 	Videos        []VideoResult  `json:"videos,omitempty"`
 	Citations     []string       `json:"citations,omitempty"`
+
+	// Sources the provider attributed the generated content to (grounding or citation metadata)
+	SourceCitations []Citation `json:"source_citations,omitempty"`
 }
@@ -3,7 +3,7 @@ package schemas
 import (
 	"fmt"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 type BifrostSpeechRequest struct {
@@ -81,13 +81,13 @@ func (vi *SpeechVoiceInput) MarshalJSON() ([]byte, error) {
 	}
 
 	if vi.Voice != nil {
-		return sonic.Marshal(*vi.Voice)
+		return serialization.Marshal(*vi.Voice)
 	}
 	if len(vi.MultiVoiceConfig) > 0 {
-		return sonic.Marshal(vi.MultiVoiceConfig)
+		return serialization.Marshal(vi.MultiVoiceConfig)
 	}
 	// If both are nil, return null
-	return sonic.Marshal(nil)
+	return serialization.Marshal(nil)
 }
 
 // UnmarshalJSON implements custom JSON unmarshalling for SpeechVoiceInput.
@@ -100,14 +100,14 @@ func (vi *SpeechVoiceInput) UnmarshalJSON(data []byte) error {
 
 	// First, try to unmarshal as a direct string
 	var stringContent string
-	if err := sonic.Unmarshal(data, &stringContent); err == nil {
+	if err := serialization.Unmarshal(data, &stringContent); err == nil {
 		vi.Voice = &stringContent
 		return nil
 	}
 
 	// Try to unmarshal as an array of VoiceConfig objects
 	var voiceConfigs []VoiceConfig
-	if err := sonic.Unmarshal(data, &voiceConfigs); err == nil {
+	if err := serialization.Unmarshal(data, &voiceConfigs); err == nil {
 		// Validate each VoiceConfig and build a new slice deterministically
 		validConfigs := make([]VoiceConfig, 0, len(voiceConfigs))
 		for _, config := range voiceConfigs {
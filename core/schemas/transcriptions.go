@@ -22,6 +22,7 @@ type BifrostTranscriptionResponse struct {
 	Text        string                     `json:"text"`
 	Usage       *TranscriptionUsage        `json:"usage,omitempty"`
 	Words       []TranscriptionWord        `json:"words,omitempty"`
+	Formatted   *string                    `json:"formatted,omitempty"` // Transcription in the requested text, srt or vtt response format
 	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
 }
 
@@ -29,8 +30,19 @@ type TranscriptionInput struct {
 	File []byte `json:"file"`
 }
 
+// Response formats of a transcription. Text, srt and vtt transcriptions are returned in the Formatted field
+// of the response, synthesized from the segments for providers that do not support the format.
+const (
+	TranscriptionResponseFormatJSON        = "json"
+	TranscriptionResponseFormatVerboseJSON = "verbose_json"
+	TranscriptionResponseFormatText        = "text"
+	TranscriptionResponseFormatSRT         = "srt"
+	TranscriptionResponseFormatVTT         = "vtt"
+)
+
 type TranscriptionParameters struct {
-	Language       *string `json:"language,omitempty"`
+	Language       *string `json:"language,omitempty"`  // ISO-639-1 code of the audio language (e.g. "fr"), a hint improving accuracy and latency
+	Translate      *bool   `json:"translate,omitempty"` // Translate the audio to English instead of transcribing it in its language
 	Prompt         *string `json:"prompt,omitempty"`
 	ResponseFormat *string `json:"response_format,omitempty"` // Default is "json"
 	Format         *string `json:"file_format,omitempty"`     // Type of file, not required in openai, but required in gemini
@@ -48,6 +60,11 @@ type TranscriptionParameters struct {
 	ExtraParams map[string]interface{} `json:"-"`
 }
 
+// IsTranslation reports whether the audio is to be translated to English.
+func (params *TranscriptionParameters) IsTranslation() bool {
+	return params != nil && params.Translate != nil && *params.Translate
+}
+
 type TranscriptionAdditionalFormat struct {
 	Format                      TranscriptionExportOptions `json:"format"`
 	IncludeSpeakers             *bool                      `json:"include_speakers,omitempty"`
@@ -8,7 +8,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/bytedance/sonic"
+	"github.com/maximhq/bifrost/core/serialization"
 )
 
 // Ptr creates a pointer to any value.
@@ -20,19 +20,63 @@ func Ptr[T any](v T) *T {
 // ParseModelString extracts provider and model from a model string.
 // For model strings like "anthropic/claude", it returns ("anthropic", "claude").
 // For model strings like "claude", it returns ("", "claude").
+// Surrounding spaces are trimmed and the provider is case-folded when it is a known provider ("OpenAI/gpt-4o"
+// returns ("openai", "gpt-4o")). Aliases and model name case-folding are applied by
+// ModelNameNormalization.ParseModelString.
 func ParseModelString(model string, defaultProvider ModelProvider) (ModelProvider, string) {
+	var normalization *ModelNameNormalization
+	return normalization.ParseModelString(model, defaultProvider)
+}
+
+// ParseModelString extracts provider and model from a model string like the package-level ParseModelString,
+// after resolving the configured aliases, and case-folds the model name if configured. A nil normalization
+// applies the default normalization only.
+func (normalization *ModelNameNormalization) ParseModelString(model string, defaultProvider ModelProvider) (ModelProvider, string) {
+	model = strings.TrimSpace(model)
+	if normalization != nil {
+		model = normalization.resolveAlias(model)
+	}
+
+	provider := defaultProvider
 	// Check if model contains a provider prefix (only split on first "/" to preserve model names with "/")
 	if strings.Contains(model, "/") {
 		parts := strings.SplitN(model, "/", 2)
 		if len(parts) == 2 {
-			extractedProvider := parts[0]
-			extractedModel := parts[1]
+			provider = normalizeProviderName(parts[0])
+			model = strings.TrimSpace(parts[1])
+		}
+	}
+	if normalization != nil && normalization.CaseFoldModels {
+		model = strings.ToLower(model)
+	}
+	// No provider prefix found, return the default provider and the model
+	return provider, model
+}
+
+// resolveAlias returns the model string an alias stands for, aliases are matched case-insensitively.
+// Model strings that are not an alias are returned as is.
+func (normalization *ModelNameNormalization) resolveAlias(model string) string {
+	if target, ok := normalization.Aliases[model]; ok {
+		return strings.TrimSpace(target)
+	}
+	for alias, target := range normalization.Aliases {
+		if strings.EqualFold(strings.TrimSpace(alias), model) {
+			return strings.TrimSpace(target)
+		}
+	}
+	return model
+}
 
-			return ModelProvider(extractedProvider), extractedModel
+// normalizeProviderName trims a provider name and case-folds it if it is a known provider.
+// Other names are kept as is, as custom provider names are case-sensitive.
+func normalizeProviderName(name string) ModelProvider {
+	name = strings.TrimSpace(name)
+	for _, provider := range StandardProviders {
+		if strings.EqualFold(name, string(provider)) {
+			return provider
 		}
 	}
-	// No provider prefix found, return empty provider and the original model
-	return defaultProvider, model
+	return ModelProvider(name)
 }
 
 // IsAllDigitsASCII checks if a string contains only ASCII digits (0-9).
@@ -267,7 +311,7 @@ func JsonifyInput(input interface{}) string {
 	if input == nil {
 		return "{}"
 	}
-	jsonString, err := sonic.MarshalString(input)
+	jsonString, err := serialization.MarshalString(input)
 	if err != nil {
 		return "{}"
 	}
@@ -0,0 +1,49 @@
+package schemas
+
+import "testing"
+
+// Test that casing and prefix variants of a model string resolve to the same provider and model
+func TestParseModelString_Normalization(t *testing.T) {
+	// By default, known providers are case-folded and spaces trimmed, model names are kept as is
+	defaults := []struct {
+		model            string
+		expectedProvider ModelProvider
+		expectedModel    string
+	}{
+		{"openai/gpt-4o", OpenAI, "gpt-4o"},
+		{"OpenAI/gpt-4o", OpenAI, "gpt-4o"},
+		{" OPENAI / gpt-4o ", OpenAI, "gpt-4o"},
+		{"OpenAI/GPT-4o", OpenAI, "GPT-4o"},
+		{"HuggingFace/meta-llama/Llama-3.1-8B", HuggingFace, "meta-llama/Llama-3.1-8B"},
+		{"MyCustomProvider/gpt-4o", ModelProvider("MyCustomProvider"), "gpt-4o"},
+		{"gpt-4o", "", "gpt-4o"},
+	}
+	for _, tc := range defaults {
+		provider, model := ParseModelString(tc.model, "")
+		if provider != tc.expectedProvider || model != tc.expectedModel {
+			t.Errorf("ParseModelString(%q) = (%q, %q), expected (%q, %q)", tc.model, provider, model, tc.expectedProvider, tc.expectedModel)
+		}
+	}
+
+	// With model case-folding and aliases, all variants resolve to openai/gpt-4o
+	normalization := &ModelNameNormalization{
+		CaseFoldModels: true,
+		Aliases:        map[string]string{"GPT-4o": "openai/gpt-4o", "gpt4o": "openai/gpt-4o"},
+	}
+	for _, model := range []string{"openai/gpt-4o", "OpenAI/GPT-4o", " openai/GPT-4O", "gpt-4o", "GPT-4o", "gpt4o", "GPT4O"} {
+		provider, parsedModel := normalization.ParseModelString(model, "")
+		if provider != OpenAI || parsedModel != "gpt-4o" {
+			t.Errorf("ParseModelString(%q) = (%q, %q), expected (openai, gpt-4o)", model, provider, parsedModel)
+		}
+	}
+
+	// The default provider is used when the model has no provider prefix and no alias
+	if provider, model := normalization.ParseModelString("Claude-3", Anthropic); provider != Anthropic || model != "claude-3" {
+		t.Errorf("Expected the default provider with a case-folded model, got (%q, %q)", provider, model)
+	}
+
+	// The package-level ParseModelString is not affected by a normalization
+	if provider, model := ParseModelString("gpt4o", ""); provider != "" || model != "gpt4o" {
+		t.Errorf("Expected the alias to be left unresolved by the default normalization, got (%q, %q)", provider, model)
+	}
+}
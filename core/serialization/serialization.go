@@ -0,0 +1,47 @@
+// Package serialization provides the JSON serialization used across Bifrost.
+// It uses sonic by default, and encoding/json when built with the bifrost_stdjson build tag,
+// for platforms and builds sonic does not support.
+package serialization
+
+// Serializer marshals and unmarshals JSON.
+type Serializer interface {
+	Marshal(v any) ([]byte, error)
+	MarshalIndent(v any, prefix, indent string) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// serializer is the serializer in use, selected by build tag (see sonic.go and stdjson.go).
+var serializer Serializer = defaultSerializer
+
+// SetSerializer replaces the serializer used by Bifrost.
+// It is not safe to call concurrently with requests, it must be called before Bifrost is initialized.
+func SetSerializer(s Serializer) {
+	if s == nil {
+		s = defaultSerializer
+	}
+	serializer = s
+}
+
+// Marshal returns the JSON encoding of v.
+func Marshal(v any) ([]byte, error) {
+	return serializer.Marshal(v)
+}
+
+// MarshalString returns the JSON encoding of v as a string.
+func MarshalString(v any) (string, error) {
+	data, err := serializer.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// MarshalIndent is like Marshal but applies indentation to format the output.
+func MarshalIndent(v any, prefix, indent string) ([]byte, error) {
+	return serializer.MarshalIndent(v, prefix, indent)
+}
+
+// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by v.
+func Unmarshal(data []byte, v any) error {
+	return serializer.Unmarshal(data, v)
+}
@@ -0,0 +1,83 @@
+package serialization
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testPayload struct {
+	Name    string            `json:"name"`
+	Count   int               `json:"count,omitempty"`
+	Tags    []string          `json:"tags,omitempty"`
+	Extra   map[string]string `json:"extra,omitempty"`
+	Ignored string            `json:"-"`
+}
+
+// Test that values round trip through the serializer in use
+func TestRoundTrip(t *testing.T) {
+	payload := testPayload{Name: "bifrost", Count: 3, Tags: []string{"a", "b"}, Extra: map[string]string{"k": "v"}, Ignored: "x"}
+	data, err := Marshal(payload)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"name":"bifrost","count":3,"tags":["a","b"],"extra":{"k":"v"}}` {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+
+	var decoded testPayload
+	if err := Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.Name != payload.Name || decoded.Count != payload.Count || len(decoded.Tags) != 2 || decoded.Extra["k"] != "v" || decoded.Ignored != "" {
+		t.Errorf("Unexpected decoded value: %+v", decoded)
+	}
+
+	str, err := MarshalString(payload)
+	if err != nil || str != string(data) {
+		t.Errorf("Expected MarshalString to match Marshal, got %q (%v)", str, err)
+	}
+
+	if err := Unmarshal([]byte(`{"name":`), &decoded); err == nil {
+		t.Error("Expected an error for invalid JSON")
+	}
+}
+
+// recordingSerializer wraps encoding/json and counts the calls it receives
+type recordingSerializer struct {
+	calls int
+}
+
+func (s *recordingSerializer) Marshal(v any) ([]byte, error) {
+	s.calls++
+	return json.Marshal(v)
+}
+
+func (s *recordingSerializer) MarshalIndent(v any, prefix, indent string) ([]byte, error) {
+	s.calls++
+	return json.MarshalIndent(v, prefix, indent)
+}
+
+func (s *recordingSerializer) Unmarshal(data []byte, v any) error {
+	s.calls++
+	return json.Unmarshal(data, v)
+}
+
+// Test that a custom serializer receives every call, and that a nil serializer restores the default
+func TestSetSerializer(t *testing.T) {
+	custom := &recordingSerializer{}
+	SetSerializer(custom)
+	t.Cleanup(func() { SetSerializer(nil) })
+
+	data, _ := Marshal(testPayload{Name: "a"})
+	MarshalIndent(testPayload{Name: "a"}, "", "  ")
+	var decoded testPayload
+	Unmarshal(data, &decoded)
+	if custom.calls != 3 {
+		t.Errorf("Expected 3 calls to the custom serializer, got %d", custom.calls)
+	}
+
+	SetSerializer(nil)
+	if serializer != defaultSerializer {
+		t.Errorf("Expected the default serializer to be restored, got %T", serializer)
+	}
+}
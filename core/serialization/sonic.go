@@ -0,0 +1,23 @@
+//go:build !bifrost_stdjson
+
+package serialization
+
+import "github.com/bytedance/sonic"
+
+// defaultSerializer is sonic, unless built with the bifrost_stdjson build tag.
+var defaultSerializer Serializer = sonicSerializer{}
+
+// sonicSerializer serializes with sonic's default config.
+type sonicSerializer struct{}
+
+func (sonicSerializer) Marshal(v any) ([]byte, error) {
+	return sonic.Marshal(v)
+}
+
+func (sonicSerializer) MarshalIndent(v any, prefix, indent string) ([]byte, error) {
+	return sonic.MarshalIndent(v, prefix, indent)
+}
+
+func (sonicSerializer) Unmarshal(data []byte, v any) error {
+	return sonic.Unmarshal(data, v)
+}
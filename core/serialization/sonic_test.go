@@ -0,0 +1,12 @@
+//go:build !bifrost_stdjson
+
+package serialization
+
+import "testing"
+
+// Test that sonic is the default serializer
+func TestDefaultSerializer_Sonic(t *testing.T) {
+	if _, ok := serializer.(sonicSerializer); !ok {
+		t.Errorf("Expected sonic serializer by default, got %T", serializer)
+	}
+}
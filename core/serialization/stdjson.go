@@ -0,0 +1,23 @@
+//go:build bifrost_stdjson
+
+package serialization
+
+import "encoding/json"
+
+// defaultSerializer is encoding/json when built with the bifrost_stdjson build tag.
+var defaultSerializer Serializer = stdJSONSerializer{}
+
+// stdJSONSerializer serializes with encoding/json, for platforms sonic does not support.
+type stdJSONSerializer struct{}
+
+func (stdJSONSerializer) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSONSerializer) MarshalIndent(v any, prefix, indent string) ([]byte, error) {
+	return json.MarshalIndent(v, prefix, indent)
+}
+
+func (stdJSONSerializer) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
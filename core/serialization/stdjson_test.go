@@ -0,0 +1,20 @@
+//go:build bifrost_stdjson
+
+package serialization
+
+import "testing"
+
+// Test that encoding/json is the default serializer when built with the bifrost_stdjson tag
+func TestDefaultSerializer_StdJSON(t *testing.T) {
+	if _, ok := serializer.(stdJSONSerializer); !ok {
+		t.Errorf("Expected encoding/json serializer with the bifrost_stdjson tag, got %T", serializer)
+	}
+
+	indented, err := MarshalIndent(map[string]int{"a": 1}, "", "  ")
+	if err != nil {
+		t.Fatalf("MarshalIndent failed: %v", err)
+	}
+	if string(indented) != "{\n  \"a\": 1\n}" {
+		t.Errorf("Unexpected indented encoding: %q", indented)
+	}
+}
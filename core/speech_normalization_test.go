@@ -0,0 +1,54 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a speech output format the provider cannot return is rejected with a 400 without calling the provider
+func TestSpeechRequest_UnsupportedFormat(t *testing.T) {
+	var calls atomic.Int32
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Write([]byte("ID3audio"))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	request := func(format string) *schemas.BifrostSpeechRequest {
+		return &schemas.BifrostSpeechRequest{
+			Provider: schemas.OpenAI,
+			Model:    "tts-1",
+			Input:    &schemas.SpeechInput{Input: "Hello"},
+			Params: &schemas.SpeechParameters{
+				VoiceConfig:    &schemas.SpeechVoiceInput{Voice: schemas.Ptr("alloy")},
+				ResponseFormat: format,
+			},
+		}
+	}
+
+	_, bifrostErr := client.SpeechRequest(context.Background(), request("ogg"))
+	if bifrostErr == nil {
+		t.Fatal("Expected the ogg format to be rejected")
+	}
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected a 400 error, got %v: %s", bifrostErr.StatusCode, GetErrorMessage(bifrostErr))
+	}
+	if calls.Load() != 0 {
+		t.Errorf("Expected the provider not to be called, got %d calls", calls.Load())
+	}
+
+	if _, bifrostErr := client.SpeechRequest(context.Background(), request("mp3")); bifrostErr != nil {
+		t.Fatalf("Expected the mp3 format to be accepted, got error: %s", GetErrorMessage(bifrostErr))
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected the provider to be called once, got %d calls", calls.Load())
+	}
+}
@@ -0,0 +1,135 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// streamLimiter counts the streams open at once and caps them.
+// Streams are only counted while a stream cap or a bulkhead applies to them (see streamsLimited).
+type streamLimiter struct {
+	maxConcurrent atomic.Int64 // 0 means unbounded
+	active        atomic.Int64
+	rejected      atomic.Uint64
+}
+
+// tryAcquire counts a new stream, unless the cap is reached.
+func (l *streamLimiter) tryAcquire() bool {
+	for {
+		maxConcurrent, active := l.maxConcurrent.Load(), l.active.Load()
+		if maxConcurrent > 0 && active >= maxConcurrent {
+			l.rejected.Add(1)
+			return false
+		}
+		if l.active.CompareAndSwap(active, active+1) {
+			return true
+		}
+	}
+}
+
+// release uncounts a stream previously counted with tryAcquire.
+func (l *streamLimiter) release() {
+	l.active.Add(-1)
+}
+
+// stats returns a snapshot of the open streams.
+func (l *streamLimiter) stats() schemas.StreamStats {
+	return schemas.StreamStats{
+		MaxConcurrent: l.maxConcurrent.Load(),
+		Active:        l.active.Load(),
+		Rejected:      l.rejected.Load(),
+	}
+}
+
+// getStreamLimiter returns the stream limiter of a provider, creating it on first use.
+func (bifrost *Bifrost) getStreamLimiter(providerKey schemas.ModelProvider) *streamLimiter {
+	if value, ok := bifrost.streamLimiters.Load(providerKey); ok {
+		return value.(*streamLimiter)
+	}
+	value, _ := bifrost.streamLimiters.LoadOrStore(providerKey, &streamLimiter{})
+	return value.(*streamLimiter)
+}
+
+// storeStreamLimit sets the cap of the streams open at once for a provider.
+// The limiter is kept across config updates, so that streams opened before the update are still counted.
+func (bifrost *Bifrost) storeStreamLimit(providerKey schemas.ModelProvider, maxConcurrent int) {
+	bifrost.getStreamLimiter(providerKey).maxConcurrent.Store(int64(max(maxConcurrent, 0)))
+}
+
+// streamsLimited reports whether a stream cap applies to the streams of a provider, instance-wide or per provider.
+func (bifrost *Bifrost) streamsLimited(providerKey schemas.ModelProvider) bool {
+	return bifrost.streams.maxConcurrent.Load() > 0 || bifrost.getStreamLimiter(providerKey).maxConcurrent.Load() > 0
+}
+
+// acquireStream counts a new stream against the instance-wide and the provider's caps.
+// Returns an error if either cap is reached, in which case nothing is counted.
+func (bifrost *Bifrost) acquireStream(providerKey schemas.ModelProvider) (release func(), err error) {
+	if !bifrost.streams.tryAcquire() {
+		return nil, fmt.Errorf("stream capacity exceeded: %d streams are already open", bifrost.streams.maxConcurrent.Load())
+	}
+	providerStreams := bifrost.getStreamLimiter(providerKey)
+	if !providerStreams.tryAcquire() {
+		bifrost.streams.release()
+		return nil, fmt.Errorf("stream capacity exceeded: %d streams are already open for provider %s", providerStreams.maxConcurrent.Load(), providerKey)
+	}
+	return func() {
+		providerStreams.release()
+		bifrost.streams.release()
+	}, nil
+}
+
+// releaseStreamOnClose holds the stream slots (bulkhead and stream caps) for the lifetime of a stream and calls
+// release once the stream is drained. The returned channel forwards every message of the given stream, with the
+// same buffer. If the caller stops reading and its context is done, the rest of the stream is drained and
+// dropped so that the slots are released once the provider stops streaming.
+func releaseStreamOnClose(ctx context.Context, stream chan *schemas.BifrostStream, release func()) chan *schemas.BifrostStream {
+	if stream == nil {
+		release()
+		return nil
+	}
+	out := make(chan *schemas.BifrostStream, cap(stream))
+	go func() {
+		defer close(out)
+		defer release()
+		for msg := range stream {
+			// Messages are still delivered after the context is done, as long as the caller is reading
+			select {
+			case out <- msg:
+				continue
+			default:
+			}
+			select {
+			case out <- msg:
+			case <-ctx.Done():
+				for range stream {
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// GetStreamStats returns the streams open at once across the instance, and per provider.
+// It is intended to be polled by metrics exporters.
+func (bifrost *Bifrost) GetStreamStats() (schemas.StreamStats, map[schemas.ModelProvider]schemas.StreamStats) {
+	providers := make(map[schemas.ModelProvider]schemas.StreamStats)
+	bifrost.streamLimiters.Range(func(key, value interface{}) bool {
+		providers[key.(schemas.ModelProvider)] = value.(*streamLimiter).stats()
+		return true
+	})
+	return bifrost.streams.stats(), providers
+}
+
+// newStreamCapacityError creates the error returned when a stream is rejected because a stream cap is reached.
+// It is a 503 so that callers (and fallbacks) treat it as a transient capacity issue, as with bulkheads.
+func newStreamCapacityError(err error) *schemas.BifrostError {
+	bifrostErr := newBifrostError(err)
+	bifrostErr.StatusCode = schemas.Ptr(fasthttp.StatusServiceUnavailable)
+	bifrostErr.Error.Type = schemas.Ptr(schemas.StreamCapacityExceeded)
+	return bifrostErr
+}
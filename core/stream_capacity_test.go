@@ -0,0 +1,192 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// newBlockingStreamServer returns a server streaming one chat chunk and holding the stream open until release is closed.
+func newBlockingStreamServer(release chan struct{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+		fmt.Fprint(w, "data: {\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"created\":1,\"model\":\"gpt-4o-mini\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\"ok\"}}]}\n\n")
+		flusher.Flush()
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		fmt.Fprint(w, "data: {\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"created\":1,\"model\":\"gpt-4o-mini\",\"choices\":[{\"index\":0,\"delta\":{},\"finish_reason\":\"stop\"}]}\n\n")
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+}
+
+func drainStream(stream chan *schemas.BifrostStream) {
+	for range stream {
+	}
+}
+
+// Test that concurrent streams over the instance-wide and provider caps are rejected, and that closed streams free their slot
+func TestMaxConcurrentStreams_EnforcedAndReleased(t *testing.T) {
+	release := make(chan struct{})
+	server := newBlockingStreamServer(release)
+	defer server.Close()
+	defer func() {
+		select {
+		case <-release:
+		default:
+			close(release)
+		}
+	}()
+
+	const cappedProvider = schemas.ModelProvider("capped-provider")
+	const otherProvider = schemas.ModelProvider("other-provider")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(cappedProvider, server.URL, nil)
+	account.configs[cappedProvider].MaxConcurrentStreams = 3
+	account.addOpenAICompatibleProvider(otherProvider, server.URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		MaxConcurrentStreams: 4,
+	})
+
+	// A burst of concurrent streams only gets as many streams as the provider cap allows
+	var (
+		mu       sync.Mutex
+		streams  []chan *schemas.BifrostStream
+		rejected atomic.Int32
+		wg       sync.WaitGroup
+	)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stream, bifrostErr := client.ChatCompletionStreamRequest(context.Background(), newTestChatRequest(cappedProvider))
+			if bifrostErr != nil {
+				if bifrostErr.Error.Type == nil || *bifrostErr.Error.Type != schemas.StreamCapacityExceeded {
+					t.Errorf("Expected a stream capacity error, got: %v", GetErrorMessage(bifrostErr))
+				}
+				rejected.Add(1)
+				return
+			}
+			mu.Lock()
+			streams = append(streams, stream)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if len(streams) != 3 || rejected.Load() != 7 {
+		t.Fatalf("Expected 3 streams and 7 rejections, got %d streams and %d rejections", len(streams), rejected.Load())
+	}
+
+	// The remaining instance-wide slot is available to another provider, then the instance cap is reached
+	otherStream, bifrostErr := client.ChatCompletionStreamRequest(context.Background(), newTestChatRequest(otherProvider))
+	if bifrostErr != nil {
+		t.Fatalf("Expected a stream within the instance cap, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	streams = append(streams, otherStream)
+	_, bifrostErr = client.ChatCompletionStreamRequest(context.Background(), newTestChatRequest(otherProvider))
+	if bifrostErr == nil {
+		t.Fatalf("Expected the stream over the instance cap to be rejected")
+	}
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected a 503 status code, got %v", bifrostErr.StatusCode)
+	}
+
+	total, providers := client.GetStreamStats()
+	if total.Active != 4 || total.MaxConcurrent != 4 || total.Rejected != 1 {
+		t.Errorf("Unexpected instance stream stats: %+v", total)
+	}
+	if stats := providers[cappedProvider]; stats.Active != 3 || stats.MaxConcurrent != 3 || stats.Rejected != 7 {
+		t.Errorf("Unexpected %s stream stats: %+v", cappedProvider, stats)
+	}
+	if stats := providers[otherProvider]; stats.Active != 1 || stats.MaxConcurrent != 0 {
+		t.Errorf("Unexpected %s stream stats: %+v", otherProvider, stats)
+	}
+
+	// Closed streams free their slots
+	close(release)
+	for _, stream := range streams {
+		drainStream(stream)
+	}
+	total, providers = client.GetStreamStats()
+	if total.Active != 0 || providers[cappedProvider].Active != 0 || providers[otherProvider].Active != 0 {
+		t.Fatalf("Expected no open streams after draining, got %+v and %+v", total, providers)
+	}
+	stream, bifrostErr := client.ChatCompletionStreamRequest(context.Background(), newTestChatRequest(cappedProvider))
+	if bifrostErr != nil {
+		t.Fatalf("Expected a stream after the others closed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	drainStream(stream)
+}
+
+// Test that a stream abandoned by its caller frees its slot once the caller's context is done and the provider
+// stops streaming, instead of blocking on a caller that no longer reads
+func TestMaxConcurrentStreams_AbandonedStreamReleased(t *testing.T) {
+	release := make(chan struct{})
+	server := newBlockingStreamServer(release)
+	defer server.Close()
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	account.configs[schemas.OpenAI].MaxConcurrentStreams = 1
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	// The caller opens a stream, never reads it and goes away, then the provider ends the stream
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, bifrostErr := client.ChatCompletionStreamRequest(ctx, newTestChatRequest(schemas.OpenAI)); bifrostErr != nil {
+		t.Fatalf("Expected a stream, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	time.Sleep(100 * time.Millisecond) // let the first chunk reach the unread stream
+	cancel()
+	close(release)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, providers := client.GetStreamStats()
+		if providers[schemas.OpenAI].Active == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the abandoned stream to free its slot, got %+v", providers[schemas.OpenAI])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	stream, bifrostErr := client.ChatCompletionStreamRequest(context.Background(), newTestChatRequest(schemas.OpenAI))
+	if bifrostErr != nil {
+		t.Fatalf("Expected a stream after the abandoned one was released, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	go drainStream(stream)
+}
+
+// Test that the stream wrapper keeps the source buffer and drains the source once the caller's context is done
+func TestReleaseStreamOnClose_DrainsAfterContextDone(t *testing.T) {
+	source := make(chan *schemas.BifrostStream, 2)
+	released := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	out := releaseStreamOnClose(ctx, source, func() { close(released) })
+	if cap(out) != cap(source) {
+		t.Errorf("Expected the wrapper to keep the source buffer of %d, got %d", cap(source), cap(out))
+	}
+
+	// The caller reads nothing, the producer keeps sending more than the buffers hold
+	cancel()
+	go func() {
+		defer close(source)
+		for range 10 {
+			source <- &schemas.BifrostStream{}
+		}
+	}()
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the slots to be released once the source was drained")
+	}
+}
@@ -0,0 +1,57 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that a provider streaming newline-delimited JSON instead of SSE events is streamed as chat chunks
+func TestChatCompletionStream_NDJSON(t *testing.T) {
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		flusher := w.(http.Flusher)
+		for _, content := range []string{"Hello", " world", "!"} {
+			fmt.Fprintf(w, "{\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"created\":1,\"model\":\"llama3\",\"choices\":[{\"index\":0,\"delta\":{\"content\":%q}}]}\n", content)
+			flusher.Flush()
+		}
+		fmt.Fprint(w, "{\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"created\":1,\"model\":\"llama3\",\"choices\":[{\"index\":0,\"delta\":{},\"finish_reason\":\"stop\"}],\"usage\":{\"prompt_tokens\":1,\"completion_tokens\":3,\"total_tokens\":4}}\n")
+	})
+
+	const ndjsonProvider = schemas.ModelProvider("ndjson-provider")
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(ndjsonProvider, server.URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{})
+
+	stream, bifrostErr := client.ChatCompletionStreamRequest(context.Background(), newTestChatRequest(ndjsonProvider))
+	if bifrostErr != nil {
+		t.Fatalf("Expected stream to start, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	var content strings.Builder
+	var finishReason string
+	for chunk := range stream {
+		if chunk.BifrostError != nil {
+			t.Fatalf("Unexpected stream error: %v", GetErrorMessage(chunk.BifrostError))
+		}
+		if chunk.BifrostChatResponse == nil || len(chunk.BifrostChatResponse.Choices) == 0 {
+			continue
+		}
+		choice := chunk.BifrostChatResponse.Choices[0]
+		if choice.ChatStreamResponseChoice != nil && choice.ChatStreamResponseChoice.Delta != nil && choice.ChatStreamResponseChoice.Delta.Content != nil {
+			content.WriteString(*choice.ChatStreamResponseChoice.Delta.Content)
+		}
+		if choice.FinishReason != nil {
+			finishReason = *choice.FinishReason
+		}
+	}
+	if content.String() != "Hello world!" {
+		t.Errorf("Expected the NDJSON chunks to be streamed, got %q", content.String())
+	}
+	if finishReason != "stop" {
+		t.Errorf("Expected the stream to finish with stop, got %q", finishReason)
+	}
+}
@@ -0,0 +1,148 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// MockAccount is an in-memory account for tests
+type MockAccount struct {
+	configs map[schemas.ModelProvider]*schemas.ProviderConfig
+	keys    map[schemas.ModelProvider][]schemas.Key
+}
+
+func NewMockAccount() *MockAccount {
+	return &MockAccount{
+		configs: make(map[schemas.ModelProvider]*schemas.ProviderConfig),
+		keys:    make(map[schemas.ModelProvider][]schemas.Key),
+	}
+}
+
+func (ma *MockAccount) AddProvider(provider schemas.ModelProvider, concurrency int, bufferSize int) {
+	ma.configs[provider] = &schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			DefaultRequestTimeoutInSeconds: 30,
+			MaxRetries:                     3,
+			RetryBackoffInitial:            500 * time.Millisecond,
+			RetryBackoffMax:                5 * time.Second,
+		},
+		ConcurrencyAndBufferSize: schemas.ConcurrencyAndBufferSize{
+			Concurrency: concurrency,
+			BufferSize:  bufferSize,
+		},
+	}
+
+	ma.keys[provider] = []schemas.Key{
+		{
+			ID:     fmt.Sprintf("test-key-%s", provider),
+			Value:  fmt.Sprintf("sk-test-%s", provider),
+			Weight: 100,
+		},
+	}
+}
+
+func (ma *MockAccount) UpdateProviderConfig(provider schemas.ModelProvider, concurrency int, bufferSize int) {
+	if config, exists := ma.configs[provider]; exists {
+		config.ConcurrencyAndBufferSize.Concurrency = concurrency
+		config.ConcurrencyAndBufferSize.BufferSize = bufferSize
+	}
+}
+
+func (ma *MockAccount) GetConfiguredProviders() ([]schemas.ModelProvider, error) {
+	providers := make([]schemas.ModelProvider, 0, len(ma.configs))
+	for provider := range ma.configs {
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+func (ma *MockAccount) GetConfigForProvider(provider schemas.ModelProvider) (*schemas.ProviderConfig, error) {
+	if config, exists := ma.configs[provider]; exists {
+		// Return a copy to simulate real behavior
+		configCopy := *config
+		return &configCopy, nil
+	}
+	return nil, fmt.Errorf("provider %s not configured", provider)
+}
+
+func (ma *MockAccount) GetKeysForProvider(ctx *context.Context, provider schemas.ModelProvider) ([]schemas.Key, error) {
+	if keys, exists := ma.keys[provider]; exists {
+		return keys, nil
+	}
+	return nil, fmt.Errorf("no keys for provider %s", provider)
+}
+
+const mockChatCompletionBody = `{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"ok"},"finish_reason":"stop"}],"usage":{"prompt_tokens":1,"completion_tokens":1,"total_tokens":2}}`
+
+// addOpenAICompatibleProvider registers an OpenAI-compatible provider pointing at the given base URL.
+func (ma *MockAccount) addOpenAICompatibleProvider(provider schemas.ModelProvider, baseURL string, bulkheadConfig *schemas.BulkheadConfig) {
+	config := &schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        baseURL,
+			DefaultRequestTimeoutInSeconds: 30,
+		},
+		ConcurrencyAndBufferSize: schemas.ConcurrencyAndBufferSize{
+			Concurrency: 10,
+			BufferSize:  100,
+		},
+		Bulkhead: bulkheadConfig,
+	}
+	if provider != schemas.OpenAI {
+		config.CustomProviderConfig = &schemas.CustomProviderConfig{
+			BaseProviderType: schemas.OpenAI,
+		}
+	}
+	ma.configs[provider] = config
+	ma.keys[provider] = []schemas.Key{
+		{
+			ID:     "test-key-" + string(provider),
+			Value:  "sk-test",
+			Models: []string{},
+			Weight: 100,
+		},
+	}
+}
+
+// newTestChatRequest returns a single-message chat request for the given provider.
+func newTestChatRequest(provider schemas.ModelProvider) *schemas.BifrostChatRequest {
+	return &schemas.BifrostChatRequest{
+		Provider: provider,
+		Model:    "gpt-4o-mini",
+		Input: []schemas.ChatMessage{
+			{
+				Role:    schemas.ChatMessageRoleUser,
+				Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("hello")},
+			},
+		},
+	}
+}
+
+// newMockProviderServer starts a server standing in for a provider, closed when the test ends.
+func newMockProviderServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// initTestClient initializes Bifrost on the given account, logging errors only unless config sets a logger,
+// and shuts it down when the test ends.
+func initTestClient(t *testing.T, account *MockAccount, config schemas.BifrostConfig) *Bifrost {
+	t.Helper()
+	config.Account = account
+	if config.Logger == nil {
+		config.Logger = NewDefaultLogger(schemas.LogLevelError)
+	}
+	client, err := Init(context.Background(), config)
+	if err != nil {
+		t.Fatalf("Failed to initialize Bifrost: %v", err)
+	}
+	t.Cleanup(client.Shutdown)
+	return client
+}
@@ -205,7 +205,7 @@ func isBatchRequestType(reqType schemas.RequestType) bool {
 
 // isFileRequestType returns true if the given request type is a file API operation.
 func isFileRequestType(reqType schemas.RequestType) bool {
-	return reqType == schemas.FileUploadRequest || reqType == schemas.FileListRequest || reqType == schemas.FileRetrieveRequest || reqType == schemas.FileDeleteRequest || reqType == schemas.FileContentRequest
+	return reqType == schemas.FileUploadRequest || reqType == schemas.FileListRequest || reqType == schemas.FileRetrieveRequest || reqType == schemas.FileDeleteRequest || reqType == schemas.FileContentRequest || reqType == schemas.FileDownloadURLRequest
 }
 
 // IsFinalChunk returns true if the given context is a final chunk.
@@ -265,6 +265,9 @@ func GetErrorMessage(err *schemas.BifrostError) string {
 		case 405:
 			return "method not allowed"
 		case 429:
+			if err.RateLimitKind == schemas.RateLimitKindQuotaExhausted {
+				return "quota exhausted"
+			}
 			return "rate limit exceeded"
 		case 500:
 			return "internal server error"
@@ -396,3 +399,30 @@ func isPrivateIP(ip net.IP) bool {
 	}
 	return false
 }
+
+// restoreModelRequested reports the model as sent by the client as ModelRequested, when it was normalized before
+// reaching Bifrost (see schemas.BifrostContextKeyModelRequested). Fallback responses keep their fallback model.
+func restoreModelRequested(ctx context.Context, response *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) {
+	if ctx == nil {
+		return
+	}
+	if fallbackIndex, ok := ctx.Value(schemas.BifrostContextKeyFallbackIndex).(int); ok && fallbackIndex > 0 {
+		return
+	}
+	modelRequested, ok := ctx.Value(schemas.BifrostContextKeyModelRequested).(string)
+	if !ok || modelRequested == "" {
+		return
+	}
+	if response != nil {
+		if extraFields := response.GetExtraFields(); extraFields != nil {
+			// The normalized model is kept for pricing, the model sent by the client may be an alias
+			if extraFields.ModelRequested != modelRequested {
+				extraFields.ModelNormalized = extraFields.ModelRequested
+			}
+			extraFields.ModelRequested = modelRequested
+		}
+	}
+	if bifrostErr != nil {
+		bifrostErr.ExtraFields.ModelRequested = modelRequested
+	}
+}
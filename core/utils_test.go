@@ -0,0 +1,39 @@
+package bifrost
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// Test that the model as sent by the client is reported as ModelRequested when it was normalized, with the
+// normalized model kept as ModelNormalized
+func TestRestoreModelRequested(t *testing.T) {
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(mockChatCompletionBody))
+	})
+
+	account := NewMockAccount()
+	account.addOpenAICompatibleProvider(schemas.OpenAI, server.URL, nil)
+	client := initTestClient(t, account, schemas.BifrostConfig{
+		ModelNameNormalization: &schemas.ModelNameNormalization{CaseFoldModels: true},
+	})
+
+	provider, model := client.ParseModelString("OpenAI/GPT-4o-Mini", "")
+	req := newTestChatRequest(provider)
+	req.Model = model
+	ctx := context.WithValue(context.Background(), schemas.BifrostContextKeyModelRequested, "GPT-4o-Mini")
+	resp, bifrostErr := client.ChatCompletionRequest(ctx, req)
+	if bifrostErr != nil {
+		t.Fatalf("Expected request to succeed, got error: %v", GetErrorMessage(bifrostErr))
+	}
+	if resp.ExtraFields.ModelRequested != "GPT-4o-Mini" || resp.ExtraFields.ModelNormalized != "gpt-4o-mini" {
+		t.Errorf("Expected ModelRequested GPT-4o-Mini normalized to gpt-4o-mini, got %q and %q", resp.ExtraFields.ModelRequested, resp.ExtraFields.ModelNormalized)
+	}
+	if resp.ExtraFields.ModelDeployment != "" {
+		t.Errorf("Expected no model deployment, got %q", resp.ExtraFields.ModelDeployment)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/maximhq/bifrost/core/schemas"
@@ -38,6 +39,12 @@ func PostHook(ctx *schemas.BifrostContext, resp *schemas.BifrostResponse, bifros
 	return resp, bifrostErr, nil
 }
 
+// Flush is optional, it is called before Cleanup to wait for work done asynchronously from the hooks
+func Flush(ctx context.Context) error {
+	fmt.Println("Flush called")
+	return nil
+}
+
 func Cleanup() error {
 	fmt.Println("Cleanup called")
 	return nil
@@ -4,10 +4,11 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"sort"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/framework/configstore/tables"
 )
 
@@ -46,7 +47,19 @@ type ClientConfig struct {
 	AllowedOrigins          []string `json:"allowed_origins,omitempty"`           // Additional allowed origins for CORS and WebSocket (localhost is always allowed)
 	MaxRequestBodySizeMB    int      `json:"max_request_body_size_mb"`            // The maximum request body size in MB
 	EnableLiteLLMFallbacks  bool     `json:"enable_litellm_fallbacks"`            // Enable litellm-specific fallbacks for text completion for Groq
+	MaxConcurrentStreams    int      `json:"max_concurrent_streams,omitempty"`    // Maximum number of streams open at once across all providers (0 means unlimited)
+	HedgingDelayInMs        int      `json:"hedging_delay_ms,omitempty"`          // Delay before a hedge is sent for requests opted in to hedging (0 disables hedging)
+	MaxContinuations        int      `json:"max_continuations,omitempty"`         // Follow-up requests allowed to continue a chat response truncated by the output token limit (0 disables continuation)
+	PluginOrder             []string `json:"plugin_order,omitempty"`              // Names of the plugins whose hooks run first, in that order (defaults to governance, telemetry, logging)
 	ConfigHash              string   `json:"-"`                                   // Config hash for reconciliation (not serialized)
+
+	MaxTokensDerivation    *schemas.MaxTokensDerivationConfig `json:"max_tokens_derivation,omitempty"`    // Fills in max_tokens of chat requests that omit it (optional)
+	ModelNameNormalization *schemas.ModelNameNormalization    `json:"model_name_normalization,omitempty"` // Model aliases and model name case-folding applied to model strings (optional)
+
+	EnableFailedRequestCapture       bool `json:"enable_failed_request_capture"`                   // Keep failed requests in memory for replay (ignored while content logging is disabled)
+	FailedRequestCaptureMaxEntries   int  `json:"failed_request_capture_max_entries,omitempty"`    // Maximum failed requests kept for replay (0 uses the default of 100)
+	FailedRequestCaptureTTLInSeconds int  `json:"failed_request_capture_ttl_seconds,omitempty"`    // How long a failed request is kept for replay (0 uses the default of 1 hour)
+	FailedRequestCaptureMaxPayloadKB int  `json:"failed_request_capture_max_payload_kb,omitempty"` // Failed requests with larger payloads are not kept (0 uses the default of 1024 KB)
 }
 
 // GenerateClientConfigHash generates a SHA256 hash of the client configuration.
@@ -98,30 +111,87 @@ func (c *ClientConfig) GenerateClientConfigHash() (string, error) {
 	}
 
 	// Hash integer fields
-	data, err := sonic.Marshal(c.InitialPoolSize)
+	data, err := serialization.Marshal(c.InitialPoolSize)
 	if err != nil {
 		return "", err
 	}
 	hash.Write(data)
 
-	data, err = sonic.Marshal(c.LogRetentionDays)
+	data, err = serialization.Marshal(c.LogRetentionDays)
 	if err != nil {
 		return "", err
 	}
 	hash.Write(data)
 
-	data, err = sonic.Marshal(c.MaxRequestBodySizeMB)
+	data, err = serialization.Marshal(c.MaxRequestBodySizeMB)
 	if err != nil {
 		return "", err
 	}
 	hash.Write(data)
 
+	if c.MaxConcurrentStreams > 0 {
+		hash.Write([]byte(fmt.Sprintf("maxConcurrentStreams:%d", c.MaxConcurrentStreams)))
+	}
+
+	if c.HedgingDelayInMs > 0 {
+		hash.Write([]byte(fmt.Sprintf("hedgingDelayInMs:%d", c.HedgingDelayInMs)))
+	}
+
+	if c.MaxContinuations > 0 {
+		hash.Write([]byte(fmt.Sprintf("maxContinuations:%d", c.MaxContinuations)))
+	}
+
+	if c.EnableFailedRequestCapture {
+		hash.Write([]byte("enableFailedRequestCapture:true"))
+	} else {
+		hash.Write([]byte("enableFailedRequestCapture:false"))
+	}
+
+	if c.FailedRequestCaptureMaxEntries > 0 {
+		hash.Write([]byte(fmt.Sprintf("failedRequestCaptureMaxEntries:%d", c.FailedRequestCaptureMaxEntries)))
+	}
+
+	if c.FailedRequestCaptureTTLInSeconds > 0 {
+		hash.Write([]byte(fmt.Sprintf("failedRequestCaptureTTLInSeconds:%d", c.FailedRequestCaptureTTLInSeconds)))
+	}
+
+	if c.FailedRequestCaptureMaxPayloadKB > 0 {
+		hash.Write([]byte(fmt.Sprintf("failedRequestCaptureMaxPayloadKB:%d", c.FailedRequestCaptureMaxPayloadKB)))
+	}
+
+	// Hash MaxTokensDerivation (encoding/json sorts the model limits for deterministic hashing)
+	if c.MaxTokensDerivation != nil {
+		data, err := json.Marshal(c.MaxTokensDerivation)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(data)
+	}
+
+	// Hash ModelNameNormalization (encoding/json sorts the aliases for deterministic hashing)
+	if c.ModelNameNormalization != nil {
+		data, err := json.Marshal(c.ModelNameNormalization)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(data)
+	}
+
+	// Hash PluginOrder (not sorted, the order is the setting)
+	if len(c.PluginOrder) > 0 {
+		data, err := serialization.Marshal(c.PluginOrder)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(data)
+	}
+
 	// Hash PrometheusLabels (sorted for deterministic hashing)
 	if len(c.PrometheusLabels) > 0 {
 		sortedLabels := make([]string, len(c.PrometheusLabels))
 		copy(sortedLabels, c.PrometheusLabels)
 		sort.Strings(sortedLabels)
-		data, err := sonic.Marshal(sortedLabels)
+		data, err := serialization.Marshal(sortedLabels)
 		if err != nil {
 			return "", err
 		}
@@ -133,7 +203,7 @@ func (c *ClientConfig) GenerateClientConfigHash() (string, error) {
 		sortedOrigins := make([]string, len(c.AllowedOrigins))
 		copy(sortedOrigins, c.AllowedOrigins)
 		sort.Strings(sortedOrigins)
-		data, err := sonic.Marshal(sortedOrigins)
+		data, err := serialization.Marshal(sortedOrigins)
 		if err != nil {
 			return "", err
 		}
@@ -153,6 +223,14 @@ type ProviderConfig struct {
 	SendBackRawRequest       bool                              `json:"send_back_raw_request"`                 // Include raw request in BifrostResponse
 	SendBackRawResponse      bool                              `json:"send_back_raw_response"`                // Include raw response in BifrostResponse
 	CustomProviderConfig     *schemas.CustomProviderConfig     `json:"custom_provider_config,omitempty"`      // Custom provider configuration
+	BatchNameTemplate        string                            `json:"batch_name_template,omitempty"`         // Naming template of batch jobs, e.g. "{vk}-{endpoint}-{timestamp}"
+	Bulkhead                 *schemas.BulkheadConfig           `json:"bulkhead,omitempty"`                    // Per-provider resource isolation
+	OutboundRateLimit        *schemas.OutboundRateLimitConfig  `json:"outbound_rate_limit,omitempty"`         // Pacing of requests sent to the provider
+	ParameterRules           []schemas.ModelParameterRule      `json:"parameter_rules,omitempty"`             // Parameters each model accepts
+	RawResponseDenylist      []string                          `json:"raw_response_denylist,omitempty"`       // JSON paths removed from raw responses
+	AssistantPrefill         schemas.AssistantPrefillMode      `json:"assistant_prefill,omitempty"`           // Handling of assistant prefills
+	MaxConcurrentStreams     int                               `json:"max_concurrent_streams,omitempty"`      // Maximum number of streams open at once, 0 means unbounded
+	MaxInlineBatchRequests   int                               `json:"max_inline_batch_requests,omitempty"`   // Maximum number of inline requests of a batch, 0 means unchecked
 	ConfigHash               string                            `json:"-"`
 }
 
@@ -167,7 +245,7 @@ func (p *ProviderConfig) GenerateConfigHash(providerName string) (string, error)
 
 	// Hash NetworkConfig
 	if p.NetworkConfig != nil {
-		data, err := sonic.Marshal(p.NetworkConfig)
+		data, err := serialization.Marshal(p.NetworkConfig)
 		if err != nil {
 			return "", err
 		}
@@ -176,7 +254,7 @@ func (p *ProviderConfig) GenerateConfigHash(providerName string) (string, error)
 
 	// Hash ConcurrencyAndBufferSize
 	if p.ConcurrencyAndBufferSize != nil {
-		data, err := sonic.Marshal(p.ConcurrencyAndBufferSize)
+		data, err := serialization.Marshal(p.ConcurrencyAndBufferSize)
 		if err != nil {
 			return "", err
 		}
@@ -185,7 +263,7 @@ func (p *ProviderConfig) GenerateConfigHash(providerName string) (string, error)
 
 	// Hash ProxyConfig
 	if p.ProxyConfig != nil {
-		data, err := sonic.Marshal(p.ProxyConfig)
+		data, err := serialization.Marshal(p.ProxyConfig)
 		if err != nil {
 			return "", err
 		}
@@ -194,7 +272,7 @@ func (p *ProviderConfig) GenerateConfigHash(providerName string) (string, error)
 
 	// Hash CustomProviderConfig
 	if p.CustomProviderConfig != nil {
-		data, err := sonic.Marshal(p.CustomProviderConfig)
+		data, err := serialization.Marshal(p.CustomProviderConfig)
 		if err != nil {
 			return "", err
 		}
@@ -211,6 +289,67 @@ func (p *ProviderConfig) GenerateConfigHash(providerName string) (string, error)
 		hash.Write([]byte("sendBackRawResponse"))
 	}
 
+	// Hash BatchNameTemplate
+	if p.BatchNameTemplate != "" {
+		hash.Write([]byte("batchNameTemplate:" + p.BatchNameTemplate))
+	}
+
+	// Hash Bulkhead
+	if p.Bulkhead != nil {
+		data, err := serialization.Marshal(p.Bulkhead)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("bulkhead:"))
+		hash.Write(data)
+	}
+
+	// Hash OutboundRateLimit
+	if p.OutboundRateLimit != nil {
+		data, err := serialization.Marshal(p.OutboundRateLimit)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("outboundRateLimit:"))
+		hash.Write(data)
+	}
+
+	// Hash ParameterRules (order matters, the first matching rule applies)
+	if len(p.ParameterRules) > 0 {
+		data, err := serialization.Marshal(p.ParameterRules)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("parameterRules:"))
+		hash.Write(data)
+	}
+
+	// Hash RawResponseDenylist (sorted for deterministic hashing)
+	if len(p.RawResponseDenylist) > 0 {
+		sortedPaths := make([]string, len(p.RawResponseDenylist))
+		copy(sortedPaths, p.RawResponseDenylist)
+		sort.Strings(sortedPaths)
+		data, err := serialization.Marshal(sortedPaths)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("rawResponseDenylist:"))
+		hash.Write(data)
+	}
+
+	// Hash AssistantPrefill
+	if p.AssistantPrefill != "" {
+		hash.Write([]byte("assistantPrefill:" + string(p.AssistantPrefill)))
+	}
+
+	// Hash stream and batch limits
+	if p.MaxConcurrentStreams != 0 {
+		hash.Write([]byte(fmt.Sprintf("maxConcurrentStreams:%d", p.MaxConcurrentStreams)))
+	}
+	if p.MaxInlineBatchRequests != 0 {
+		hash.Write([]byte(fmt.Sprintf("maxInlineBatchRequests:%d", p.MaxInlineBatchRequests)))
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
@@ -231,7 +370,7 @@ func GenerateKeyHash(key schemas.Key) (string, error) {
 		sortedModels := make([]string, len(key.Models))
 		copy(sortedModels, key.Models)
 		sort.Strings(sortedModels)
-		data, err := sonic.Marshal(sortedModels)
+		data, err := serialization.Marshal(sortedModels)
 		if err != nil {
 			return "", err
 		}
@@ -239,7 +378,7 @@ func GenerateKeyHash(key schemas.Key) (string, error) {
 	}
 
 	// Hash Weight
-	data, err := sonic.Marshal(key.Weight)
+	data, err := serialization.Marshal(key.Weight)
 	if err != nil {
 		return "", err
 	}
@@ -247,7 +386,7 @@ func GenerateKeyHash(key schemas.Key) (string, error) {
 
 	// Hash AzureKeyConfig
 	if key.AzureKeyConfig != nil {
-		data, err := sonic.Marshal(key.AzureKeyConfig)
+		data, err := serialization.Marshal(key.AzureKeyConfig)
 		if err != nil {
 			return "", err
 		}
@@ -256,7 +395,7 @@ func GenerateKeyHash(key schemas.Key) (string, error) {
 
 	// Hash VertexKeyConfig
 	if key.VertexKeyConfig != nil {
-		data, err := sonic.Marshal(key.VertexKeyConfig)
+		data, err := serialization.Marshal(key.VertexKeyConfig)
 		if err != nil {
 			return "", err
 		}
@@ -265,7 +404,7 @@ func GenerateKeyHash(key schemas.Key) (string, error) {
 
 	// Hash BedrockKeyConfig
 	if key.BedrockKeyConfig != nil {
-		data, err := sonic.Marshal(key.BedrockKeyConfig)
+		data, err := serialization.Marshal(key.BedrockKeyConfig)
 		if err != nil {
 			return "", err
 		}
@@ -405,7 +544,7 @@ func GenerateVirtualKeyHash(vk tables.TableVirtualKey) (string, error) {
 				KeyIDs:        keyIDs,
 			}
 		}
-		data, err := sonic.Marshal(providerConfigsForHash)
+		data, err := serialization.Marshal(providerConfigsForHash)
 		if err != nil {
 			return "", err
 		}
@@ -433,7 +572,7 @@ func GenerateVirtualKeyHash(vk tables.TableVirtualKey) (string, error) {
 				ToolsToExecute: sortedTools,
 			}
 		}
-		data, err := sonic.Marshal(mcpConfigsForHash)
+		data, err := serialization.Marshal(mcpConfigsForHash)
 		if err != nil {
 			return "", err
 		}
@@ -453,7 +592,7 @@ func GenerateBudgetHash(b tables.TableBudget) (string, error) {
 	hash.Write([]byte(b.ID))
 
 	// Hash MaxLimit
-	data, err := sonic.Marshal(b.MaxLimit)
+	data, err := serialization.Marshal(b.MaxLimit)
 	if err != nil {
 		return "", err
 	}
@@ -476,7 +615,7 @@ func GenerateRateLimitHash(rl tables.TableRateLimit) (string, error) {
 
 	// Hash TokenMaxLimit
 	if rl.TokenMaxLimit != nil {
-		data, err := sonic.Marshal(*rl.TokenMaxLimit)
+		data, err := serialization.Marshal(*rl.TokenMaxLimit)
 		if err != nil {
 			return "", err
 		}
@@ -490,7 +629,7 @@ func GenerateRateLimitHash(rl tables.TableRateLimit) (string, error) {
 
 	// Hash RequestMaxLimit
 	if rl.RequestMaxLimit != nil {
-		data, err := sonic.Marshal(*rl.RequestMaxLimit)
+		data, err := serialization.Marshal(*rl.RequestMaxLimit)
 		if err != nil {
 			return "", err
 		}
@@ -609,7 +748,7 @@ func GenerateMCPClientHash(m tables.TableMCPClient) (string, error) {
 
 	// Hash StdioConfig
 	if m.StdioConfig != nil {
-		data, err := sonic.Marshal(m.StdioConfig)
+		data, err := serialization.Marshal(m.StdioConfig)
 		if err != nil {
 			return "", err
 		}
@@ -621,7 +760,7 @@ func GenerateMCPClientHash(m tables.TableMCPClient) (string, error) {
 		sortedTools := make([]string, len(m.ToolsToExecute))
 		copy(sortedTools, m.ToolsToExecute)
 		sort.Strings(sortedTools)
-		data, err := sonic.Marshal(sortedTools)
+		data, err := serialization.Marshal(sortedTools)
 		if err != nil {
 			return "", err
 		}
@@ -682,7 +821,7 @@ func GeneratePluginHash(p tables.TablePlugin) (string, error) {
 	}
 
 	// Hash Version
-	data, err := sonic.Marshal(p.Version)
+	data, err := serialization.Marshal(p.Version)
 	if err != nil {
 		return "", err
 	}
@@ -110,6 +110,27 @@ func triggerMigrations(ctx context.Context, db *gorm.DB) error {
 	if err := migrationAddUseForBatchAPIColumnAndS3BucketsConfig(ctx, db); err != nil {
 		return err
 	}
+	if err := migrationAddBatchNameTemplateColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddProviderResilienceColumns(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddClientStreamingColumns(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddMaxContinuationsColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddModelNameNormalizationColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddFailedRequestCaptureColumns(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddPluginOrderColumn(ctx, db); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1827,3 +1848,293 @@ func migrationAddUseForBatchAPIColumnAndS3BucketsConfig(ctx context.Context, db
 	}
 	return nil
 }
+
+// migrationAddBatchNameTemplateColumn adds the batch_name_template column to the provider table
+func migrationAddBatchNameTemplateColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_batch_name_template_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if !mg.HasColumn(&tables.TableProvider{}, "batch_name_template") {
+				if err := mg.AddColumn(&tables.TableProvider{}, "batch_name_template"); err != nil {
+					return fmt.Errorf("failed to add batch_name_template column: %w", err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if mg.HasColumn(&tables.TableProvider{}, "batch_name_template") {
+				if err := mg.DropColumn(&tables.TableProvider{}, "batch_name_template"); err != nil {
+					return fmt.Errorf("failed to drop batch_name_template column: %w", err)
+				}
+			}
+			return nil
+		},
+	}})
+
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running batch_name_template migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddProviderResilienceColumns adds the bulkhead, outbound rate limit, parameter rule,
+// raw response denylist, assistant prefill and stream/batch limit columns to the provider table
+func migrationAddProviderResilienceColumns(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_provider_resilience_columns",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			columns := []string{
+				"bulkhead_json",
+				"outbound_rate_limit_json",
+				"parameter_rules_json",
+				"raw_response_denylist_json",
+				"assistant_prefill",
+				"max_concurrent_streams",
+				"max_inline_batch_requests",
+			}
+
+			for _, field := range columns {
+				if !migrator.HasColumn(&tables.TableProvider{}, field) {
+					if err := migrator.AddColumn(&tables.TableProvider{}, field); err != nil {
+						return fmt.Errorf("failed to add column %s: %w", field, err)
+					}
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			columns := []string{
+				"bulkhead_json",
+				"outbound_rate_limit_json",
+				"parameter_rules_json",
+				"raw_response_denylist_json",
+				"assistant_prefill",
+				"max_concurrent_streams",
+				"max_inline_batch_requests",
+			}
+
+			for _, field := range columns {
+				if migrator.HasColumn(&tables.TableProvider{}, field) {
+					if err := migrator.DropColumn(&tables.TableProvider{}, field); err != nil {
+						return fmt.Errorf("failed to drop column %s: %w", field, err)
+					}
+				}
+			}
+			return nil
+		},
+	}})
+
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running provider resilience columns migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddClientStreamingColumns adds the max concurrent streams, hedging delay and max tokens derivation
+// columns to the client config table
+func migrationAddClientStreamingColumns(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_client_streaming_columns",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			columns := []string{
+				"max_concurrent_streams",
+				"hedging_delay_in_ms",
+				"max_tokens_derivation_json",
+			}
+
+			for _, field := range columns {
+				if !migrator.HasColumn(&tables.TableClientConfig{}, field) {
+					if err := migrator.AddColumn(&tables.TableClientConfig{}, field); err != nil {
+						return fmt.Errorf("failed to add column %s: %w", field, err)
+					}
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			columns := []string{
+				"max_concurrent_streams",
+				"hedging_delay_in_ms",
+				"max_tokens_derivation_json",
+			}
+
+			for _, field := range columns {
+				if migrator.HasColumn(&tables.TableClientConfig{}, field) {
+					if err := migrator.DropColumn(&tables.TableClientConfig{}, field); err != nil {
+						return fmt.Errorf("failed to drop column %s: %w", field, err)
+					}
+				}
+			}
+			return nil
+		},
+	}})
+
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running client streaming columns migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddMaxContinuationsColumn adds the max_continuations column to the client config table
+func migrationAddMaxContinuationsColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_max_continuations_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if !mg.HasColumn(&tables.TableClientConfig{}, "max_continuations") {
+				if err := mg.AddColumn(&tables.TableClientConfig{}, "max_continuations"); err != nil {
+					return fmt.Errorf("failed to add max_continuations column: %w", err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if mg.HasColumn(&tables.TableClientConfig{}, "max_continuations") {
+				if err := mg.DropColumn(&tables.TableClientConfig{}, "max_continuations"); err != nil {
+					return fmt.Errorf("failed to drop max_continuations column: %w", err)
+				}
+			}
+			return nil
+		},
+	}})
+
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running max_continuations migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddModelNameNormalizationColumn adds the model_name_normalization_json column to the client config table
+func migrationAddModelNameNormalizationColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_model_name_normalization_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if !mg.HasColumn(&tables.TableClientConfig{}, "model_name_normalization_json") {
+				if err := mg.AddColumn(&tables.TableClientConfig{}, "model_name_normalization_json"); err != nil {
+					return fmt.Errorf("failed to add model_name_normalization_json column: %w", err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if mg.HasColumn(&tables.TableClientConfig{}, "model_name_normalization_json") {
+				if err := mg.DropColumn(&tables.TableClientConfig{}, "model_name_normalization_json"); err != nil {
+					return fmt.Errorf("failed to drop model_name_normalization_json column: %w", err)
+				}
+			}
+			return nil
+		},
+	}})
+
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running model_name_normalization_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddFailedRequestCaptureColumns adds the failed request capture columns to the client config table
+func migrationAddFailedRequestCaptureColumns(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_failed_request_capture_columns",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			columns := []string{
+				"enable_failed_request_capture",
+				"failed_request_capture_max_entries",
+				"failed_request_capture_ttl_in_seconds",
+				"failed_request_capture_max_payload_kb",
+			}
+
+			for _, field := range columns {
+				if !migrator.HasColumn(&tables.TableClientConfig{}, field) {
+					if err := migrator.AddColumn(&tables.TableClientConfig{}, field); err != nil {
+						return fmt.Errorf("failed to add column %s: %w", field, err)
+					}
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			columns := []string{
+				"enable_failed_request_capture",
+				"failed_request_capture_max_entries",
+				"failed_request_capture_ttl_in_seconds",
+				"failed_request_capture_max_payload_kb",
+			}
+
+			for _, field := range columns {
+				if migrator.HasColumn(&tables.TableClientConfig{}, field) {
+					if err := migrator.DropColumn(&tables.TableClientConfig{}, field); err != nil {
+						return fmt.Errorf("failed to drop column %s: %w", field, err)
+					}
+				}
+			}
+			return nil
+		},
+	}})
+
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running failed request capture migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddPluginOrderColumn adds the plugin_order_json column to the client config table
+func migrationAddPluginOrderColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_plugin_order_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if !mg.HasColumn(&tables.TableClientConfig{}, "plugin_order_json") {
+				if err := mg.AddColumn(&tables.TableClientConfig{}, "plugin_order_json"); err != nil {
+					return fmt.Errorf("failed to add plugin_order_json column: %w", err)
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			if mg.HasColumn(&tables.TableClientConfig{}, "plugin_order_json") {
+				if err := mg.DropColumn(&tables.TableClientConfig{}, "plugin_order_json"); err != nil {
+					return fmt.Errorf("failed to drop plugin_order_json column: %w", err)
+				}
+			}
+			return nil
+		},
+	}})
+
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running plugin_order_json migration: %s", err.Error())
+	}
+	return nil
+}
@@ -7,9 +7,9 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/bytedance/sonic"
 	bifrost "github.com/maximhq/bifrost/core"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/framework/configstore/tables"
 	"github.com/maximhq/bifrost/framework/encrypt"
 	"github.com/maximhq/bifrost/framework/envutils"
@@ -39,8 +39,19 @@ func (s *RDBConfigStore) UpdateClientConfig(ctx context.Context, config *ClientC
 		AllowDirectKeys:         config.AllowDirectKeys,
 		PrometheusLabels:        config.PrometheusLabels,
 		AllowedOrigins:          config.AllowedOrigins,
+		PluginOrder:             config.PluginOrder,
 		MaxRequestBodySizeMB:    config.MaxRequestBodySizeMB,
 		EnableLiteLLMFallbacks:  config.EnableLiteLLMFallbacks,
+		MaxConcurrentStreams:    config.MaxConcurrentStreams,
+		HedgingDelayInMs:        config.HedgingDelayInMs,
+		MaxTokensDerivation:     config.MaxTokensDerivation,
+		MaxContinuations:        config.MaxContinuations,
+		ModelNameNormalization:  config.ModelNameNormalization,
+
+		EnableFailedRequestCapture:       config.EnableFailedRequestCapture,
+		FailedRequestCaptureMaxEntries:   config.FailedRequestCaptureMaxEntries,
+		FailedRequestCaptureTTLInSeconds: config.FailedRequestCaptureTTLInSeconds,
+		FailedRequestCaptureMaxPayloadKB: config.FailedRequestCaptureMaxPayloadKB,
 	}
 	// Delete existing client config and create new one in a transaction
 	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -193,8 +204,19 @@ func (s *RDBConfigStore) GetClientConfig(ctx context.Context) (*ClientConfig, er
 		EnforceGovernanceHeader: dbConfig.EnforceGovernanceHeader,
 		AllowDirectKeys:         dbConfig.AllowDirectKeys,
 		AllowedOrigins:          dbConfig.AllowedOrigins,
+		PluginOrder:             dbConfig.PluginOrder,
 		MaxRequestBodySizeMB:    dbConfig.MaxRequestBodySizeMB,
 		EnableLiteLLMFallbacks:  dbConfig.EnableLiteLLMFallbacks,
+		MaxConcurrentStreams:    dbConfig.MaxConcurrentStreams,
+		HedgingDelayInMs:        dbConfig.HedgingDelayInMs,
+		MaxTokensDerivation:     dbConfig.MaxTokensDerivation,
+		MaxContinuations:        dbConfig.MaxContinuations,
+		ModelNameNormalization:  dbConfig.ModelNameNormalization,
+
+		EnableFailedRequestCapture:       dbConfig.EnableFailedRequestCapture,
+		FailedRequestCaptureMaxEntries:   dbConfig.FailedRequestCaptureMaxEntries,
+		FailedRequestCaptureTTLInSeconds: dbConfig.FailedRequestCaptureTTLInSeconds,
+		FailedRequestCaptureMaxPayloadKB: dbConfig.FailedRequestCaptureMaxPayloadKB,
 	}, nil
 }
 
@@ -215,6 +237,14 @@ func (s *RDBConfigStore) UpdateProvidersConfig(ctx context.Context, providers ma
 			SendBackRawRequest:       providerConfig.SendBackRawRequest,
 			SendBackRawResponse:      providerConfig.SendBackRawResponse,
 			CustomProviderConfig:     providerConfig.CustomProviderConfig,
+			BatchNameTemplate:        providerConfig.BatchNameTemplate,
+			Bulkhead:                 providerConfig.Bulkhead,
+			OutboundRateLimit:        providerConfig.OutboundRateLimit,
+			ParameterRules:           providerConfig.ParameterRules,
+			RawResponseDenylist:      providerConfig.RawResponseDenylist,
+			AssistantPrefill:         providerConfig.AssistantPrefill,
+			MaxConcurrentStreams:     providerConfig.MaxConcurrentStreams,
+			MaxInlineBatchRequests:   providerConfig.MaxInlineBatchRequests,
 			ConfigHash:               providerConfig.ConfigHash,
 		}
 
@@ -275,7 +305,7 @@ func (s *RDBConfigStore) UpdateProvidersConfig(ctx context.Context, providers ma
 				dbKey.BedrockRegion = key.BedrockKeyConfig.Region
 				dbKey.BedrockARN = key.BedrockKeyConfig.ARN
 				if key.BedrockKeyConfig.BatchS3Config != nil {
-					data, err := sonic.Marshal(key.BedrockKeyConfig.BatchS3Config)
+					data, err := serialization.Marshal(key.BedrockKeyConfig.BatchS3Config)
 					if err != nil {
 						return err
 					}
@@ -349,6 +379,14 @@ func (s *RDBConfigStore) UpdateProvider(ctx context.Context, provider schemas.Mo
 	dbProvider.SendBackRawRequest = configCopy.SendBackRawRequest
 	dbProvider.SendBackRawResponse = configCopy.SendBackRawResponse
 	dbProvider.CustomProviderConfig = configCopy.CustomProviderConfig
+	dbProvider.BatchNameTemplate = configCopy.BatchNameTemplate
+	dbProvider.Bulkhead = configCopy.Bulkhead
+	dbProvider.OutboundRateLimit = configCopy.OutboundRateLimit
+	dbProvider.ParameterRules = configCopy.ParameterRules
+	dbProvider.RawResponseDenylist = configCopy.RawResponseDenylist
+	dbProvider.AssistantPrefill = configCopy.AssistantPrefill
+	dbProvider.MaxConcurrentStreams = configCopy.MaxConcurrentStreams
+	dbProvider.MaxInlineBatchRequests = configCopy.MaxInlineBatchRequests
 	dbProvider.ConfigHash = configCopy.ConfigHash
 
 	// Save the updated provider
@@ -413,7 +451,7 @@ func (s *RDBConfigStore) UpdateProvider(ctx context.Context, provider schemas.Mo
 			dbKey.BedrockRegion = key.BedrockKeyConfig.Region
 			dbKey.BedrockARN = key.BedrockKeyConfig.ARN
 			if key.BedrockKeyConfig.BatchS3Config != nil {
-				data, err := sonic.Marshal(key.BedrockKeyConfig.BatchS3Config)
+				data, err := serialization.Marshal(key.BedrockKeyConfig.BatchS3Config)
 				if err != nil {
 					return err
 				}
@@ -480,6 +518,14 @@ func (s *RDBConfigStore) AddProvider(ctx context.Context, provider schemas.Model
 		SendBackRawRequest:       configCopy.SendBackRawRequest,
 		SendBackRawResponse:      configCopy.SendBackRawResponse,
 		CustomProviderConfig:     configCopy.CustomProviderConfig,
+		BatchNameTemplate:        configCopy.BatchNameTemplate,
+		Bulkhead:                 configCopy.Bulkhead,
+		OutboundRateLimit:        configCopy.OutboundRateLimit,
+		ParameterRules:           configCopy.ParameterRules,
+		RawResponseDenylist:      configCopy.RawResponseDenylist,
+		AssistantPrefill:         configCopy.AssistantPrefill,
+		MaxConcurrentStreams:     configCopy.MaxConcurrentStreams,
+		MaxInlineBatchRequests:   configCopy.MaxInlineBatchRequests,
 		ConfigHash:               configCopy.ConfigHash,
 	}
 
@@ -533,7 +579,7 @@ func (s *RDBConfigStore) AddProvider(ctx context.Context, provider schemas.Model
 			dbKey.BedrockRegion = key.BedrockKeyConfig.Region
 			dbKey.BedrockARN = key.BedrockKeyConfig.ARN
 			if key.BedrockKeyConfig.BatchS3Config != nil {
-				data, err := sonic.Marshal(key.BedrockKeyConfig.BatchS3Config)
+				data, err := serialization.Marshal(key.BedrockKeyConfig.BatchS3Config)
 				if err != nil {
 					return err
 				}
@@ -690,6 +736,14 @@ func (s *RDBConfigStore) GetProvidersConfig(ctx context.Context) (map[schemas.Mo
 			SendBackRawRequest:       dbProvider.SendBackRawRequest,
 			SendBackRawResponse:      dbProvider.SendBackRawResponse,
 			CustomProviderConfig:     dbProvider.CustomProviderConfig,
+			BatchNameTemplate:        dbProvider.BatchNameTemplate,
+			Bulkhead:                 dbProvider.Bulkhead,
+			OutboundRateLimit:        dbProvider.OutboundRateLimit,
+			ParameterRules:           dbProvider.ParameterRules,
+			RawResponseDenylist:      dbProvider.RawResponseDenylist,
+			AssistantPrefill:         dbProvider.AssistantPrefill,
+			MaxConcurrentStreams:     dbProvider.MaxConcurrentStreams,
+			MaxInlineBatchRequests:   dbProvider.MaxInlineBatchRequests,
 			ConfigHash:               dbProvider.ConfigHash,
 		}
 		processedProviders[provider] = providerConfig
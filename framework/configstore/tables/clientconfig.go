@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/maximhq/bifrost/core/schemas"
 	"gorm.io/gorm"
 )
 
@@ -13,16 +14,28 @@ type TableClientConfig struct {
 	DropExcessRequests      bool   `gorm:"default:false" json:"drop_excess_requests"`
 	PrometheusLabelsJSON    string `gorm:"type:text" json:"-"` // JSON serialized []string
 	AllowedOriginsJSON      string `gorm:"type:text" json:"-"` // JSON serialized []string
+	PluginOrderJSON         string `gorm:"type:text" json:"-"` // JSON serialized []string
 	InitialPoolSize         int    `gorm:"default:300" json:"initial_pool_size"`
 	EnableLogging           bool   `gorm:"" json:"enable_logging"`
-	DisableContentLogging   bool   `gorm:"default:false" json:"disable_content_logging"`                // DisableContentLogging controls whether sensitive content (inputs, outputs, embeddings, etc.) is logged
-	LogRetentionDays        int    `gorm:"default:365" json:"log_retention_days" validate:"min=1"`      // Number of days to retain logs (minimum 1 day)
+	DisableContentLogging   bool   `gorm:"default:false" json:"disable_content_logging"`           // DisableContentLogging controls whether sensitive content (inputs, outputs, embeddings, etc.) is logged
+	LogRetentionDays        int    `gorm:"default:365" json:"log_retention_days" validate:"min=1"` // Number of days to retain logs (minimum 1 day)
 	EnableGovernance        bool   `gorm:"" json:"enable_governance"`
 	EnforceGovernanceHeader bool   `gorm:"" json:"enforce_governance_header"`
 	AllowDirectKeys         bool   `gorm:"" json:"allow_direct_keys"`
 	MaxRequestBodySizeMB    int    `gorm:"default:100" json:"max_request_body_size_mb"`
 	// LiteLLM fallback flag
 	EnableLiteLLMFallbacks bool `gorm:"column:enable_litellm_fallbacks;default:false" json:"enable_litellm_fallbacks"`
+	// Streaming, hedging and output token limit settings of the Bifrost client
+	MaxConcurrentStreams       int    `gorm:"default:0" json:"max_concurrent_streams"`
+	HedgingDelayInMs           int    `gorm:"default:0" json:"hedging_delay_ms"`
+	MaxTokensDerivationJSON    string `gorm:"type:text" json:"-"` // JSON serialized schemas.MaxTokensDerivationConfig
+	ModelNameNormalizationJSON string `gorm:"type:text" json:"-"` // JSON serialized schemas.ModelNameNormalization
+	MaxContinuations           int    `gorm:"default:0" json:"max_continuations"`
+	// Failed request capture settings, capturing is opt-in
+	EnableFailedRequestCapture       bool `gorm:"default:false" json:"enable_failed_request_capture"`
+	FailedRequestCaptureMaxEntries   int  `gorm:"default:0" json:"failed_request_capture_max_entries"`
+	FailedRequestCaptureTTLInSeconds int  `gorm:"default:0" json:"failed_request_capture_ttl_seconds"`
+	FailedRequestCaptureMaxPayloadKB int  `gorm:"default:0" json:"failed_request_capture_max_payload_kb"`
 
 	// Config hash is used to detect the changes synced from config.json file
 	// Every time we sync the config.json file, we will update the config hash
@@ -32,8 +45,11 @@ type TableClientConfig struct {
 	UpdatedAt time.Time `gorm:"index;not null" json:"updated_at"`
 
 	// Virtual fields for runtime use (not stored in DB)
-	PrometheusLabels []string `gorm:"-" json:"prometheus_labels"`
-	AllowedOrigins   []string `gorm:"-" json:"allowed_origins,omitempty"`	
+	PrometheusLabels       []string                           `gorm:"-" json:"prometheus_labels"`
+	AllowedOrigins         []string                           `gorm:"-" json:"allowed_origins,omitempty"`
+	PluginOrder            []string                           `gorm:"-" json:"plugin_order,omitempty"`
+	MaxTokensDerivation    *schemas.MaxTokensDerivationConfig `gorm:"-" json:"max_tokens_derivation,omitempty"`
+	ModelNameNormalization *schemas.ModelNameNormalization    `gorm:"-" json:"model_name_normalization,omitempty"`
 }
 
 // TableName sets the table name for each model
@@ -60,6 +76,33 @@ func (cc *TableClientConfig) BeforeSave(tx *gorm.DB) error {
 		cc.AllowedOriginsJSON = "[]"
 	}
 
+	cc.PluginOrderJSON = ""
+	if cc.PluginOrder != nil {
+		data, err := json.Marshal(cc.PluginOrder)
+		if err != nil {
+			return err
+		}
+		cc.PluginOrderJSON = string(data)
+	}
+
+	cc.MaxTokensDerivationJSON = ""
+	if cc.MaxTokensDerivation != nil {
+		data, err := json.Marshal(cc.MaxTokensDerivation)
+		if err != nil {
+			return err
+		}
+		cc.MaxTokensDerivationJSON = string(data)
+	}
+
+	cc.ModelNameNormalizationJSON = ""
+	if cc.ModelNameNormalization != nil {
+		data, err := json.Marshal(cc.ModelNameNormalization)
+		if err != nil {
+			return err
+		}
+		cc.ModelNameNormalizationJSON = string(data)
+	}
+
 	return nil
 }
 
@@ -77,5 +120,27 @@ func (cc *TableClientConfig) AfterFind(tx *gorm.DB) error {
 		}
 	}
 
+	if cc.PluginOrderJSON != "" {
+		if err := json.Unmarshal([]byte(cc.PluginOrderJSON), &cc.PluginOrder); err != nil {
+			return err
+		}
+	}
+
+	if cc.MaxTokensDerivationJSON != "" {
+		var derivation schemas.MaxTokensDerivationConfig
+		if err := json.Unmarshal([]byte(cc.MaxTokensDerivationJSON), &derivation); err != nil {
+			return err
+		}
+		cc.MaxTokensDerivation = &derivation
+	}
+
+	if cc.ModelNameNormalizationJSON != "" {
+		var normalization schemas.ModelNameNormalization
+		if err := json.Unmarshal([]byte(cc.ModelNameNormalizationJSON), &normalization); err != nil {
+			return err
+		}
+		cc.ModelNameNormalization = &normalization
+	}
+
 	return nil
 }
@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"gorm.io/gorm"
 )
 
@@ -154,7 +154,7 @@ func (k *TableKey) BeforeSave(tx *gorm.DB) error {
 		k.BedrockRegion = k.BedrockKeyConfig.Region
 		k.BedrockARN = k.BedrockKeyConfig.ARN
 		if k.BedrockKeyConfig.Deployments != nil {
-			data, err := sonic.Marshal(k.BedrockKeyConfig.Deployments)
+			data, err := serialization.Marshal(k.BedrockKeyConfig.Deployments)
 			if err != nil {
 				return err
 			}
@@ -164,7 +164,7 @@ func (k *TableKey) BeforeSave(tx *gorm.DB) error {
 			k.BedrockDeploymentsJSON = nil
 		}
 		if k.BedrockKeyConfig.BatchS3Config != nil {
-			data, err := sonic.Marshal(k.BedrockKeyConfig.BatchS3Config)
+			data, err := serialization.Marshal(k.BedrockKeyConfig.BatchS3Config)
 			if err != nil {
 				return err
 			}
@@ -13,16 +13,24 @@ import (
 // NOTE: Any changes to the provider configuration should be reflected in the GenerateConfigHash function
 // That helps us detect changes between config file and database config
 type TableProvider struct {
-	ID                       uint      `gorm:"primaryKey;autoIncrement" json:"id"`
-	Name                     string    `gorm:"type:varchar(50);uniqueIndex;not null" json:"name"` // ModelProvider as string
-	NetworkConfigJSON        string    `gorm:"type:text" json:"-"`                                // JSON serialized schemas.NetworkConfig
-	ConcurrencyBufferJSON    string    `gorm:"type:text" json:"-"`                                // JSON serialized schemas.ConcurrencyAndBufferSize
-	ProxyConfigJSON          string    `gorm:"type:text" json:"-"`                                // JSON serialized schemas.ProxyConfig
-	CustomProviderConfigJSON string    `gorm:"type:text" json:"-"`                                // JSON serialized schemas.CustomProviderConfig
-	SendBackRawRequest       bool      `json:"send_back_raw_request"`
-	SendBackRawResponse      bool      `json:"send_back_raw_response"`
-	CreatedAt                time.Time `gorm:"index;not null" json:"created_at"`
-	UpdatedAt                time.Time `gorm:"index;not null" json:"updated_at"`
+	ID                       uint                         `gorm:"primaryKey;autoIncrement" json:"id"`
+	Name                     string                       `gorm:"type:varchar(50);uniqueIndex;not null" json:"name"` // ModelProvider as string
+	NetworkConfigJSON        string                       `gorm:"type:text" json:"-"`                                // JSON serialized schemas.NetworkConfig
+	ConcurrencyBufferJSON    string                       `gorm:"type:text" json:"-"`                                // JSON serialized schemas.ConcurrencyAndBufferSize
+	ProxyConfigJSON          string                       `gorm:"type:text" json:"-"`                                // JSON serialized schemas.ProxyConfig
+	CustomProviderConfigJSON string                       `gorm:"type:text" json:"-"`                                // JSON serialized schemas.CustomProviderConfig
+	BulkheadJSON             string                       `gorm:"type:text" json:"-"`                                // JSON serialized schemas.BulkheadConfig
+	OutboundRateLimitJSON    string                       `gorm:"type:text" json:"-"`                                // JSON serialized schemas.OutboundRateLimitConfig
+	ParameterRulesJSON       string                       `gorm:"type:text" json:"-"`                                // JSON serialized []schemas.ModelParameterRule
+	RawResponseDenylistJSON  string                       `gorm:"type:text" json:"-"`                                // JSON serialized []string
+	SendBackRawRequest       bool                         `json:"send_back_raw_request"`
+	SendBackRawResponse      bool                         `json:"send_back_raw_response"`
+	BatchNameTemplate        string                       `gorm:"type:varchar(255)" json:"batch_name_template"`
+	AssistantPrefill         schemas.AssistantPrefillMode `gorm:"type:varchar(20)" json:"assistant_prefill"`
+	MaxConcurrentStreams     int                          `gorm:"default:0" json:"max_concurrent_streams"`
+	MaxInlineBatchRequests   int                          `gorm:"default:0" json:"max_inline_batch_requests"`
+	CreatedAt                time.Time                    `gorm:"index;not null" json:"created_at"`
+	UpdatedAt                time.Time                    `gorm:"index;not null" json:"updated_at"`
 
 	// Relationships
 	Keys []TableKey `gorm:"foreignKey:ProviderID;constraint:OnDelete:CASCADE" json:"keys"`
@@ -35,6 +43,12 @@ type TableProvider struct {
 	// Custom provider fields
 	CustomProviderConfig *schemas.CustomProviderConfig `gorm:"-" json:"custom_provider_config,omitempty"`
 
+	// Resource isolation and request shaping fields
+	Bulkhead            *schemas.BulkheadConfig          `gorm:"-" json:"bulkhead,omitempty"`
+	OutboundRateLimit   *schemas.OutboundRateLimitConfig `gorm:"-" json:"outbound_rate_limit,omitempty"`
+	ParameterRules      []schemas.ModelParameterRule     `gorm:"-" json:"parameter_rules,omitempty"`
+	RawResponseDenylist []string                         `gorm:"-" json:"raw_response_denylist,omitempty"`
+
 	// Foreign keys
 	Models []TableModel `gorm:"foreignKey:ProviderID;constraint:OnDelete:CASCADE" json:"models"`
 
@@ -79,6 +93,38 @@ func (p *TableProvider) BeforeSave(tx *gorm.DB) error {
 		}
 		p.CustomProviderConfigJSON = string(data)
 	}
+	p.BulkheadJSON = ""
+	if p.Bulkhead != nil {
+		data, err := json.Marshal(p.Bulkhead)
+		if err != nil {
+			return err
+		}
+		p.BulkheadJSON = string(data)
+	}
+	p.OutboundRateLimitJSON = ""
+	if p.OutboundRateLimit != nil {
+		data, err := json.Marshal(p.OutboundRateLimit)
+		if err != nil {
+			return err
+		}
+		p.OutboundRateLimitJSON = string(data)
+	}
+	p.ParameterRulesJSON = ""
+	if len(p.ParameterRules) > 0 {
+		data, err := json.Marshal(p.ParameterRules)
+		if err != nil {
+			return err
+		}
+		p.ParameterRulesJSON = string(data)
+	}
+	p.RawResponseDenylistJSON = ""
+	if len(p.RawResponseDenylist) > 0 {
+		data, err := json.Marshal(p.RawResponseDenylist)
+		if err != nil {
+			return err
+		}
+		p.RawResponseDenylistJSON = string(data)
+	}
 	return nil
 }
 
@@ -116,5 +162,33 @@ func (p *TableProvider) AfterFind(tx *gorm.DB) error {
 		p.CustomProviderConfig = &customConfig
 	}
 
+	if p.BulkheadJSON != "" {
+		var bulkhead schemas.BulkheadConfig
+		if err := json.Unmarshal([]byte(p.BulkheadJSON), &bulkhead); err != nil {
+			return err
+		}
+		p.Bulkhead = &bulkhead
+	}
+
+	if p.OutboundRateLimitJSON != "" {
+		var rateLimit schemas.OutboundRateLimitConfig
+		if err := json.Unmarshal([]byte(p.OutboundRateLimitJSON), &rateLimit); err != nil {
+			return err
+		}
+		p.OutboundRateLimit = &rateLimit
+	}
+
+	if p.ParameterRulesJSON != "" {
+		if err := json.Unmarshal([]byte(p.ParameterRulesJSON), &p.ParameterRules); err != nil {
+			return err
+		}
+	}
+
+	if p.RawResponseDenylistJSON != "" {
+		if err := json.Unmarshal([]byte(p.RawResponseDenylistJSON), &p.RawResponseDenylist); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -104,7 +104,12 @@ func (mc *ModelCatalog) CalculateCost(result *schemas.BifrostResponse) float64 {
 	cost := 0.0
 	if usage != nil || audioSeconds != nil || audioTokenDetails != nil {
 		extraFields := result.GetExtraFields()
-		cost = mc.CalculateCostFromUsage(string(extraFields.Provider), extraFields.ModelRequested, extraFields.ModelDeployment, usage, extraFields.RequestType, isBatch, audioSeconds, audioTokenDetails)
+		// Price the normalized model, the model sent by the client may be an alias
+		model := extraFields.ModelRequested
+		if extraFields.ModelNormalized != "" {
+			model = extraFields.ModelNormalized
+		}
+		cost = mc.CalculateCostFromUsage(string(extraFields.Provider), model, extraFields.ModelDeployment, usage, extraFields.RequestType, isBatch, audioSeconds, audioTokenDetails)
 	}
 
 	return cost
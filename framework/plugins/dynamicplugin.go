@@ -1,6 +1,7 @@
 package plugins
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"plugin"
@@ -26,6 +27,7 @@ type DynamicPlugin struct {
 	preHook              func(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error)
 	postHook             func(ctx *schemas.BifrostContext, resp *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error)
 	cleanup              func() error
+	flush                func(ctx context.Context) error // optional, nil if the plugin does not export Flush
 }
 
 // GetName returns the name of the plugin
@@ -53,6 +55,14 @@ func (dp *DynamicPlugin) Cleanup() error {
 	return dp.cleanup()
 }
 
+// Flush waits for the pending asynchronous work of the plugin, if it exports a Flush function
+func (dp *DynamicPlugin) Flush(ctx context.Context) error {
+	if dp.flush == nil {
+		return nil
+	}
+	return dp.flush(ctx)
+}
+
 // loadDynamicPlugin loads a dynamic plugin from a path
 func loadDynamicPlugin(path string, config any) (schemas.Plugin, error) {
 	dp := &DynamicPlugin{
@@ -169,6 +179,15 @@ func loadDynamicPlugin(path string, config any) (schemas.Plugin, error) {
 	if dp.cleanup, ok = cleanupSym.(func() error); !ok {
 		return nil, fmt.Errorf("failed to cast Cleanup to func() error")
 	}
+	// Looking up for optional Flush method
+	flushSym, err := plugin.Lookup("Flush")
+	if err != nil {
+		if !strings.Contains(err.Error(), "symbol Flush not found") {
+			return nil, err
+		}
+	} else if dp.flush, ok = flushSym.(func(ctx context.Context) error); !ok {
+		return nil, fmt.Errorf("failed to cast Flush to func(ctx context.Context) error")
+	}
 	dp.plugin = plugin
 	return dp, nil
 }
@@ -44,6 +44,7 @@ func (a *Accumulator) putChatStreamChunk(chunk *ChatStreamChunk) {
 	chunk.FinishReason = nil
 	chunk.TokenUsage = nil
 	chunk.RawResponse = nil
+	chunk.Segment = 0
 	a.chatStreamChunkPool.Put(chunk)
 }
 
@@ -98,6 +99,7 @@ func (a *Accumulator) putResponsesStreamChunk(chunk *ResponsesStreamChunk) {
 	chunk.FinishReason = nil
 	chunk.TokenUsage = nil
 	chunk.RawResponse = nil
+	chunk.Segment = 0
 	a.responsesStreamChunkPool.Put(chunk)
 }
 
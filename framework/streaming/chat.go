@@ -16,6 +16,9 @@ func (a *Accumulator) buildCompleteMessageFromChatStreamChunks(chunks []*ChatStr
 		Content: &schemas.ChatMessageContent{},
 	}
 	sort.Slice(chunks, func(i, j int) bool {
+		if chunks[i].Segment != chunks[j].Segment {
+			return chunks[i].Segment < chunks[j].Segment
+		}
 		return chunks[i].ChunkIndex < chunks[j].ChunkIndex
 	})
 	for _, chunk := range chunks {
@@ -193,7 +196,14 @@ func (a *Accumulator) processAccumulatedChatStreamingChunks(requestID string, re
 	// Update token usage from final chunk if available
 	if len(accumulator.ChatStreamChunks) > 0 {
 		lastChunk := accumulator.ChatStreamChunks[len(accumulator.ChatStreamChunks)-1]
-		if lastChunk.TokenUsage != nil {
+		if lastChunk.Segment > 0 {
+			// The stream was resumed on new connections, merge the usage of every segment
+			usages := make([]segmentUsage, 0, len(accumulator.ChatStreamChunks))
+			for _, chunk := range accumulator.ChatStreamChunks {
+				usages = append(usages, segmentUsage{segment: chunk.Segment, usage: chunk.TokenUsage})
+			}
+			data.TokenUsage = accumulateSegmentUsage(usages)
+		} else if lastChunk.TokenUsage != nil {
 			data.TokenUsage = lastChunk.TokenUsage
 		}
 		// Handle cache debug
@@ -211,8 +221,11 @@ func (a *Accumulator) processAccumulatedChatStreamingChunks(requestID string, re
 	}
 	// Accumulate raw response
 	if len(accumulator.ChatStreamChunks) > 0 {
-		// Sort chunks by chunk index
+		// Sort chunks by segment and chunk index
 		sort.Slice(accumulator.ChatStreamChunks, func(i, j int) bool {
+			if accumulator.ChatStreamChunks[i].Segment != accumulator.ChatStreamChunks[j].Segment {
+				return accumulator.ChatStreamChunks[i].Segment < accumulator.ChatStreamChunks[j].Segment
+			}
 			return accumulator.ChatStreamChunks[i].ChunkIndex < accumulator.ChatStreamChunks[j].ChunkIndex
 		})
 		for _, chunk := range accumulator.ChatStreamChunks {
@@ -268,6 +281,7 @@ func (a *Accumulator) processChatStreamingResponse(ctx *schemas.BifrostContext,
 			chunk.TokenUsage = result.TextCompletionResponse.Usage
 		}
 		chunk.ChunkIndex = result.TextCompletionResponse.ExtraFields.ChunkIndex
+		chunk.Segment = result.TextCompletionResponse.ExtraFields.StreamSegment
 		if isFinalChunk {
 			if a.pricingManager != nil {
 				cost := a.pricingManager.CalculateCostWithCacheDebug(result)
@@ -291,6 +305,7 @@ func (a *Accumulator) processChatStreamingResponse(ctx *schemas.BifrostContext,
 			chunk.TokenUsage = result.ChatResponse.Usage
 		}
 		chunk.ChunkIndex = result.ChatResponse.ExtraFields.ChunkIndex
+		chunk.Segment = result.ChatResponse.ExtraFields.StreamSegment
 		if result.ChatResponse.ExtraFields.RawResponse != nil {
 			chunk.RawResponse = bifrost.Ptr(fmt.Sprintf("%v", result.ChatResponse.ExtraFields.RawResponse))
 		}
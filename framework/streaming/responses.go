@@ -427,11 +427,14 @@ func deepCopyResponsesMessageContentBlock(original schemas.ResponsesMessageConte
 func (a *Accumulator) buildCompleteMessageFromResponsesStreamChunks(chunks []*ResponsesStreamChunk) []schemas.ResponsesMessage {
 	var messages []schemas.ResponsesMessage
 
-	// Sort chunks by chunk index to ensure correct processing order
+	// Sort chunks by segment and chunk index to ensure correct processing order
 	sort.Slice(chunks, func(i, j int) bool {
 		if chunks[i].StreamResponse == nil || chunks[j].StreamResponse == nil {
 			return false
 		}
+		if chunks[i].Segment != chunks[j].Segment {
+			return chunks[i].Segment < chunks[j].Segment
+		}
 		return chunks[i].ChunkIndex < chunks[j].ChunkIndex
 	})
 
@@ -783,7 +786,14 @@ func (a *Accumulator) processAccumulatedResponsesStreamingChunks(requestID strin
 	// Update token usage from final chunk if available
 	if len(accumulator.ResponsesStreamChunks) > 0 {
 		lastChunk := accumulator.ResponsesStreamChunks[len(accumulator.ResponsesStreamChunks)-1]
-		if lastChunk.TokenUsage != nil {
+		if lastChunk.Segment > 0 {
+			// The stream was resumed on new connections, merge the usage of every segment
+			usages := make([]segmentUsage, 0, len(accumulator.ResponsesStreamChunks))
+			for _, chunk := range accumulator.ResponsesStreamChunks {
+				usages = append(usages, segmentUsage{segment: chunk.Segment, usage: chunk.TokenUsage})
+			}
+			data.TokenUsage = accumulateSegmentUsage(usages)
+		} else if lastChunk.TokenUsage != nil {
 			data.TokenUsage = lastChunk.TokenUsage
 		}
 		// Handle cache debug
@@ -803,8 +813,11 @@ func (a *Accumulator) processAccumulatedResponsesStreamingChunks(requestID strin
 
 	// Accumulate raw response
 	if len(accumulator.ResponsesStreamChunks) > 0 {
-		// Sort chunks by chunk index
+		// Sort chunks by segment and chunk index
 		sort.Slice(accumulator.ResponsesStreamChunks, func(i, j int) bool {
+			if accumulator.ResponsesStreamChunks[i].Segment != accumulator.ResponsesStreamChunks[j].Segment {
+				return accumulator.ResponsesStreamChunks[i].Segment < accumulator.ResponsesStreamChunks[j].Segment
+			}
 			return accumulator.ResponsesStreamChunks[i].ChunkIndex < accumulator.ResponsesStreamChunks[j].ChunkIndex
 		})
 		for _, chunk := range accumulator.ResponsesStreamChunks {
@@ -971,6 +984,7 @@ func (a *Accumulator) processResponsesStreamingResponse(ctx *schemas.BifrostCont
 			}
 		}
 		chunk.ChunkIndex = result.ResponsesStreamResponse.ExtraFields.ChunkIndex
+		chunk.Segment = result.ResponsesStreamResponse.ExtraFields.StreamSegment
 		if isFinalChunk {
 			if a.pricingManager != nil {
 				cost := a.pricingManager.CalculateCostWithCacheDebug(result)
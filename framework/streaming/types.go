@@ -82,6 +82,7 @@ type ChatStreamChunk struct {
 	Cost               *float64                               // Cost in dollars from pricing plugin
 	ErrorDetails       *schemas.BifrostError                  // Error if any
 	ChunkIndex         int                                    // Index of the chunk in the stream
+	Segment            int                                    // Connection segment of the stream, incremented on each reconnect
 	RawResponse        *string                                // Raw response if available
 }
 
@@ -95,6 +96,7 @@ type ResponsesStreamChunk struct {
 	Cost               *float64                                // Cost in dollars from pricing plugin
 	ErrorDetails       *schemas.BifrostError                   // Error if any
 	ChunkIndex         int                                     // Index of the chunk in the stream
+	Segment            int                                     // Connection segment of the stream, incremented on each reconnect
 	RawResponse        *string
 }
 
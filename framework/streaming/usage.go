@@ -0,0 +1,68 @@
+package streaming
+
+import (
+	"sort"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+)
+
+// segmentUsage is the token usage carried by a stream chunk, with the connection segment it was received on.
+type segmentUsage struct {
+	segment int
+	usage   *schemas.BifrostLLMUsage
+}
+
+// accumulateSegmentUsage merges the token usage of a stream resumed on new connections.
+// Providers report usage cumulatively within a connection, so the last usage of each segment is kept.
+// Completion tokens are then summed across segments, while prompt tokens are taken once, from the first
+// segment reporting them, as every reconnect sends the same input again.
+// Usages must be in the order they were received.
+func accumulateSegmentUsage(usages []segmentUsage) *schemas.BifrostLLMUsage {
+	var segments []int
+	lastUsage := make(map[int]*schemas.BifrostLLMUsage)
+	for _, u := range usages {
+		if u.usage == nil {
+			continue
+		}
+		if _, ok := lastUsage[u.segment]; !ok {
+			segments = append(segments, u.segment)
+		}
+		lastUsage[u.segment] = u.usage
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+	sort.Ints(segments)
+
+	merged := &schemas.BifrostLLMUsage{}
+	for _, segment := range segments {
+		usage := lastUsage[segment]
+		if merged.PromptTokens == 0 {
+			merged.PromptTokens = usage.PromptTokens
+			merged.PromptTokensDetails = usage.PromptTokensDetails
+		}
+		merged.CompletionTokens += usage.CompletionTokens
+		merged.CompletionTokensDetails = addCompletionTokensDetails(merged.CompletionTokensDetails, usage.CompletionTokensDetails)
+	}
+	// Provider-reported cost only covers its own segment, so it is not carried over
+	merged.TotalTokens = merged.PromptTokens + merged.CompletionTokens
+	return merged
+}
+
+// addCompletionTokensDetails returns the sum of two completion token breakdowns, either of which may be nil.
+func addCompletionTokensDetails(a, b *schemas.ChatCompletionTokensDetails) *schemas.ChatCompletionTokensDetails {
+	if b == nil {
+		return a
+	}
+	sum := schemas.ChatCompletionTokensDetails{}
+	if a != nil {
+		sum = *a
+	}
+	sum.TextTokens += b.TextTokens
+	sum.AcceptedPredictionTokens += b.AcceptedPredictionTokens
+	sum.AudioTokens += b.AudioTokens
+	sum.ReasoningTokens += b.ReasoningTokens
+	sum.RejectedPredictionTokens += b.RejectedPredictionTokens
+	sum.CachedTokens += b.CachedTokens
+	return &sum
+}
@@ -0,0 +1,79 @@
+package streaming
+
+import (
+	"context"
+	"testing"
+
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+)
+
+func newChatStreamResult(segment, chunkIndex int, content string, usage *schemas.BifrostLLMUsage) *schemas.BifrostResponse {
+	return &schemas.BifrostResponse{
+		ChatResponse: &schemas.BifrostChatResponse{
+			Choices: []schemas.BifrostResponseChoice{{
+				ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{
+					Delta: &schemas.ChatStreamResponseChoiceDelta{Content: schemas.Ptr(content)},
+				},
+			}},
+			Usage: usage,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType:   schemas.ChatCompletionStreamRequest,
+				Provider:      schemas.OpenAI,
+				ChunkIndex:    chunkIndex,
+				StreamSegment: segment,
+			},
+		},
+	}
+}
+
+// Test that usage reported by a stream resumed on a new connection is merged with the usage of the first
+// connection, summing completion tokens and counting input tokens once
+func TestAccumulator_UsageAcrossReconnect(t *testing.T) {
+	accumulator := NewAccumulator(nil, bifrost.NewDefaultLogger(schemas.LogLevelError))
+	defer accumulator.Cleanup()
+
+	// Request ID and stream end indicator are reserved keys set by bifrost, so they come from the parent context
+	parent := context.WithValue(context.Background(), schemas.BifrostContextKeyRequestID, "req-reconnect")
+
+	chunks := []*schemas.BifrostResponse{
+		// First connection, dropped after reporting usage for the partial output
+		newChatStreamResult(0, 0, "Hello", nil),
+		newChatStreamResult(0, 1, " wor", &schemas.BifrostLLMUsage{PromptTokens: 100, CompletionTokens: 12, TotalTokens: 112}),
+		// Second connection resends the input, its usage covers only the rest of the output
+		newChatStreamResult(1, 0, "ld", &schemas.BifrostLLMUsage{PromptTokens: 100, CompletionTokens: 5, TotalTokens: 105}),
+		newChatStreamResult(1, 1, "!", &schemas.BifrostLLMUsage{PromptTokens: 100, CompletionTokens: 8, TotalTokens: 108}),
+	}
+	var processed *ProcessedStreamResponse
+	for i, chunk := range chunks {
+		if i == len(chunks)-1 {
+			parent = context.WithValue(parent, schemas.BifrostContextKeyStreamEndIndicator, true)
+		}
+		ctx := schemas.NewBifrostContext(parent, schemas.NoDeadline)
+		var err error
+		processed, err = accumulator.ProcessStreamingResponse(ctx, chunk, nil)
+		if err != nil {
+			t.Fatalf("failed to process chunk %d: %v", i, err)
+		}
+	}
+
+	if processed == nil || processed.Type != StreamResponseTypeFinal || processed.Data == nil {
+		t.Fatalf("expected final accumulated data, got %+v", processed)
+	}
+	usage := processed.Data.TokenUsage
+	if usage == nil {
+		t.Fatal("expected token usage to be accumulated")
+	}
+	if usage.PromptTokens != 100 {
+		t.Errorf("expected input tokens to be counted once (100), got %d", usage.PromptTokens)
+	}
+	if usage.CompletionTokens != 20 {
+		t.Errorf("expected completion tokens summed across segments (12 + 8 = 20), got %d", usage.CompletionTokens)
+	}
+	if usage.TotalTokens != 120 {
+		t.Errorf("expected total tokens 120, got %d", usage.TotalTokens)
+	}
+	if content := processed.Data.OutputMessage.Content.ContentStr; content == nil || *content != "Hello world!" {
+		t.Errorf("expected segments to be joined in order, got %v", content)
+	}
+}
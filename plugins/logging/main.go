@@ -102,6 +102,7 @@ type LoggerPlugin struct {
 	mu                    sync.Mutex
 	done                  chan struct{}
 	wg                    sync.WaitGroup
+	pendingWg             sync.WaitGroup // Pending asynchronous log writes from PreHook and PostHook
 	logger                schemas.Logger
 	logCallback           LogCallback
 	droppedRequests       atomic.Int64
@@ -295,7 +296,9 @@ func (p *LoggerPlugin) PreHook(ctx *schemas.BifrostContext, req *schemas.Bifrost
 	logMsg.InitialData = initialData
 	logMsg.FallbackIndex = fallbackIndex
 
+	p.pendingWg.Add(1)
 	go func(msg *LogMessage) {
+		defer p.pendingWg.Done()
 		defer p.putLogMessage(msg) // Return to pool when done
 		if err := p.insertInitialLogEntry(
 			p.ctx,
@@ -367,7 +370,9 @@ func (p *LoggerPlugin) PostHook(ctx *schemas.BifrostContext, result *schemas.Bif
 	virtualKeyName := getStringFromContext(ctx, schemas.BifrostContextKey("bf-governance-virtual-key-name"))
 	numberOfRetries := getIntFromContext(ctx, schemas.BifrostContextKeyNumberOfRetries)
 
+	p.pendingWg.Add(1)
 	go func() {
+		defer p.pendingWg.Done()
 		requestType, _, _ := bifrost.GetResponseFields(result, bifrostErr)
 		// Queue the log update message (non-blocking) - use same pattern for both streaming and regular
 		logMsg := p.getLogMessage()
@@ -607,6 +612,11 @@ func (p *LoggerPlugin) PostHook(ctx *schemas.BifrostContext, result *schemas.Bif
 	return result, bifrostErr, nil
 }
 
+// Flush waits for the pending log writes of PreHook and PostHook, so that no log is lost on shutdown
+func (p *LoggerPlugin) Flush(ctx context.Context) error {
+	return bifrost.WaitWithContext(ctx, &p.pendingWg)
+}
+
 // Cleanup is called when the plugin is being shut down
 func (p *LoggerPlugin) Cleanup() error {
 	// Stop the cleanup ticker
@@ -6,8 +6,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/framework/logstore"
 	"github.com/maximhq/bifrost/framework/streaming"
 )
@@ -125,7 +125,7 @@ func (p *LoggerPlugin) updateLogEntry(
 
 		// Handle raw request marshaling and logging
 		if data.RawRequest != nil {
-			rawRequestBytes, err := sonic.Marshal(data.RawRequest)
+			rawRequestBytes, err := serialization.Marshal(data.RawRequest)
 			if err != nil {
 				p.logger.Error("failed to marshal raw request: %v", err)
 			} else {
@@ -171,7 +171,7 @@ func (p *LoggerPlugin) updateLogEntry(
 	}
 
 	if p.disableContentLogging == nil || !*p.disableContentLogging && data.RawResponse != nil {
-		rawResponseBytes, err := sonic.Marshal(data.RawResponse)
+		rawResponseBytes, err := serialization.Marshal(data.RawResponse)
 		if err != nil {
 			p.logger.Error("failed to marshal raw response: %v", err)
 		} else {
@@ -304,7 +304,7 @@ func (p *LoggerPlugin) updateStreamingLogEntry(
 		}
 		// Handle raw request from stream updates
 		if streamResponse.RawRequest != nil && *streamResponse.RawRequest != nil {
-			rawRequestBytes, err := sonic.Marshal(*streamResponse.RawRequest)
+			rawRequestBytes, err := serialization.Marshal(*streamResponse.RawRequest)
 			if err != nil {
 				p.logger.Error("failed to marshal raw request: %v", err)
 			} else {
@@ -3,6 +3,7 @@
 package maxim
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -48,6 +49,7 @@ type Plugin struct {
 	loggerMutex      *sync.RWMutex
 	accumulator      *streaming.Accumulator
 	logger           schemas.Logger
+	pendingWg        sync.WaitGroup // Pending asynchronous trace updates from PostHook
 }
 
 // Init initializes and returns a Plugin instance for Maxim's logger.
@@ -445,7 +447,9 @@ func (plugin *Plugin) PostHook(ctx *schemas.BifrostContext, result *schemas.Bifr
 		return result, bifrostErr, nil
 	}
 
+	plugin.pendingWg.Add(1)
 	go func() {
+		defer plugin.pendingWg.Done()
 		requestType, _, model := bifrost.GetResponseFields(result, bifrostErr)
 
 		var streamResponse *streaming.ProcessedStreamResponse
@@ -546,6 +550,11 @@ func (plugin *Plugin) PostHook(ctx *schemas.BifrostContext, result *schemas.Bifr
 	return result, bifrostErr, nil
 }
 
+// Flush waits for the pending trace updates of PostHook, which are flushed to Maxim as they complete
+func (plugin *Plugin) Flush(ctx context.Context) error {
+	return bifrost.WaitWithContext(ctx, &plugin.pendingWg)
+}
+
 func (plugin *Plugin) Cleanup() error {
 	if plugin.accumulator != nil {
 		plugin.accumulator.Cleanup()
@@ -452,6 +452,18 @@ func getFileContentRequestParams(req *schemas.BifrostFileContentRequest) []*KeyV
 	return params
 }
 
+// getFileDownloadURLRequestParams handles the file download url request
+func getFileDownloadURLRequestParams(req *schemas.BifrostFileDownloadURLRequest) []*KeyValue {
+	params := []*KeyValue{}
+	if req.FileID != "" {
+		params = append(params, kvStr("gen_ai.file.file_id", req.FileID))
+	}
+	if req.TTL > 0 {
+		params = append(params, kvInt("gen_ai.file.download_url.ttl_seconds", int64(req.TTL.Seconds())))
+	}
+	return params
+}
+
 // getBatchCreateRequestParams handles the batch create request
 func getBatchCreateRequestParams(req *schemas.BifrostBatchCreateRequest) []*KeyValue {
 	params := []*KeyValue{}
@@ -608,6 +620,9 @@ func (p *OtelPlugin) createResourceSpan(traceID, spanID string, timestamp time.T
 	case schemas.FileContentRequest:
 		spanName = "gen_ai.file.content"
 		params = append(params, getFileContentRequestParams(req.FileContentRequest)...)
+	case schemas.FileDownloadURLRequest:
+		spanName = "gen_ai.file.download_url"
+		params = append(params, getFileDownloadURLRequestParams(req.FileDownloadURLRequest)...)
 	}
 	attributes := append(p.attributesFromEnvironment, kvStr("service.name", p.serviceName), kvStr("service.version", p.bifrostVersion))
 	// Preparing final resource span
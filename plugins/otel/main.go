@@ -9,9 +9,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/bytedance/sonic"
 	bifrost "github.com/maximhq/bifrost/core"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/framework/modelcatalog"
 	"github.com/maximhq/bifrost/framework/streaming"
 	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
@@ -174,17 +174,17 @@ func (p *OtelPlugin) ValidateConfig(config any) (*Config, error) {
 	var otelConfig Config
 	// Checking if its a string, then we will JSON parse and confirm
 	if configStr, ok := config.(string); ok {
-		if err := sonic.Unmarshal([]byte(configStr), &otelConfig); err != nil {
+		if err := serialization.Unmarshal([]byte(configStr), &otelConfig); err != nil {
 			return nil, err
 		}
 	}
 	// Checking if its a map[string]any, then we will JSON parse and confirm
 	if configMap, ok := config.(map[string]any); ok {
-		configString, err := sonic.Marshal(configMap)
+		configString, err := serialization.Marshal(configMap)
 		if err != nil {
 			return nil, err
 		}
-		if err := sonic.Unmarshal([]byte(configString), &otelConfig); err != nil {
+		if err := serialization.Unmarshal([]byte(configString), &otelConfig); err != nil {
 			return nil, err
 		}
 	}
@@ -324,6 +324,11 @@ func (p *OtelPlugin) PostHook(ctx *schemas.BifrostContext, resp *schemas.Bifrost
 	return resp, bifrostErr, nil
 }
 
+// Flush waits for the pending span emissions of PostHook
+func (p *OtelPlugin) Flush(ctx context.Context) error {
+	return bifrost.WaitWithContext(ctx, &p.emitWg)
+}
+
 // Cleanup function for the OTEL plugin
 func (p *OtelPlugin) Cleanup() error {
 	p.emitWg.Wait()
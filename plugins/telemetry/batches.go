@@ -0,0 +1,189 @@
+package telemetry
+
+import (
+	"sync"
+
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	batchRequestCountKey schemas.BifrostContextKey = "bf-prom-batch-request-count"
+)
+
+// maxFinishedBatches bounds the number of finished batch IDs remembered, so that batches polled after they
+// finished are only counted once.
+const maxFinishedBatches = 10000
+
+// finishedBatchSet remembers the most recent finished batches, evicting the oldest ones once full.
+type finishedBatchSet struct {
+	mu    sync.Mutex
+	ids   map[string]struct{}
+	order []string
+	next  int
+}
+
+func newFinishedBatchSet(size int) *finishedBatchSet {
+	return &finishedBatchSet{ids: make(map[string]struct{}, size), order: make([]string, 0, size)}
+}
+
+// add records a finished batch, and reports whether it was not recorded yet.
+func (s *finishedBatchSet) add(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.ids[id]; ok {
+		return false
+	}
+	if len(s.order) < cap(s.order) {
+		s.order = append(s.order, id)
+	} else {
+		delete(s.ids, s.order[s.next])
+		s.order[s.next] = id
+		s.next = (s.next + 1) % len(s.order)
+	}
+	s.ids[id] = struct{}{}
+	return true
+}
+
+// batchFileMetrics holds the metrics of the batch and file operations, labelled by provider only: they are
+// few and costly, and carry no model or usage.
+type batchFileMetrics struct {
+	BatchesCreatedTotal       *prometheus.CounterVec
+	BatchesCompletedTotal     *prometheus.CounterVec
+	BatchesFailedTotal        *prometheus.CounterVec
+	BatchSubmissionRequests   *prometheus.HistogramVec
+	FileUploadsTotal          *prometheus.CounterVec
+	FileDownloadsTotal        *prometheus.CounterVec
+	FileTransferredBytesTotal *prometheus.CounterVec
+
+	finishedBatches *finishedBatchSet
+}
+
+func newBatchFileMetrics(factory promauto.Factory) batchFileMetrics {
+	labels := []string{"provider"}
+	return batchFileMetrics{
+		BatchesCreatedTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "bifrost_batches_created_total",
+			Help: "Total number of batches created on upstream providers by Bifrost.",
+		}, labels),
+		BatchesCompletedTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "bifrost_batches_completed_total",
+			Help: "Total number of batches seen completed, including partially completed ones.",
+		}, labels),
+		BatchesFailedTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "bifrost_batches_failed_total",
+			Help: "Total number of batches seen failed or expired.",
+		}, labels),
+		BatchSubmissionRequests: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "bifrost_batch_submission_requests",
+			Help:    "Number of requests of the batches created, when known at creation.",
+			Buckets: prometheus.ExponentialBuckets(1, 4, 10), // 1 to ~260k requests
+		}, labels),
+		FileUploadsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "bifrost_file_uploads_total",
+			Help: "Total number of files uploaded to upstream providers by Bifrost.",
+		}, labels),
+		FileDownloadsTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "bifrost_file_downloads_total",
+			Help: "Total number of file contents downloaded from upstream providers by Bifrost.",
+		}, labels),
+		FileTransferredBytesTotal: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "bifrost_file_transferred_bytes_total",
+			Help: "Total number of bytes of files uploaded to and downloaded from upstream providers, by direction (upload/download).",
+		}, append(labels, "direction")),
+		finishedBatches: newFinishedBatchSet(maxFinishedBatches),
+	}
+}
+
+// recordBatchFileMetrics records the metrics of a successful batch or file operation. Batches are counted as
+// completed or failed the first time they are retrieved or listed in a final state.
+func (m *batchFileMetrics) recordBatchFileMetrics(ctx *schemas.BifrostContext, provider schemas.ModelProvider, result *schemas.BifrostResponse) {
+	if result == nil {
+		return
+	}
+	switch {
+	case result.BatchCreateResponse != nil:
+		m.BatchesCreatedTotal.WithLabelValues(string(provider)).Inc()
+		requests := result.BatchCreateResponse.RequestCounts.Total
+		if count, ok := ctx.Value(batchRequestCountKey).(int); ok && count > 0 {
+			requests = count
+		}
+		if requests > 0 {
+			m.BatchSubmissionRequests.WithLabelValues(string(provider)).Observe(float64(requests))
+		}
+	case result.BatchRetrieveResponse != nil:
+		m.recordBatchStatus(provider, result.BatchRetrieveResponse.ID, result.BatchRetrieveResponse.Status)
+	case result.BatchListResponse != nil:
+		for _, batch := range result.BatchListResponse.Data {
+			m.recordBatchStatus(provider, batch.ID, batch.Status)
+		}
+	case result.FileUploadResponse != nil:
+		m.FileUploadsTotal.WithLabelValues(string(provider)).Inc()
+		m.FileTransferredBytesTotal.WithLabelValues(string(provider), "upload").Add(float64(result.FileUploadResponse.Bytes))
+	case result.FileContentResponse != nil:
+		m.FileDownloadsTotal.WithLabelValues(string(provider)).Inc()
+		m.FileTransferredBytesTotal.WithLabelValues(string(provider), "download").Add(float64(len(result.FileContentResponse.Content)))
+	}
+}
+
+// recordBatchStatus counts a batch as completed or failed if it is in a final state and was not counted yet.
+func (m *batchFileMetrics) recordBatchStatus(provider schemas.ModelProvider, id string, status schemas.BatchStatus) {
+	var counter *prometheus.CounterVec
+	switch status {
+	case schemas.BatchStatusCompleted, schemas.BatchStatusEnded, schemas.BatchStatusPartiallyCompleted:
+		counter = m.BatchesCompletedTotal
+	case schemas.BatchStatusFailed, schemas.BatchStatusExpired:
+		counter = m.BatchesFailedTotal
+	default:
+		return
+	}
+	if id == "" || !m.finishedBatches.add(string(provider)+"/"+id) {
+		return
+	}
+	counter.WithLabelValues(string(provider)).Inc()
+}
+
+// S3OperationStatsSource returns the S3 operations sent by the providers so far (see bifrost.GetS3OperationStats).
+type S3OperationStatsSource func() map[schemas.ModelProvider]map[string]schemas.S3OperationStats
+
+// s3OperationCollector exposes the S3 operations sent for batches and files as Prometheus metrics. Their latency
+// is reported as a summary without quantiles: its sum and count give the average latency over any window.
+type s3OperationCollector struct {
+	source   S3OperationStatsSource
+	duration *prometheus.Desc
+	errors   *prometheus.Desc
+}
+
+func newS3OperationCollector(source S3OperationStatsSource) *s3OperationCollector {
+	labels := []string{"provider", "operation"}
+	return &s3OperationCollector{
+		source:   source,
+		duration: prometheus.NewDesc("bifrost_s3_operation_duration_seconds", "Latency of the S3 operations sent by providers for batches and files.", labels, nil),
+		errors:   prometheus.NewDesc("bifrost_s3_operation_errors_total", "Total number of S3 operations that failed or got an error response.", labels, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *s3OperationCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.duration
+	ch <- c.errors
+}
+
+// Collect implements prometheus.Collector.
+func (c *s3OperationCollector) Collect(ch chan<- prometheus.Metric) {
+	for provider, operations := range c.source() {
+		for operation, stats := range operations {
+			ch <- prometheus.MustNewConstSummary(c.duration, stats.Count, stats.DurationSeconds, nil, string(provider), operation)
+			ch <- prometheus.MustNewConstMetric(c.errors, prometheus.CounterValue, float64(stats.Errors), string(provider), operation)
+		}
+	}
+}
+
+// RegisterS3OperationStats registers a collector exposing the S3 operations sent by the providers from the given source.
+func (p *PrometheusPlugin) RegisterS3OperationStats(source S3OperationStatsSource) error {
+	if source == nil {
+		return nil
+	}
+	return p.registry.Register(newS3OperationCollector(source))
+}
@@ -0,0 +1,107 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bifrost "github.com/maximhq/bifrost/core"
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// Test that batch and file operations increment their counters, and that finished batches are counted once however often they are polled
+func TestBatchFileMetrics(t *testing.T) {
+	plugin, err := Init(&Config{}, nil, bifrost.NewDefaultLogger(schemas.LogLevelError))
+	if err != nil {
+		t.Fatalf("failed to initialize plugin: %v", err)
+	}
+	extraFields := schemas.BifrostResponseExtraFields{Provider: schemas.Bedrock}
+	send := func(req *schemas.BifrostRequest, result *schemas.BifrostResponse) {
+		ctx := schemas.NewBifrostContext(context.Background(), time.Time{})
+		if _, _, err := plugin.PreHook(ctx, req); err != nil {
+			t.Fatalf("unexpected PreHook error: %v", err)
+		}
+		if _, _, err := plugin.PostHook(ctx, result, nil); err != nil {
+			t.Fatalf("unexpected PostHook error: %v", err)
+		}
+	}
+
+	send(&schemas.BifrostRequest{
+		RequestType: schemas.BatchCreateRequest,
+		BatchCreateRequest: &schemas.BifrostBatchCreateRequest{
+			Provider: schemas.Bedrock,
+			Requests: []schemas.BatchRequestItem{{CustomID: "1"}, {CustomID: "2"}, {CustomID: "3"}},
+		},
+	}, &schemas.BifrostResponse{BatchCreateResponse: &schemas.BifrostBatchCreateResponse{ID: "batch-1", ExtraFields: extraFields}})
+	for _, status := range []schemas.BatchStatus{schemas.BatchStatusInProgress, schemas.BatchStatusCompleted, schemas.BatchStatusCompleted} {
+		send(&schemas.BifrostRequest{RequestType: schemas.BatchRetrieveRequest}, &schemas.BifrostResponse{
+			BatchRetrieveResponse: &schemas.BifrostBatchRetrieveResponse{ID: "batch-1", Status: status, ExtraFields: extraFields},
+		})
+	}
+	send(&schemas.BifrostRequest{RequestType: schemas.BatchListRequest}, &schemas.BifrostResponse{
+		BatchListResponse: &schemas.BifrostBatchListResponse{
+			Data: []schemas.BifrostBatchRetrieveResponse{
+				{ID: "batch-1", Status: schemas.BatchStatusCompleted},
+				{ID: "batch-2", Status: schemas.BatchStatusFailed},
+			},
+			ExtraFields: extraFields,
+		},
+	})
+	send(&schemas.BifrostRequest{RequestType: schemas.FileUploadRequest}, &schemas.BifrostResponse{
+		FileUploadResponse: &schemas.BifrostFileUploadResponse{ID: "s3://bucket/input.jsonl", Bytes: 1024, ExtraFields: extraFields},
+	})
+	send(&schemas.BifrostRequest{RequestType: schemas.FileContentRequest}, &schemas.BifrostResponse{
+		FileContentResponse: &schemas.BifrostFileContentResponse{FileID: "s3://bucket/output.jsonl", Content: make([]byte, 300), ExtraFields: extraFields},
+	})
+	if err := plugin.Flush(context.Background()); err != nil {
+		t.Fatalf("failed to flush plugin: %v", err)
+	}
+
+	provider := string(schemas.Bedrock)
+	counters := map[string]float64{
+		"batches created":   testutil.ToFloat64(plugin.BatchesCreatedTotal.WithLabelValues(provider)),
+		"batches completed": testutil.ToFloat64(plugin.BatchesCompletedTotal.WithLabelValues(provider)),
+		"batches failed":    testutil.ToFloat64(plugin.BatchesFailedTotal.WithLabelValues(provider)),
+		"file uploads":      testutil.ToFloat64(plugin.FileUploadsTotal.WithLabelValues(provider)),
+		"file downloads":    testutil.ToFloat64(plugin.FileDownloadsTotal.WithLabelValues(provider)),
+		"uploaded bytes":    testutil.ToFloat64(plugin.FileTransferredBytesTotal.WithLabelValues(provider, "upload")),
+		"downloaded bytes":  testutil.ToFloat64(plugin.FileTransferredBytesTotal.WithLabelValues(provider, "download")),
+	}
+	want := map[string]float64{
+		"batches created":   1,
+		"batches completed": 1,
+		"batches failed":    1,
+		"file uploads":      1,
+		"file downloads":    1,
+		"uploaded bytes":    1024,
+		"downloaded bytes":  300,
+	}
+	for name, value := range want {
+		if counters[name] != value {
+			t.Errorf("expected %v %s, got %v", value, name, counters[name])
+		}
+	}
+	if count := testutil.CollectAndCount(plugin.BatchSubmissionRequests); count != 1 {
+		t.Errorf("expected the submission size of the created batch to be observed, got %d series", count)
+	}
+}
+
+// Test that the S3 operations of the source are exposed with their latency and errors
+func TestS3OperationCollector(t *testing.T) {
+	plugin, err := Init(&Config{}, nil, bifrost.NewDefaultLogger(schemas.LogLevelError))
+	if err != nil {
+		t.Fatalf("failed to initialize plugin: %v", err)
+	}
+	if err := plugin.RegisterS3OperationStats(func() map[schemas.ModelProvider]map[string]schemas.S3OperationStats {
+		return map[schemas.ModelProvider]map[string]schemas.S3OperationStats{
+			schemas.Bedrock: {"PutObject": {Count: 4, Errors: 1, DurationSeconds: 2}},
+		}
+	}); err != nil {
+		t.Fatalf("failed to register S3 operation stats: %v", err)
+	}
+	count, err := testutil.GatherAndCount(plugin.GetRegistry(), "bifrost_s3_operation_duration_seconds", "bifrost_s3_operation_errors_total")
+	if err != nil || count != 2 {
+		t.Errorf("expected the S3 operation latency and errors, got %d series (%v)", count, err)
+	}
+}
@@ -0,0 +1,60 @@
+package telemetry
+
+import (
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BulkheadStatsSource returns the current per-provider bulkhead usage (see bifrost.GetBulkheadStats).
+type BulkheadStatsSource func() map[schemas.ModelProvider]schemas.BulkheadStats
+
+// bulkheadCollector exposes per-provider bulkhead usage as Prometheus metrics.
+// Values are read from the source on every scrape, so no bookkeeping is needed on the request path.
+type bulkheadCollector struct {
+	source      BulkheadStatsSource
+	maxInFlight *prometheus.Desc
+	limit       *prometheus.Desc
+	inFlight    *prometheus.Desc
+	waiting     *prometheus.Desc
+	rejected    *prometheus.Desc
+}
+
+func newBulkheadCollector(source BulkheadStatsSource) *bulkheadCollector {
+	labels := []string{"provider"}
+	return &bulkheadCollector{
+		source:      source,
+		maxInFlight: prometheus.NewDesc("bifrost_provider_bulkhead_max_in_flight", "Configured maximum number of in-flight requests per provider.", labels, nil),
+		limit:       prometheus.NewDesc("bifrost_provider_bulkhead_limit", "Number of in-flight requests currently admitted per provider, lowered by an adaptive limit under overload.", labels, nil),
+		inFlight:    prometheus.NewDesc("bifrost_provider_bulkhead_in_flight", "Number of requests currently holding a provider bulkhead slot.", labels, nil),
+		waiting:     prometheus.NewDesc("bifrost_provider_bulkhead_waiting", "Number of requests currently waiting for a provider bulkhead slot.", labels, nil),
+		rejected:    prometheus.NewDesc("bifrost_provider_bulkhead_rejected_total", "Total number of requests rejected by a provider bulkhead.", labels, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *bulkheadCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxInFlight
+	ch <- c.limit
+	ch <- c.inFlight
+	ch <- c.waiting
+	ch <- c.rejected
+}
+
+// Collect implements prometheus.Collector.
+func (c *bulkheadCollector) Collect(ch chan<- prometheus.Metric) {
+	for provider, stats := range c.source() {
+		ch <- prometheus.MustNewConstMetric(c.maxInFlight, prometheus.GaugeValue, float64(stats.MaxInFlight), string(provider))
+		ch <- prometheus.MustNewConstMetric(c.limit, prometheus.GaugeValue, float64(stats.Limit), string(provider))
+		ch <- prometheus.MustNewConstMetric(c.inFlight, prometheus.GaugeValue, float64(stats.InFlight), string(provider))
+		ch <- prometheus.MustNewConstMetric(c.waiting, prometheus.GaugeValue, float64(stats.Waiting), string(provider))
+		ch <- prometheus.MustNewConstMetric(c.rejected, prometheus.CounterValue, float64(stats.Rejected), string(provider))
+	}
+}
+
+// RegisterBulkheadStats registers a collector exposing per-provider bulkhead usage from the given source.
+func (p *PrometheusPlugin) RegisterBulkheadStats(source BulkheadStatsSource) error {
+	if source == nil {
+		return nil
+	}
+	return p.registry.Register(newBulkheadCollector(source))
+}
@@ -4,8 +4,10 @@
 package telemetry
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	bifrost "github.com/maximhq/bifrost/core"
@@ -55,10 +57,13 @@ type PrometheusPlugin struct {
 	CostTotal                      *prometheus.CounterVec
 	StreamInterTokenLatencySeconds *prometheus.HistogramVec
 	StreamFirstTokenLatencySeconds *prometheus.HistogramVec
-	customLabels                   []string
+	batchFileMetrics
+	customLabels []string
 
 	defaultHTTPLabels    []string
 	defaultBifrostLabels []string
+
+	pendingWg sync.WaitGroup // Pending asynchronous metric recordings from PostHook
 }
 
 type Config struct {
@@ -261,6 +266,7 @@ func Init(config *Config, pricingManager *modelcatalog.ModelCatalog, logger sche
 		CostTotal:                      bifrostCostTotal,
 		StreamInterTokenLatencySeconds: bifrostStreamInterTokenLatencySeconds,
 		StreamFirstTokenLatencySeconds: bifrostStreamFirstTokenLatencySeconds,
+		batchFileMetrics:               newBatchFileMetrics(factory),
 		customLabels:                   filteredCustomLabels,
 		defaultHTTPLabels:              defaultHTTPLabels,
 		defaultBifrostLabels:           defaultBifrostLabels,
@@ -285,6 +291,9 @@ func (p *PrometheusPlugin) TransportInterceptor(ctx *schemas.BifrostContext, url
 // This time is used later in PostHook to calculate request duration.
 func (p *PrometheusPlugin) PreHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error) {
 	ctx.SetValue(startTimeKey, time.Now())
+	if req.BatchCreateRequest != nil {
+		ctx.SetValue(batchRequestCountKey, len(req.BatchCreateRequest.Requests))
+	}
 	return req, nil, nil
 }
 
@@ -315,8 +324,14 @@ func (p *PrometheusPlugin) PostHook(ctx *schemas.BifrostContext, result *schemas
 	customerID := getStringFromContext(ctx, schemas.BifrostContextKey("bf-governance-customer-id"))
 	customerName := getStringFromContext(ctx, schemas.BifrostContextKey("bf-governance-customer-name"))
 
+	if bifrostErr == nil {
+		p.recordBatchFileMetrics(ctx, provider, result)
+	}
+
 	// Calculate cost and record metrics in a separate goroutine to avoid blocking the main thread
+	p.pendingWg.Add(1)
 	go func() {
+		defer p.pendingWg.Done()
 		labelValues := map[string]string{
 			"provider":          string(provider),
 			"model":             model,
@@ -505,6 +520,11 @@ func (p *PrometheusPlugin) HTTPMiddleware(handler fasthttp.RequestHandler) fasth
 	}
 }
 
+// Flush waits for the pending metric recordings of PostHook, so that no request is missing from the metrics scraped on shutdown
+func (p *PrometheusPlugin) Flush(ctx context.Context) error {
+	return bifrost.WaitWithContext(ctx, &p.pendingWg)
+}
+
 func (p *PrometheusPlugin) Cleanup() error {
 	// No-op. With a local registry, there's no need to unregister metrics.
 	// The registry and all its metrics will be garbage collected with the plugin instance.
@@ -0,0 +1,53 @@
+package telemetry
+
+import (
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ResponsePoolStatsSource returns the current usage of the provider response pools (see bifrost.GetResponsePoolStats).
+type ResponsePoolStatsSource func() map[string]schemas.ResponsePoolStats
+
+// responsePoolCollector exposes the usage of the provider response pools as Prometheus metrics.
+// The allocation rate relative to the get rate tells whether pools are effective, while GC pressure
+// is exposed by the Go collector (go_gc_duration_seconds, go_memstats_*).
+type responsePoolCollector struct {
+	source      ResponsePoolStatsSource
+	gets        *prometheus.Desc
+	puts        *prometheus.Desc
+	allocations *prometheus.Desc
+}
+
+func newResponsePoolCollector(source ResponsePoolStatsSource) *responsePoolCollector {
+	labels := []string{"pool"}
+	return &responsePoolCollector{
+		source:      source,
+		gets:        prometheus.NewDesc("bifrost_response_pool_gets_total", "Total number of provider responses acquired from a pool.", labels, nil),
+		puts:        prometheus.NewDesc("bifrost_response_pool_puts_total", "Total number of provider responses returned to a pool.", labels, nil),
+		allocations: prometheus.NewDesc("bifrost_response_pool_allocations_total", "Total number of provider responses allocated because a pool was empty.", labels, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *responsePoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.gets
+	ch <- c.puts
+	ch <- c.allocations
+}
+
+// Collect implements prometheus.Collector.
+func (c *responsePoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for pool, stats := range c.source() {
+		ch <- prometheus.MustNewConstMetric(c.gets, prometheus.CounterValue, float64(stats.Gets), pool)
+		ch <- prometheus.MustNewConstMetric(c.puts, prometheus.CounterValue, float64(stats.Puts), pool)
+		ch <- prometheus.MustNewConstMetric(c.allocations, prometheus.CounterValue, float64(stats.Allocations), pool)
+	}
+}
+
+// RegisterResponsePoolStats registers a collector exposing the usage of the provider response pools from the given source.
+func (p *PrometheusPlugin) RegisterResponsePoolStats(source ResponsePoolStatsSource) error {
+	if source == nil {
+		return nil
+	}
+	return p.registry.Register(newResponsePoolCollector(source))
+}
@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	schemas "github.com/maximhq/bifrost/core/schemas"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// StreamStatsSource returns the streams open at once across the instance and per provider (see bifrost.GetStreamStats).
+type StreamStatsSource func() (schemas.StreamStats, map[schemas.ModelProvider]schemas.StreamStats)
+
+// streamCollector exposes the streams open at once as Prometheus metrics.
+// The instance-wide values are reported without provider label, the per-provider values with it.
+type streamCollector struct {
+	source                StreamStatsSource
+	maxConcurrent         *prometheus.Desc
+	active                *prometheus.Desc
+	rejected              *prometheus.Desc
+	providerMaxConcurrent *prometheus.Desc
+	providerActive        *prometheus.Desc
+	providerRejected      *prometheus.Desc
+}
+
+func newStreamCollector(source StreamStatsSource) *streamCollector {
+	labels := []string{"provider"}
+	return &streamCollector{
+		source:                source,
+		maxConcurrent:         prometheus.NewDesc("bifrost_streams_max_concurrent", "Configured maximum number of streams open at once, 0 if unbounded.", nil, nil),
+		active:                prometheus.NewDesc("bifrost_streams_active", "Number of streams currently open.", nil, nil),
+		rejected:              prometheus.NewDesc("bifrost_streams_rejected_total", "Total number of streams rejected because the maximum number of streams was reached.", nil, nil),
+		providerMaxConcurrent: prometheus.NewDesc("bifrost_provider_streams_max_concurrent", "Configured maximum number of streams open at once per provider, 0 if unbounded.", labels, nil),
+		providerActive:        prometheus.NewDesc("bifrost_provider_streams_active", "Number of streams currently open per provider.", labels, nil),
+		providerRejected:      prometheus.NewDesc("bifrost_provider_streams_rejected_total", "Total number of streams rejected because the provider's maximum number of streams was reached.", labels, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *streamCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxConcurrent
+	ch <- c.active
+	ch <- c.rejected
+	ch <- c.providerMaxConcurrent
+	ch <- c.providerActive
+	ch <- c.providerRejected
+}
+
+// Collect implements prometheus.Collector.
+func (c *streamCollector) Collect(ch chan<- prometheus.Metric) {
+	total, providers := c.source()
+	ch <- prometheus.MustNewConstMetric(c.maxConcurrent, prometheus.GaugeValue, float64(total.MaxConcurrent))
+	ch <- prometheus.MustNewConstMetric(c.active, prometheus.GaugeValue, float64(total.Active))
+	ch <- prometheus.MustNewConstMetric(c.rejected, prometheus.CounterValue, float64(total.Rejected))
+	for provider, stats := range providers {
+		ch <- prometheus.MustNewConstMetric(c.providerMaxConcurrent, prometheus.GaugeValue, float64(stats.MaxConcurrent), string(provider))
+		ch <- prometheus.MustNewConstMetric(c.providerActive, prometheus.GaugeValue, float64(stats.Active), string(provider))
+		ch <- prometheus.MustNewConstMetric(c.providerRejected, prometheus.CounterValue, float64(stats.Rejected), string(provider))
+	}
+}
+
+// RegisterStreamStats registers a collector exposing the streams open at once from the given source.
+func (p *PrometheusPlugin) RegisterStreamStats(source StreamStatsSource) error {
+	if source == nil {
+		return nil
+	}
+	return p.registry.Register(newStreamCollector(source))
+}
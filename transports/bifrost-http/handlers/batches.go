@@ -0,0 +1,413 @@
+// Package handlers provides HTTP request handlers for the Bifrost HTTP transport.
+// This file contains the batch events handler, which streams batch progress over Server-Sent Events, and the
+// batch administration endpoints.
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fasthttp/router"
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+	"github.com/maximhq/bifrost/framework/modelcatalog"
+	"github.com/maximhq/bifrost/transports/bifrost-http/lib"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// DefaultBatchPollInterval is how often a batch is polled when the client does not set an interval.
+	DefaultBatchPollInterval = 10 * time.Second
+	// MinBatchPollInterval is the shortest poll interval a client can request.
+	MinBatchPollInterval = time.Second
+	// DefaultStaleBatchReapInterval is how often the stale batch reaper looks for stale tracked batches.
+	DefaultStaleBatchReapInterval = time.Minute
+	// DefaultStaleBatchThreshold is how long a tracked batch may stay without status or progress change before
+	// it is reaped as stuck.
+	DefaultStaleBatchThreshold = 24 * time.Hour
+)
+
+// Batch event types sent over the batch events stream
+const (
+	BatchEventStatus   = "status"   // The batch status changed (also sent first, with the current status)
+	BatchEventProgress = "progress" // The request counts changed without a status change
+	BatchEventError    = "error"    // Polling the batch failed, the stream ends
+)
+
+// BatchHandler manages HTTP requests for batch progress notifications and batch administration
+type BatchHandler struct {
+	client          *bifrost.Bifrost
+	handlerStore    lib.HandlerStore
+	pricingManager  *modelcatalog.ModelCatalog // Prices batch dry run estimates, optional
+	minPollInterval time.Duration
+	batchesMu       sync.Mutex
+	batches         map[batchKey]*trackedBatch // Non-terminal batches seen by an events stream
+}
+
+// batchKey identifies a tracked batch
+type batchKey struct {
+	provider string
+	batchID  string
+}
+
+// trackedBatch is the last known state of a non-terminal batch seen by an events stream. It outlives the
+// streams watching it, until the batch is seen terminal or the stale batch reaper removes it.
+type trackedBatch struct {
+	status        schemas.BatchStatus
+	requestCounts schemas.BatchRequestCounts
+	expiresAt     int64                                 // Unix time in seconds of the batch expiry, 0 if unknown
+	lastChangedAt time.Time                             // Last status or progress change
+	watchers      map[chan schemas.BatchStatus]struct{} // Events streams to notify when the batch is reaped
+}
+
+// NewBatchHandler creates a new batch handler instance, the pricing manager may be nil
+func NewBatchHandler(client *bifrost.Bifrost, handlerStore lib.HandlerStore, pricingManager *modelcatalog.ModelCatalog) *BatchHandler {
+	return &BatchHandler{
+		client:          client,
+		handlerStore:    handlerStore,
+		pricingManager:  pricingManager,
+		minPollInterval: MinBatchPollInterval,
+		batches:         make(map[batchKey]*trackedBatch),
+	}
+}
+
+// RegisterRoutes registers the batch-related routes
+func (h *BatchHandler) RegisterRoutes(r *router.Router, middlewares ...lib.BifrostHTTPMiddleware) {
+	r.GET("/api/batches/{batch_id}/events", lib.ChainMiddlewares(h.batchEvents, middlewares...))
+	r.POST("/api/batches/cancel-all", lib.ChainMiddlewares(h.batchCancelAll, middlewares...))
+	r.POST("/api/batches/dry-run", lib.ChainMiddlewares(h.batchDryRun, middlewares...))
+}
+
+// BatchEvent is a batch status or progress update sent over the batch events stream
+type BatchEvent struct {
+	BatchID        string                     `json:"batch_id"`
+	Status         schemas.BatchStatus        `json:"status"`
+	PreviousStatus schemas.BatchStatus        `json:"previous_status,omitempty"`
+	RequestCounts  schemas.BatchRequestCounts `json:"request_counts"`
+	Progress       float64                    `json:"progress"`         // Fraction of the requests processed, from 0 to 1
+	Terminal       bool                       `json:"terminal"`         // Whether this is the last event of the stream
+	Reaped         bool                       `json:"reaped,omitempty"` // The status was set locally by the stale batch reaper, not reported by the provider
+}
+
+// newBatchEvent builds the event for a retrieved batch
+func newBatchEvent(batch *schemas.BifrostBatchRetrieveResponse, previousStatus schemas.BatchStatus) *BatchEvent {
+	event := &BatchEvent{
+		BatchID:        batch.ID,
+		Status:         batch.Status,
+		PreviousStatus: previousStatus,
+		RequestCounts:  batch.RequestCounts,
+		Terminal:       batch.Status.IsTerminal(),
+	}
+	counts := batch.RequestCounts
+	if counts.Total > 0 {
+		processed := counts.Completed + counts.Failed + counts.Expired + counts.Canceled
+		event.Progress = min(float64(processed)/float64(counts.Total), 1)
+	}
+	if event.Terminal {
+		event.Progress = 1
+	}
+	return event
+}
+
+// batchEvents handles GET /api/batches/{batch_id}/events - Stream batch status transitions and progress
+// until the batch reaches a terminal status. The batch is polled every `interval` (a duration such as 30s,
+// 10s by default), and an event is sent whenever its status or request counts change.
+func (h *BatchHandler) batchEvents(ctx *fasthttp.RequestCtx) {
+	batchID, ok := ctx.UserValue("batch_id").(string)
+	if !ok || batchID == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "batch_id is required")
+		return
+	}
+
+	provider := string(ctx.QueryArgs().Peek("provider"))
+	if provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider query parameter is required")
+		return
+	}
+
+	interval := DefaultBatchPollInterval
+	if intervalStr := ctx.QueryArgs().Peek("interval"); len(intervalStr) > 0 {
+		parsed, err := time.ParseDuration(string(intervalStr))
+		if err != nil {
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid interval: %v", err))
+			return
+		}
+		interval = max(parsed, h.minPollInterval)
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys())
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to convert context")
+		return
+	}
+	pollCtx := *bifrostCtx
+	retrieve := func() (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
+		return h.client.BatchRetrieveRequest(pollCtx, &schemas.BifrostBatchRetrieveRequest{
+			Provider: schemas.ModelProvider(provider),
+			BatchID:  batchID,
+		})
+	}
+
+	// The first poll is done before streaming, so that an unknown batch is reported with its status code
+	batch, bifrostErr := retrieve()
+	if bifrostErr != nil {
+		cancel()
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	ctx.SetContentType("text/event-stream")
+	ctx.Response.Header.Set("Cache-Control", "no-cache")
+	ctx.Response.Header.Set("Connection", "keep-alive")
+	ctx.Response.Header.Set("Access-Control-Allow-Origin", "*")
+
+	ctx.Response.SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+		defer w.Flush()
+
+		event := newBatchEvent(batch, "")
+		if err := writeBatchEvent(w, BatchEventStatus, event); err != nil {
+			return
+		}
+		if event.Terminal {
+			return
+		}
+		key := batchKey{provider: provider, batchID: batchID}
+		reaped := h.watchBatch(key, batch)
+		defer h.unwatchBatch(key, reaped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for !event.Terminal {
+			select {
+			case <-pollCtx.Done():
+				return
+			case status := <-reaped:
+				// The batch is stale, the stream ends with the status set by the reaper
+				reapedEvent := *event
+				reapedEvent.PreviousStatus = event.Status
+				reapedEvent.Status = status
+				reapedEvent.Terminal = true
+				reapedEvent.Reaped = true
+				writeBatchEvent(w, BatchEventStatus, &reapedEvent)
+				return
+			case <-ticker.C:
+			}
+
+			batch, bifrostErr := retrieve()
+			if bifrostErr != nil {
+				writeBatchEvent(w, BatchEventError, bifrostErr)
+				return
+			}
+			previous := event
+			event = newBatchEvent(batch, previous.Status)
+			h.observeBatch(key, batch, time.Now())
+			var err error
+			switch {
+			case event.Status != previous.Status:
+				err = writeBatchEvent(w, BatchEventStatus, event)
+			case event.RequestCounts != previous.RequestCounts:
+				event.PreviousStatus = ""
+				err = writeBatchEvent(w, BatchEventProgress, event)
+			default:
+				// Nothing changed, a comment line keeps the connection alive and detects client disconnects
+				_, err = fmt.Fprint(w, ": ping\n\n")
+				if err == nil {
+					err = w.Flush()
+				}
+			}
+			if err != nil {
+				return // Client disconnected
+			}
+		}
+	})
+}
+
+// watchBatch records the state of a non-terminal batch and returns the channel on which the stale batch reaper
+// sends the status it sets if it reaps the batch.
+func (h *BatchHandler) watchBatch(key batchKey, batch *schemas.BifrostBatchRetrieveResponse) chan schemas.BatchStatus {
+	reaped := make(chan schemas.BatchStatus, 1)
+	h.batchesMu.Lock()
+	defer h.batchesMu.Unlock()
+	h.observeBatchLocked(key, batch, time.Now())
+	if tracked, ok := h.batches[key]; ok {
+		tracked.watchers[reaped] = struct{}{}
+	}
+	return reaped
+}
+
+// unwatchBatch stops notifying an events stream, the batch itself stays tracked.
+func (h *BatchHandler) unwatchBatch(key batchKey, reaped chan schemas.BatchStatus) {
+	h.batchesMu.Lock()
+	defer h.batchesMu.Unlock()
+	if tracked, ok := h.batches[key]; ok {
+		delete(tracked.watchers, reaped)
+	}
+}
+
+// observeBatch records a polled batch state, a terminal batch is no longer tracked.
+func (h *BatchHandler) observeBatch(key batchKey, batch *schemas.BifrostBatchRetrieveResponse, now time.Time) {
+	h.batchesMu.Lock()
+	defer h.batchesMu.Unlock()
+	h.observeBatchLocked(key, batch, now)
+}
+
+// observeBatchLocked is observeBatch with batchesMu held.
+func (h *BatchHandler) observeBatchLocked(key batchKey, batch *schemas.BifrostBatchRetrieveResponse, now time.Time) {
+	if batch.Status.IsTerminal() {
+		delete(h.batches, key)
+		return
+	}
+	tracked, ok := h.batches[key]
+	if !ok {
+		tracked = &trackedBatch{lastChangedAt: now, watchers: make(map[chan schemas.BatchStatus]struct{})}
+		h.batches[key] = tracked
+	} else if tracked.status != batch.Status || tracked.requestCounts != batch.RequestCounts {
+		tracked.lastChangedAt = now
+	}
+	tracked.status = batch.Status
+	tracked.requestCounts = batch.RequestCounts
+	if batch.ExpiresAt != nil {
+		tracked.expiresAt = *batch.ExpiresAt
+	}
+}
+
+// StartStaleBatchReaper starts a background reaper that, every interval, removes the tracked batches that are
+// past their expiry (marked expired) or whose status and progress did not change for staleAfter (marked failed,
+// 0 disables this check). The status is only set locally, the provider's batch is not changed, and it is sent to
+// the events streams still watching the batch as a final status event. The reaper stops when ctx is cancelled.
+func (h *BatchHandler) StartStaleBatchReaper(ctx context.Context, interval, staleAfter time.Duration) {
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				h.reapStaleBatches(now, staleAfter)
+			}
+		}
+	}()
+}
+
+// reapStaleBatches removes the stale tracked batches, notifies their watchers and returns how many were reaped.
+func (h *BatchHandler) reapStaleBatches(now time.Time, staleAfter time.Duration) int {
+	h.batchesMu.Lock()
+	defer h.batchesMu.Unlock()
+	reaped := 0
+	for key, tracked := range h.batches {
+		var status schemas.BatchStatus
+		switch {
+		case tracked.expiresAt > 0 && now.Unix() >= tracked.expiresAt:
+			status = schemas.BatchStatusExpired
+		case staleAfter > 0 && now.Sub(tracked.lastChangedAt) >= staleAfter:
+			status = schemas.BatchStatusFailed
+		default:
+			continue
+		}
+		delete(h.batches, key)
+		for watcher := range tracked.watchers {
+			watcher <- status
+		}
+		reaped++
+		logger.Warn(fmt.Sprintf("stale batch %s of provider %s reaped as %s", key.batchID, key.provider, status))
+	}
+	return reaped
+}
+
+// batchCancelAll handles POST /api/batches/cancel-all - Cancel every running batch of a provider
+func (h *BatchHandler) batchCancelAll(ctx *fasthttp.RequestCtx) {
+	provider := string(ctx.QueryArgs().Peek("provider"))
+	if provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider query parameter is required")
+		return
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.BatchCancelAllRequest(*bifrostCtx, &schemas.BifrostBatchCancelAllRequest{
+		Provider: schemas.ModelProvider(provider),
+	})
+	if bifrostErr != nil {
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	SendJSON(ctx, resp)
+}
+
+// batchDryRun handles POST /api/batches/dry-run - Validate a batch create request, estimate its requests and
+// render the provider-native batch create request, without submitting the batch. The body is a batch create
+// request. The estimate is priced with the batch pricing of the model catalog when every model has pricing.
+func (h *BatchHandler) batchDryRun(ctx *fasthttp.RequestCtx) {
+	var req schemas.BifrostBatchCreateRequest
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.BatchDryRunRequest(*bifrostCtx, &req)
+	if bifrostErr != nil {
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+	if resp.Estimate != nil && h.pricingManager != nil {
+		resp.Estimate.Cost = h.estimateBatchCost(req.Provider, req.Endpoint, resp.Estimate)
+	}
+
+	SendJSON(ctx, resp)
+}
+
+// estimateBatchCost prices a batch estimate with the batch pricing of its models, or returns nil if a model
+// has no pricing.
+func (h *BatchHandler) estimateBatchCost(provider schemas.ModelProvider, endpoint schemas.BatchEndpoint, estimate *schemas.BatchEstimate) *float64 {
+	requestType := schemas.ChatCompletionRequest
+	switch endpoint {
+	case schemas.BatchEndpointCanonicalEmbeddings, schemas.BatchEndpointEmbeddings:
+		requestType = schemas.EmbeddingRequest
+	case schemas.BatchEndpointCanonicalResponses, schemas.BatchEndpointResponses:
+		requestType = schemas.ResponsesRequest
+	}
+
+	cost := 0.0
+	for model, modelEstimate := range estimate.Models {
+		if model == "" || h.pricingManager.GetPricingEntryForModel(model, provider) == nil {
+			return nil
+		}
+		cost += h.pricingManager.CalculateCostFromUsage(string(provider), model, "", &schemas.BifrostLLMUsage{
+			PromptTokens:     modelEstimate.InputTokens,
+			CompletionTokens: modelEstimate.OutputTokens,
+			TotalTokens:      modelEstimate.InputTokens + modelEstimate.OutputTokens,
+		}, requestType, true, nil, nil)
+	}
+	return &cost
+}
+
+// writeBatchEvent writes an SSE event and flushes it to the client
+func writeBatchEvent(w *bufio.Writer, eventType string, data any) error {
+	dataJSON, err := serialization.Marshal(data)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Failed to marshal batch event: %v", err))
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, dataJSON); err != nil {
+		return err
+	}
+	return w.Flush()
+}
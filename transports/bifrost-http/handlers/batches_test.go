@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// batchTestAccount is a schemas.Account with a single OpenAI key pointing at a test server
+type batchTestAccount struct {
+	baseURL string
+}
+
+func (a *batchTestAccount) GetConfiguredProviders() ([]schemas.ModelProvider, error) {
+	return []schemas.ModelProvider{schemas.OpenAI}, nil
+}
+
+func (a *batchTestAccount) GetKeysForProvider(ctx *context.Context, providerKey schemas.ModelProvider) ([]schemas.Key, error) {
+	return []schemas.Key{{ID: "key-1", Value: "sk-test", Weight: 1, UseForBatchAPI: schemas.Ptr(true)}}, nil
+}
+
+func (a *batchTestAccount) GetConfigForProvider(providerKey schemas.ModelProvider) (*schemas.ProviderConfig, error) {
+	return &schemas.ProviderConfig{
+		NetworkConfig:            schemas.NetworkConfig{BaseURL: a.baseURL, DefaultRequestTimeoutInSeconds: 5},
+		ConcurrencyAndBufferSize: schemas.DefaultConcurrencyAndBufferSize,
+	}, nil
+}
+
+type batchTestHandlerStore struct{}
+
+func (batchTestHandlerStore) ShouldAllowDirectKeys() bool { return false }
+
+// TestBatchEvents_StatusTransition tests that the events stream reports progress and status transitions
+// until the batch is terminal
+func TestBatchEvents_StatusTransition(t *testing.T) {
+	SetLogger(&mockLogger{})
+
+	// The batch progresses on each poll: in_progress 0/4, in_progress 2/4, in_progress 2/4, completed 4/4
+	states := []string{
+		`"status":"in_progress","request_counts":{"total":4,"completed":0,"failed":0}`,
+		`"status":"in_progress","request_counts":{"total":4,"completed":2,"failed":0}`,
+		`"status":"in_progress","request_counts":{"total":4,"completed":2,"failed":0}`,
+		`"status":"completed","request_counts":{"total":4,"completed":3,"failed":1}`,
+	}
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/batches/batch_abc" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"batch not found","type":"invalid_request_error"}}`))
+			return
+		}
+		state := states[min(int(polls.Add(1))-1, len(states)-1)]
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":"batch_abc","object":"batch",%s}`, state)
+	}))
+	defer server.Close()
+
+	client, err := bifrost.Init(context.Background(), schemas.BifrostConfig{
+		Account: &batchTestAccount{baseURL: server.URL},
+		Logger:  bifrost.NewDefaultLogger(schemas.LogLevelError),
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize Bifrost: %v", err)
+	}
+	defer client.Shutdown()
+
+	handler := NewBatchHandler(client, batchTestHandlerStore{}, nil)
+	handler.minPollInterval = 0
+
+	var ctx fasthttp.RequestCtx
+	ctx.Request.SetRequestURI("/api/batches/batch_abc/events?provider=openai&interval=10ms")
+	ctx.SetUserValue("batch_id", "batch_abc")
+	handler.batchEvents(&ctx)
+
+	if ctx.Response.StatusCode() != fasthttp.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", ctx.Response.StatusCode(), ctx.Response.Body())
+	}
+	if contentType := string(ctx.Response.Header.ContentType()); contentType != "text/event-stream" {
+		t.Errorf("expected text/event-stream content type, got %s", contentType)
+	}
+
+	// Reading the body consumes the stream until the batch is terminal
+	type receivedEvent struct {
+		eventType string
+		event     BatchEvent
+	}
+	var received []receivedEvent
+	for _, block := range strings.Split(strings.TrimSpace(string(ctx.Response.Body())), "\n\n") {
+		if strings.HasPrefix(block, ":") {
+			continue
+		}
+		lines := strings.SplitN(block, "\n", 2)
+		if len(lines) != 2 || !strings.HasPrefix(lines[0], "event: ") || !strings.HasPrefix(lines[1], "data: ") {
+			t.Fatalf("unexpected SSE block: %q", block)
+		}
+		var event BatchEvent
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(lines[1], "data: ")), &event); err != nil {
+			t.Fatalf("failed to parse event data: %v", err)
+		}
+		received = append(received, receivedEvent{eventType: strings.TrimPrefix(lines[0], "event: "), event: event})
+	}
+
+	expected := []struct {
+		eventType      string
+		status         schemas.BatchStatus
+		previousStatus schemas.BatchStatus
+		progress       float64
+	}{
+		{BatchEventStatus, schemas.BatchStatusInProgress, "", 0},
+		{BatchEventProgress, schemas.BatchStatusInProgress, "", 0.5},
+		{BatchEventStatus, schemas.BatchStatusCompleted, schemas.BatchStatusInProgress, 1},
+	}
+	if len(received) != len(expected) {
+		t.Fatalf("expected %d events, got %d: %+v", len(expected), len(received), received)
+	}
+	for i, want := range expected {
+		got := received[i]
+		if got.eventType != want.eventType || got.event.Status != want.status || got.event.PreviousStatus != want.previousStatus || got.event.Progress != want.progress {
+			t.Errorf("event %d: expected %s %s (from %q) at %v, got %s %+v", i, want.eventType, want.status, want.previousStatus, want.progress, got.eventType, got.event)
+		}
+	}
+	if last := received[len(received)-1].event; !last.Terminal || last.RequestCounts.Failed != 1 {
+		t.Errorf("expected the last event to be terminal with the final counts, got %+v", last)
+	}
+	if got := polls.Load(); got != int32(len(states)) {
+		t.Errorf("expected polling to stop at the terminal status after %d polls, got %d", len(states), got)
+	}
+}
+
+// TestBatchEvents_UnknownBatch tests that an unknown batch is reported as an error before streaming
+func TestBatchEvents_UnknownBatch(t *testing.T) {
+	SetLogger(&mockLogger{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"message":"batch not found","type":"invalid_request_error"}}`))
+	}))
+	defer server.Close()
+
+	client, err := bifrost.Init(context.Background(), schemas.BifrostConfig{
+		Account: &batchTestAccount{baseURL: server.URL},
+		Logger:  bifrost.NewDefaultLogger(schemas.LogLevelError),
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize Bifrost: %v", err)
+	}
+	defer client.Shutdown()
+
+	var ctx fasthttp.RequestCtx
+	ctx.Request.SetRequestURI("/api/batches/batch_missing/events?provider=openai")
+	ctx.SetUserValue("batch_id", "batch_missing")
+	NewBatchHandler(client, batchTestHandlerStore{}, nil).batchEvents(&ctx)
+
+	if ctx.Response.StatusCode() != fasthttp.StatusNotFound {
+		t.Errorf("expected status 404, got %d: %s", ctx.Response.StatusCode(), ctx.Response.Body())
+	}
+}
+
+// TestBatchEvents_StaleBatchReaped tests that the reaper ends the events stream of a batch stuck past its expiry
+// with a local expired status, and stops tracking it
+func TestBatchEvents_StaleBatchReaped(t *testing.T) {
+	SetLogger(&mockLogger{})
+
+	// The batch stays validating although it expired a minute ago
+	expiresAt := time.Now().Add(-time.Minute).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":"batch_stuck","object":"batch","status":"validating","expires_at":%d,"request_counts":{"total":2,"completed":0,"failed":0}}`, expiresAt)
+	}))
+	defer server.Close()
+
+	client, err := bifrost.Init(context.Background(), schemas.BifrostConfig{
+		Account: &batchTestAccount{baseURL: server.URL},
+		Logger:  bifrost.NewDefaultLogger(schemas.LogLevelError),
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize Bifrost: %v", err)
+	}
+	defer client.Shutdown()
+
+	handler := NewBatchHandler(client, batchTestHandlerStore{}, nil)
+	handler.minPollInterval = 0
+	reaperCtx, stopReaper := context.WithCancel(context.Background())
+	defer stopReaper()
+	handler.StartStaleBatchReaper(reaperCtx, 20*time.Millisecond, time.Hour)
+
+	var ctx fasthttp.RequestCtx
+	ctx.Request.SetRequestURI("/api/batches/batch_stuck/events?provider=openai&interval=10ms")
+	ctx.SetUserValue("batch_id", "batch_stuck")
+	handler.batchEvents(&ctx)
+
+	// Reading the body consumes the stream until the batch is reaped
+	body := strings.TrimSpace(string(ctx.Response.Body()))
+	blocks := strings.Split(body, "\n\n")
+	last := blocks[len(blocks)-1]
+	if !strings.HasPrefix(last, "event: "+BatchEventStatus+"\ndata: ") {
+		t.Fatalf("expected the stream to end with a status event, got %q", last)
+	}
+	var event BatchEvent
+	if err := json.Unmarshal([]byte(strings.SplitN(last, "data: ", 2)[1]), &event); err != nil {
+		t.Fatalf("failed to parse event data: %v", err)
+	}
+	if event.Status != schemas.BatchStatusExpired || event.PreviousStatus != schemas.BatchStatusValidating || !event.Terminal || !event.Reaped {
+		t.Errorf("expected a terminal reaped expired event, got %+v", event)
+	}
+
+	handler.batchesMu.Lock()
+	defer handler.batchesMu.Unlock()
+	if len(handler.batches) != 0 {
+		t.Errorf("expected the reaped batch to be untracked, got %d tracked batches", len(handler.batches))
+	}
+}
+
+// TestReapStaleBatches_Unwatched tests that the reaper removes stuck batches no events stream watches anymore,
+// and keeps those whose progress changed within the threshold
+func TestReapStaleBatches_Unwatched(t *testing.T) {
+	SetLogger(&mockLogger{})
+
+	handler := NewBatchHandler(nil, batchTestHandlerStore{}, nil)
+	start := time.Now()
+	stuck := batchKey{provider: "openai", batchID: "batch_stuck"}
+	progressing := batchKey{provider: "openai", batchID: "batch_progressing"}
+	batch := func(id string, completed int) *schemas.BifrostBatchRetrieveResponse {
+		return &schemas.BifrostBatchRetrieveResponse{
+			ID:            id,
+			Status:        schemas.BatchStatusInProgress,
+			RequestCounts: schemas.BatchRequestCounts{Total: 4, Completed: completed},
+		}
+	}
+
+	// Both streams are gone, only the progressing batch changed since
+	handler.unwatchBatch(stuck, handler.watchBatch(stuck, batch(stuck.batchID, 1)))
+	handler.unwatchBatch(progressing, handler.watchBatch(progressing, batch(progressing.batchID, 1)))
+	handler.observeBatch(stuck, batch(stuck.batchID, 1), start.Add(30*time.Minute))
+	handler.observeBatch(progressing, batch(progressing.batchID, 2), start.Add(30*time.Minute))
+
+	if reaped := handler.reapStaleBatches(start.Add(80*time.Minute), time.Hour); reaped != 1 {
+		t.Fatalf("expected 1 reaped batch, got %d", reaped)
+	}
+	handler.batchesMu.Lock()
+	defer handler.batchesMu.Unlock()
+	if _, ok := handler.batches[stuck]; ok {
+		t.Error("expected the stuck batch to be untracked")
+	}
+	if _, ok := handler.batches[progressing]; !ok {
+		t.Error("expected the progressing batch to stay tracked")
+	}
+}
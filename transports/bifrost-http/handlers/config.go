@@ -233,6 +233,26 @@ func (h *ConfigHandler) updateConfig(ctx *fasthttp.RequestCtx) {
 	updatedConfig.MaxRequestBodySizeMB = payload.ClientConfig.MaxRequestBodySizeMB
 	updatedConfig.EnableLiteLLMFallbacks = payload.ClientConfig.EnableLiteLLMFallbacks
 
+	if payload.ClientConfig.MaxConcurrentStreams < 0 || payload.ClientConfig.HedgingDelayInMs < 0 || payload.ClientConfig.MaxContinuations < 0 {
+		SendError(ctx, fasthttp.StatusBadRequest, "max_concurrent_streams, hedging_delay_ms and max_continuations cannot be negative")
+		return
+	}
+	updatedConfig.MaxConcurrentStreams = payload.ClientConfig.MaxConcurrentStreams
+	updatedConfig.HedgingDelayInMs = payload.ClientConfig.HedgingDelayInMs
+	updatedConfig.MaxTokensDerivation = payload.ClientConfig.MaxTokensDerivation
+	updatedConfig.MaxContinuations = payload.ClientConfig.MaxContinuations
+	updatedConfig.ModelNameNormalization = payload.ClientConfig.ModelNameNormalization
+	updatedConfig.PluginOrder = payload.ClientConfig.PluginOrder
+
+	if payload.ClientConfig.FailedRequestCaptureMaxEntries < 0 || payload.ClientConfig.FailedRequestCaptureTTLInSeconds < 0 || payload.ClientConfig.FailedRequestCaptureMaxPayloadKB < 0 {
+		SendError(ctx, fasthttp.StatusBadRequest, "failed_request_capture_max_entries, failed_request_capture_ttl_seconds and failed_request_capture_max_payload_kb cannot be negative")
+		return
+	}
+	updatedConfig.EnableFailedRequestCapture = payload.ClientConfig.EnableFailedRequestCapture
+	updatedConfig.FailedRequestCaptureMaxEntries = payload.ClientConfig.FailedRequestCaptureMaxEntries
+	updatedConfig.FailedRequestCaptureTTLInSeconds = payload.ClientConfig.FailedRequestCaptureTTLInSeconds
+	updatedConfig.FailedRequestCaptureMaxPayloadKB = payload.ClientConfig.FailedRequestCaptureMaxPayloadKB
+
 	// Validate LogRetentionDays
 	if payload.ClientConfig.LogRetentionDays < 1 {
 		logger.Warn("log_retention_days must be at least 1")
@@ -13,11 +13,12 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/fasthttp/router"
 	bifrost "github.com/maximhq/bifrost/core"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/transports/bifrost-http/lib"
 	"github.com/valyala/fasthttp"
 )
@@ -63,6 +64,7 @@ var textParamsKnownFields = map[string]bool{
 var chatParamsKnownFields = map[string]bool{
 	"model":                 true,
 	"messages":              true,
+	"attachments":           true,
 	"fallbacks":             true,
 	"stream":                true,
 	"frequency_penalty":     true,
@@ -142,6 +144,7 @@ var transcriptionParamsKnownFields = map[string]bool{
 	"fallbacks":       true,
 	"stream":          true,
 	"language":        true,
+	"translate":       true,
 	"prompt":          true,
 	"response_format": true,
 	"file_format":     true,
@@ -170,7 +173,8 @@ type TextRequest struct {
 }
 
 type ChatRequest struct {
-	Messages []schemas.ChatMessage `json:"messages"`
+	Messages    []schemas.ChatMessage   `json:"messages"`
+	Attachments []schemas.FileReference `json:"attachments,omitempty"`
 	BifrostParams
 	*schemas.ChatParameters
 }
@@ -182,25 +186,27 @@ func (cr *ChatRequest) UnmarshalJSON(data []byte) error {
 	// First, unmarshal BifrostParams fields directly
 	type bifrostAlias BifrostParams
 	var bp bifrostAlias
-	if err := sonic.Unmarshal(data, &bp); err != nil {
+	if err := serialization.Unmarshal(data, &bp); err != nil {
 		return err
 	}
 	cr.BifrostParams = BifrostParams(bp)
 
-	// Unmarshal messages
+	// Unmarshal messages and attachments
 	var msgStruct struct {
-		Messages []schemas.ChatMessage `json:"messages"`
+		Messages    []schemas.ChatMessage   `json:"messages"`
+		Attachments []schemas.FileReference `json:"attachments"`
 	}
-	if err := sonic.Unmarshal(data, &msgStruct); err != nil {
+	if err := serialization.Unmarshal(data, &msgStruct); err != nil {
 		return err
 	}
 	cr.Messages = msgStruct.Messages
+	cr.Attachments = msgStruct.Attachments
 
 	// Unmarshal ChatParameters (which has its own custom unmarshaller)
 	if cr.ChatParameters == nil {
 		cr.ChatParameters = &schemas.ChatParameters{}
 	}
-	if err := sonic.Unmarshal(data, cr.ChatParameters); err != nil {
+	if err := serialization.Unmarshal(data, cr.ChatParameters); err != nil {
 		return err
 	}
 
@@ -216,13 +222,13 @@ type ResponsesRequestInput struct {
 // UnmarshalJSON unmarshals the responses request input
 func (r *ResponsesRequestInput) UnmarshalJSON(data []byte) error {
 	var str string
-	if err := sonic.Unmarshal(data, &str); err == nil {
+	if err := serialization.Unmarshal(data, &str); err == nil {
 		r.ResponsesRequestInputStr = &str
 		r.ResponsesRequestInputArray = nil
 		return nil
 	}
 	var array []schemas.ResponsesMessage
-	if err := sonic.Unmarshal(data, &array); err == nil {
+	if err := serialization.Unmarshal(data, &array); err == nil {
 		r.ResponsesRequestInputStr = nil
 		r.ResponsesRequestInputArray = array
 		return nil
@@ -237,7 +243,7 @@ func (rr *ResponsesRequest) UnmarshalJSON(data []byte) error {
 	// First, unmarshal BifrostParams fields directly
 	type bifrostAlias BifrostParams
 	var bp bifrostAlias
-	if err := sonic.Unmarshal(data, &bp); err != nil {
+	if err := serialization.Unmarshal(data, &bp); err != nil {
 		return err
 	}
 	rr.BifrostParams = BifrostParams(bp)
@@ -246,7 +252,7 @@ func (rr *ResponsesRequest) UnmarshalJSON(data []byte) error {
 	var inputStruct struct {
 		Input ResponsesRequestInput `json:"input"`
 	}
-	if err := sonic.Unmarshal(data, &inputStruct); err != nil {
+	if err := serialization.Unmarshal(data, &inputStruct); err != nil {
 		return err
 	}
 	rr.Input = inputStruct.Input
@@ -255,7 +261,7 @@ func (rr *ResponsesRequest) UnmarshalJSON(data []byte) error {
 	if rr.ResponsesParameters == nil {
 		rr.ResponsesParameters = &schemas.ResponsesParameters{}
 	}
-	if err := sonic.Unmarshal(data, rr.ResponsesParameters); err != nil {
+	if err := serialization.Unmarshal(data, rr.ResponsesParameters); err != nil {
 		return err
 	}
 
@@ -309,10 +315,10 @@ type BatchListRequest struct {
 // Helper functions
 
 // parseFallbacks extracts fallbacks from string array and converts to Fallback structs
-func parseFallbacks(fallbackStrings []string) ([]schemas.Fallback, error) {
+func parseFallbacks(client *bifrost.Bifrost, fallbackStrings []string) ([]schemas.Fallback, error) {
 	fallbacks := make([]schemas.Fallback, 0, len(fallbackStrings))
 	for _, fallback := range fallbackStrings {
-		fallbackProvider, fallbackModelName := schemas.ParseModelString(fallback, "")
+		fallbackProvider, fallbackModelName := client.ParseModelString(fallback, "")
 		if fallbackProvider != "" && fallbackModelName != "" {
 			fallbacks = append(fallbacks, schemas.Fallback{
 				Provider: fallbackProvider,
@@ -327,7 +333,7 @@ func parseFallbacks(fallbackStrings []string) ([]schemas.Fallback, error) {
 func extractExtraParams(data []byte, knownFields map[string]bool) (map[string]any, error) {
 	// Parse JSON to extract unknown fields
 	var rawData map[string]json.RawMessage
-	if err := sonic.Unmarshal(data, &rawData); err != nil {
+	if err := serialization.Unmarshal(data, &rawData); err != nil {
 		return nil, err
 	}
 
@@ -336,7 +342,7 @@ func extractExtraParams(data []byte, knownFields map[string]bool) (map[string]an
 	for key, value := range rawData {
 		if !knownFields[key] {
 			var v any
-			if err := sonic.Unmarshal(value, &v); err != nil {
+			if err := serialization.Unmarshal(value, &v); err != nil {
 				continue // Skip fields that can't be unmarshaled
 			}
 			extraParams[key] = v
@@ -387,6 +393,7 @@ func (h *CompletionHandler) RegisterRoutes(r *router.Router, middlewares ...lib.
 	r.GET("/v1/files/{file_id}", lib.ChainMiddlewares(h.fileRetrieve, middlewares...))
 	r.DELETE("/v1/files/{file_id}", lib.ChainMiddlewares(h.fileDelete, middlewares...))
 	r.GET("/v1/files/{file_id}/content", lib.ChainMiddlewares(h.fileContent, middlewares...))
+	r.GET("/v1/files/{file_id}/download_url", lib.ChainMiddlewares(h.fileDownloadURL, middlewares...))
 }
 
 // listModels handles GET /v1/models - Process list models requests
@@ -476,18 +483,18 @@ func (h *CompletionHandler) listModels(ctx *fasthttp.RequestCtx) {
 // textCompletion handles POST /v1/completions - Process text completion requests
 func (h *CompletionHandler) textCompletion(ctx *fasthttp.RequestCtx) {
 	var req TextRequest
-	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
 		return
 	}
 	// Create BifrostTextCompletionRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := parseRequestModel(ctx, h.client, req.Model)
 	if provider == "" || modelName == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
 		return
 	}
 	// Parse fallbacks using helper function
-	fallbacks, err := parseFallbacks(req.Fallbacks)
+	fallbacks, err := parseFallbacks(h.client, req.Fallbacks)
 	if err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
 		return
@@ -549,20 +556,20 @@ func (h *CompletionHandler) chatCompletion(ctx *fasthttp.RequestCtx) {
 	req := ChatRequest{
 		ChatParameters: &schemas.ChatParameters{},
 	}
-	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
 		return
 	}
 
 	// Create BifrostChatRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := parseRequestModel(ctx, h.client, req.Model)
 	if provider == "" || modelName == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
 		return
 	}
 
 	// Parse fallbacks using helper function
-	fallbacks, err := parseFallbacks(req.Fallbacks)
+	fallbacks, err := parseFallbacks(h.client, req.Fallbacks)
 	if err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
 		return
@@ -587,11 +594,12 @@ func (h *CompletionHandler) chatCompletion(ctx *fasthttp.RequestCtx) {
 
 	// Create segregated BifrostChatRequest
 	bifrostChatReq := &schemas.BifrostChatRequest{
-		Provider:  schemas.ModelProvider(provider),
-		Model:     modelName,
-		Input:     req.Messages,
-		Params:    req.ChatParameters,
-		Fallbacks: fallbacks,
+		Provider:    schemas.ModelProvider(provider),
+		Model:       modelName,
+		Input:       req.Messages,
+		Params:      req.ChatParameters,
+		Fallbacks:   fallbacks,
+		Attachments: req.Attachments,
 	}
 
 	// Convert context
@@ -621,20 +629,20 @@ func (h *CompletionHandler) chatCompletion(ctx *fasthttp.RequestCtx) {
 // responses handles POST /v1/responses - Process responses requests
 func (h *CompletionHandler) responses(ctx *fasthttp.RequestCtx) {
 	var req ResponsesRequest
-	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
 		return
 	}
 
 	// Create BifrostResponsesRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := parseRequestModel(ctx, h.client, req.Model)
 	if provider == "" || modelName == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
 		return
 	}
 
 	// Parse fallbacks using helper function
-	fallbacks, err := parseFallbacks(req.Fallbacks)
+	fallbacks, err := parseFallbacks(h.client, req.Fallbacks)
 	if err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
 		return
@@ -703,20 +711,20 @@ func (h *CompletionHandler) responses(ctx *fasthttp.RequestCtx) {
 // embeddings handles POST /v1/embeddings - Process embeddings requests
 func (h *CompletionHandler) embeddings(ctx *fasthttp.RequestCtx) {
 	var req EmbeddingRequest
-	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
 		return
 	}
 
 	// Create BifrostEmbeddingRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := parseRequestModel(ctx, h.client, req.Model)
 	if provider == "" || modelName == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
 		return
 	}
 
 	// Parse fallbacks using helper function
-	fallbacks, err := parseFallbacks(req.Fallbacks)
+	fallbacks, err := parseFallbacks(h.client, req.Fallbacks)
 	if err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
 		return
@@ -769,20 +777,20 @@ func (h *CompletionHandler) embeddings(ctx *fasthttp.RequestCtx) {
 // speech handles POST /v1/audio/speech - Process speech completion requests
 func (h *CompletionHandler) speech(ctx *fasthttp.RequestCtx) {
 	var req SpeechRequest
-	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
 		return
 	}
 
 	// Create BifrostSpeechRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := parseRequestModel(ctx, h.client, req.Model)
 	if provider == "" || modelName == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
 		return
 	}
 
 	// Parse fallbacks using helper function
-	fallbacks, err := parseFallbacks(req.Fallbacks)
+	fallbacks, err := parseFallbacks(h.client, req.Fallbacks)
 	if err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
 		return
@@ -881,7 +889,7 @@ func (h *CompletionHandler) transcription(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
-	provider, modelName := schemas.ParseModelString(modelValues[0], "")
+	provider, modelName := parseRequestModel(ctx, h.client, modelValues[0])
 	if provider == "" || modelName == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
 		return
@@ -929,6 +937,15 @@ func (h *CompletionHandler) transcription(ctx *fasthttp.RequestCtx) {
 		transcriptionParams.Language = &languageValues[0]
 	}
 
+	if translateValues := form.Value["translate"]; len(translateValues) > 0 && translateValues[0] != "" {
+		translate, err := strconv.ParseBool(translateValues[0])
+		if err != nil {
+			SendError(ctx, fasthttp.StatusBadRequest, "translate must be a boolean")
+			return
+		}
+		transcriptionParams.Translate = &translate
+	}
+
 	if promptValues := form.Value["prompt"]; len(promptValues) > 0 && promptValues[0] != "" {
 		transcriptionParams.Prompt = &promptValues[0]
 	}
@@ -981,6 +998,13 @@ func (h *CompletionHandler) transcription(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	// Text, srt and vtt transcriptions are sent as plain text
+	if resp.Formatted != nil {
+		ctx.SetContentType("text/plain; charset=utf-8")
+		ctx.SetBodyString(*resp.Formatted)
+		return
+	}
+
 	// Send successful response
 	SendJSON(ctx, resp)
 }
@@ -995,7 +1019,7 @@ func (h *CompletionHandler) handleStreamingTextCompletion(ctx *fasthttp.RequestC
 		return h.client.TextCompletionStreamRequest(streamCtx, req)
 	}
 
-	h.handleStreamingResponse(ctx, getStream, cancel)
+	handleStreamingResponse(ctx, getStream, cancel)
 }
 
 // handleStreamingChatCompletion handles streaming chat completion requests using Server-Sent Events (SSE)
@@ -1008,7 +1032,7 @@ func (h *CompletionHandler) handleStreamingChatCompletion(ctx *fasthttp.RequestC
 		return h.client.ChatCompletionStreamRequest(streamCtx, req)
 	}
 
-	h.handleStreamingResponse(ctx, getStream, cancel)
+	handleStreamingResponse(ctx, getStream, cancel)
 }
 
 // handleStreamingResponses handles streaming responses requests using Server-Sent Events (SSE)
@@ -1021,7 +1045,7 @@ func (h *CompletionHandler) handleStreamingResponses(ctx *fasthttp.RequestCtx, r
 		return h.client.ResponsesStreamRequest(streamCtx, req)
 	}
 
-	h.handleStreamingResponse(ctx, getStream, cancel)
+	handleStreamingResponse(ctx, getStream, cancel)
 }
 
 // handleStreamingSpeech handles streaming speech requests using Server-Sent Events (SSE)
@@ -1034,7 +1058,7 @@ func (h *CompletionHandler) handleStreamingSpeech(ctx *fasthttp.RequestCtx, req
 		return h.client.SpeechStreamRequest(streamCtx, req)
 	}
 
-	h.handleStreamingResponse(ctx, getStream, cancel)
+	handleStreamingResponse(ctx, getStream, cancel)
 }
 
 // handleStreamingTranscriptionRequest handles streaming transcription requests using Server-Sent Events (SSE)
@@ -1047,14 +1071,14 @@ func (h *CompletionHandler) handleStreamingTranscriptionRequest(ctx *fasthttp.Re
 		return h.client.TranscriptionStreamRequest(streamCtx, req)
 	}
 
-	h.handleStreamingResponse(ctx, getStream, cancel)
+	handleStreamingResponse(ctx, getStream, cancel)
 }
 
 // handleStreamingResponse is a generic function to handle streaming responses using Server-Sent Events (SSE)
 // The cancel function is called ONLY when client disconnects are detected via write errors.
 // Bifrost handles cleanup internally for normal completion and errors, so we only cancel
 // upstream streams when write errors indicate the client has disconnected.
-func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, getStream func() (chan *schemas.BifrostStream, *schemas.BifrostError), cancel context.CancelFunc) {
+func handleStreamingResponse(ctx *fasthttp.RequestCtx, getStream func() (chan *schemas.BifrostStream, *schemas.BifrostError), cancel context.CancelFunc) {
 	// Set SSE headers
 	ctx.SetContentType("text/event-stream")
 	ctx.Response.Header.Set("Cache-Control", "no-cache")
@@ -1089,7 +1113,7 @@ func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, ge
 			}
 
 			// Convert response to JSON
-			chunkJSON, err := sonic.Marshal(chunk)
+			chunkJSON, err := serialization.Marshal(chunk)
 			if err != nil {
 				logger.Warn(fmt.Sprintf("Failed to marshal streaming response: %v", err))
 				continue
@@ -1224,13 +1248,13 @@ func (h *CompletionHandler) validateAudioFile(fileHeader *multipart.FileHeader)
 // batchCreate handles POST /v1/batches - Create a new batch job
 func (h *CompletionHandler) batchCreate(ctx *fasthttp.RequestCtx) {
 	var req BatchCreateRequest
-	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
 		return
 	}
 
 	// Parse provider from model string
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := h.client.ParseModelString(req.Model, "")
 	if provider == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format or provider must be specified")
 		return
@@ -1282,7 +1306,7 @@ func (h *CompletionHandler) batchCreate(ctx *fasthttp.RequestCtx) {
 	SendJSON(ctx, resp)
 }
 
-// batchList handles GET /v1/batches - List batch jobs
+// batchList handles GET /v1/batches - List batch jobs, of all keys at once with all_keys=true
 func (h *CompletionHandler) batchList(ctx *fasthttp.RequestCtx) {
 	// Get provider from query parameters
 	provider := string(ctx.QueryArgs().Peek("provider"))
@@ -1317,6 +1341,11 @@ func (h *CompletionHandler) batchList(ctx *fasthttp.RequestCtx) {
 		After:    after,
 		BeforeID: before,
 	}
+	// all_keys=true merges the batches of all keys, the cursor is read from After or AfterID depending on the provider
+	if string(ctx.QueryArgs().Peek("all_keys")) == "true" {
+		bifrostBatchReq.AllKeys = true
+		bifrostBatchReq.AfterID = after
+	}
 
 	// Convert context
 	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys())
@@ -1429,10 +1458,36 @@ func (h *CompletionHandler) batchResults(ctx *fasthttp.RequestCtx) {
 		return
 	}
 
+	// Parse pagination parameters, all results are returned at once if neither is set
+	limit := 0
+	if limitStr := ctx.QueryArgs().Peek("limit"); len(limitStr) > 0 {
+		if n, err := strconv.Atoi(string(limitStr)); err == nil && n > 0 {
+			limit = n
+		}
+	}
+	var after *string
+	if afterStr := ctx.QueryArgs().Peek("after"); len(afterStr) > 0 {
+		s := string(afterStr)
+		after = &s
+	}
+
+	// Parse submission order, a comma-separated list of custom IDs to return the results in
+	var customIDOrder []string
+	if orderStr := ctx.QueryArgs().Peek("custom_id_order"); len(orderStr) > 0 {
+		for _, customID := range strings.Split(string(orderStr), ",") {
+			if customID = strings.TrimSpace(customID); customID != "" {
+				customIDOrder = append(customIDOrder, customID)
+			}
+		}
+	}
+
 	// Build Bifrost batch results request
 	bifrostBatchReq := &schemas.BifrostBatchResultsRequest{
-		Provider: schemas.ModelProvider(provider),
-		BatchID:  batchID,
+		Provider:      schemas.ModelProvider(provider),
+		BatchID:       batchID,
+		Limit:         limit,
+		After:         after,
+		CustomIDOrder: customIDOrder,
 	}
 
 	// Convert context
@@ -1712,3 +1767,55 @@ func (h *CompletionHandler) fileContent(ctx *fasthttp.RequestCtx) {
 	ctx.Response.Header.Set("Content-Length", strconv.Itoa(len(resp.Content)))
 	ctx.Response.SetBody(resp.Content)
 }
+
+// fileDownloadURL handles GET /v1/files/{file_id}/download_url - Get a short-lived URL to download a file directly
+// from the provider's storage
+func (h *CompletionHandler) fileDownloadURL(ctx *fasthttp.RequestCtx) {
+	// Get file ID from URL parameter
+	fileID := ctx.UserValue("file_id").(string)
+	if fileID == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "file_id is required")
+		return
+	}
+
+	// Get provider from query parameters
+	provider := string(ctx.QueryArgs().Peek("provider"))
+	if provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider query parameter is required")
+		return
+	}
+
+	// Parse the optional URL lifetime
+	var ttl time.Duration
+	if ttlStr := ctx.QueryArgs().Peek("ttl_seconds"); len(ttlStr) > 0 {
+		seconds, err := strconv.Atoi(string(ttlStr))
+		if err != nil || seconds <= 0 {
+			SendError(ctx, fasthttp.StatusBadRequest, "ttl_seconds must be a positive integer")
+			return
+		}
+		ttl = time.Duration(seconds) * time.Second
+	}
+
+	// Build Bifrost file download URL request
+	bifrostFileReq := &schemas.BifrostFileDownloadURLRequest{
+		Provider: schemas.ModelProvider(provider),
+		FileID:   fileID,
+		TTL:      ttl,
+	}
+
+	// Convert context
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.FileDownloadURLRequest(*bifrostCtx, bifrostFileReq)
+	if bifrostErr != nil {
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	SendJSON(ctx, resp)
+}
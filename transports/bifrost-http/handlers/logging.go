@@ -9,9 +9,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/fasthttp/router"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/framework/configstore/tables"
 	"github.com/maximhq/bifrost/framework/logstore"
 	"github.com/maximhq/bifrost/plugins/logging"
@@ -365,7 +365,7 @@ func (h *LoggingHandler) deleteLogs(ctx *fasthttp.RequestCtx) {
 	var req struct {
 		IDs []string `json:"ids"`
 	}
-	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+	if err := serialization.Unmarshal(ctx.PostBody(), &req); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, "Invalid JSON")
 		return
 	}
@@ -391,7 +391,7 @@ func (h *LoggingHandler) recalculateLogCosts(ctx *fasthttp.RequestCtx) {
 	var payload recalculateCostRequest
 	body := ctx.PostBody()
 	if len(body) > 0 {
-		if err := sonic.Unmarshal(body, &payload); err != nil {
+		if err := serialization.Unmarshal(body, &payload); err != nil {
 			SendError(ctx, fasthttp.StatusBadRequest, "Invalid JSON")
 			return
 		}
@@ -54,14 +54,22 @@ const (
 // ProviderResponse represents the response for provider operations
 type ProviderResponse struct {
 	Name                     schemas.ModelProvider            `json:"name"`
-	Keys                     []schemas.Key                    `json:"keys"`                             // API keys for the provider
-	NetworkConfig            schemas.NetworkConfig            `json:"network_config"`                   // Network-related settings
-	ConcurrencyAndBufferSize schemas.ConcurrencyAndBufferSize `json:"concurrency_and_buffer_size"`      // Concurrency settings
-	ProxyConfig              *schemas.ProxyConfig             `json:"proxy_config"`                     // Proxy configuration
-	SendBackRawRequest       bool                             `json:"send_back_raw_request"`            // Include raw request in BifrostResponse
-	SendBackRawResponse      bool                             `json:"send_back_raw_response"`           // Include raw response in BifrostResponse
-	CustomProviderConfig     *schemas.CustomProviderConfig    `json:"custom_provider_config,omitempty"` // Custom provider configuration
-	Status                   ProviderStatus                   `json:"status"`                           // Status of the provider
+	Keys                     []schemas.Key                    `json:"keys"`                                // API keys for the provider
+	NetworkConfig            schemas.NetworkConfig            `json:"network_config"`                      // Network-related settings
+	ConcurrencyAndBufferSize schemas.ConcurrencyAndBufferSize `json:"concurrency_and_buffer_size"`         // Concurrency settings
+	ProxyConfig              *schemas.ProxyConfig             `json:"proxy_config"`                        // Proxy configuration
+	SendBackRawRequest       bool                             `json:"send_back_raw_request"`               // Include raw request in BifrostResponse
+	SendBackRawResponse      bool                             `json:"send_back_raw_response"`              // Include raw response in BifrostResponse
+	CustomProviderConfig     *schemas.CustomProviderConfig    `json:"custom_provider_config,omitempty"`    // Custom provider configuration
+	BatchNameTemplate        string                           `json:"batch_name_template,omitempty"`       // Naming template of batch jobs
+	Bulkhead                 *schemas.BulkheadConfig          `json:"bulkhead,omitempty"`                  // Per-provider resource isolation
+	OutboundRateLimit        *schemas.OutboundRateLimitConfig `json:"outbound_rate_limit,omitempty"`       // Pacing of requests sent to the provider
+	ParameterRules           []schemas.ModelParameterRule     `json:"parameter_rules,omitempty"`           // Parameters each model accepts
+	RawResponseDenylist      []string                         `json:"raw_response_denylist,omitempty"`     // JSON paths removed from raw responses
+	AssistantPrefill         schemas.AssistantPrefillMode     `json:"assistant_prefill,omitempty"`         // Handling of assistant prefills
+	MaxConcurrentStreams     int                              `json:"max_concurrent_streams,omitempty"`    // Maximum number of streams open at once
+	MaxInlineBatchRequests   int                              `json:"max_inline_batch_requests,omitempty"` // Maximum number of inline requests of a batch
+	Status                   ProviderStatus                   `json:"status"`                              // Status of the provider
 }
 
 // ListProvidersResponse represents the response for listing all providers
@@ -179,6 +187,14 @@ func (h *ProviderHandler) addProvider(ctx *fasthttp.RequestCtx) {
 		SendBackRawRequest       *bool                             `json:"send_back_raw_request,omitempty"`       // Include raw request in BifrostResponse
 		SendBackRawResponse      *bool                             `json:"send_back_raw_response,omitempty"`      // Include raw response in BifrostResponse
 		CustomProviderConfig     *schemas.CustomProviderConfig     `json:"custom_provider_config,omitempty"`      // Custom provider configuration
+		BatchNameTemplate        string                            `json:"batch_name_template,omitempty"`         // Naming template of batch jobs
+		Bulkhead                 *schemas.BulkheadConfig           `json:"bulkhead,omitempty"`                    // Per-provider resource isolation
+		OutboundRateLimit        *schemas.OutboundRateLimitConfig  `json:"outbound_rate_limit,omitempty"`         // Pacing of requests sent to the provider
+		ParameterRules           []schemas.ModelParameterRule      `json:"parameter_rules,omitempty"`             // Parameters each model accepts
+		RawResponseDenylist      []string                          `json:"raw_response_denylist,omitempty"`       // JSON paths removed from raw responses
+		AssistantPrefill         schemas.AssistantPrefillMode      `json:"assistant_prefill,omitempty"`           // Handling of assistant prefills
+		MaxConcurrentStreams     int                               `json:"max_concurrent_streams,omitempty"`      // Maximum number of streams open at once
+		MaxInlineBatchRequests   int                               `json:"max_inline_batch_requests,omitempty"`   // Maximum number of inline requests of a batch
 	}{}
 
 	if err := json.Unmarshal(ctx.PostBody(), &payload); err != nil {
@@ -250,6 +266,14 @@ func (h *ProviderHandler) addProvider(ctx *fasthttp.RequestCtx) {
 		SendBackRawRequest:       payload.SendBackRawRequest != nil && *payload.SendBackRawRequest,
 		SendBackRawResponse:      payload.SendBackRawResponse != nil && *payload.SendBackRawResponse,
 		CustomProviderConfig:     payload.CustomProviderConfig,
+		BatchNameTemplate:        payload.BatchNameTemplate,
+		Bulkhead:                 payload.Bulkhead,
+		OutboundRateLimit:        payload.OutboundRateLimit,
+		ParameterRules:           payload.ParameterRules,
+		RawResponseDenylist:      payload.RawResponseDenylist,
+		AssistantPrefill:         payload.AssistantPrefill,
+		MaxConcurrentStreams:     payload.MaxConcurrentStreams,
+		MaxInlineBatchRequests:   payload.MaxInlineBatchRequests,
 	}
 
 	// Validate custom provider configuration before persisting
@@ -279,6 +303,14 @@ func (h *ProviderHandler) addProvider(ctx *fasthttp.RequestCtx) {
 			SendBackRawRequest:       config.SendBackRawRequest,
 			SendBackRawResponse:      config.SendBackRawResponse,
 			CustomProviderConfig:     config.CustomProviderConfig,
+			BatchNameTemplate:        config.BatchNameTemplate,
+			Bulkhead:                 config.Bulkhead,
+			OutboundRateLimit:        config.OutboundRateLimit,
+			ParameterRules:           config.ParameterRules,
+			RawResponseDenylist:      config.RawResponseDenylist,
+			AssistantPrefill:         config.AssistantPrefill,
+			MaxConcurrentStreams:     config.MaxConcurrentStreams,
+			MaxInlineBatchRequests:   config.MaxInlineBatchRequests,
 		}, ProviderStatusActive)
 		SendJSON(ctx, response)
 		return
@@ -320,6 +352,15 @@ func (h *ProviderHandler) updateProvider(ctx *fasthttp.RequestCtx) {
 		SendBackRawRequest       *bool                            `json:"send_back_raw_request,omitempty"`  // Include raw request in BifrostResponse
 		SendBackRawResponse      *bool                            `json:"send_back_raw_response,omitempty"` // Include raw response in BifrostResponse
 		CustomProviderConfig     *schemas.CustomProviderConfig    `json:"custom_provider_config,omitempty"` // Custom provider configuration
+		BatchNameTemplate        *string                          `json:"batch_name_template,omitempty"`    // Naming template of batch jobs, kept if omitted
+		// Resource isolation and request shaping settings, kept if omitted
+		Bulkhead               *schemas.BulkheadConfig          `json:"bulkhead,omitempty"`
+		OutboundRateLimit      *schemas.OutboundRateLimitConfig `json:"outbound_rate_limit,omitempty"`
+		ParameterRules         []schemas.ModelParameterRule     `json:"parameter_rules,omitempty"`
+		RawResponseDenylist    []string                         `json:"raw_response_denylist,omitempty"`
+		AssistantPrefill       *schemas.AssistantPrefillMode    `json:"assistant_prefill,omitempty"`
+		MaxConcurrentStreams   *int                             `json:"max_concurrent_streams,omitempty"`
+		MaxInlineBatchRequests *int                             `json:"max_inline_batch_requests,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(ctx.PostBody(), &payload); err != nil {
@@ -361,6 +402,14 @@ func (h *ProviderHandler) updateProvider(ctx *fasthttp.RequestCtx) {
 		ConcurrencyAndBufferSize: oldConfigRaw.ConcurrencyAndBufferSize,
 		ProxyConfig:              oldConfigRaw.ProxyConfig,
 		CustomProviderConfig:     oldConfigRaw.CustomProviderConfig,
+		BatchNameTemplate:        oldConfigRaw.BatchNameTemplate,
+		Bulkhead:                 oldConfigRaw.Bulkhead,
+		OutboundRateLimit:        oldConfigRaw.OutboundRateLimit,
+		ParameterRules:           oldConfigRaw.ParameterRules,
+		RawResponseDenylist:      oldConfigRaw.RawResponseDenylist,
+		AssistantPrefill:         oldConfigRaw.AssistantPrefill,
+		MaxConcurrentStreams:     oldConfigRaw.MaxConcurrentStreams,
+		MaxInlineBatchRequests:   oldConfigRaw.MaxInlineBatchRequests,
 	}
 
 	// Environment variable cleanup is now handled automatically by mergeKeys function
@@ -434,6 +483,30 @@ func (h *ProviderHandler) updateProvider(ctx *fasthttp.RequestCtx) {
 	if payload.SendBackRawResponse != nil {
 		config.SendBackRawResponse = *payload.SendBackRawResponse
 	}
+	if payload.BatchNameTemplate != nil {
+		config.BatchNameTemplate = *payload.BatchNameTemplate
+	}
+	if payload.Bulkhead != nil {
+		config.Bulkhead = payload.Bulkhead
+	}
+	if payload.OutboundRateLimit != nil {
+		config.OutboundRateLimit = payload.OutboundRateLimit
+	}
+	if payload.ParameterRules != nil {
+		config.ParameterRules = payload.ParameterRules
+	}
+	if payload.RawResponseDenylist != nil {
+		config.RawResponseDenylist = payload.RawResponseDenylist
+	}
+	if payload.AssistantPrefill != nil {
+		config.AssistantPrefill = *payload.AssistantPrefill
+	}
+	if payload.MaxConcurrentStreams != nil {
+		config.MaxConcurrentStreams = *payload.MaxConcurrentStreams
+	}
+	if payload.MaxInlineBatchRequests != nil {
+		config.MaxInlineBatchRequests = *payload.MaxInlineBatchRequests
+	}
 
 	// Update provider config in store (env vars will be processed by store)
 	if err := h.store.UpdateProviderConfig(ctx, provider, config); err != nil {
@@ -462,6 +535,14 @@ func (h *ProviderHandler) updateProvider(ctx *fasthttp.RequestCtx) {
 			SendBackRawRequest:       config.SendBackRawRequest,
 			SendBackRawResponse:      config.SendBackRawResponse,
 			CustomProviderConfig:     config.CustomProviderConfig,
+			BatchNameTemplate:        config.BatchNameTemplate,
+			Bulkhead:                 config.Bulkhead,
+			OutboundRateLimit:        config.OutboundRateLimit,
+			ParameterRules:           config.ParameterRules,
+			RawResponseDenylist:      config.RawResponseDenylist,
+			AssistantPrefill:         config.AssistantPrefill,
+			MaxConcurrentStreams:     config.MaxConcurrentStreams,
+			MaxInlineBatchRequests:   config.MaxInlineBatchRequests,
 		}, ProviderStatusActive)
 		SendJSON(ctx, response)
 		return
@@ -856,6 +937,14 @@ func (h *ProviderHandler) getProviderResponseFromConfig(provider schemas.ModelPr
 		SendBackRawRequest:       config.SendBackRawRequest,
 		SendBackRawResponse:      config.SendBackRawResponse,
 		CustomProviderConfig:     config.CustomProviderConfig,
+		BatchNameTemplate:        config.BatchNameTemplate,
+		Bulkhead:                 config.Bulkhead,
+		OutboundRateLimit:        config.OutboundRateLimit,
+		ParameterRules:           config.ParameterRules,
+		RawResponseDenylist:      config.RawResponseDenylist,
+		AssistantPrefill:         config.AssistantPrefill,
+		MaxConcurrentStreams:     config.MaxConcurrentStreams,
+		MaxInlineBatchRequests:   config.MaxInlineBatchRequests,
 		Status:                   status,
 	}
 }
@@ -0,0 +1,114 @@
+// Package handlers provides HTTP request handlers for the Bifrost HTTP transport.
+// This file contains the failed request handler, which exports captured failed requests and replays them.
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/fasthttp/router"
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
+	"github.com/maximhq/bifrost/transports/bifrost-http/lib"
+	"github.com/valyala/fasthttp"
+)
+
+// FailedRequestHandler manages HTTP requests for exporting and replaying captured failed requests
+type FailedRequestHandler struct {
+	client       *bifrost.Bifrost
+	handlerStore lib.HandlerStore
+}
+
+// NewFailedRequestHandler creates a new failed request handler instance
+func NewFailedRequestHandler(client *bifrost.Bifrost, handlerStore lib.HandlerStore) *FailedRequestHandler {
+	return &FailedRequestHandler{
+		client:       client,
+		handlerStore: handlerStore,
+	}
+}
+
+// RegisterRoutes registers the failed request routes
+func (h *FailedRequestHandler) RegisterRoutes(r *router.Router, middlewares ...lib.BifrostHTTPMiddleware) {
+	r.GET("/api/failed-requests", lib.ChainMiddlewares(h.listFailedRequests, middlewares...))
+	r.GET("/api/failed-requests/{capture_id}", lib.ChainMiddlewares(h.getFailedRequest, middlewares...))
+	r.POST("/api/failed-requests/replay", lib.ChainMiddlewares(h.replayFailedRequest, middlewares...))
+}
+
+// listFailedRequests handles GET /api/failed-requests - List the captured failed requests, most recent first
+func (h *FailedRequestHandler) listFailedRequests(ctx *fasthttp.RequestCtx) {
+	captures := h.client.FailedRequestCaptures()
+	if captures == nil {
+		captures = []schemas.CapturedRequest{}
+	}
+	SendJSON(ctx, map[string]any{
+		"failed_requests": captures,
+		"count":           len(captures),
+	})
+}
+
+// getFailedRequest handles GET /api/failed-requests/{capture_id} - Export a captured failed request
+func (h *FailedRequestHandler) getFailedRequest(ctx *fasthttp.RequestCtx) {
+	captureID, ok := ctx.UserValue("capture_id").(string)
+	if !ok || captureID == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "capture_id is required")
+		return
+	}
+	capture, found := h.client.GetFailedRequestCapture(captureID)
+	if !found {
+		SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("failed request %s not found or expired", captureID))
+		return
+	}
+	SendJSON(ctx, capture)
+}
+
+// replayFailedRequest handles POST /api/failed-requests/replay - Re-submit an exported failed request.
+// The body is an export from GET /api/failed-requests/{capture_id}, possibly from another Bifrost instance.
+// Streaming requests are replayed as Server-Sent Events.
+func (h *FailedRequestHandler) replayFailedRequest(ctx *fasthttp.RequestCtx) {
+	var capture schemas.CapturedRequest
+	if err := serialization.Unmarshal(ctx.PostBody(), &capture); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	if capture.RequestType == "" || len(capture.Request) == 0 {
+		SendError(ctx, fasthttp.StatusBadRequest, "request_type and request are required")
+		return
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys())
+	if bifrostCtx == nil {
+		cancel()
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to convert context")
+		return
+	}
+
+	response, stream, bifrostErr := h.client.ReplayCapturedRequest(*bifrostCtx, &capture)
+	if stream != nil {
+		handleStreamingResponse(ctx, func() (chan *schemas.BifrostStream, *schemas.BifrostError) {
+			return stream, nil
+		}, cancel)
+		return
+	}
+	defer cancel()
+	if bifrostErr != nil {
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	switch {
+	case response.TextCompletionResponse != nil:
+		SendJSON(ctx, response.TextCompletionResponse)
+	case response.ChatResponse != nil:
+		SendJSON(ctx, response.ChatResponse)
+	case response.ResponsesResponse != nil:
+		SendJSON(ctx, response.ResponsesResponse)
+	case response.EmbeddingResponse != nil:
+		SendJSON(ctx, response.EmbeddingResponse)
+	case response.SpeechResponse != nil:
+		SendJSON(ctx, response.SpeechResponse)
+	case response.TranscriptionResponse != nil:
+		SendJSON(ctx, response.TranscriptionResponse)
+	default:
+		SendError(ctx, fasthttp.StatusInternalServerError, "replay returned no response")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	bifrost "github.com/maximhq/bifrost/core"
 	"github.com/maximhq/bifrost/core/schemas"
 	"github.com/valyala/fasthttp"
 )
@@ -180,3 +181,18 @@ func fuzzyMatch(text, query string) bool {
 
 	return queryIndex == len(queryRunes)
 }
+
+// parseRequestModel parses the provider/model string of a request with the client's model name normalization.
+// When the model name is normalized (e.g. an alias or a case-folded name), the model as sent by the client is
+// kept on the request to be reported as ModelRequested.
+func parseRequestModel(ctx *fasthttp.RequestCtx, client *bifrost.Bifrost, model string) (schemas.ModelProvider, string) {
+	provider, modelName := client.ParseModelString(model, "")
+	requested := strings.TrimSpace(model)
+	if _, requestedModel, found := strings.Cut(requested, "/"); found {
+		requested = strings.TrimSpace(requestedModel)
+	}
+	if modelName != "" && requested != modelName {
+		ctx.SetUserValue(schemas.BifrostContextKeyModelRequested, requested)
+	}
+	return provider, modelName
+}
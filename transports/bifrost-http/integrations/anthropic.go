@@ -8,10 +8,10 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/bytedance/sonic"
 	bifrost "github.com/maximhq/bifrost/core"
 	"github.com/maximhq/bifrost/core/providers/anthropic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 
 	"github.com/maximhq/bifrost/transports/bifrost-http/lib"
 	"github.com/valyala/fasthttp"
@@ -95,7 +95,7 @@ func createAnthropicMessagesRouteConfig(pathPrefix string) []RouteConfig {
 								return "", nil, fmt.Errorf("expected RawResponse string, got %T", resp.ExtraFields.RawResponse)
 							}
 							var rawResponseJSON anthropic.AnthropicStreamEvent
-							if err := sonic.Unmarshal([]byte(raw), &rawResponseJSON); err == nil {
+							if err := serialization.Unmarshal([]byte(raw), &rawResponseJSON); err == nil {
 								return string(rawResponseJSON.Type), raw, nil
 							}
 						}
@@ -109,7 +109,7 @@ func createAnthropicMessagesRouteConfig(pathPrefix string) []RouteConfig {
 						if len(anthropicResponse) > 1 {
 							combinedContent := ""
 							for _, event := range anthropicResponse {
-								responseJSON, err := sonic.Marshal(event)
+								responseJSON, err := serialization.Marshal(event)
 								if err != nil {
 									continue
 								}
@@ -143,7 +143,7 @@ func CreateOpenAIRouteConfigs(pathPrefix string, handlerStore lib.HandlerStore)
 					return "", resp, nil
 				},
 				ErrorConverter: func(ctx *context.Context, err *schemas.BifrostError) interface{} {
-					return err
+					return openai.ToOpenAIStreamError(err)
 				},
 			},
 			PreCallback: AzureEndpointPreHook(handlerStore),
@@ -192,7 +192,7 @@ func CreateOpenAIRouteConfigs(pathPrefix string, handlerStore lib.HandlerStore)
 					return "", resp, nil
 				},
 				ErrorConverter: func(ctx *context.Context, err *schemas.BifrostError) interface{} {
-					return err
+					return openai.ToOpenAIStreamError(err)
 				},
 			},
 			PreCallback: AzureEndpointPreHook(handlerStore),
@@ -242,7 +242,7 @@ func CreateOpenAIRouteConfigs(pathPrefix string, handlerStore lib.HandlerStore)
 					return string(resp.Type), resp, nil
 				},
 				ErrorConverter: func(ctx *context.Context, err *schemas.BifrostError) interface{} {
-					return err
+					return openai.ToOpenAIResponsesStreamError(err)
 				},
 			},
 			PreCallback: AzureEndpointPreHook(handlerStore),
@@ -319,18 +319,21 @@ func CreateOpenAIRouteConfigs(pathPrefix string, handlerStore lib.HandlerStore)
 					return "", resp, nil
 				},
 				ErrorConverter: func(ctx *context.Context, err *schemas.BifrostError) interface{} {
-					return err
+					return openai.ToOpenAIStreamError(err)
 				},
 			},
 			PreCallback: AzureEndpointPreHook(handlerStore),
 		})
 	}
 
-	// Audio transcription endpoint
+	// Audio transcription and translation endpoints
 	for _, path := range []string{
 		"/v1/audio/transcriptions",
 		"/audio/transcriptions",
 		"/openai/deployments/{deployment-id}/audio/transcriptions",
+		"/v1/audio/translations",
+		"/audio/translations",
+		"/openai/deployments/{deployment-id}/audio/translations",
 	} {
 		routes = append(routes, RouteConfig{
 			Type:   RouteConfigTypeOpenAI,
@@ -369,7 +372,7 @@ func CreateOpenAIRouteConfigs(pathPrefix string, handlerStore lib.HandlerStore)
 					return "", resp, nil
 				},
 				ErrorConverter: func(ctx *context.Context, err *schemas.BifrostError) interface{} {
-					return err
+					return openai.ToOpenAIStreamError(err)
 				},
 			},
 			PreCallback: AzureEndpointPreHook(handlerStore),
@@ -1325,6 +1328,11 @@ func parseTranscriptionMultipartRequest(ctx *fasthttp.RequestCtx, req interface{
 		transcriptionReq.TranscriptionParameters.Language = &language
 	}
 
+	// Translations endpoints translate the audio to English
+	if strings.HasSuffix(string(ctx.Path()), "/audio/translations") {
+		transcriptionReq.TranscriptionParameters.Translate = schemas.Ptr(true)
+	}
+
 	if promptValues := form.Value["prompt"]; len(promptValues) > 0 && promptValues[0] != "" {
 		prompt := promptValues[0]
 		transcriptionReq.TranscriptionParameters.Prompt = &prompt
@@ -57,11 +57,11 @@ import (
 	"bufio"
 
 	"github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream"
-	"github.com/bytedance/sonic"
 	"github.com/fasthttp/router"
 	bifrost "github.com/maximhq/bifrost/core"
 	"github.com/maximhq/bifrost/core/providers/bedrock"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/transports/bifrost-http/lib"
 	"github.com/valyala/fasthttp"
 )
@@ -405,7 +405,7 @@ func (g *GenericRouter) createHandler(config RouteConfig) fasthttp.RequestHandle
 				// Use default JSON parsing
 				rawBody = ctx.Request.Body()
 				if len(rawBody) > 0 {
-					if err := sonic.Unmarshal(rawBody, req); err != nil {
+					if err := serialization.Unmarshal(rawBody, req); err != nil {
 						g.sendError(ctx, bifrostCtx, config.ErrorConverter, newBifrostError(err, "Invalid JSON"))
 						return
 					}
@@ -473,6 +473,7 @@ func (g *GenericRouter) createHandler(config RouteConfig) fasthttp.RequestHandle
 			g.sendError(ctx, bifrostCtx, config.ErrorConverter, newBifrostError(nil, "Invalid request"))
 			return
 		}
+		g.normalizeRequestModel(bifrostCtx, bifrostReq)
 		if sendRawRequestBody, ok := (*bifrostCtx).Value(schemas.BifrostContextKeyUseRawRequestBody).(bool); ok && sendRawRequestBody {
 			bifrostReq.SetRawRequestBody(rawBody)
 		}
@@ -678,6 +679,14 @@ func (g *GenericRouter) handleNonStreamingRequest(ctx *fasthttp.RequestCtx, conf
 			return
 		}
 
+		// Text, srt and vtt transcriptions are sent as plain text, as OpenAI does
+		if transcriptionResponse.Formatted != nil {
+			ctx.SetStatusCode(fasthttp.StatusOK)
+			ctx.SetContentType("text/plain; charset=utf-8")
+			ctx.SetBodyString(*transcriptionResponse.Formatted)
+			return
+		}
+
 		// Convert Bifrost response to integration-specific format and send
 		response, err = config.TranscriptionResponseConverter(bifrostCtx, transcriptionResponse)
 	default:
@@ -1162,7 +1171,7 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *co
 					// STANDARD SSE FORMAT: The converter returned an object
 					// This will be JSON marshaled and wrapped as "data: {json}\n\n"
 					// Used by most providers (OpenAI, Google, etc.)
-					errorJSON, err = sonic.Marshal(errorResponse)
+					errorJSON, err = serialization.Marshal(errorResponse)
 					if err != nil {
 						// Fallback to basic error if marshaling fails
 						basicError := map[string]interface{}{
@@ -1171,7 +1180,7 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *co
 								"message": "An error occurred while processing your request",
 							},
 						}
-						if errorJSON, err = sonic.Marshal(basicError); err != nil {
+						if errorJSON, err = serialization.Marshal(basicError); err != nil {
 							cancel() // Can't send error (client likely disconnected), cancel upstream stream
 							return
 						}
@@ -1241,7 +1250,7 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *co
 
 						// Send all collected events
 						for _, evt := range events {
-							jsonData, err := sonic.Marshal(evt.Payload)
+							jsonData, err := serialization.Marshal(evt.Payload)
 							if err != nil {
 								log.Printf("Failed to marshal bedrock payload: %v", err)
 								continue
@@ -1298,7 +1307,7 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *co
 					// STANDARD SSE FORMAT: The converter returned an object
 					// This will be JSON marshaled and wrapped as "data: {json}\n\n"
 					// Used by most providers (OpenAI chat/completions, Google, etc.)
-					responseJSON, err := sonic.Marshal(convertedResponse)
+					responseJSON, err := serialization.Marshal(convertedResponse)
 					if err != nil {
 						// Log JSON marshaling error but continue processing
 						log.Printf("Failed to marshal streaming response: %v", err)
@@ -8,8 +8,8 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/bytedance/sonic"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/valyala/fasthttp"
 )
 
@@ -146,7 +146,7 @@ func (g *GenericRouter) sendStreamError(ctx *fasthttp.RequestCtx, bifrostCtx *co
 		errorResponse = config.ErrorConverter(bifrostCtx, bifrostErr)
 	}
 
-	errorJSON, err := sonic.Marshal(map[string]interface{}{
+	errorJSON, err := serialization.Marshal(map[string]interface{}{
 		"error": errorResponse,
 	})
 	if err != nil {
@@ -170,7 +170,7 @@ func (g *GenericRouter) sendError(ctx *fasthttp.RequestCtx, bifrostCtx *context.
 	}
 	ctx.SetContentType("application/json")
 
-	errorBody, err := sonic.Marshal(errorConverter(bifrostCtx, bifrostErr))
+	errorBody, err := serialization.Marshal(errorConverter(bifrostCtx, bifrostErr))
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
 		ctx.SetBodyString(fmt.Sprintf("failed to encode error response: %v", err))
@@ -185,7 +185,7 @@ func (g *GenericRouter) sendSuccess(ctx *fasthttp.RequestCtx, bifrostCtx *contex
 	ctx.SetStatusCode(fasthttp.StatusOK)
 	ctx.SetContentType("application/json")
 
-	responseBody, err := sonic.Marshal(response)
+	responseBody, err := serialization.Marshal(response)
 	if err != nil {
 		g.sendError(ctx, bifrostCtx, errorConverter, newBifrostError(err, "failed to encode response"))
 		return
@@ -194,6 +194,30 @@ func (g *GenericRouter) sendSuccess(ctx *fasthttp.RequestCtx, bifrostCtx *contex
 	ctx.SetBody(responseBody)
 }
 
+// normalizeRequestModel applies the client's model name normalization (aliases, model name case-folding) to the
+// model of a converted request, which integrations parse with the default normalization only. When the model is
+// normalized, the model as sent by the client is kept in the context to be reported as ModelRequested.
+func (g *GenericRouter) normalizeRequestModel(bifrostCtx *context.Context, bifrostReq *schemas.BifrostRequest) {
+	provider, model, _ := bifrostReq.GetRequestFields()
+	if model == "" {
+		return
+	}
+	var normalizedProvider schemas.ModelProvider
+	var normalizedModel string
+	if strings.Contains(model, "/") && provider != "" {
+		// Model names may contain "/" (e.g. OpenRouter models), keep them from being split as a provider prefix
+		normalizedProvider, normalizedModel = g.client.ParseModelString(string(provider)+"/"+model, "")
+	} else {
+		normalizedProvider, normalizedModel = g.client.ParseModelString(model, provider)
+	}
+	if normalizedModel == "" || (normalizedProvider == provider && normalizedModel == model) {
+		return
+	}
+	bifrostReq.SetProvider(normalizedProvider)
+	bifrostReq.SetModel(normalizedModel)
+	*bifrostCtx = context.WithValue(*bifrostCtx, schemas.BifrostContextKeyModelRequested, model)
+}
+
 // extractAndParseFallbacks extracts fallbacks from the integration request and adds them to the BifrostRequest
 func (g *GenericRouter) extractAndParseFallbacks(req interface{}, bifrostReq *schemas.BifrostRequest) error {
 	// Check if the request has a fallbacks field ([]string)
@@ -215,8 +239,8 @@ func (g *GenericRouter) extractAndParseFallbacks(req interface{}, bifrostReq *sc
 			continue // Skip empty strings
 		}
 
-		// Use ParseModelString to extract provider and model
-		provider, model := schemas.ParseModelString(fallbackStr, provider)
+		// Parse with the client's model name normalization to extract provider and model
+		provider, model := g.client.ParseModelString(fallbackStr, provider)
 
 		parsedFallback := schemas.Fallback{
 			Provider: provider,
@@ -0,0 +1,56 @@
+package integrations
+
+import (
+	"context"
+	"testing"
+
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/transports/bifrost-http/lib"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_normalizeRequestModel(t *testing.T) {
+	client, err := bifrost.Init(context.Background(), schemas.BifrostConfig{
+		Account: lib.NewBaseAccount(&lib.Config{}),
+		Logger:  bifrost.NewDefaultLogger(schemas.LogLevelError),
+		ModelNameNormalization: &schemas.ModelNameNormalization{
+			CaseFoldModels: true,
+			Aliases:        map[string]string{"fast": "groq/llama-3.1-8b-instant"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize bifrost: %v", err)
+	}
+	defer client.Shutdown()
+	router := NewGenericRouter(client, &mockHandlerStore{}, nil, nil)
+
+	tests := []struct {
+		name             string
+		provider         schemas.ModelProvider
+		model            string
+		wantProvider     schemas.ModelProvider
+		wantModel        string
+		wantModelRequest string
+	}{
+		{"alias", schemas.OpenAI, "fast", schemas.Groq, "llama-3.1-8b-instant", "fast"},
+		{"case-folded model", schemas.OpenAI, "GPT-4o", schemas.OpenAI, "gpt-4o", "GPT-4o"},
+		{"model name with slash", schemas.OpenRouter, "Meta-Llama/Llama-3", schemas.OpenRouter, "meta-llama/llama-3", "Meta-Llama/Llama-3"},
+		{"already normalized", schemas.OpenAI, "gpt-4o", schemas.OpenAI, "gpt-4o", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bifrostCtx := context.Background()
+			bifrostReq := &schemas.BifrostRequest{
+				RequestType: schemas.ChatCompletionRequest,
+				ChatRequest: &schemas.BifrostChatRequest{Provider: tt.provider, Model: tt.model},
+			}
+			router.normalizeRequestModel(&bifrostCtx, bifrostReq)
+
+			assert.Equal(t, tt.wantProvider, bifrostReq.ChatRequest.Provider)
+			assert.Equal(t, tt.wantModel, bifrostReq.ChatRequest.Model)
+			modelRequested, _ := bifrostCtx.Value(schemas.BifrostContextKeyModelRequested).(string)
+			assert.Equal(t, tt.wantModelRequest, modelRequested)
+		})
+	}
+}
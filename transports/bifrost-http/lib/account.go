@@ -107,6 +107,14 @@ func (baseAccount *BaseAccount) GetConfigForProvider(providerKey schemas.ModelPr
 
 	providerConfig.SendBackRawRequest = config.SendBackRawRequest
 	providerConfig.SendBackRawResponse = config.SendBackRawResponse
+	providerConfig.BatchNameTemplate = config.BatchNameTemplate
+	providerConfig.Bulkhead = config.Bulkhead
+	providerConfig.OutboundRateLimit = config.OutboundRateLimit
+	providerConfig.ParameterRules = config.ParameterRules
+	providerConfig.RawResponseDenylist = config.RawResponseDenylist
+	providerConfig.AssistantPrefill = config.AssistantPrefill
+	providerConfig.MaxConcurrentStreams = config.MaxConcurrentStreams
+	providerConfig.MaxInlineBatchRequests = config.MaxInlineBatchRequests
 
 	if config.CustomProviderConfig != nil {
 		providerConfig.CustomProviderConfig = config.CustomProviderConfig
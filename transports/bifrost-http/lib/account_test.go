@@ -0,0 +1,49 @@
+package lib
+
+import (
+	"testing"
+
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/framework/configstore"
+)
+
+// Test that the provider settings of the config store reach the provider config given to Bifrost
+func TestGetConfigForProvider_CopiesProviderSettings(t *testing.T) {
+	store := &Config{
+		Providers: map[schemas.ModelProvider]configstore.ProviderConfig{
+			schemas.Bedrock: {
+				BatchNameTemplate:      "{vk}-{endpoint}-{timestamp}",
+				Bulkhead:               &schemas.BulkheadConfig{MaxInFlight: 8, MaxWaitInMs: 100},
+				OutboundRateLimit:      &schemas.OutboundRateLimitConfig{RequestsPerSecond: 5, Burst: 2},
+				ParameterRules:         []schemas.ModelParameterRule{{Models: []string{"o1*"}, DeniedParams: []string{"temperature"}}},
+				RawResponseDenylist:    []string{"usage.internal_id"},
+				AssistantPrefill:       schemas.AssistantPrefillModeEmulate,
+				MaxConcurrentStreams:   4,
+				MaxInlineBatchRequests: 50,
+			},
+		},
+	}
+
+	config, err := NewBaseAccount(store).GetConfigForProvider(schemas.Bedrock)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.BatchNameTemplate != "{vk}-{endpoint}-{timestamp}" {
+		t.Errorf("expected the batch name template to be copied, got %q", config.BatchNameTemplate)
+	}
+	if config.Bulkhead == nil || config.Bulkhead.MaxInFlight != 8 {
+		t.Errorf("expected the bulkhead to be copied, got %+v", config.Bulkhead)
+	}
+	if config.OutboundRateLimit == nil || config.OutboundRateLimit.RequestsPerSecond != 5 {
+		t.Errorf("expected the outbound rate limit to be copied, got %+v", config.OutboundRateLimit)
+	}
+	if len(config.ParameterRules) != 1 || len(config.RawResponseDenylist) != 1 {
+		t.Errorf("expected the parameter rules and raw response denylist to be copied, got %+v and %v", config.ParameterRules, config.RawResponseDenylist)
+	}
+	if config.AssistantPrefill != schemas.AssistantPrefillModeEmulate {
+		t.Errorf("expected the assistant prefill mode to be copied, got %q", config.AssistantPrefill)
+	}
+	if config.MaxConcurrentStreams != 4 || config.MaxInlineBatchRequests != 50 {
+		t.Errorf("expected the stream and batch limits to be copied, got %d and %d", config.MaxConcurrentStreams, config.MaxInlineBatchRequests)
+	}
+}
@@ -355,6 +355,11 @@ func loadConfigFromFile(ctx context.Context, config *Config, data []byte) (*Conf
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	// Fail fast on invalid config, reporting every problem at once
+	if err := ValidateConfig(&configData); err != nil {
+		return nil, err
+	}
+
 	var err error
 
 	// Initialize stores from config file
@@ -527,6 +532,36 @@ func mergeClientConfig(dbConfig *configstore.ClientConfig, fileConfig *configsto
 	if !dbConfig.EnableLiteLLMFallbacks && fileConfig.EnableLiteLLMFallbacks {
 		dbConfig.EnableLiteLLMFallbacks = fileConfig.EnableLiteLLMFallbacks
 	}
+	if dbConfig.MaxConcurrentStreams == 0 && fileConfig.MaxConcurrentStreams != 0 {
+		dbConfig.MaxConcurrentStreams = fileConfig.MaxConcurrentStreams
+	}
+	if dbConfig.HedgingDelayInMs == 0 && fileConfig.HedgingDelayInMs != 0 {
+		dbConfig.HedgingDelayInMs = fileConfig.HedgingDelayInMs
+	}
+	if dbConfig.MaxTokensDerivation == nil && fileConfig.MaxTokensDerivation != nil {
+		dbConfig.MaxTokensDerivation = fileConfig.MaxTokensDerivation
+	}
+	if dbConfig.MaxContinuations == 0 && fileConfig.MaxContinuations != 0 {
+		dbConfig.MaxContinuations = fileConfig.MaxContinuations
+	}
+	if dbConfig.ModelNameNormalization == nil && fileConfig.ModelNameNormalization != nil {
+		dbConfig.ModelNameNormalization = fileConfig.ModelNameNormalization
+	}
+	if len(dbConfig.PluginOrder) == 0 && len(fileConfig.PluginOrder) > 0 {
+		dbConfig.PluginOrder = fileConfig.PluginOrder
+	}
+	if !dbConfig.EnableFailedRequestCapture && fileConfig.EnableFailedRequestCapture {
+		dbConfig.EnableFailedRequestCapture = fileConfig.EnableFailedRequestCapture
+	}
+	if dbConfig.FailedRequestCaptureMaxEntries == 0 && fileConfig.FailedRequestCaptureMaxEntries != 0 {
+		dbConfig.FailedRequestCaptureMaxEntries = fileConfig.FailedRequestCaptureMaxEntries
+	}
+	if dbConfig.FailedRequestCaptureTTLInSeconds == 0 && fileConfig.FailedRequestCaptureTTLInSeconds != 0 {
+		dbConfig.FailedRequestCaptureTTLInSeconds = fileConfig.FailedRequestCaptureTTLInSeconds
+	}
+	if dbConfig.FailedRequestCaptureMaxPayloadKB == 0 && fileConfig.FailedRequestCaptureMaxPayloadKB != 0 {
+		dbConfig.FailedRequestCaptureMaxPayloadKB = fileConfig.FailedRequestCaptureMaxPayloadKB
+	}
 }
 
 // loadProvidersFromFile loads and merges providers from file with store using hash reconciliation
@@ -1698,6 +1733,14 @@ func loadDefaultProviders(ctx context.Context, config *Config) error {
 				SendBackRawRequest:       dbProvider.SendBackRawRequest,
 				SendBackRawResponse:      dbProvider.SendBackRawResponse,
 				CustomProviderConfig:     dbProvider.CustomProviderConfig,
+				BatchNameTemplate:        dbProvider.BatchNameTemplate,
+				Bulkhead:                 dbProvider.Bulkhead,
+				OutboundRateLimit:        dbProvider.OutboundRateLimit,
+				ParameterRules:           dbProvider.ParameterRules,
+				RawResponseDenylist:      dbProvider.RawResponseDenylist,
+				AssistantPrefill:         dbProvider.AssistantPrefill,
+				MaxConcurrentStreams:     dbProvider.MaxConcurrentStreams,
+				MaxInlineBatchRequests:   dbProvider.MaxInlineBatchRequests,
 			}
 			if err := ValidateCustomProvider(providerConfig, provider); err != nil {
 				logger.Warn("invalid custom provider config for %s: %v", provider, err)
@@ -2107,6 +2150,14 @@ func (c *Config) GetProviderConfigRedacted(provider schemas.ModelProvider) (*con
 		SendBackRawRequest:       config.SendBackRawRequest,
 		SendBackRawResponse:      config.SendBackRawResponse,
 		CustomProviderConfig:     config.CustomProviderConfig,
+		BatchNameTemplate:        config.BatchNameTemplate,
+		Bulkhead:                 config.Bulkhead,
+		OutboundRateLimit:        config.OutboundRateLimit,
+		ParameterRules:           config.ParameterRules,
+		RawResponseDenylist:      config.RawResponseDenylist,
+		AssistantPrefill:         config.AssistantPrefill,
+		MaxConcurrentStreams:     config.MaxConcurrentStreams,
+		MaxInlineBatchRequests:   config.MaxInlineBatchRequests,
 	}
 
 	// Create redacted keys
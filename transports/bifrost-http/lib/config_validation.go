@@ -0,0 +1,248 @@
+package lib
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+	configstoreTables "github.com/maximhq/bifrost/framework/configstore/tables"
+)
+
+// ValidateConfig runs a validation pass over a parsed config file before anything is loaded.
+// Every problem found is reported at once, each with the dotted path of the offending field,
+// so that a misconfiguration fails fast at startup instead of at the first request.
+// Returns nil if the config is valid, a bifrost.ConfigValidationErrors otherwise.
+func ValidateConfig(configData *ConfigData) error {
+	var errs bifrost.ConfigValidationErrors
+	if configData == nil {
+		return nil
+	}
+	validateProvidersConfig(configData, &errs)
+	validateGovernanceConfig(configData, &errs)
+	validatePluginsConfig(configData, &errs)
+	return errs.ErrOrNil()
+}
+
+// validateProvidersConfig validates the network, concurrency, proxy, custom provider and key settings of every provider.
+func validateProvidersConfig(configData *ConfigData, errs *bifrost.ConfigValidationErrors) {
+	// Sorted so that problems are always reported in the same order
+	providerNames := make([]string, 0, len(configData.Providers))
+	for providerName := range configData.Providers {
+		providerNames = append(providerNames, providerName)
+	}
+	sort.Strings(providerNames)
+	for _, providerName := range providerNames {
+		providerConfig := configData.Providers[providerName]
+		provider := schemas.ModelProvider(providerName)
+		prefix := fmt.Sprintf("providers.%s", provider)
+		if strings.TrimSpace(providerName) == "" {
+			errs.Add("providers", "provider name must not be empty")
+			continue
+		}
+
+		// Unset sections are filled with defaults when the provider is loaded, only validate what is set
+		config := schemas.ProviderConfig{
+			ProxyConfig:          providerConfig.ProxyConfig,
+			CustomProviderConfig: providerConfig.CustomProviderConfig,
+		}
+		if providerConfig.NetworkConfig != nil {
+			config.NetworkConfig = *providerConfig.NetworkConfig
+		}
+		if providerConfig.ConcurrencyAndBufferSize != nil {
+			config.ConcurrencyAndBufferSize = *providerConfig.ConcurrencyAndBufferSize
+		}
+		*errs = append(*errs, bifrost.ValidateProviderConfig(provider, &config)...)
+
+		if network := providerConfig.NetworkConfig; network != nil {
+			if network.RetryBackoffInitial > 0 && network.RetryBackoffInitial < MinRetryBackoff {
+				errs.Add(prefix+".network_config.retry_backoff_initial", "must be at least %s", MinRetryBackoff)
+			}
+			if network.RetryBackoffMax > MaxRetryBackoff {
+				errs.Add(prefix+".network_config.retry_backoff_max", "must be at most %s", MaxRetryBackoff)
+			}
+		}
+
+		// Keys can be added later from the UI, so an empty key list is not an error here
+		if len(providerConfig.Keys) > 0 {
+			*errs = append(*errs, bifrost.ValidateProviderKeys(provider, providerConfig.CustomProviderConfig, uniqueKeysByID(providerConfig.Keys))...)
+		}
+	}
+}
+
+// uniqueKeysByID returns the keys without the repeated copies of a key, keeping the first occurrence.
+// Keys referenced by virtual key provider configs are appended to the provider's keys when the
+// config is unmarshalled, so a key referenced this way legitimately appears more than once.
+// Keys sharing an ID but differing otherwise are kept, so that they are reported as duplicates.
+func uniqueKeysByID(keys []schemas.Key) []schemas.Key {
+	first := make(map[string]int, len(keys))
+	unique := make([]schemas.Key, 0, len(keys))
+	for _, key := range keys {
+		if key.ID != "" {
+			if i, ok := first[key.ID]; !ok {
+				first[key.ID] = len(unique)
+			} else if sameReferencedKey(unique[i], key) {
+				continue
+			}
+		}
+		unique = append(unique, key)
+	}
+	return unique
+}
+
+// sameReferencedKey reports whether two keys are the same key, comparing the fields virtual key
+// references carry over when they are appended to the provider's keys. References loaded from the
+// config store have their defaults filled in, so an unset flag matches its default value.
+func sameReferencedKey(a, b schemas.Key) bool {
+	return a.ID == b.ID && a.Name == b.Name && a.Value == b.Value && a.Weight == b.Weight &&
+		slices.Equal(a.Models, b.Models) &&
+		sameKeyFlag(a.Enabled, b.Enabled, schemas.Ptr(true)) && sameKeyFlag(a.UseForBatchAPI, b.UseForBatchAPI, nil) &&
+		reflect.DeepEqual(a.AzureKeyConfig, b.AzureKeyConfig) &&
+		reflect.DeepEqual(a.VertexKeyConfig, b.VertexKeyConfig) &&
+		reflect.DeepEqual(a.BedrockKeyConfig, b.BedrockKeyConfig)
+}
+
+// sameKeyFlag reports whether two optional key flags agree. An unset flag stands for its default value,
+// or matches any value when the default is nil (it depends on when the key was created).
+func sameKeyFlag(a, b *bool, defaultValue *bool) bool {
+	if a == nil {
+		a = defaultValue
+	}
+	if b == nil {
+		b = defaultValue
+	}
+	return a == nil || b == nil || *a == *b
+}
+
+// validateGovernanceConfig validates budgets, rate limits, customers, teams and virtual keys,
+// including that every referenced budget, rate limit, team and customer is defined.
+func validateGovernanceConfig(configData *ConfigData, errs *bifrost.ConfigValidationErrors) {
+	governance := configData.Governance
+	if governance == nil {
+		return
+	}
+
+	budgetIDs := make(map[string]struct{}, len(governance.Budgets))
+	for i, budget := range governance.Budgets {
+		prefix := fmt.Sprintf("governance.budgets[%d]", i)
+		validateGovernanceID(prefix, budget.ID, budgetIDs, errs)
+		if budget.MaxLimit < 0 {
+			errs.Add(prefix+".max_limit", "must not be negative")
+		}
+		validateResetDuration(prefix+".reset_duration", budget.ResetDuration, errs)
+	}
+
+	rateLimitIDs := make(map[string]struct{}, len(governance.RateLimits))
+	for i, rateLimit := range governance.RateLimits {
+		prefix := fmt.Sprintf("governance.rate_limits[%d]", i)
+		validateGovernanceID(prefix, rateLimit.ID, rateLimitIDs, errs)
+		if rateLimit.TokenMaxLimit != nil && *rateLimit.TokenMaxLimit < 0 {
+			errs.Add(prefix+".token_max_limit", "must not be negative")
+		}
+		if rateLimit.TokenResetDuration != nil {
+			validateResetDuration(prefix+".token_reset_duration", *rateLimit.TokenResetDuration, errs)
+		}
+		if rateLimit.RequestMaxLimit != nil && *rateLimit.RequestMaxLimit < 0 {
+			errs.Add(prefix+".request_max_limit", "must not be negative")
+		}
+		if rateLimit.RequestResetDuration != nil {
+			validateResetDuration(prefix+".request_reset_duration", *rateLimit.RequestResetDuration, errs)
+		}
+	}
+
+	customerIDs := make(map[string]struct{}, len(governance.Customers))
+	for i, customer := range governance.Customers {
+		prefix := fmt.Sprintf("governance.customers[%d]", i)
+		validateGovernanceID(prefix, customer.ID, customerIDs, errs)
+		validateGovernanceReference(prefix+".budget_id", customer.BudgetID, budgetIDs, "budget", errs)
+	}
+
+	teamIDs := make(map[string]struct{}, len(governance.Teams))
+	for i, team := range governance.Teams {
+		prefix := fmt.Sprintf("governance.teams[%d]", i)
+		validateGovernanceID(prefix, team.ID, teamIDs, errs)
+		validateGovernanceReference(prefix+".customer_id", team.CustomerID, customerIDs, "customer", errs)
+		validateGovernanceReference(prefix+".budget_id", team.BudgetID, budgetIDs, "budget", errs)
+	}
+
+	virtualKeyIDs := make(map[string]struct{}, len(governance.VirtualKeys))
+	for i, virtualKey := range governance.VirtualKeys {
+		prefix := fmt.Sprintf("governance.virtual_keys[%d]", i)
+		validateGovernanceID(prefix, virtualKey.ID, virtualKeyIDs, errs)
+		if virtualKey.TeamID != nil && virtualKey.CustomerID != nil {
+			errs.Add(prefix, "team_id and customer_id are mutually exclusive")
+		}
+		validateGovernanceReference(prefix+".team_id", virtualKey.TeamID, teamIDs, "team", errs)
+		validateGovernanceReference(prefix+".customer_id", virtualKey.CustomerID, customerIDs, "customer", errs)
+		validateGovernanceReference(prefix+".budget_id", virtualKey.BudgetID, budgetIDs, "budget", errs)
+		validateGovernanceReference(prefix+".rate_limit_id", virtualKey.RateLimitID, rateLimitIDs, "rate limit", errs)
+		for j, providerConfig := range virtualKey.ProviderConfigs {
+			providerPrefix := fmt.Sprintf("%s.provider_configs[%d]", prefix, j)
+			if providerConfig.Weight < 0 {
+				errs.Add(providerPrefix+".weight", "must not be negative")
+			}
+			validateGovernanceReference(providerPrefix+".budget_id", providerConfig.BudgetID, budgetIDs, "budget", errs)
+			validateGovernanceReference(providerPrefix+".rate_limit_id", providerConfig.RateLimitID, rateLimitIDs, "rate limit", errs)
+		}
+	}
+}
+
+// validatePluginsConfig checks that every plugin has a name and that no plugin is configured twice.
+func validatePluginsConfig(configData *ConfigData, errs *bifrost.ConfigValidationErrors) {
+	seen := make(map[string]int, len(configData.Plugins))
+	for i, plugin := range configData.Plugins {
+		prefix := fmt.Sprintf("plugins[%d]", i)
+		if plugin == nil {
+			errs.Add(prefix, "plugin config must not be null")
+			continue
+		}
+		if strings.TrimSpace(plugin.Name) == "" {
+			errs.Add(prefix+".name", "is required")
+			continue
+		}
+		if first, ok := seen[plugin.Name]; ok {
+			errs.Add(prefix+".name", "duplicate plugin %q (also configured in plugins[%d])", plugin.Name, first)
+			continue
+		}
+		seen[plugin.Name] = i
+	}
+}
+
+// validateGovernanceID checks that a governance entity has a unique, non-empty id and records it in seen.
+func validateGovernanceID(prefix string, id string, seen map[string]struct{}, errs *bifrost.ConfigValidationErrors) {
+	if strings.TrimSpace(id) == "" {
+		errs.Add(prefix+".id", "is required")
+		return
+	}
+	if _, ok := seen[id]; ok {
+		errs.Add(prefix+".id", "duplicate id %q", id)
+		return
+	}
+	seen[id] = struct{}{}
+}
+
+// validateGovernanceReference checks that a reference to another governance entity points to a defined one.
+func validateGovernanceReference(field string, id *string, defined map[string]struct{}, kind string, errs *bifrost.ConfigValidationErrors) {
+	if id == nil {
+		return
+	}
+	if _, ok := defined[*id]; !ok {
+		errs.Add(field, "references unknown %s %q", kind, *id)
+	}
+}
+
+// validateResetDuration checks that a reset duration parses and is positive.
+func validateResetDuration(field string, duration string, errs *bifrost.ConfigValidationErrors) {
+	parsed, err := configstoreTables.ParseDuration(duration)
+	if err != nil {
+		errs.Add(field, "invalid duration %q, expected a value like \"30s\", \"5m\", \"1h\", \"1d\", \"1w\", \"1M\" or \"1Y\"", duration)
+		return
+	}
+	if parsed <= time.Duration(0) {
+		errs.Add(field, "must be positive, got %q", duration)
+	}
+}
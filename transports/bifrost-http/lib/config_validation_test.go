@@ -0,0 +1,154 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/framework/configstore"
+	configstoreTables "github.com/maximhq/bifrost/framework/configstore/tables"
+)
+
+// TestValidateConfig_ValidConfig tests that a well-formed config passes validation
+func TestValidateConfig_ValidConfig(t *testing.T) {
+	configData := &ConfigData{
+		Providers: map[string]configstore.ProviderConfig{
+			"openai": makeProviderConfigWithNetwork("openai-key-1", "sk-test-123", "https://api.openai.com"),
+		},
+		Governance: &configstore.GovernanceConfig{
+			Budgets:    []configstoreTables.TableBudget{{ID: "budget-1", MaxLimit: 100, ResetDuration: "1M"}},
+			RateLimits: []configstoreTables.TableRateLimit{{ID: "rl-1", RequestMaxLimit: schemas.Ptr(int64(10)), RequestResetDuration: schemas.Ptr("1m")}},
+			Customers:  []configstoreTables.TableCustomer{{ID: "customer-1", Name: "Acme", BudgetID: schemas.Ptr("budget-1")}},
+			Teams:      []configstoreTables.TableTeam{{ID: "team-1", Name: "Platform", CustomerID: schemas.Ptr("customer-1")}},
+			VirtualKeys: []configstoreTables.TableVirtualKey{
+				{ID: "vk-1", Name: "vk", Value: "sk-bf-1", TeamID: schemas.Ptr("team-1"), RateLimitID: schemas.Ptr("rl-1")},
+			},
+		},
+		Plugins: []*schemas.PluginConfig{{Name: "telemetry", Enabled: true}},
+	}
+
+	if err := ValidateConfig(configData); err != nil {
+		t.Fatalf("Expected valid config, got: %v", err)
+	}
+}
+
+// TestValidateConfig_ReportsAllProblems tests that every problem is reported with the path of the offending field
+func TestValidateConfig_ReportsAllProblems(t *testing.T) {
+	configData := &ConfigData{
+		Providers: map[string]configstore.ProviderConfig{
+			"openai": {
+				Keys: []schemas.Key{{ID: "key-1", Value: "sk-test", Weight: 1}},
+				NetworkConfig: &schemas.NetworkConfig{
+					BaseURL:         "api.openai.com",
+					RetryBackoffMax: MaxRetryBackoff * 2,
+				},
+			},
+			"azure": {
+				Keys: []schemas.Key{{ID: "key-1", Value: "azure-key", Weight: 1}},
+			},
+		},
+		Governance: &configstore.GovernanceConfig{
+			Budgets: []configstoreTables.TableBudget{{ID: "budget-1", MaxLimit: 100, ResetDuration: "monthly"}},
+			VirtualKeys: []configstoreTables.TableVirtualKey{
+				{ID: "vk-1", Name: "vk", Value: "sk-bf-1", TeamID: schemas.Ptr("team-1"), CustomerID: schemas.Ptr("customer-1")},
+			},
+		},
+		Plugins: []*schemas.PluginConfig{{Name: "telemetry"}, {Name: "telemetry"}},
+	}
+
+	err := ValidateConfig(configData)
+	if err == nil {
+		t.Fatal("Expected validation to fail")
+	}
+	var validationErrs bifrost.ConfigValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("Expected ConfigValidationErrors, got %T", err)
+	}
+
+	fields := make(map[string]bool, len(validationErrs))
+	for _, validationErr := range validationErrs {
+		fields[validationErr.Field] = true
+	}
+	for _, expected := range []string{
+		"providers.openai.network_config.base_url",
+		"providers.openai.network_config.retry_backoff_max",
+		"providers.azure.keys[0].azure_key_config",
+		"governance.budgets[0].reset_duration",
+		"governance.virtual_keys[0]",
+		"governance.virtual_keys[0].team_id",
+		"governance.virtual_keys[0].customer_id",
+		"plugins[1].name",
+	} {
+		if !fields[expected] {
+			t.Errorf("Expected a problem for %s, got:\n%v", expected, err)
+		}
+	}
+	// Providers are validated in a stable order
+	if err.Error() != ValidateConfig(configData).Error() {
+		t.Error("Expected validation output to be deterministic")
+	}
+}
+
+// TestValidateConfig_RepeatedKeyIDs tests that keys repeated by virtual key references are accepted,
+// while different keys sharing an ID are still reported
+func TestValidateConfig_RepeatedKeyIDs(t *testing.T) {
+	key := schemas.Key{ID: "openai-key-1", Value: "sk-test-123", Models: []string{"gpt-4o"}, Weight: 1}
+	configData := &ConfigData{
+		Providers: map[string]configstore.ProviderConfig{
+			"openai": {Keys: []schemas.Key{key, key}},
+		},
+	}
+	if err := ValidateConfig(configData); err != nil {
+		t.Fatalf("Expected a key repeated by a virtual key reference to be valid, got: %v", err)
+	}
+
+	// References loaded from the config store have the defaults of the key filled in
+	stored := key
+	stored.Enabled = schemas.Ptr(true)
+	stored.UseForBatchAPI = schemas.Ptr(false)
+	configData.Providers["openai"] = configstore.ProviderConfig{Keys: []schemas.Key{key, stored}}
+	if err := ValidateConfig(configData); err != nil {
+		t.Fatalf("Expected a stored reference with default flags to be valid, got: %v", err)
+	}
+
+	disabled := key
+	disabled.Enabled = schemas.Ptr(false)
+	configData.Providers["openai"] = configstore.ProviderConfig{Keys: []schemas.Key{key, disabled}}
+	if err := ValidateConfig(configData); err == nil || !strings.Contains(err.Error(), "duplicate key id") {
+		t.Errorf("Expected a reference disabling the key to be reported as a duplicate key id, got: %v", err)
+	}
+
+	conflicting := key
+	conflicting.Value = "sk-test-456"
+	configData.Providers["openai"] = configstore.ProviderConfig{Keys: []schemas.Key{key, key, conflicting}}
+	err := ValidateConfig(configData)
+	if err == nil {
+		t.Fatal("Expected different keys sharing an ID to be reported")
+	}
+	if !strings.Contains(err.Error(), "duplicate key id") {
+		t.Errorf("Expected a duplicate key id problem, got: %v", err)
+	}
+}
+
+// TestLoadConfig_InvalidConfigFailsFast tests that LoadConfig refuses to start with an invalid config file
+func TestLoadConfig_InvalidConfigFailsFast(t *testing.T) {
+	initTestLogger()
+	tempDir := createTempDir(t)
+
+	providers := map[string]configstore.ProviderConfig{
+		"openai": makeProviderConfigWithNetwork("openai-key-1", "sk-test-123", "ftp://api.openai.com"),
+	}
+	configData := makeConfigDataWithProvidersAndDir(providers, tempDir)
+	createConfigFile(t, tempDir, configData)
+
+	_, err := LoadConfig(context.Background(), tempDir)
+	if err == nil {
+		t.Fatal("Expected LoadConfig to fail for invalid config")
+	}
+	if !strings.Contains(err.Error(), "providers.openai.network_config.base_url") {
+		t.Errorf("Expected error to point at the base_url field, got: %v", err)
+	}
+}
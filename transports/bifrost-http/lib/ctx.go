@@ -59,6 +59,16 @@ import (
 //   - Any header starting with 'x-bf-eh-' is collected and added to the map stored under schemas.BifrostContextKeyExtraHeaders
 //   - The prefix is stripped, the remainder is lower-cased, and duplicate names append values
 //   - This allows callers to send arbitrary context metadata without needing to extend the public schema
+//
+// 8. Request Metadata Headers (x-bf-metadata-*):
+//   - Any header starting with 'x-bf-metadata-' is collected into the map stored under schemas.BifrostContextKeyRequestMetadata
+//   - The prefix is stripped and the remainder becomes the metadata key (e.g. 'x-bf-metadata-user_id' becomes 'user_id')
+//   - The metadata is mirrored in the provider's native metadata fields (OpenAI metadata, Anthropic metadata.user_id)
+//
+// 9. Pinned Provider Headers:
+//   - x-bf-pin-provider: sends the request to this provider only, bypassing load balancing and fallbacks
+//   - x-bf-pin-key: optional ID or name of the pinned provider's key to use
+//   - Stored as a schemas.PinnedProvider under schemas.BifrostContextKeyPinnedProvider
 
 // Parameters:
 //   - ctx: The FastHTTP request context containing the original headers
@@ -95,6 +105,10 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool) (*c
 	maximTags := make(map[string]string)
 	// Initialize extra headers map for headers prefixed with x-bf-eh-
 	extraHeaders := make(map[string][]string)
+	// Initialize request metadata map for headers prefixed with x-bf-metadata-
+	requestMetadata := make(map[string]string)
+	// Pinned provider and key, from x-bf-pin-provider and x-bf-pin-key
+	var pinnedProvider schemas.PinnedProvider
 	// Denylist of header names that should not be accepted (case-insensitive)
 	denylist := map[string]bool{
 		"authorization":       true,
@@ -260,6 +274,20 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool) (*c
 			extraHeaders[labelName] = append(extraHeaders[labelName], string(value))
 			return true
 		}
+		if metadataKey, ok := strings.CutPrefix(keyStr, "x-bf-metadata-"); ok {
+			if metadataKey != "" {
+				requestMetadata[metadataKey] = string(value)
+			}
+			return true
+		}
+		if keyStr == "x-bf-pin-provider" {
+			pinnedProvider.Provider = schemas.ModelProvider(strings.TrimSpace(string(value)))
+			return true
+		}
+		if keyStr == "x-bf-pin-key" {
+			pinnedProvider.Key = strings.TrimSpace(string(value))
+			return true
+		}
 		// Send back raw response header
 		if keyStr == "x-bf-send-back-raw-response" {
 			if valueStr := string(value); valueStr == "true" {
@@ -280,6 +308,16 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool) (*c
 		bifrostCtx = context.WithValue(bifrostCtx, schemas.BifrostContextKeyExtraHeaders, extraHeaders)
 	}
 
+	// Store collected request metadata in the context if any was found
+	if len(requestMetadata) > 0 {
+		bifrostCtx = context.WithValue(bifrostCtx, schemas.BifrostContextKeyRequestMetadata, requestMetadata)
+	}
+
+	// Store the pinned provider in the context if one was set, a pinned key alone is ignored
+	if pinnedProvider.Provider != "" {
+		bifrostCtx = context.WithValue(bifrostCtx, schemas.BifrostContextKeyPinnedProvider, &pinnedProvider)
+	}
+
 	if allowDirectKeys {
 		// Extract API key from Authorization header (Bearer format), x-api-key, or x-goog-api-key header
 		var apiKey string
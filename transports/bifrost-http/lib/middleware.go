@@ -6,6 +6,35 @@ import "github.com/valyala/fasthttp"
 // It follows the standard pattern: receives the next handler and returns a new handler
 type BifrostHTTPMiddleware func(next fasthttp.RequestHandler) fasthttp.RequestHandler
 
+// Requests to the inference routes go through the following pipeline, in order:
+//
+//  1. Transport middlewares (plugin transport interceptors, telemetry), request phase
+//  2. Authentication
+//  3. Governance PreHook
+//  4. Other plugin PreHooks: telemetry, logging, then the custom plugins in the order they were loaded
+//  5. Routing (provider, fallbacks and key selection)
+//  6. Provider request
+//  7. Plugin PostHooks, in reverse order of the PreHooks
+//  8. Transport middlewares, response phase
+//
+// Stages 1-2 are the middlewares returned by InferenceMiddlewares, stages 3-7 run in the Bifrost client,
+// which runs the plugins named in schemas.BifrostConfig.PluginOrder first. The order of stages 3-4 is the
+// default one and can be changed with the plugin_order client config setting.
+// A plugin short-circuiting a request skips the PreHooks after it, and only the PostHooks of the plugins whose
+// PreHook ran are run: with the default order, a request rejected by governance is not seen by the telemetry and
+// logging plugins, ordering them ahead of governance makes them observe its rejections.
+
+// InferenceMiddlewares returns the middlewares of the inference routes in pipeline order:
+// the transport middlewares first, then the auth middleware (nil when authentication is disabled).
+func InferenceMiddlewares(transportMiddlewares []BifrostHTTPMiddleware, authMiddleware BifrostHTTPMiddleware) []BifrostHTTPMiddleware {
+	middlewares := make([]BifrostHTTPMiddleware, 0, len(transportMiddlewares)+1)
+	middlewares = append(middlewares, transportMiddlewares...)
+	if authMiddleware != nil {
+		middlewares = append(middlewares, authMiddleware)
+	}
+	return middlewares
+}
+
 // ChainMiddlewares chains multiple middlewares together
 // Middlewares are applied in order: the first middleware wraps the second, etc.
 // This allows earlier middlewares to short-circuit by not calling next(ctx)
@@ -100,6 +100,8 @@ func init() {
 	flag.StringVar(&server.AppDir, "app-dir", bifrostServer.DefaultAppDir, "Application data directory (contains config.json and logs)")
 	flag.StringVar(&server.LogLevel, "log-level", bifrostServer.DefaultLogLevel, "Logger level (debug, info, warn, error). Default is info.")
 	flag.StringVar(&server.LogOutputStyle, "log-style", bifrostServer.DefaultLogOutputStyle, "Logger output type (json or pretty). Default is JSON.")
+	flag.DurationVar(&server.StaleBatchReapInterval, "stale-batch-reap-interval", bifrostServer.DefaultStaleBatchReapInterval, "How often stale tracked batches are reaped (0 disables the reaper)")
+	flag.DurationVar(&server.StaleBatchThreshold, "stale-batch-threshold", bifrostServer.DefaultStaleBatchThreshold, "How long a tracked batch may stay without status or progress change before it is reaped as failed (0 disables this check)")
 }
 
 // main is the entry point of the application.
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/fasthttp/router"
+	bifrost "github.com/maximhq/bifrost/core"
+	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/framework/configstore"
+	"github.com/maximhq/bifrost/plugins/governance"
+	"github.com/maximhq/bifrost/plugins/logging"
+	"github.com/maximhq/bifrost/plugins/telemetry"
+	"github.com/maximhq/bifrost/transports/bifrost-http/handlers"
+	"github.com/maximhq/bifrost/transports/bifrost-http/lib"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttputil"
+)
+
+// pipelineRecorder records the stages of the request pipeline in the order they run
+type pipelineRecorder struct {
+	mu     sync.Mutex
+	stages []string
+}
+
+func (r *pipelineRecorder) record(stage string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stages = append(r.stages, stage)
+}
+
+func (r *pipelineRecorder) middleware(name string) lib.BifrostHTTPMiddleware {
+	return func(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			r.record(name + ".request")
+			next(ctx)
+			r.record(name + ".response")
+		}
+	}
+}
+
+// pipelinePlugin records its hooks in the pipeline recorder, and rejects requests from its PreHook if reject is set
+type pipelinePlugin struct {
+	name     string
+	recorder *pipelineRecorder
+	reject   bool
+}
+
+func (p *pipelinePlugin) GetName() string { return p.name }
+
+func (p *pipelinePlugin) TransportInterceptor(ctx *schemas.BifrostContext, url string, headers map[string]string, body map[string]any) (map[string]string, map[string]any, error) {
+	return headers, body, nil
+}
+
+func (p *pipelinePlugin) PreHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.PluginShortCircuit, error) {
+	p.recorder.record(p.name + ".pre")
+	if p.reject {
+		return req, &schemas.PluginShortCircuit{Error: &schemas.BifrostError{
+			StatusCode: schemas.Ptr(fasthttp.StatusForbidden),
+			Error:      &schemas.ErrorField{Message: "rejected by " + p.name},
+		}}, nil
+	}
+	return req, nil, nil
+}
+
+func (p *pipelinePlugin) PostHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	p.recorder.record(p.name + ".post")
+	return result, err, nil
+}
+
+func (p *pipelinePlugin) Cleanup() error { return nil }
+
+// pipelineTestAccount is a schemas.Account with two OpenAI keys pointing at a test server, so that a key is selected
+type pipelineTestAccount struct {
+	baseURL string
+}
+
+func (a *pipelineTestAccount) GetConfiguredProviders() ([]schemas.ModelProvider, error) {
+	return []schemas.ModelProvider{schemas.OpenAI}, nil
+}
+
+func (a *pipelineTestAccount) GetKeysForProvider(ctx *context.Context, providerKey schemas.ModelProvider) ([]schemas.Key, error) {
+	return []schemas.Key{{ID: "key-1", Value: "sk-test-1", Weight: 1}, {ID: "key-2", Value: "sk-test-2", Weight: 1}}, nil
+}
+
+func (a *pipelineTestAccount) GetConfigForProvider(providerKey schemas.ModelProvider) (*schemas.ProviderConfig, error) {
+	return &schemas.ProviderConfig{
+		NetworkConfig:            schemas.NetworkConfig{BaseURL: a.baseURL, DefaultRequestTimeoutInSeconds: 5},
+		ConcurrencyAndBufferSize: schemas.DefaultConcurrencyAndBufferSize,
+	}, nil
+}
+
+// Test that a chat completion sent to the HTTP server goes through the transport middlewares, auth, the plugin
+// PreHooks in the configured order, routing and the provider, and back through the PostHooks and the middlewares
+func TestInferencePipelineOrder(t *testing.T) {
+	tests := []struct {
+		name           string
+		clientConfig   configstore.ClientConfig
+		rejectedBy     string
+		expectedStatus int
+		expectedStages []string
+	}{
+		{
+			name:           "default order",
+			expectedStatus: fasthttp.StatusOK,
+			expectedStages: []string{
+				"transport.request", "auth.request",
+				"governance.pre", "telemetry.pre", "logging.pre", "custom.pre",
+				"routing", "provider",
+				"custom.post", "logging.post", "telemetry.post", "governance.post",
+				"auth.response", "transport.response",
+			},
+		},
+		{
+			name:           "default order, rejected by governance",
+			rejectedBy:     governance.PluginName,
+			expectedStatus: fasthttp.StatusForbidden,
+			expectedStages: []string{
+				"transport.request", "auth.request",
+				"governance.pre", "governance.post",
+				"auth.response", "transport.response",
+			},
+		},
+		{
+			name:           "configured order, rejected by governance",
+			clientConfig:   configstore.ClientConfig{PluginOrder: []string{telemetry.PluginName, logging.PluginName, governance.PluginName}},
+			rejectedBy:     governance.PluginName,
+			expectedStatus: fasthttp.StatusForbidden,
+			expectedStages: []string{
+				"transport.request", "auth.request",
+				"telemetry.pre", "logging.pre", "governance.pre",
+				"governance.post", "logging.post", "telemetry.post",
+				"auth.response", "transport.response",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &pipelineRecorder{}
+			provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				recorder.record("provider")
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o-mini","choices":[{"index":0,"message":{"role":"assistant","content":"Hello"},"finish_reason":"stop"}]}`))
+			}))
+			defer provider.Close()
+
+			// The plugins are loaded out of order, as when governance is enabled at runtime
+			var plugins []schemas.Plugin
+			for _, name := range []string{"custom", logging.PluginName, telemetry.PluginName, governance.PluginName} {
+				plugins = append(plugins, &pipelinePlugin{name: name, recorder: recorder, reject: name == tt.rejectedBy})
+			}
+			client, err := bifrost.Init(context.Background(), schemas.BifrostConfig{
+				Account:     &pipelineTestAccount{baseURL: provider.URL},
+				Plugins:     plugins,
+				Logger:      bifrost.NewDefaultLogger(schemas.LogLevelError),
+				PluginOrder: pluginOrder(tt.clientConfig),
+				KeySelector: func(ctx *context.Context, keys []schemas.Key, providerKey schemas.ModelProvider, model string) (schemas.Key, error) {
+					recorder.record("routing")
+					return keys[0], nil
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to initialize Bifrost: %v", err)
+			}
+			defer client.Shutdown()
+			handlers.SetLogger(bifrost.NewDefaultLogger(schemas.LogLevelError))
+
+			r := router.New()
+			handlers.NewInferenceHandler(client, &lib.Config{}).RegisterRoutes(r,
+				lib.InferenceMiddlewares([]lib.BifrostHTTPMiddleware{recorder.middleware("transport")}, recorder.middleware("auth"))...)
+			listener := fasthttputil.NewInmemoryListener()
+			defer listener.Close()
+			go fasthttp.Serve(listener, r.Handler)
+
+			req := fasthttp.AcquireRequest()
+			defer fasthttp.ReleaseRequest(req)
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseResponse(resp)
+			req.SetRequestURI("http://bifrost/v1/chat/completions")
+			req.Header.SetMethod(fasthttp.MethodPost)
+			req.Header.SetContentType("application/json")
+			req.SetBodyString(`{"model":"openai/gpt-4o-mini","messages":[{"role":"user","content":"Hello"}]}`)
+			httpClient := &fasthttp.Client{Dial: func(addr string) (net.Conn, error) { return listener.Dial() }}
+			if err := httpClient.Do(req, resp); err != nil {
+				t.Fatalf("failed to send the request: %v", err)
+			}
+
+			if resp.StatusCode() != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, resp.StatusCode(), resp.Body())
+			}
+			recorder.mu.Lock()
+			defer recorder.mu.Unlock()
+			if !slices.Equal(recorder.stages, tt.expectedStages) {
+				t.Errorf("expected pipeline stages %v, got %v", tt.expectedStages, recorder.stages)
+			}
+		})
+	}
+}
@@ -16,10 +16,10 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/bytedance/sonic"
 	"github.com/fasthttp/router"
 	bifrost "github.com/maximhq/bifrost/core"
 	"github.com/maximhq/bifrost/core/schemas"
+	"github.com/maximhq/bifrost/core/serialization"
 	"github.com/maximhq/bifrost/framework/configstore"
 	"github.com/maximhq/bifrost/framework/configstore/tables"
 	"github.com/maximhq/bifrost/framework/logstore"
@@ -44,6 +44,9 @@ const (
 	DefaultAppDir         = "" // Empty string means use OS-specific config directory
 	DefaultLogLevel       = string(schemas.LogLevelInfo)
 	DefaultLogOutputStyle = string(schemas.LoggerOutputTypeJSON)
+
+	DefaultStaleBatchReapInterval = handlers.DefaultStaleBatchReapInterval
+	DefaultStaleBatchThreshold    = handlers.DefaultStaleBatchThreshold
 )
 
 var enterprisePlugins = []string{
@@ -95,6 +98,9 @@ type BifrostHTTPServer struct {
 	LogLevel       string
 	LogOutputStyle string
 
+	StaleBatchReapInterval time.Duration // How often stale tracked batches are reaped, 0 disables the reaper
+	StaleBatchThreshold    time.Duration // How long a tracked batch may stay unchanged before it is reaped, 0 disables this check
+
 	PluginsMutex      sync.RWMutex
 	Plugins           []schemas.Plugin
 	pluginStatusMutex sync.RWMutex
@@ -126,6 +132,9 @@ func NewBifrostHTTPServer(version string, uiContent embed.FS) *BifrostHTTPServer
 		AppDir:         DefaultAppDir,
 		LogLevel:       DefaultLogLevel,
 		LogOutputStyle: DefaultLogOutputStyle,
+
+		StaleBatchReapInterval: DefaultStaleBatchReapInterval,
+		StaleBatchThreshold:    DefaultStaleBatchThreshold,
 	}
 }
 
@@ -178,18 +187,18 @@ func MarshalPluginConfig[T any](source any) (*T, error) {
 	config := new(T)
 	// If its a map[string]any, then we will JSON parse and confirm
 	if configMap, ok := source.(map[string]any); ok {
-		configString, err := sonic.Marshal(configMap)
+		configString, err := serialization.Marshal(configMap)
 		if err != nil {
 			return nil, err
 		}
-		if err := sonic.Unmarshal([]byte(configString), config); err != nil {
+		if err := serialization.Unmarshal([]byte(configString), config); err != nil {
 			return nil, err
 		}
 		return config, nil
 	}
 	// If its a string, then we will JSON parse and confirm
 	if configStr, ok := source.(string); ok {
-		if err := sonic.Unmarshal([]byte(configStr), config); err != nil {
+		if err := serialization.Unmarshal([]byte(configStr), config); err != nil {
 			return nil, err
 		}
 		return config, nil
@@ -320,6 +329,12 @@ func LoadPlugin[T schemas.Plugin](ctx context.Context, name string, path *string
 	return zero, fmt.Errorf("plugin %s not found", name)
 }
 
+// DefaultPluginOrder is the order in which the hooks of the built-in plugins run, ahead of the custom plugins,
+// whatever the order in which plugins are loaded or reloaded (see the pipeline documented in lib/middleware.go),
+// unless the client config sets plugin_order. Governance runs first so that a request it rejects reaches no
+// other plugin, then the telemetry and logging plugins, then the custom plugins.
+var DefaultPluginOrder = []string{governance.PluginName, telemetry.PluginName, logging.PluginName}
+
 // LoadPlugins loads the plugins for the server.
 func LoadPlugins(ctx context.Context, config *lib.Config) ([]schemas.Plugin, []schemas.PluginStatus, error) {
 	var err error
@@ -652,6 +667,44 @@ func (s *BifrostHTTPServer) RemoveCustomer(ctx context.Context, id string) error
 	return nil
 }
 
+// failedRequestCaptureConfig returns the capture configuration of failed requests, which are kept in memory
+// for replay. Capturing is opt-in and stays off while content logging is disabled.
+func failedRequestCaptureConfig(clientConfig configstore.ClientConfig) *schemas.FailedRequestCaptureConfig {
+	if !clientConfig.EnableFailedRequestCapture || clientConfig.DisableContentLogging {
+		return nil
+	}
+	return &schemas.FailedRequestCaptureConfig{
+		MaxEntries:      clientConfig.FailedRequestCaptureMaxEntries,
+		TTL:             time.Duration(clientConfig.FailedRequestCaptureTTLInSeconds) * time.Second,
+		MaxPayloadBytes: clientConfig.FailedRequestCaptureMaxPayloadKB * 1024,
+	}
+}
+
+// pluginOrder returns the plugin order of the client config, DefaultPluginOrder when it sets none.
+func pluginOrder(clientConfig configstore.ClientConfig) []string {
+	if len(clientConfig.PluginOrder) == 0 {
+		return DefaultPluginOrder
+	}
+	return clientConfig.PluginOrder
+}
+
+// hedgingConfig returns the hedging configuration of the client config, nil while hedging is disabled.
+func hedgingConfig(clientConfig configstore.ClientConfig) *schemas.HedgingConfig {
+	if clientConfig.HedgingDelayInMs <= 0 {
+		return nil
+	}
+	return &schemas.HedgingConfig{Delay: time.Duration(clientConfig.HedgingDelayInMs) * time.Millisecond}
+}
+
+// lengthContinuationConfig returns the continuation configuration of truncated chat responses, nil while
+// continuation is disabled.
+func lengthContinuationConfig(clientConfig configstore.ClientConfig) *schemas.LengthContinuationConfig {
+	if clientConfig.MaxContinuations <= 0 {
+		return nil
+	}
+	return &schemas.LengthContinuationConfig{MaxContinuations: clientConfig.MaxContinuations}
+}
+
 // ReloadClientConfigFromConfigStore reloads the client config from config store
 func (s *BifrostHTTPServer) ReloadClientConfigFromConfigStore(ctx context.Context) error {
 	if s.Config == nil || s.Config.ConfigStore == nil {
@@ -666,12 +719,19 @@ func (s *BifrostHTTPServer) ReloadClientConfigFromConfigStore(ctx context.Contex
 	if s.Client != nil {
 		account := lib.NewBaseAccount(s.Config)
 		s.Client.ReloadConfig(schemas.BifrostConfig{
-			Account:            account,
-			InitialPoolSize:    s.Config.ClientConfig.InitialPoolSize,
-			DropExcessRequests: s.Config.ClientConfig.DropExcessRequests,
-			Plugins:            s.Config.GetLoadedPlugins(),
-			MCPConfig:          s.Config.MCPConfig,
-			Logger:             logger,
+			Account:                account,
+			InitialPoolSize:        s.Config.ClientConfig.InitialPoolSize,
+			DropExcessRequests:     s.Config.ClientConfig.DropExcessRequests,
+			Plugins:                s.Config.GetLoadedPlugins(),
+			MCPConfig:              s.Config.MCPConfig,
+			Logger:                 logger,
+			FailedRequestCapture:   failedRequestCaptureConfig(s.Config.ClientConfig),
+			PluginOrder:            pluginOrder(s.Config.ClientConfig),
+			MaxConcurrentStreams:   s.Config.ClientConfig.MaxConcurrentStreams,
+			Hedging:                hedgingConfig(s.Config.ClientConfig),
+			MaxTokensDerivation:    s.Config.ClientConfig.MaxTokensDerivation,
+			LengthContinuation:     lengthContinuationConfig(s.Config.ClientConfig),
+			ModelNameNormalization: s.Config.ClientConfig.ModelNameNormalization,
 		})
 	}
 	return nil
@@ -965,9 +1025,16 @@ func (s *BifrostHTTPServer) RegisterAPIRoutes(ctx context.Context, callbacks Ser
 	configHandler := handlers.NewConfigHandler(callbacks, s.Config)
 	pluginsHandler := handlers.NewPluginsHandler(callbacks, s.Config.ConfigStore)
 	sessionHandler := handlers.NewSessionHandler(s.Config.ConfigStore)
+	batchHandler := handlers.NewBatchHandler(s.Client, s.Config, s.Config.PricingManager)
+	if s.StaleBatchReapInterval > 0 {
+		batchHandler.StartStaleBatchReaper(ctx, s.StaleBatchReapInterval, s.StaleBatchThreshold)
+	}
+	failedRequestHandler := handlers.NewFailedRequestHandler(s.Client, s.Config)
 	// Going ahead with API handlers
 	healthHandler.RegisterRoutes(s.Router, middlewares...)
 	providerHandler.RegisterRoutes(s.Router, middlewares...)
+	batchHandler.RegisterRoutes(s.Router, middlewares...)
+	failedRequestHandler.RegisterRoutes(s.Router, middlewares...)
 	mcpHandler.RegisterRoutes(s.Router, middlewares...)
 	configHandler.RegisterRoutes(s.Router, middlewares...)
 	if pluginsHandler != nil {
@@ -1121,17 +1188,42 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 	// The account interface now benefits from ultra-fast config access times via in-memory storage
 	account := lib.NewBaseAccount(s.Config)
 	s.Client, err = bifrost.Init(ctx, schemas.BifrostConfig{
-		Account:            account,
-		InitialPoolSize:    s.Config.ClientConfig.InitialPoolSize,
-		DropExcessRequests: s.Config.ClientConfig.DropExcessRequests,
-		Plugins:            s.Plugins,
-		MCPConfig:          s.Config.MCPConfig,
-		Logger:             logger,
+		Account:                account,
+		InitialPoolSize:        s.Config.ClientConfig.InitialPoolSize,
+		DropExcessRequests:     s.Config.ClientConfig.DropExcessRequests,
+		Plugins:                s.Plugins,
+		MCPConfig:              s.Config.MCPConfig,
+		Logger:                 logger,
+		FailedRequestCapture:   failedRequestCaptureConfig(s.Config.ClientConfig),
+		PluginOrder:            pluginOrder(s.Config.ClientConfig),
+		MaxConcurrentStreams:   s.Config.ClientConfig.MaxConcurrentStreams,
+		Hedging:                hedgingConfig(s.Config.ClientConfig),
+		MaxTokensDerivation:    s.Config.ClientConfig.MaxTokensDerivation,
+		LengthContinuation:     lengthContinuationConfig(s.Config.ClientConfig),
+		ModelNameNormalization: s.Config.ClientConfig.ModelNameNormalization,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize bifrost: %v", err)
 	}
 	logger.Info("bifrost client initialized")
+	// Expose per-provider bulkhead usage
+	if prometheusPlugin, err := FindPluginByName[*telemetry.PrometheusPlugin](s.Plugins, telemetry.PluginName); err == nil {
+		if err := prometheusPlugin.RegisterBulkheadStats(s.Client.GetBulkheadStats); err != nil {
+			logger.Warn("failed to register bulkhead metrics: %v", err)
+		}
+		// Expose provider response pool usage
+		if err := prometheusPlugin.RegisterResponsePoolStats(s.Client.GetResponsePoolStats); err != nil {
+			logger.Warn("failed to register response pool metrics: %v", err)
+		}
+		// Expose the streams open at once
+		if err := prometheusPlugin.RegisterStreamStats(s.Client.GetStreamStats); err != nil {
+			logger.Warn("failed to register stream metrics: %v", err)
+		}
+		// Expose the latency of the S3 operations of batches and files
+		if err := prometheusPlugin.RegisterS3OperationStats(s.Client.GetS3OperationStats); err != nil {
+			logger.Warn("failed to register S3 operation metrics: %v", err)
+		}
+	}
 	// List all models and add to model catalog
 	logger.Info("listing all models and adding to model catalog")
 	modelData, listModelsErr := s.Client.ListAllModels(ctx, nil)
@@ -1151,7 +1243,6 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 	s.Router = router.New()
 	commonMiddlewares := s.PrepareCommonMiddlewares()
 	apiMiddlewares := commonMiddlewares
-	inferenceMiddlewares := commonMiddlewares
 	var authConfig *configstore.AuthConfig
 	if s.Config.ConfigStore != nil {
 		authConfig, err = s.Config.ConfigStore.GetAuthConfig(ctx)
@@ -1170,13 +1261,13 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to initialize routes: %v", err)
 	}
-	// Registering inference routes
+	// Registering inference routes, with the middlewares in pipeline order (see lib.InferenceMiddlewares)
+	var inferenceAuthMiddleware lib.BifrostHTTPMiddleware
 	if ctx.Value("isEnterprise") == nil && authConfig != nil && authConfig.IsEnabled && !authConfig.DisableAuthOnInference {
-		inferenceMiddlewares = append(inferenceMiddlewares, handlers.AuthMiddleware(s.Config.ConfigStore))
+		inferenceAuthMiddleware = handlers.AuthMiddleware(s.Config.ConfigStore)
 	}
-	// Registering inference middlewares
-	inferenceMiddlewares = append([]lib.BifrostHTTPMiddleware{handlers.TransportInterceptorMiddleware(s.Config)}, inferenceMiddlewares...)
-	err = s.RegisterInferenceRoutes(s.ctx, inferenceMiddlewares...)
+	transportMiddlewares := append([]lib.BifrostHTTPMiddleware{handlers.TransportInterceptorMiddleware(s.Config)}, commonMiddlewares...)
+	err = s.RegisterInferenceRoutes(s.ctx, lib.InferenceMiddlewares(transportMiddlewares, inferenceAuthMiddleware)...)
 	if err != nil {
 		return fmt.Errorf("failed to initialize inference routes: %v", err)
 	}
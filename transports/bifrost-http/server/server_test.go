@@ -2,6 +2,9 @@ package server
 
 import (
 	"testing"
+	"time"
+
+	"github.com/maximhq/bifrost/framework/configstore"
 )
 
 // TestConfig is a sample config struct for testing
@@ -278,3 +281,41 @@ func TestMarshalPluginConfig_WithComplexType(t *testing.T) {
 		t.Errorf("Expected nested name=nested-config, got %s", result.Nested.Name)
 	}
 }
+
+func TestHedgingConfig(t *testing.T) {
+	if config := hedgingConfig(configstore.ClientConfig{}); config != nil {
+		t.Errorf("Expected hedging to be disabled without a delay, got %+v", config)
+	}
+	config := hedgingConfig(configstore.ClientConfig{HedgingDelayInMs: 250})
+	if config == nil || config.Delay != 250*time.Millisecond {
+		t.Errorf("Expected a 250ms hedging delay, got %+v", config)
+	}
+}
+
+func TestLengthContinuationConfig(t *testing.T) {
+	if config := lengthContinuationConfig(configstore.ClientConfig{}); config != nil {
+		t.Errorf("Expected continuation to be disabled without max continuations, got %+v", config)
+	}
+	config := lengthContinuationConfig(configstore.ClientConfig{MaxContinuations: 2})
+	if config == nil || config.MaxContinuations != 2 {
+		t.Errorf("Expected 2 max continuations, got %+v", config)
+	}
+}
+
+func TestFailedRequestCaptureConfig(t *testing.T) {
+	if config := failedRequestCaptureConfig(configstore.ClientConfig{}); config != nil {
+		t.Errorf("Expected failed request capture to be off by default, got %+v", config)
+	}
+	if config := failedRequestCaptureConfig(configstore.ClientConfig{EnableFailedRequestCapture: true, DisableContentLogging: true}); config != nil {
+		t.Errorf("Expected failed request capture to be off while content logging is disabled, got %+v", config)
+	}
+	config := failedRequestCaptureConfig(configstore.ClientConfig{
+		EnableFailedRequestCapture:       true,
+		FailedRequestCaptureMaxEntries:   20,
+		FailedRequestCaptureTTLInSeconds: 600,
+		FailedRequestCaptureMaxPayloadKB: 64,
+	})
+	if config == nil || config.MaxEntries != 20 || config.TTL != 10*time.Minute || config.MaxPayloadBytes != 64*1024 {
+		t.Errorf("Expected the configured capture bounds, got %+v", config)
+	}
+}
@@ -100,6 +100,7 @@ const S3BucketConfigSchema = z.object({
 
 const BatchS3ConfigSchema = z.object({
 	buckets: z.array(S3BucketConfigSchema).optional(),
+	input_s3_uri: z.string().optional(),
 });
 
 const BedrockKeyConfigSchema = z
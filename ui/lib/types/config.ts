@@ -57,6 +57,7 @@ export interface S3BucketConfig {
 
 export interface BatchS3Config {
 	buckets?: S3BucketConfig[];
+	input_s3_uri?: string;
 }
 
 // BedrockKeyConfig matching Go's schemas.BedrockKeyConfig
@@ -175,12 +176,20 @@ export interface AllowedRequests {
 	list_models: boolean;
 }
 
+// RequestBodyTemplate matching Go's schemas.RequestBodyTemplate
+export interface RequestBodyTemplate {
+	fields?: Record<string, string>;
+	defaults?: Record<string, unknown>;
+	keep_unmapped?: boolean;
+}
+
 // CustomProviderConfig matching Go's schemas.CustomProviderConfig
 export interface CustomProviderConfig {
 	base_provider_type: KnownProvider;
 	is_key_less?: boolean;
 	allowed_requests?: AllowedRequests;
 	request_path_overrides?: Record<string, string>;
+	request_body_templates?: Record<string, RequestBodyTemplate>;
 }
 
 // ProviderConfig matching Go's lib.ProviderConfig
@@ -95,6 +95,7 @@ export const s3BucketConfigSchema = z.object({
 
 export const batchS3ConfigSchema = z.object({
 	buckets: z.array(s3BucketConfigSchema).optional(),
+	input_s3_uri: z.string().optional(),
 })
 
 // Bedrock key config schema
@@ -337,6 +338,13 @@ export const allowedRequestsSchema = z.object({
 	list_models: z.boolean(),
 });
 
+// Request body template schema
+export const requestBodyTemplateSchema = z.object({
+	fields: z.record(z.string(), z.string()).optional(),
+	defaults: z.record(z.string(), z.unknown()).optional(),
+	keep_unmapped: z.boolean().optional(),
+});
+
 // Custom provider config schema
 export const customProviderConfigSchema = z
 	.object({
@@ -344,6 +352,7 @@ export const customProviderConfigSchema = z
 		is_key_less: z.boolean().optional(),
 		allowed_requests: allowedRequestsSchema.optional(),
 		request_path_overrides: z.record(z.string(), z.string().optional()).optional(),
+		request_body_templates: z.record(z.string(), requestBodyTemplateSchema).optional(),
 	})
 	.refine(
 		(data) => {
@@ -365,6 +374,7 @@ export const formCustomProviderConfigSchema = z
 		is_key_less: z.boolean().optional(),
 		allowed_requests: allowedRequestsSchema.optional(),
 		request_path_overrides: z.record(z.string(), z.string().optional()).optional(),
+		request_body_templates: z.record(z.string(), requestBodyTemplateSchema).optional(),
 	})
 	.refine(
 		(data) => {